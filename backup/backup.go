@@ -0,0 +1,128 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+// Package backup exports the knowledge graph in a database to a stream of records, and imports such a stream again.
+// Only the DatabaseConnector interface is used, so that data can be moved between all connectors.
+package backup
+
+import (
+	"time"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/creativesoftwarefdn/weaviate/schema"
+)
+
+// pageSize is the number of things or actions that are listed at once
+const pageSize int = 100
+
+// schemaRefs returns the UUIDs that the properties of the given schema refer to, by property name
+func schemaRefs(objectSchema models.Schema) map[string]strfmt.UUID {
+	refs := map[string]strfmt.UUID{}
+
+	properties, ok := objectSchema.(map[string]interface{})
+	if !ok {
+		return refs
+	}
+
+	for key, value := range properties {
+		switch ref := value.(type) {
+		case *models.SingleRef:
+			refs[key] = ref.NrDollarCref
+		case map[string]interface{}:
+			if cref, ok := ref["$cref"].(string); ok {
+				refs[key] = strfmt.UUID(cref)
+			}
+		}
+	}
+
+	return refs
+}
+
+// normalizeSchema converts the values of a schema that is decoded from JSON to the types that the connectors
+// expect, based on the data types in the semantic schema. Properties in skip are left out.
+func normalizeSchema(semanticSchema *models.SemanticSchema, className string, objectSchema models.Schema, skip map[string]bool) models.Schema {
+	properties, ok := objectSchema.(map[string]interface{})
+	if !ok {
+		return objectSchema
+	}
+
+	class, _ := schema.GetClassByName(semanticSchema, className)
+
+	normalized := map[string]interface{}{}
+	for key, value := range properties {
+		if skip[key] {
+			continue
+		}
+
+		var dataType schema.DataType
+		if class != nil {
+			if dt, err := schema.GetPropertyDataType(class, key); err == nil {
+				dataType = *dt
+			}
+		}
+
+		normalized[key] = normalizeValue(dataType, value)
+	}
+
+	return normalized
+}
+
+// normalizeValue converts a single value of a schema to the type that belongs to the given data type
+func normalizeValue(dataType schema.DataType, value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if dataType == schema.DataTypeGeoCoordinates {
+			latitude, _ := v["latitude"].(float64)
+			longitude, _ := v["longitude"].(float64)
+			return &models.GeoCoordinates{Latitude: latitude, Longitude: longitude}
+		}
+
+		cref, ok := v["$cref"].(string)
+		if !ok {
+			return value
+		}
+
+		ref := &models.SingleRef{NrDollarCref: strfmt.UUID(cref)}
+		ref.Type, _ = v["type"].(string)
+		if location, ok := v["locationUrl"].(string); ok {
+			ref.LocationURL = &location
+		}
+		return ref
+	case []interface{}:
+		// The values of an array have the data type of its elements
+		values := make([]interface{}, 0, len(v))
+		for _, element := range v {
+			values = append(values, normalizeValue(schema.ElementDataType(dataType), element))
+		}
+		return values
+	case float64:
+		if dataType == schema.DataTypeInt {
+			return int64(v)
+		}
+	case string:
+		if dataType != schema.DataTypeDate {
+			return value
+		}
+
+		// Dates are given in RFC3339, or in the default format of Go when they are stored as a string by a connector
+		for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05.999999999 -0700 MST"} {
+			if date, err := time.Parse(layout, v); err == nil {
+				return date
+			}
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,81 @@
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/creativesoftwarefdn/weaviate/schema"
+)
+
+func TestNormalizeSchema(t *testing.T) {
+	semanticSchema := &models.SemanticSchema{
+		Classes: []*models.SemanticSchemaClass{
+			{
+				Class: "City",
+				Properties: []*models.SemanticSchemaClassProperty{
+					{Name: "inhabitants", AtDataType: []string{string(schema.DataTypeInt)}},
+					{Name: "area", AtDataType: []string{string(schema.DataTypeNumber)}},
+					{Name: "founded", AtDataType: []string{string(schema.DataTypeDate)}},
+					{Name: "districts", AtDataType: []string{string(schema.DataTypeIntArray)}},
+					{Name: "location", AtDataType: []string{string(schema.DataTypeGeoCoordinates)}},
+					{Name: "country", AtDataType: []string{"Country"}},
+					{Name: "mayor", AtDataType: []string{"Person"}},
+				},
+			},
+		},
+	}
+
+	input := map[string]interface{}{
+		"inhabitants": float64(800000),
+		"area":        float64(219.3),
+		"founded":     "1275-10-27T00:00:00Z",
+		"districts":   []interface{}{float64(1), float64(2)},
+		"location":    map[string]interface{}{"latitude": float64(52.37), "longitude": float64(4.89)},
+		"country": map[string]interface{}{
+			"$cref":       "6f2ba5b8-63ef-4d82-9b8b-6d1d3b5c1a52",
+			"locationUrl": "http://localhost",
+			"type":        "Thing",
+		},
+		"mayor": map[string]interface{}{
+			"$cref":       "1e0d8b1d-0c0f-4f4a-8a45-7b5b7a4b0a1d",
+			"locationUrl": "http://localhost",
+			"type":        "Thing",
+		},
+	}
+
+	normalized := normalizeSchema(semanticSchema, "City", input, map[string]bool{"mayor": true}).(map[string]interface{})
+
+	if _, ok := normalized["mayor"]; ok {
+		t.Errorf("skipped property 'mayor' should be left out")
+	}
+
+	if inhabitants, ok := normalized["inhabitants"].(int64); !ok || inhabitants != 800000 {
+		t.Errorf("expected 'inhabitants' to be int64 800000, got %#v", normalized["inhabitants"])
+	}
+
+	if area, ok := normalized["area"].(float64); !ok || area != 219.3 {
+		t.Errorf("expected 'area' to be float64 219.3, got %#v", normalized["area"])
+	}
+
+	if founded, ok := normalized["founded"].(time.Time); !ok || founded.Year() != 1275 {
+		t.Errorf("expected 'founded' to be a time in 1275, got %#v", normalized["founded"])
+	}
+
+	if districts, ok := normalized["districts"].([]interface{}); !ok || len(districts) != 2 || districts[1] != int64(2) {
+		t.Errorf("expected 'districts' to be a list of int64, got %#v", normalized["districts"])
+	}
+
+	if location, ok := normalized["location"].(*models.GeoCoordinates); !ok || location.Latitude != 52.37 || location.Longitude != 4.89 {
+		t.Errorf("expected 'location' to be geo coordinates, got %#v", normalized["location"])
+	}
+
+	country, ok := normalized["country"].(*models.SingleRef)
+	if !ok {
+		t.Fatalf("expected 'country' to be a single ref, got %#v", normalized["country"])
+	}
+
+	if country.NrDollarCref != "6f2ba5b8-63ef-4d82-9b8b-6d1d3b5c1a52" || country.Type != "Thing" || *country.LocationURL != "http://localhost" {
+		t.Errorf("unexpected single ref for 'country': %#v", country)
+	}
+}
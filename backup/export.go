@@ -0,0 +1,179 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+package backup
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+
+	dbconnector "github.com/creativesoftwarefdn/weaviate/connectors"
+	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/models"
+)
+
+// Export calls write for every record in the database, as it is listed: first the keys in the tree of the given
+// root key, parents before children, then the things and at last the actions. Only the UUIDs of the records are kept
+// in memory. Things and actions can refer to things and actions that come later in the stream; the Importer adds
+// those references after the other records.
+func Export(ctx context.Context, databaseConnector dbconnector.DatabaseConnector, rootKeyID strfmt.UUID, serverAddress string, includeKeys bool, write func(record *models.ExportRecord) error) error {
+	keys, err := exportKeys(ctx, databaseConnector, rootKeyID, serverAddress)
+	if err != nil {
+		return err
+	}
+
+	if includeKeys {
+		for _, key := range keys {
+			if err := write(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	things, err := exportThings(ctx, databaseConnector, keys, write)
+	if err != nil {
+		return err
+	}
+
+	return exportActions(ctx, databaseConnector, things, write)
+}
+
+// exportKeys returns a record for every key in the tree of the given root key, breadth first
+func exportKeys(ctx context.Context, databaseConnector dbconnector.DatabaseConnector, rootKeyID strfmt.UUID, serverAddress string) ([]*models.ExportRecord, error) {
+	records := []*models.ExportRecord{}
+	parents := map[strfmt.UUID]strfmt.UUID{}
+
+	for queue := []strfmt.UUID{rootKeyID}; len(queue) > 0; queue = queue[1:] {
+		UUID := queue[0]
+
+		// Validating the token is the only way to get the hashed token of a key
+		key := models.KeyGetResponse{}
+		tokenHash, err := databaseConnector.ValidateToken(ctx, UUID, &key)
+		if err != nil {
+			return nil, err
+		}
+
+		// Not all connectors fill the parent of a key, but it follows from the tree
+		if parentID, ok := parents[UUID]; ok && key.Parent == nil {
+			key.Parent = &models.SingleRef{
+				LocationURL:  &serverAddress,
+				NrDollarCref: parentID,
+				Type:         string(connutils.RefTypeKey),
+			}
+		}
+
+		records = append(records, &models.ExportRecord{
+			Type:      models.ExportRecordTypeKey,
+			UUID:      UUID,
+			Key:       &key.Key,
+			TokenHash: tokenHash,
+		})
+
+		children := []*models.KeyGetResponse{}
+		if err := databaseConnector.GetKeyChildren(ctx, UUID, &children); err != nil {
+			return nil, err
+		}
+
+		for _, child := range children {
+			parents[child.KeyID] = UUID
+			queue = append(queue, child.KeyID)
+		}
+	}
+
+	return records, nil
+}
+
+// exportThings writes a record for every thing that belongs to one of the given keys, and returns their UUIDs
+func exportThings(ctx context.Context, databaseConnector dbconnector.DatabaseConnector, keys []*models.ExportRecord, write func(record *models.ExportRecord) error) ([]strfmt.UUID, error) {
+	exported := map[strfmt.UUID]bool{}
+	UUIDs := []strfmt.UUID{}
+
+	for _, key := range keys {
+		for offset := 0; ; offset += pageSize {
+			response := models.ThingsListResponse{}
+			err := databaseConnector.ListThings(ctx, pageSize, offset, key.UUID, "", []*connutils.WhereQuery{}, &response)
+			if err != nil {
+				return nil, err
+			}
+
+			// Not every connector limits the list to the given key, so every page is checked for things that are new
+			added := 0
+			for _, thing := range response.Things {
+				if exported[thing.ThingID] {
+					continue
+				}
+
+				err := write(&models.ExportRecord{
+					Type:  models.ExportRecordTypeThing,
+					UUID:  thing.ThingID,
+					Thing: &thing.Thing,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				exported[thing.ThingID] = true
+				UUIDs = append(UUIDs, thing.ThingID)
+				added++
+			}
+
+			if added == 0 {
+				break
+			}
+		}
+	}
+
+	return UUIDs, nil
+}
+
+// exportActions writes a record for every action of one of the given things
+func exportActions(ctx context.Context, databaseConnector dbconnector.DatabaseConnector, things []strfmt.UUID, write func(record *models.ExportRecord) error) error {
+	exported := map[strfmt.UUID]bool{}
+
+	for _, thingID := range things {
+		for offset := 0; ; offset += pageSize {
+			response := models.ActionsListResponse{}
+			err := databaseConnector.ListActions(ctx, thingID, pageSize, offset, []*connutils.WhereQuery{}, &response)
+			if err != nil {
+				return err
+			}
+
+			// An action belongs to more than one thing, so it is only written the first time
+			added := 0
+			for _, action := range response.Actions {
+				if exported[action.ActionID] {
+					continue
+				}
+
+				err := write(&models.ExportRecord{
+					Type:   models.ExportRecordTypeAction,
+					UUID:   action.ActionID,
+					Action: &action.Action,
+				})
+				if err != nil {
+					return err
+				}
+
+				exported[action.ActionID] = true
+				added++
+			}
+
+			if added == 0 {
+				break
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,284 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/strfmt"
+
+	dbconnector "github.com/creativesoftwarefdn/weaviate/connectors"
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/creativesoftwarefdn/weaviate/schema"
+)
+
+const (
+	// ErrorInvalidRecord message
+	ErrorInvalidRecord string = "record %d is not a valid %s record"
+	// ErrorUnknownRecordType message
+	ErrorUnknownRecordType string = "record %d has an unknown type '%s'"
+	// ErrorDecodeRecord message
+	ErrorDecodeRecord string = "record %d can not be decoded: %s"
+	// ErrorImportRecord message
+	ErrorImportRecord string = "record %d with UUID '%s' can not be imported: %s"
+)
+
+// Importer adds the records of an export to a database. Records are added as they are, without validation, so
+// that UUIDs, creation times, key ownership and references are preserved.
+type Importer struct {
+	databaseConnector dbconnector.DatabaseConnector
+	databaseSchema    schema.WeaviateSchema
+	checkpoint        int64
+
+	// seen holds the UUIDs of all records up to now, to find references to records that are not imported yet
+	seen map[strfmt.UUID]bool
+
+	// deferred holds the references that were left out of the things and actions, as they did not exist yet
+	deferred []*deferredRefs
+
+	// Result holds the checkpoint and the number of imported records
+	Result models.ImportResponse
+}
+
+// deferredRefs are the references of a thing or action to records that come later in the stream, by property name
+type deferredRefs struct {
+	recordType string
+	UUID       strfmt.UUID
+	refs       map[string]interface{}
+}
+
+// NewImporter creates an Importer that skips the given number of records, which are imported before
+func NewImporter(databaseConnector dbconnector.DatabaseConnector, databaseSchema schema.WeaviateSchema, checkpoint int64) *Importer {
+	return &Importer{
+		databaseConnector: databaseConnector,
+		databaseSchema:    databaseSchema,
+		checkpoint:        checkpoint,
+		seen:              map[strfmt.UUID]bool{},
+	}
+}
+
+// ImportStream imports a stream of newline-delimited records and completes the import
+func (i *Importer) ImportStream(ctx context.Context, stream io.Reader) error {
+	decoder := json.NewDecoder(stream)
+
+	for {
+		record := &models.ExportRecord{}
+		err := decoder.Decode(record)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return fmt.Errorf(ErrorDecodeRecord, i.Result.Checkpoint, err)
+		}
+
+		if err := i.Import(ctx, record); err != nil {
+			return err
+		}
+	}
+
+	return i.Finish(ctx)
+}
+
+// Import imports a single record. When it succeeds, the checkpoint is moved past this record.
+func (i *Importer) Import(ctx context.Context, record *models.ExportRecord) error {
+	position := i.Result.Checkpoint
+
+	if err := validateRecord(position, record); err != nil {
+		return err
+	}
+
+	// References to records that come later in the stream are left out for now, and added in Finish
+	pending := map[string]bool{}
+	if record.Type != models.ExportRecordTypeKey {
+		properties, _ := recordSchema(record).(map[string]interface{})
+		refs := map[string]interface{}{}
+		for key, UUID := range schemaRefs(properties) {
+			if !i.seen[UUID] {
+				pending[key] = true
+				refs[key] = properties[key]
+			}
+		}
+
+		if len(refs) > 0 {
+			i.deferred = append(i.deferred, &deferredRefs{recordType: record.Type, UUID: record.UUID, refs: refs})
+		}
+	}
+	i.seen[record.UUID] = true
+
+	// Records before the checkpoint are imported already
+	if position < i.checkpoint {
+		i.Result.Checkpoint++
+		return nil
+	}
+
+	var err error
+	switch record.Type {
+	case models.ExportRecordTypeKey:
+		err = i.importKey(ctx, record)
+	case models.ExportRecordTypeThing:
+		err = i.importThing(ctx, record, pending)
+	case models.ExportRecordTypeAction:
+		err = i.importAction(ctx, record, pending)
+	}
+
+	if err != nil {
+		return fmt.Errorf(ErrorImportRecord, position, record.UUID, err)
+	}
+
+	i.Result.Checkpoint++
+	return nil
+}
+
+// Finish adds the references that were left out while importing to the imported things and actions, now that all
+// records exist
+func (i *Importer) Finish(ctx context.Context) error {
+	for _, deferred := range i.deferred {
+		var err error
+		if deferred.recordType == models.ExportRecordTypeThing {
+			err = i.addThingRefs(ctx, deferred)
+		} else {
+			err = i.addActionRefs(ctx, deferred)
+		}
+
+		if err != nil {
+			return fmt.Errorf(ErrorImportRecord, i.Result.Checkpoint, deferred.UUID, err)
+		}
+	}
+
+	i.deferred = nil
+	return nil
+}
+
+// addThingRefs adds the deferred references to a thing that is imported already
+func (i *Importer) addThingRefs(ctx context.Context, deferred *deferredRefs) error {
+	existing := models.ThingGetResponse{}
+	if err := i.databaseConnector.GetThing(ctx, deferred.UUID, &existing); err != nil {
+		return err
+	}
+
+	thing := existing.Thing
+	thing.Schema = normalizeSchema(i.databaseSchema.ThingSchema.Schema, thing.AtClass, withRefs(thing.Schema, deferred.refs), nil)
+	return i.databaseConnector.UpdateThing(ctx, &thing, deferred.UUID)
+}
+
+// addActionRefs adds the deferred references to an action that is imported already
+func (i *Importer) addActionRefs(ctx context.Context, deferred *deferredRefs) error {
+	existing := models.ActionGetResponse{}
+	if err := i.databaseConnector.GetAction(ctx, deferred.UUID, &existing); err != nil {
+		return err
+	}
+
+	action := existing.Action
+	action.Schema = normalizeSchema(i.databaseSchema.ActionSchema.Schema, action.AtClass, withRefs(action.Schema, deferred.refs), nil)
+	return i.databaseConnector.UpdateAction(ctx, &action, deferred.UUID)
+}
+
+// importKey adds a key with its hashed token, unless a key with the same UUID exists already
+func (i *Importer) importKey(ctx context.Context, record *models.ExportRecord) error {
+	existing := models.KeyGetResponse{}
+	if err := i.databaseConnector.GetKey(ctx, record.UUID, &existing); err == nil && existing.KeyID != "" {
+		return nil
+	}
+
+	if err := i.databaseConnector.AddKey(ctx, record.Key, record.UUID, record.TokenHash); err != nil {
+		return err
+	}
+
+	i.Result.Keys++
+	return nil
+}
+
+// importThing adds a thing, or updates it when it exists already. The properties in skip are left out.
+func (i *Importer) importThing(ctx context.Context, record *models.ExportRecord, skip map[string]bool) error {
+	thing := *record.Thing
+	thing.Schema = normalizeSchema(i.databaseSchema.ThingSchema.Schema, thing.AtClass, thing.Schema, skip)
+
+	existing := models.ThingGetResponse{}
+	if err := i.databaseConnector.GetThing(ctx, record.UUID, &existing); err == nil && existing.Key != nil {
+		return i.databaseConnector.UpdateThing(ctx, &thing, record.UUID)
+	}
+
+	if err := i.databaseConnector.AddThing(ctx, &thing, record.UUID); err != nil {
+		return err
+	}
+
+	i.Result.Things++
+	return nil
+}
+
+// importAction adds an action, or updates it when it exists already. The properties in skip are left out.
+func (i *Importer) importAction(ctx context.Context, record *models.ExportRecord, skip map[string]bool) error {
+	action := *record.Action
+	action.Schema = normalizeSchema(i.databaseSchema.ActionSchema.Schema, action.AtClass, action.Schema, skip)
+
+	existing := models.ActionGetResponse{}
+	if err := i.databaseConnector.GetAction(ctx, record.UUID, &existing); err == nil && existing.Key != nil {
+		return i.databaseConnector.UpdateAction(ctx, &action, record.UUID)
+	}
+
+	if err := i.databaseConnector.AddAction(ctx, &action, record.UUID); err != nil {
+		return err
+	}
+
+	i.Result.Actions++
+	return nil
+}
+
+// validateRecord checks whether the record has a UUID and holds the object of its type
+func validateRecord(position int64, record *models.ExportRecord) error {
+	var ok bool
+	switch record.Type {
+	case models.ExportRecordTypeKey:
+		ok = record.Key != nil
+	case models.ExportRecordTypeThing:
+		ok = record.Thing != nil && record.Thing.Key != nil
+	case models.ExportRecordTypeAction:
+		ok = record.Action != nil && record.Action.Key != nil
+	default:
+		return fmt.Errorf(ErrorUnknownRecordType, position, record.Type)
+	}
+
+	if !ok || record.UUID == "" {
+		return fmt.Errorf(ErrorInvalidRecord, position, record.Type)
+	}
+
+	return nil
+}
+
+// withRefs returns the properties of a schema with the given references added
+func withRefs(objectSchema models.Schema, refs map[string]interface{}) map[string]interface{} {
+	properties := map[string]interface{}{}
+	if existing, ok := objectSchema.(map[string]interface{}); ok {
+		for key, value := range existing {
+			properties[key] = value
+		}
+	}
+
+	for key, value := range refs {
+		properties[key] = value
+	}
+
+	return properties
+}
+
+// recordSchema returns the schema of the thing or action in a record
+func recordSchema(record *models.ExportRecord) models.Schema {
+	if record.Type == models.ExportRecordTypeThing {
+		return record.Thing.Schema
+	}
+
+	return record.Action.Schema
+}
@@ -0,0 +1,75 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+
+	dbconnector "github.com/creativesoftwarefdn/weaviate/connectors"
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/creativesoftwarefdn/weaviate/schema"
+)
+
+// thingStore keeps things in memory; the other methods of the connector are not used by the tests
+type thingStore struct {
+	dbconnector.DatabaseConnector
+	things  map[strfmt.UUID]models.Thing
+	updates int
+}
+
+func (s *thingStore) AddThing(ctx context.Context, thing *models.Thing, UUID strfmt.UUID) error {
+	s.things[UUID] = *thing
+	return nil
+}
+
+func (s *thingStore) GetThing(ctx context.Context, UUID strfmt.UUID, thingResponse *models.ThingGetResponse) error {
+	thing, ok := s.things[UUID]
+	if !ok {
+		return fmt.Errorf("thing '%s' not found", UUID)
+	}
+
+	thingResponse.Thing = thing
+	thingResponse.ThingID = UUID
+	return nil
+}
+
+func (s *thingStore) UpdateThing(ctx context.Context, thing *models.Thing, UUID strfmt.UUID) error {
+	s.things[UUID] = *thing
+	s.updates++
+	return nil
+}
+
+func TestImportDeferredRefs(t *testing.T) {
+	// Amsterdam refers to the Netherlands, which comes later in the stream
+	stream := strings.NewReader(`{"type": "thing", "uuid": "a", "thing": {"@class": "City", "key": {"$cref": "k"}, "schema": {"name": "Amsterdam", "country": {"$cref": "n", "type": "Thing"}}}}
+{"type": "thing", "uuid": "n", "thing": {"@class": "Country", "key": {"$cref": "k"}, "schema": {"name": "Netherlands", "capital": {"$cref": "a", "type": "Thing"}}}}
+`)
+
+	store := &thingStore{things: map[strfmt.UUID]models.Thing{}}
+	var databaseSchema schema.WeaviateSchema
+	databaseSchema.ThingSchema.Schema = &models.SemanticSchema{}
+	importer := NewImporter(store, databaseSchema, 0)
+	if err := importer.ImportStream(context.Background(), stream); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if importer.Result.Things != 2 || store.updates != 1 {
+		t.Errorf("expected 2 things to be added and 1 to be updated, got %d and %d", importer.Result.Things, store.updates)
+	}
+
+	amsterdam := store.things["a"].Schema.(map[string]interface{})
+	if amsterdam["name"] != "Amsterdam" {
+		t.Errorf("expected the properties of Amsterdam to be kept, got %v", amsterdam)
+	}
+	if ref, ok := amsterdam["country"].(*models.SingleRef); !ok || ref.NrDollarCref != "n" {
+		t.Errorf("expected Amsterdam to refer to the Netherlands, got %v", amsterdam["country"])
+	}
+
+	netherlands := store.things["n"].Schema.(map[string]interface{})
+	if ref, ok := netherlands["capital"].(*models.SingleRef); !ok || ref.NrDollarCref != "a" {
+		t.Errorf("expected the Netherlands to refer to Amsterdam, got %v", netherlands["capital"])
+	}
+}
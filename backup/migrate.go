@@ -0,0 +1,191 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+
+	dbconnector "github.com/creativesoftwarefdn/weaviate/connectors"
+	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/creativesoftwarefdn/weaviate/schema"
+)
+
+const (
+	// ErrorMigrateCount message
+	ErrorMigrateCount string = "the source has %d %s, but only %d are found in the target"
+)
+
+// MigrateResult holds the number of records that are copied by Migrate
+type MigrateResult struct {
+	models.ImportResponse
+
+	// History is the number of copied history entries
+	History int64
+}
+
+// Migrate copies the keys in the tree of the given root key, with their hashed tokens, and all things and actions,
+// with their history, from one connector to another. The history of deleted things and actions is copied as well.
+// Only things, actions and history entries that are created or updated since the given time are copied, so a
+// migration can be repeated to copy the changes since the last run; history entries that are copied already are not
+// copied again. Afterwards, it is verified whether all keys, things and actions of the source are found in the target.
+func Migrate(ctx context.Context, from dbconnector.DatabaseConnector, to dbconnector.DatabaseConnector, databaseSchema schema.WeaviateSchema, rootKeyID strfmt.UUID, serverAddress string, sinceUnix int64) (*MigrateResult, error) {
+	importer := NewImporter(to, databaseSchema, 0)
+	result := &MigrateResult{}
+	sourceCounts := map[string]int64{}
+	exported := map[strfmt.UUID]bool{}
+
+	err := Export(ctx, from, rootKeyID, serverAddress, true, func(record *models.ExportRecord) error {
+		sourceCounts[record.Type]++
+		exported[record.UUID] = true
+
+		if !changedSince(record, sinceUnix) {
+			return nil
+		}
+
+		if err := importer.Import(ctx, record); err != nil {
+			return err
+		}
+
+		history, err := migrateHistory(ctx, from, to, record.Type, record.UUID, sinceUnix)
+		result.History += history
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Deleted things and actions are not exported, only their history is left
+	deletedThings, err := from.DeletedThings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deletedActions, err := from.DeletedActions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for recordType, UUIDs := range map[string][]strfmt.UUID{
+		models.ExportRecordTypeThing:  deletedThings,
+		models.ExportRecordTypeAction: deletedActions,
+	} {
+		for _, UUID := range UUIDs {
+			if exported[UUID] {
+				continue
+			}
+
+			history, err := migrateHistory(ctx, from, to, recordType, UUID, sinceUnix)
+			result.History += history
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := importer.Finish(ctx); err != nil {
+		return nil, err
+	}
+
+	result.ImportResponse = importer.Result
+	result.ImportResponse.Checkpoint = 0
+
+	// Verify the migration by counting the records in the target
+	targetCounts := map[string]int64{}
+	err = Export(ctx, to, rootKeyID, serverAddress, true, func(record *models.ExportRecord) error {
+		targetCounts[record.Type]++
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, recordType := range []string{models.ExportRecordTypeKey, models.ExportRecordTypeThing, models.ExportRecordTypeAction} {
+		if targetCounts[recordType] < sourceCounts[recordType] {
+			return result, fmt.Errorf(ErrorMigrateCount, sourceCounts[recordType], recordType+"s", targetCounts[recordType])
+		}
+	}
+
+	return result, nil
+}
+
+// changedSince returns whether a record is created or updated since the given time. Keys have no such time, they are
+// always copied; keys that exist in the target already are skipped by the importer.
+func changedSince(record *models.ExportRecord, sinceUnix int64) bool {
+	switch record.Type {
+	case models.ExportRecordTypeThing:
+		return record.Thing.CreationTimeUnix >= sinceUnix || record.Thing.LastUpdateTimeUnix >= sinceUnix
+	case models.ExportRecordTypeAction:
+		return record.Action.CreationTimeUnix >= sinceUnix || record.Action.LastUpdateTimeUnix >= sinceUnix
+	}
+
+	return true
+}
+
+// migrateHistory copies the history entries of a thing or action that are made since the given time. The entries keep
+// their time, and the latest one keeps whether it records the deletion. Entries that the target has already are not
+// copied again.
+func migrateHistory(ctx context.Context, from dbconnector.DatabaseConnector, to dbconnector.DatabaseConnector, recordType string, UUID strfmt.UUID, sinceUnix int64) (int64, error) {
+	var copied int64
+
+	switch recordType {
+	case models.ExportRecordTypeThing:
+		history := models.ThingHistory{}
+		if err := from.HistoryThing(ctx, UUID, &history); err != nil {
+			return 0, ignoreNoHistory(err)
+		}
+
+		for i, entry := range history.PropertyHistory {
+			if entry.CreationTimeUnix < sinceUnix {
+				continue
+			}
+
+			deleted := history.Deleted && i == len(history.PropertyHistory)-1
+			if err := to.AddThingHistory(ctx, UUID, entry, history.Key, deleted); err != nil {
+				return copied, err
+			}
+			copied++
+		}
+	case models.ExportRecordTypeAction:
+		history := models.ActionHistory{}
+		if err := from.HistoryAction(ctx, UUID, &history); err != nil {
+			return 0, ignoreNoHistory(err)
+		}
+
+		for i, entry := range history.PropertyHistory {
+			if entry.CreationTimeUnix < sinceUnix {
+				continue
+			}
+
+			deleted := history.Deleted && i == len(history.PropertyHistory)-1
+			if err := to.AddActionHistory(ctx, UUID, entry, history.Key, deleted); err != nil {
+				return copied, err
+			}
+			copied++
+		}
+	}
+
+	return copied, nil
+}
+
+// ignoreNoHistory returns nil for the error of a thing or action without history, which has nothing to copy
+func ignoreNoHistory(err error) error {
+	if err.Error() == connutils.StaticNoHistoryFound {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,278 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+
+	dbconnector "github.com/creativesoftwarefdn/weaviate/connectors"
+	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/creativesoftwarefdn/weaviate/schema"
+)
+
+const (
+	// ErrorMigrationOperation message
+	ErrorMigrationOperation string = "operation %d of the migration is not valid: %s"
+	// defaultSeparator is where values are split, when the operation does not give a separator
+	defaultSeparator string = " "
+)
+
+// SchemaMigration renames classes and properties, and splits properties, in the schema and in the existing things
+// and actions. The schema is changed with Change, and the data with Migrate.
+type SchemaMigration struct {
+	operations []*models.SchemaMigrationOperation
+}
+
+// NewSchemaMigration checks whether every operation of a migration has the fields that it needs
+func NewSchemaMigration(migration *models.SchemaMigration) (*SchemaMigration, error) {
+	m := &SchemaMigration{}
+	if migration == nil || len(migration.Operations) == 0 {
+		return nil, fmt.Errorf("the migration has no operations")
+	}
+
+	for i, operation := range migration.Operations {
+		if operation == nil || operation.Op == nil || operation.Kind == nil || operation.ClassName == nil {
+			return nil, fmt.Errorf(ErrorMigrationOperation, i, "op, kind and className are required")
+		}
+
+		switch *operation.Op {
+		case models.SchemaMigrationOperationOpRenameClass:
+			if operation.NewName == "" {
+				return nil, fmt.Errorf(ErrorMigrationOperation, i, "newName is required")
+			}
+		case models.SchemaMigrationOperationOpRenameProperty:
+			if operation.PropertyName == "" || operation.NewName == "" {
+				return nil, fmt.Errorf(ErrorMigrationOperation, i, "propertyName and newName are required")
+			}
+		case models.SchemaMigrationOperationOpSplitProperty:
+			if operation.PropertyName == "" || len(operation.Into) == 0 {
+				return nil, fmt.Errorf(ErrorMigrationOperation, i, "propertyName and into are required")
+			}
+		default:
+			return nil, fmt.Errorf(ErrorMigrationOperation, i, fmt.Sprintf("unknown op '%s'", *operation.Op))
+		}
+
+		if *operation.Kind != models.SchemaMigrationOperationKindThing && *operation.Kind != models.SchemaMigrationOperationKindAction {
+			return nil, fmt.Errorf(ErrorMigrationOperation, i, fmt.Sprintf("unknown kind '%s'", *operation.Kind))
+		}
+
+		checked := *operation
+		if checked.Separator == "" {
+			checked.Separator = defaultSeparator
+		}
+		m.operations = append(m.operations, &checked)
+	}
+
+	return m, nil
+}
+
+// Change returns the change of the schema that the migration makes
+func (m *SchemaMigration) Change() schema.Change {
+	changes := []schema.Change{}
+	for _, operation := range m.operations {
+		kind := operationRefType(operation)
+		switch *operation.Op {
+		case models.SchemaMigrationOperationOpRenameClass:
+			changes = append(changes, schema.RenameClass(kind, *operation.ClassName, operation.NewName))
+		case models.SchemaMigrationOperationOpRenameProperty:
+			changes = append(changes, schema.RenameProperty(kind, *operation.ClassName, operation.PropertyName, operation.NewName))
+		case models.SchemaMigrationOperationOpSplitProperty:
+			changes = append(changes, schema.SplitProperty(kind, *operation.ClassName, operation.PropertyName, operation.Into))
+		}
+	}
+
+	return schema.Changes(changes...)
+}
+
+// Applied returns whether the schema is changed by the migration already, which is the case when the data of an
+// earlier run of the migration was not migrated completely. Every class and property that the migration renames or
+// splits is gone from the schema, and the new ones exist.
+func (m *SchemaMigration) Applied(databaseSchema schema.WeaviateSchema) bool {
+	for i, operation := range m.operations {
+		kind := operationRefType(operation)
+		semanticSchema := databaseSchema.ThingSchema.Schema
+		if kind == connutils.RefTypeAction {
+			semanticSchema = databaseSchema.ActionSchema.Schema
+		}
+		if semanticSchema == nil {
+			return false
+		}
+
+		className := m.renamedClass(i, kind, *operation.ClassName)
+		if *operation.Op == models.SchemaMigrationOperationOpRenameClass {
+			if _, err := schema.GetClassByName(semanticSchema, *operation.ClassName); err == nil {
+				return false
+			}
+			className = m.renamedClass(i, kind, operation.NewName)
+		}
+
+		class, err := schema.GetClassByName(semanticSchema, className)
+		if err != nil {
+			return false
+		}
+
+		switch *operation.Op {
+		case models.SchemaMigrationOperationOpRenameProperty:
+			if !hasProperties(class, operation.PropertyName, operation.NewName) {
+				return false
+			}
+		case models.SchemaMigrationOperationOpSplitProperty:
+			names := make([]string, 0, len(operation.Into))
+			for _, property := range operation.Into {
+				names = append(names, property.Name)
+			}
+			if !hasProperties(class, operation.PropertyName, names...) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// renamedClass returns the name that a class has after the operations that follow the given one
+func (m *SchemaMigration) renamedClass(after int, kind connutils.RefType, className string) string {
+	for _, operation := range m.operations[after+1:] {
+		if *operation.Op == models.SchemaMigrationOperationOpRenameClass && operationRefType(operation) == kind && *operation.ClassName == className {
+			className = operation.NewName
+		}
+	}
+
+	return className
+}
+
+// hasProperties returns whether a class does not have the old property, and has all the new ones
+func hasProperties(class *models.SemanticSchemaClass, oldName string, newNames ...string) bool {
+	if _, err := schema.GetPropertyByName(class, oldName); err == nil {
+		return false
+	}
+
+	for _, name := range newNames {
+		if _, err := schema.GetPropertyByName(class, name); err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Migrate changes the things and actions in the tree of the given root key, and returns how many are changed. The
+// schema must be changed first, because the changed values are converted to the data types of the changed schema. For
+// a dry run, nothing is changed, and the things and actions that would be changed are counted.
+func (m *SchemaMigration) Migrate(ctx context.Context, databaseConnector dbconnector.DatabaseConnector, databaseSchema schema.WeaviateSchema, rootKeyID strfmt.UUID, serverAddress string, dryRun bool) (things int64, actions int64, err error) {
+	err = Export(ctx, databaseConnector, rootKeyID, serverAddress, false, func(record *models.ExportRecord) error {
+		switch record.Type {
+		case models.ExportRecordTypeThing:
+			thing := *record.Thing
+			className, properties, changed := m.migrateInstance(connutils.RefTypeThing, thing.AtClass, thing.Schema)
+			if !changed {
+				return nil
+			}
+
+			if !dryRun {
+				thing.AtClass = className
+				thing.Schema = normalizeSchema(databaseSchema.ThingSchema.Schema, className, properties, nil)
+				thing.LastUpdateTimeUnix = connutils.NowUnix()
+				if err := databaseConnector.UpdateThing(ctx, &thing, record.UUID); err != nil {
+					return err
+				}
+			}
+
+			things++
+			return nil
+		case models.ExportRecordTypeAction:
+			action := *record.Action
+			className, properties, changed := m.migrateInstance(connutils.RefTypeAction, action.AtClass, action.Schema)
+			if !changed {
+				return nil
+			}
+
+			if !dryRun {
+				action.AtClass = className
+				action.Schema = normalizeSchema(databaseSchema.ActionSchema.Schema, className, properties, nil)
+				action.LastUpdateTimeUnix = connutils.NowUnix()
+				if err := databaseConnector.UpdateAction(ctx, &action, record.UUID); err != nil {
+					return err
+				}
+			}
+
+			actions++
+			return nil
+		}
+
+		return nil
+	})
+
+	return things, actions, err
+}
+
+// migrateInstance applies the operations to the class and the schema of a thing or action. Properties that are
+// renamed or split get a nil value, which tells the connector to remove them.
+func (m *SchemaMigration) migrateInstance(kind connutils.RefType, className string, objectSchema models.Schema) (string, map[string]interface{}, bool) {
+	properties := map[string]interface{}{}
+	if existing, ok := objectSchema.(map[string]interface{}); ok {
+		for key, value := range existing {
+			properties[key] = value
+		}
+	}
+
+	changed := false
+	for _, operation := range m.operations {
+		if operationRefType(operation) != kind || *operation.ClassName != className {
+			continue
+		}
+
+		switch *operation.Op {
+		case models.SchemaMigrationOperationOpRenameClass:
+			className = operation.NewName
+			changed = true
+		case models.SchemaMigrationOperationOpRenameProperty:
+			value, ok := properties[operation.PropertyName]
+			if !ok || value == nil {
+				continue
+			}
+
+			properties[operation.PropertyName] = nil
+			properties[operation.NewName] = value
+			changed = true
+		case models.SchemaMigrationOperationOpSplitProperty:
+			value, ok := properties[operation.PropertyName].(string)
+			if !ok {
+				continue
+			}
+
+			properties[operation.PropertyName] = nil
+			for i, part := range strings.SplitN(value, operation.Separator, len(operation.Into)) {
+				properties[operation.Into[i].Name] = part
+			}
+			changed = true
+		}
+	}
+
+	return className, properties, changed
+}
+
+// operationRefType returns whether an operation is about a thing or an action class
+func operationRefType(operation *models.SchemaMigrationOperation) connutils.RefType {
+	if *operation.Kind == models.SchemaMigrationOperationKindAction {
+		return connutils.RefTypeAction
+	}
+
+	return connutils.RefTypeThing
+}
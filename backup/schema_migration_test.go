@@ -0,0 +1,110 @@
+package backup
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/creativesoftwarefdn/weaviate/schema"
+)
+
+func TestNewSchemaMigration(t *testing.T) {
+	op, kind, className := "renameProperty", "thing", "City"
+	if _, err := NewSchemaMigration(&models.SchemaMigration{}); err == nil {
+		t.Errorf("expected an error for a migration without operations")
+	}
+
+	operation := &models.SchemaMigrationOperation{Op: &op, Kind: &kind, ClassName: &className, PropertyName: "name"}
+	if _, err := NewSchemaMigration(&models.SchemaMigration{Operations: []*models.SchemaMigrationOperation{operation}}); err == nil {
+		t.Errorf("expected an error for a rename without a new name")
+	}
+
+	operation.NewName = "title"
+	if _, err := NewSchemaMigration(&models.SchemaMigration{Operations: []*models.SchemaMigrationOperation{operation}}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestMigrateInstance(t *testing.T) {
+	renameClass, renameProperty, splitProperty := "renameClass", "renameProperty", "splitProperty"
+	thing, action, city, person := "thing", "action", "City", "Person"
+
+	migration, err := NewSchemaMigration(&models.SchemaMigration{
+		Operations: []*models.SchemaMigrationOperation{
+			{Op: &renameProperty, Kind: &thing, ClassName: &city, PropertyName: "inhabitants", NewName: "population"},
+			{Op: &renameClass, Kind: &thing, ClassName: &city, NewName: "Town"},
+			{Op: &splitProperty, Kind: &action, ClassName: &person, PropertyName: "name", Into: []*models.SemanticSchemaClassProperty{
+				{Name: "firstName"},
+				{Name: "lastName"},
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	className, properties, changed := migration.migrateInstance(connutils.RefTypeThing, "City", map[string]interface{}{
+		"name":        "Amsterdam",
+		"inhabitants": float64(800000),
+	})
+	expected := map[string]interface{}{"name": "Amsterdam", "inhabitants": nil, "population": float64(800000)}
+	if !changed || className != "Town" || !reflect.DeepEqual(expected, properties) {
+		t.Errorf("expected a Town with %v, got a %s with %v", expected, className, properties)
+	}
+
+	// The operations are only applied to things and actions of their class
+	_, _, changed = migration.migrateInstance(connutils.RefTypeAction, "City", map[string]interface{}{"inhabitants": float64(1)})
+	if changed {
+		t.Errorf("expected an action of class City not to change")
+	}
+
+	// The last property gets the rest of the value
+	_, properties, changed = migration.migrateInstance(connutils.RefTypeAction, "Person", map[string]interface{}{"name": "Vincent van Gogh"})
+	expected = map[string]interface{}{"name": nil, "firstName": "Vincent", "lastName": "van Gogh"}
+	if !changed || !reflect.DeepEqual(expected, properties) {
+		t.Errorf("expected %v, got %v", expected, properties)
+	}
+}
+
+func TestApplied(t *testing.T) {
+	renameClass, renameProperty, splitProperty := "renameClass", "renameProperty", "splitProperty"
+	thing, action, city, person := "thing", "action", "City", "Person"
+
+	migration, err := NewSchemaMigration(&models.SchemaMigration{
+		Operations: []*models.SchemaMigrationOperation{
+			{Op: &renameProperty, Kind: &thing, ClassName: &city, PropertyName: "inhabitants", NewName: "population"},
+			{Op: &renameClass, Kind: &thing, ClassName: &city, NewName: "Town"},
+			{Op: &splitProperty, Kind: &action, ClassName: &person, PropertyName: "name", Into: []*models.SemanticSchemaClassProperty{
+				{Name: "firstName"},
+				{Name: "lastName"},
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var databaseSchema schema.WeaviateSchema
+	databaseSchema.ThingSchema.Schema = &models.SemanticSchema{Classes: []*models.SemanticSchemaClass{
+		{Class: "City", Properties: []*models.SemanticSchemaClassProperty{{Name: "inhabitants"}}},
+	}}
+	databaseSchema.ActionSchema.Schema = &models.SemanticSchema{Classes: []*models.SemanticSchemaClass{
+		{Class: "Person", Properties: []*models.SemanticSchemaClassProperty{{Name: "name"}}},
+	}}
+	if migration.Applied(databaseSchema) {
+		t.Errorf("expected the migration not to be applied to the original schema")
+	}
+
+	databaseSchema.ThingSchema.Schema.Classes[0] = &models.SemanticSchemaClass{
+		Class: "Town", Properties: []*models.SemanticSchemaClassProperty{{Name: "population"}},
+	}
+	if migration.Applied(databaseSchema) {
+		t.Errorf("expected the migration not to be applied while the action class is not changed")
+	}
+
+	databaseSchema.ActionSchema.Schema.Classes[0].Properties = []*models.SemanticSchemaClassProperty{{Name: "firstName"}, {Name: "lastName"}}
+	if !migration.Applied(databaseSchema) {
+		t.Errorf("expected the migration to be applied to the changed schema")
+	}
+}
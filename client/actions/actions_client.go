@@ -86,6 +86,37 @@ func (a *Client) WeaviateActionUpdate(params *WeaviateActionUpdateParams, authIn
 
 }
 
+/*
+WeaviateActionsBatchCreate creates or update a batch of actions related to this key
+
+Registers or updates multiple actions at once. Every action is validated separately, the results are returned per action.
+*/
+func (a *Client) WeaviateActionsBatchCreate(params *WeaviateActionsBatchCreateParams, authInfo runtime.ClientAuthInfoWriter) (*WeaviateActionsBatchCreateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewWeaviateActionsBatchCreateParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "weaviate.actions.batch.create",
+		Method:             "POST",
+		PathPattern:        "/actions/batch",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &WeaviateActionsBatchCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*WeaviateActionsBatchCreateOK), nil
+
+}
+
 /*
 WeaviateActionsCreate creates actions between two things object and subject
 
@@ -7,10 +7,13 @@ package actions
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/go-openapi/runtime"
 
 	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/creativesoftwarefdn/weaviate/models"
 )
 
 // WeaviateActionsDeleteReader is a Reader for the WeaviateActionsDelete structure.
@@ -50,6 +53,13 @@ func (o *WeaviateActionsDeleteReader) ReadResponse(response runtime.ClientRespon
 		}
 		return nil, result
 
+	case 500:
+		result := NewWeaviateActionsDeleteInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
 	default:
 		return nil, runtime.NewAPIError("unknown error", response, response.Code())
 	}
@@ -138,3 +148,32 @@ func (o *WeaviateActionsDeleteNotFound) readResponse(response runtime.ClientResp
 
 	return nil
 }
+
+// NewWeaviateActionsDeleteInternalServerError creates a WeaviateActionsDeleteInternalServerError with default headers values
+func NewWeaviateActionsDeleteInternalServerError() *WeaviateActionsDeleteInternalServerError {
+	return &WeaviateActionsDeleteInternalServerError{}
+}
+
+/*WeaviateActionsDeleteInternalServerError handles this case with default header values.
+
+The action could not be written to the database.
+*/
+type WeaviateActionsDeleteInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *WeaviateActionsDeleteInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /actions/{actionId}][%d] weaviateActionsDeleteInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *WeaviateActionsDeleteInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -67,6 +67,13 @@ func (o *WeaviateActionsPatchReader) ReadResponse(response runtime.ClientRespons
 		}
 		return nil, result
 
+	case 500:
+		result := NewWeaviateActionsPatchInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
 	default:
 		return nil, runtime.NewAPIError("unknown error", response, response.Code())
 	}
@@ -213,3 +220,32 @@ func (o *WeaviateActionsPatchUnprocessableEntity) readResponse(response runtime.
 
 	return nil
 }
+
+// NewWeaviateActionsPatchInternalServerError creates a WeaviateActionsPatchInternalServerError with default headers values
+func NewWeaviateActionsPatchInternalServerError() *WeaviateActionsPatchInternalServerError {
+	return &WeaviateActionsPatchInternalServerError{}
+}
+
+/*WeaviateActionsPatchInternalServerError handles this case with default header values.
+
+The action could not be written to the database.
+*/
+type WeaviateActionsPatchInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *WeaviateActionsPatchInternalServerError) Error() string {
+	return fmt.Sprintf("[PATCH /actions/{actionId}][%d] weaviateActionsPatchInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *WeaviateActionsPatchInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
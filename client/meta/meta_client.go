@@ -24,6 +24,36 @@ type Client struct {
 	formats   strfmt.Registry
 }
 
+/*
+WeaviateHealth checks the health of this weaviate instance
+
+Checks whether the database can be reached and answers queries, and gives the status of the circuit breaker that protects it. Does not need an API key, so that load balancers can use it.
+*/
+func (a *Client) WeaviateHealth(params *WeaviateHealthParams) (*WeaviateHealthOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewWeaviateHealthParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "weaviate.health",
+		Method:             "GET",
+		PathPattern:        "/health",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &WeaviateHealthReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*WeaviateHealthOK), nil
+
+}
+
 /*
 WeaviateMetaGet returns meta information of the current weaviate instance
 
@@ -86,6 +86,37 @@ func (a *Client) WeaviateThingsActionsList(params *WeaviateThingsActionsListPara
 
 }
 
+/*
+WeaviateThingsBatchCreate creates or update a batch of things related to this key
+
+Registers or updates multiple things at once. Every thing is validated separately, the results are returned per thing.
+*/
+func (a *Client) WeaviateThingsBatchCreate(params *WeaviateThingsBatchCreateParams, authInfo runtime.ClientAuthInfoWriter) (*WeaviateThingsBatchCreateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewWeaviateThingsBatchCreateParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "weaviate.things.batch.create",
+		Method:             "POST",
+		PathPattern:        "/things/batch",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &WeaviateThingsBatchCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*WeaviateThingsBatchCreateOK), nil
+
+}
+
 /*
 WeaviateThingsCreate creates a new thing based on a thing template related to this key
 
@@ -62,6 +62,13 @@ func (o *WeaviateThingsCreateReader) ReadResponse(response runtime.ClientRespons
 		}
 		return nil, result
 
+	case 500:
+		result := NewWeaviateThingsCreateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
 	default:
 		return nil, runtime.NewAPIError("unknown error", response, response.Code())
 	}
@@ -196,6 +203,35 @@ func (o *WeaviateThingsCreateUnprocessableEntity) readResponse(response runtime.
 	return nil
 }
 
+// NewWeaviateThingsCreateInternalServerError creates a WeaviateThingsCreateInternalServerError with default headers values
+func NewWeaviateThingsCreateInternalServerError() *WeaviateThingsCreateInternalServerError {
+	return &WeaviateThingsCreateInternalServerError{}
+}
+
+/*WeaviateThingsCreateInternalServerError handles this case with default header values.
+
+The thing could not be written to the database.
+*/
+type WeaviateThingsCreateInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *WeaviateThingsCreateInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /things][%d] weaviateThingsCreateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *WeaviateThingsCreateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 /*WeaviateThingsCreateBody weaviate things create body
 swagger:model WeaviateThingsCreateBody
 */
@@ -7,10 +7,13 @@ package things
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/go-openapi/runtime"
 
 	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/creativesoftwarefdn/weaviate/models"
 )
 
 // WeaviateThingsDeleteReader is a Reader for the WeaviateThingsDelete structure.
@@ -50,6 +53,13 @@ func (o *WeaviateThingsDeleteReader) ReadResponse(response runtime.ClientRespons
 		}
 		return nil, result
 
+	case 500:
+		result := NewWeaviateThingsDeleteInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
 	default:
 		return nil, runtime.NewAPIError("unknown error", response, response.Code())
 	}
@@ -138,3 +148,32 @@ func (o *WeaviateThingsDeleteNotFound) readResponse(response runtime.ClientRespo
 
 	return nil
 }
+
+// NewWeaviateThingsDeleteInternalServerError creates a WeaviateThingsDeleteInternalServerError with default headers values
+func NewWeaviateThingsDeleteInternalServerError() *WeaviateThingsDeleteInternalServerError {
+	return &WeaviateThingsDeleteInternalServerError{}
+}
+
+/*WeaviateThingsDeleteInternalServerError handles this case with default header values.
+
+The thing could not be written to the database.
+*/
+type WeaviateThingsDeleteInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *WeaviateThingsDeleteInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /things/{thingId}][%d] weaviateThingsDeleteInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *WeaviateThingsDeleteInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
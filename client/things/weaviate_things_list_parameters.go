@@ -63,6 +63,11 @@ for the weaviate things list operation typically these are written to a http.Req
 */
 type WeaviateThingsListParams struct {
 
+	/*Class
+	  Only list the things of this class. The values of the where filters are converted to the data types of the properties of this class.
+
+	*/
+	Class *string
 	/*MaxResults
 	  The maximum number of items to be returned per page. Default value is set in Weaviate config.
 
@@ -73,6 +78,11 @@ type WeaviateThingsListParams struct {
 
 	*/
 	Page *int64
+	/*Where
+	  Only list the things whose schema matches all of the filters. A filter is a property, an operator and a value, e.g. 'founded>=2018-01-01T00:00:00Z'. The operators are ':' or '=' for equal, '!=' for not equal, '>', '>=', '<' and '<='. Dates are given in RFC 3339.
+
+	*/
+	Where []string
 
 	timeout    time.Duration
 	Context    context.Context
@@ -112,6 +122,17 @@ func (o *WeaviateThingsListParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithClass adds the class to the weaviate things list params
+func (o *WeaviateThingsListParams) WithClass(class *string) *WeaviateThingsListParams {
+	o.SetClass(class)
+	return o
+}
+
+// SetClass adds the class to the weaviate things list params
+func (o *WeaviateThingsListParams) SetClass(class *string) {
+	o.Class = class
+}
+
 // WithMaxResults adds the maxResults to the weaviate things list params
 func (o *WeaviateThingsListParams) WithMaxResults(maxResults *int64) *WeaviateThingsListParams {
 	o.SetMaxResults(maxResults)
@@ -134,6 +155,17 @@ func (o *WeaviateThingsListParams) SetPage(page *int64) {
 	o.Page = page
 }
 
+// WithWhere adds the where to the weaviate things list params
+func (o *WeaviateThingsListParams) WithWhere(where []string) *WeaviateThingsListParams {
+	o.SetWhere(where)
+	return o
+}
+
+// SetWhere adds the where to the weaviate things list params
+func (o *WeaviateThingsListParams) SetWhere(where []string) {
+	o.Where = where
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *WeaviateThingsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -142,6 +174,22 @@ func (o *WeaviateThingsListParams) WriteToRequest(r runtime.ClientRequest, reg s
 	}
 	var res []error
 
+	if o.Class != nil {
+
+		// query param class
+		var qrClass string
+		if o.Class != nil {
+			qrClass = *o.Class
+		}
+		qClass := qrClass
+		if qClass != "" {
+			if err := r.SetQueryParam("class", qClass); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if o.MaxResults != nil {
 
 		// query param maxResults
@@ -174,6 +222,14 @@ func (o *WeaviateThingsListParams) WriteToRequest(r runtime.ClientRequest, reg s
 
 	}
 
+	valuesWhere := o.Where
+
+	joinedWhere := swag.JoinByFormat(valuesWhere, "multi")
+	// query array param where
+	if err := r.SetQueryParam("where", joinedWhere...); err != nil {
+		return err
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -53,6 +53,13 @@ func (o *WeaviateThingsListReader) ReadResponse(response runtime.ClientResponse,
 		}
 		return nil, result
 
+	case 422:
+		result := NewWeaviateThingsListUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
 	default:
 		return nil, runtime.NewAPIError("unknown error", response, response.Code())
 	}
@@ -149,3 +156,32 @@ func (o *WeaviateThingsListNotFound) readResponse(response runtime.ClientRespons
 
 	return nil
 }
+
+// NewWeaviateThingsListUnprocessableEntity creates a WeaviateThingsListUnprocessableEntity with default headers values
+func NewWeaviateThingsListUnprocessableEntity() *WeaviateThingsListUnprocessableEntity {
+	return &WeaviateThingsListUnprocessableEntity{}
+}
+
+/*WeaviateThingsListUnprocessableEntity handles this case with default header values.
+
+A where filter is invalid, e.g. because its property does not exist or its value does not fit the data type of the property.
+*/
+type WeaviateThingsListUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *WeaviateThingsListUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[GET /things][%d] weaviateThingsListUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *WeaviateThingsListUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
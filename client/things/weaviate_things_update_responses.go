@@ -60,6 +60,13 @@ func (o *WeaviateThingsUpdateReader) ReadResponse(response runtime.ClientRespons
 		}
 		return nil, result
 
+	case 500:
+		result := NewWeaviateThingsUpdateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
 	default:
 		return nil, runtime.NewAPIError("unknown error", response, response.Code())
 	}
@@ -185,3 +192,32 @@ func (o *WeaviateThingsUpdateUnprocessableEntity) readResponse(response runtime.
 
 	return nil
 }
+
+// NewWeaviateThingsUpdateInternalServerError creates a WeaviateThingsUpdateInternalServerError with default headers values
+func NewWeaviateThingsUpdateInternalServerError() *WeaviateThingsUpdateInternalServerError {
+	return &WeaviateThingsUpdateInternalServerError{}
+}
+
+/*WeaviateThingsUpdateInternalServerError handles this case with default header values.
+
+The thing could not be written to the database.
+*/
+type WeaviateThingsUpdateInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *WeaviateThingsUpdateInternalServerError) Error() string {
+	return fmt.Sprintf("[PUT /things/{thingId}][%d] weaviateThingsUpdateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *WeaviateThingsUpdateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -12,10 +12,13 @@ import (
 	strfmt "github.com/go-openapi/strfmt"
 
 	"github.com/creativesoftwarefdn/weaviate/client/actions"
+	"github.com/creativesoftwarefdn/weaviate/client/backup"
+	"github.com/creativesoftwarefdn/weaviate/client/contextionary_api"
 	"github.com/creativesoftwarefdn/weaviate/client/graphql"
 	"github.com/creativesoftwarefdn/weaviate/client/keys"
 	"github.com/creativesoftwarefdn/weaviate/client/meta"
 	"github.com/creativesoftwarefdn/weaviate/client/p2_p"
+	"github.com/creativesoftwarefdn/weaviate/client/schema"
 	"github.com/creativesoftwarefdn/weaviate/client/things"
 )
 
@@ -64,6 +67,10 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *WeaviateDe
 
 	cli.Actions = actions.New(transport, formats)
 
+	cli.Backup = backup.New(transport, formats)
+
+	cli.ContextionaryAPI = contextionary_api.New(transport, formats)
+
 	cli.Graphql = graphql.New(transport, formats)
 
 	cli.Keys = keys.New(transport, formats)
@@ -72,6 +79,8 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *WeaviateDe
 
 	cli.P2P = p2_p.New(transport, formats)
 
+	cli.Schema = schema.New(transport, formats)
+
 	cli.Things = things.New(transport, formats)
 
 	return cli
@@ -120,6 +129,10 @@ func (cfg *TransportConfig) WithSchemes(schemes []string) *TransportConfig {
 type WeaviateDecentralisedKnowledgeGraph struct {
 	Actions *actions.Client
 
+	Backup *backup.Client
+
+	ContextionaryAPI *contextionary_api.Client
+
 	Graphql *graphql.Client
 
 	Keys *keys.Client
@@ -128,6 +141,8 @@ type WeaviateDecentralisedKnowledgeGraph struct {
 
 	P2P *p2_p.Client
 
+	Schema *schema.Client
+
 	Things *things.Client
 
 	Transport runtime.ClientTransport
@@ -139,6 +154,10 @@ func (c *WeaviateDecentralisedKnowledgeGraph) SetTransport(transport runtime.Cli
 
 	c.Actions.SetTransport(transport)
 
+	c.Backup.SetTransport(transport)
+
+	c.ContextionaryAPI.SetTransport(transport)
+
 	c.Graphql.SetTransport(transport)
 
 	c.Keys.SetTransport(transport)
@@ -147,6 +166,8 @@ func (c *WeaviateDecentralisedKnowledgeGraph) SetTransport(transport runtime.Cli
 
 	c.P2P.SetTransport(transport)
 
+	c.Schema.SetTransport(transport)
+
 	c.Things.SetTransport(transport)
 
 }
@@ -0,0 +1,136 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+// Command weaviate-migrate copies all data from the database of one config environment to the database of another.
+//
+// When the target database is empty, its connector creates a new root key on initialization. To keep the root key of
+// the source, configure the same initial root key and token for the target environment.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	flags "github.com/jessevdk/go-flags"
+
+	"github.com/creativesoftwarefdn/weaviate/backup"
+	"github.com/creativesoftwarefdn/weaviate/config"
+	dbconnector "github.com/creativesoftwarefdn/weaviate/connectors"
+	dblisting "github.com/creativesoftwarefdn/weaviate/connectors/listing"
+	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/messages"
+	"github.com/creativesoftwarefdn/weaviate/schema"
+)
+
+// Options are the command line options of the migration
+type Options struct {
+	ConfigFile    string `long:"config-file" description:"path to config file (default: ./weaviate.conf.json)"`
+	From          string `long:"from" description:"the environment in the config file to copy the data from" required:"true"`
+	To            string `long:"to" description:"the environment in the config file to copy the data to" required:"true"`
+	RootKey       string `long:"root-key" description:"UUID of the root key of the source, all keys in its tree are copied" required:"true"`
+	ServerAddress string `long:"server-address" description:"address of the Weaviate instance, used in references" default:"http://localhost"`
+	Since         int64  `long:"since" description:"only copy things and actions that are created or updated since this time in milliseconds since epoch, as given by the previous run" default:"0"`
+}
+
+func main() {
+	var options Options
+	var parser = flags.NewParser(&options, flags.Default)
+
+	if _, err := parser.Parse(); err != nil {
+		if flagsErr, ok := err.(*flags.Error); ok && flagsErr.Type == flags.ErrHelp {
+			os.Exit(0)
+		} else {
+			os.Exit(1)
+		}
+	}
+
+	messaging := &messages.Messaging{}
+	ctx := context.Background()
+
+	// Remember the start, so that the next run only copies what changes from now on
+	start := connutils.NowUnix()
+
+	// The records are converted to the types of the target schema
+	from, _ := createConnector(options, options.From, messaging)
+	to, databaseSchema := createConnector(options, options.To, messaging)
+
+	result, err := backup.Migrate(ctx, from, to, databaseSchema, strfmt.UUID(options.RootKey), options.ServerAddress, options.Since)
+	if result != nil {
+		messaging.InfoMessage(fmt.Sprintf("Copied %d keys, %d things, %d actions and %d history entries", result.Keys, result.Things, result.Actions, result.History))
+	}
+
+	if err != nil {
+		messaging.ExitError(1, err.Error())
+	}
+
+	messaging.InfoMessage(fmt.Sprintf("Migration is verified, continue it later with '--since %d'", start))
+}
+
+// createConnector loads the config environment with the given name, and connects to and initializes its database
+func createConnector(options Options, environment string, messaging *messages.Messaging) (dbconnector.DatabaseConnector, schema.WeaviateSchema) {
+	weaviateConfig := &config.WeaviateConfig{}
+	err := weaviateConfig.LoadConfig(&swag.CommandLineOptionsGroup{
+		Options: &config.Flags{
+			ConfigSection: environment,
+			ConfigFile:    options.ConfigFile,
+		},
+	}, messaging)
+	if err != nil {
+		messaging.ExitError(78, err.Error())
+	}
+
+	databaseSchema := schema.WeaviateSchema{}
+	if err := databaseSchema.LoadSchema(&weaviateConfig.Environment, messaging); err != nil {
+		messaging.ExitError(78, err.Error())
+	}
+
+	// Every call to the listing gives new connectors, so the source and target do not share one
+	var connector dbconnector.DatabaseConnector
+	for _, c := range dblisting.GetAllConnectors() {
+		if c.GetName() == weaviateConfig.Environment.Database.Name {
+			connector = c
+			break
+		}
+	}
+
+	if connector == nil {
+		messaging.ExitError(78, "database with the name '"+weaviateConfig.Environment.Database.Name+"' couldn't be loaded from the config")
+	}
+
+	if err := connector.SetConfig(&weaviateConfig.Environment); err != nil {
+		messaging.ExitError(78, err.Error())
+	}
+
+	if err := connector.SetSchema(&databaseSchema); err != nil {
+		messaging.ExitError(78, err.Error())
+	}
+
+	if err := connector.SetMessaging(messaging); err != nil {
+		messaging.ExitError(78, err.Error())
+	}
+
+	connector.SetServerAddress(options.ServerAddress)
+
+	if err := connector.Connect(); err != nil {
+		messaging.ExitError(1, "database of environment '"+environment+"' gave an error when connecting: "+err.Error())
+	}
+
+	if err := connector.Init(); err != nil {
+		messaging.ExitError(1, "database of environment '"+environment+"' gave an error when initializing: "+err.Error())
+	}
+
+	return connector, databaseSchema
+}
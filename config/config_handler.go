@@ -36,6 +36,7 @@ const DefaultEnvironment string = "development"
 type Flags struct {
 	ConfigSection string `long:"config" description:"the section inside the config file that has to be used"`
 	ConfigFile    string `long:"config-file" description:"path to config file (default: ./weaviate.conf.json)"`
+	SchemaLint    bool   `long:"schema-lint" description:"check the schema against the contextionary, report all problems and exit"`
 }
 
 // File gives the outline of the config file
@@ -61,6 +62,9 @@ type Contextionary struct {
 	KNNFile      string `json:"knn_file"`
 	IDXFile      string `json:"idx_file"`
 	failOnGerund bool   `json:"fail_ongerund"` // is false by default.
+	// The metric of the distances between words: euclidean, cosine, dot or manhattan. By default it is the metric
+	// that the KNN file is built for.
+	DistanceMetric string `json:"distance_metric"`
 }
 
 type Network struct {
@@ -85,6 +89,9 @@ type Database struct {
 type Schemas struct {
 	Thing  string `json:"thing"`
 	Action string `json:"action"`
+	// The file with the changes of the schema that are made at runtime. By default it is schema_history.json, next to
+	// the thing schema.
+	History string `json:"history"`
 }
 
 // Cache is the outline of the cache-system
@@ -0,0 +1,134 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+package conformance
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/require"
+
+	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/models"
+)
+
+// An action can be added, fetched, updated and deleted, keeping its meta data, key and references
+func testActionCRUD(t *testing.T, c *conformanceContext) {
+	subject := c.addThing(t, map[string]interface{}{"name": "subject"})
+
+	action := c.newAction(map[string]interface{}{
+		"name":    "visited",
+		"subject": c.ref(connutils.RefTypeThing, subject),
+	})
+	UUID := connutils.GenerateUUID()
+	require.NoError(t, c.connector.AddAction(c.ctx, action, UUID))
+
+	response := models.ActionGetResponse{}
+	require.NoError(t, c.connector.GetAction(c.ctx, UUID, &response))
+	require.Equal(t, UUID, response.ActionID)
+	require.Equal(t, ActionClass, response.AtClass)
+	require.Equal(t, action.AtContext, response.AtContext)
+	require.Equal(t, action.CreationTimeUnix, response.CreationTimeUnix)
+	require.Equal(t, action.LastUpdateTimeUnix, response.LastUpdateTimeUnix)
+	require.NotNil(t, response.Key)
+	require.Equal(t, c.rootKey, response.Key.NrDollarCref)
+	requireSchemaValue(t, response.Schema, "name", "visited")
+	requireSchemaRef(t, response.Schema, "subject", connutils.RefTypeThing, subject)
+
+	action.Schema = map[string]interface{}{
+		"name":    "left",
+		"subject": c.ref(connutils.RefTypeThing, subject),
+	}
+	action.LastUpdateTimeUnix++
+	require.NoError(t, c.connector.UpdateAction(c.ctx, action, UUID))
+
+	response = models.ActionGetResponse{}
+	require.NoError(t, c.connector.GetAction(c.ctx, UUID, &response))
+	require.Equal(t, action.LastUpdateTimeUnix, response.LastUpdateTimeUnix)
+	requireSchemaValue(t, response.Schema, "name", "left")
+
+	require.NoError(t, c.connector.DeleteAction(c.ctx, action, UUID))
+
+	err := c.connector.GetAction(c.ctx, UUID, &models.ActionGetResponse{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), connutils.StaticActionNotFound)
+}
+
+// Fetching an action that does not exist gives the StaticActionNotFound error
+func testActionNotFound(t *testing.T, c *conformanceContext) {
+	err := c.connector.GetAction(c.ctx, connutils.GenerateUUID(), &models.ActionGetResponse{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), connutils.StaticActionNotFound)
+}
+
+// The actions of a thing are the actions that refer to it, listed in pages that do not overlap
+func testListActions(t *testing.T, c *conformanceContext) {
+	subject := c.addThing(t, map[string]interface{}{"name": "subject"})
+	other := c.addThing(t, map[string]interface{}{"name": "other"})
+
+	added := map[strfmt.UUID]bool{}
+	for i := 0; i < 3; i++ {
+		added[c.addAction(t, map[string]interface{}{"subject": c.ref(connutils.RefTypeThing, subject)})] = true
+	}
+	c.addAction(t, map[string]interface{}{"subject": c.ref(connutils.RefTypeThing, other)})
+
+	const pageSize = 2
+	listed := map[strfmt.UUID]bool{}
+	for offset := 0; ; offset += pageSize {
+		response := models.ActionsListResponse{}
+		require.NoError(t, c.connector.ListActions(c.ctx, subject, pageSize, offset, []*connutils.WhereQuery{}, &response))
+		require.True(t, len(response.Actions) <= pageSize, "a page should have at most %d actions, got %d", pageSize, len(response.Actions))
+
+		if len(response.Actions) == 0 {
+			break
+		}
+
+		for _, action := range response.Actions {
+			require.True(t, added[action.ActionID], "action '%s' does not refer to the thing", action.ActionID)
+			require.False(t, listed[action.ActionID], "action '%s' is listed on more than one page", action.ActionID)
+			listed[action.ActionID] = true
+		}
+	}
+
+	require.Len(t, listed, len(added))
+}
+
+// Moving an action to the history keeps its old values, and marks it deleted when it is deleted
+func testActionHistory(t *testing.T, c *conformanceContext) {
+	action := c.newAction(map[string]interface{}{"name": "before"})
+	UUID := connutils.GenerateUUID()
+	require.NoError(t, c.connector.AddAction(c.ctx, action, UUID))
+
+	require.NoError(t, c.connector.MoveToHistoryAction(c.ctx, action, UUID, false))
+	updated := c.newAction(map[string]interface{}{"name": "after"})
+	require.NoError(t, c.connector.UpdateAction(c.ctx, updated, UUID))
+
+	history := models.ActionHistory{}
+	require.NoError(t, c.connector.HistoryAction(c.ctx, UUID, &history))
+	require.False(t, history.Deleted)
+	require.NotNil(t, history.Key)
+	require.Equal(t, c.rootKey, history.Key.NrDollarCref)
+	require.Len(t, history.PropertyHistory, 1)
+	requireSchemaValue(t, history.PropertyHistory[0].Schema, "name", "before")
+
+	// The history move and the change can be made at once
+	again := c.newAction(map[string]interface{}{"name": "again"})
+	require.NoError(t, c.connector.MoveToHistoryAndUpdateAction(c.ctx, updated, again, UUID))
+	require.NoError(t, c.connector.MoveToHistoryAndDeleteAction(c.ctx, again, UUID))
+
+	history = models.ActionHistory{}
+	require.NoError(t, c.connector.HistoryAction(c.ctx, UUID, &history))
+	require.True(t, history.Deleted)
+	require.Len(t, history.PropertyHistory, 3)
+}
@@ -0,0 +1,311 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+// Package conformance is a test suite that every database connector should pass. Run it from a test in the package
+// of the connector:
+//
+//	func TestConformance(t *testing.T) {
+//		suite := &conformance.Suite{
+//			New: func(t *testing.T) dbconnector.DatabaseConnector {
+//				// Return a connected and initialized connector, with conformance.Schema() set as its schema
+//			},
+//		}
+//		suite.Run(t)
+//	}
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/require"
+
+	dbconnector "github.com/creativesoftwarefdn/weaviate/connectors"
+	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/creativesoftwarefdn/weaviate/schema"
+)
+
+const (
+	// ThingClass is the class of the things in the suite
+	ThingClass string = "ConformanceThing"
+	// ActionClass is the class of the actions in the suite
+	ActionClass string = "ConformanceAction"
+)
+
+// Suite runs the conformance tests against the connectors that are created by New
+type Suite struct {
+	// New returns a connector that is connected and initialized, with the schema of Schema() set.
+	// Every test creates its own keys, things and actions, so the connector does not need to be empty.
+	New func(t *testing.T) dbconnector.DatabaseConnector
+
+	// ServerAddress is the location URL used in references, defaults to http://localhost
+	ServerAddress string
+
+	// Skip holds the reasons to skip the tests, by the name of the test, for features a connector does not support yet
+	Skip map[string]string
+}
+
+// test is a single conformance test, which gets a fresh connector and its own root key
+type test func(t *testing.T, c *conformanceContext)
+
+// conformanceContext holds what a single test needs
+type conformanceContext struct {
+	ctx           context.Context
+	connector     dbconnector.DatabaseConnector
+	serverAddress string
+	rootKey       strfmt.UUID
+
+	// tokens holds the unhashed token of every key that is added by the test
+	tokens map[strfmt.UUID]strfmt.UUID
+}
+
+// Run runs all conformance tests as subtests of t
+func (s *Suite) Run(t *testing.T) {
+	tests := []struct {
+		name string
+		run  test
+	}{
+		{"ThingCRUD", testThingCRUD},
+		{"ThingNotFound", testThingNotFound},
+		{"GetThings", testGetThings},
+		{"ListThingsPaging", testListThingsPaging},
+		{"ListThingsWheres", testListThingsWheres},
+		{"ThingArrayProperties", testThingArrayProperties},
+		{"ThingGeoCoordinates", testThingGeoCoordinates},
+		{"ThingDates", testThingDates},
+		{"ThingInvalidValues", testThingInvalidValues},
+		{"ThingCrefEdges", testThingCrefEdges},
+		{"ThingHistory", testThingHistory},
+		{"AddThingHistory", testAddThingHistory},
+		{"BatchThings", testBatchThings},
+		{"BatchThingsMissingUpdate", testBatchThingsMissingUpdate},
+		{"CountInstances", testCountInstances},
+		{"RemoveThingProperties", testRemoveThingProperties},
+		{"FindInstances", testFindInstances},
+		{"ActionCRUD", testActionCRUD},
+		{"ActionNotFound", testActionNotFound},
+		{"ListActions", testListActions},
+		{"ActionHistory", testActionHistory},
+		{"KeyHierarchy", testKeyHierarchy},
+		{"ValidateToken", testValidateToken},
+		{"UpdateKey", testUpdateKey},
+		{"DeleteKey", testDeleteKey},
+	}
+
+	for _, tc := range tests {
+		run := tc.run
+		name := tc.name
+
+		t.Run(name, func(t *testing.T) {
+			if reason, ok := s.Skip[name]; ok {
+				t.Skip(reason)
+			}
+
+			serverAddress := s.ServerAddress
+			if serverAddress == "" {
+				serverAddress = "http://localhost"
+			}
+
+			c := &conformanceContext{
+				ctx:           context.Background(),
+				connector:     s.New(t),
+				serverAddress: serverAddress,
+				tokens:        map[strfmt.UUID]strfmt.UUID{},
+			}
+			c.rootKey = c.addKey(t, nil, "root@conformance.test")
+
+			run(t, c)
+		})
+	}
+}
+
+// Schema returns the schema that the connectors in the suite should use
+func Schema() *schema.WeaviateSchema {
+	weaviateSchema := &schema.WeaviateSchema{}
+
+	weaviateSchema.ThingSchema.Schema = &models.SemanticSchema{
+		Type: "thing",
+		Classes: []*models.SemanticSchemaClass{
+			{
+				Class: ThingClass,
+				Properties: []*models.SemanticSchemaClassProperty{
+					{Name: "name", AtDataType: []string{string(schema.DataTypeString)}},
+					{Name: "count", AtDataType: []string{string(schema.DataTypeInt)}},
+					{Name: "weight", AtDataType: []string{string(schema.DataTypeNumber)}},
+					{Name: "active", AtDataType: []string{string(schema.DataTypeBoolean)}},
+					{Name: "tags", AtDataType: []string{string(schema.DataTypeStringArray)}},
+					{Name: "location", AtDataType: []string{string(schema.DataTypeGeoCoordinates)}},
+					{Name: "founded", AtDataType: []string{string(schema.DataTypeDate)}},
+					{Name: "related", AtDataType: []string{ThingClass}},
+				},
+			},
+		},
+	}
+
+	weaviateSchema.ActionSchema.Schema = &models.SemanticSchema{
+		Type: "action",
+		Classes: []*models.SemanticSchemaClass{
+			{
+				Class: ActionClass,
+				Properties: []*models.SemanticSchemaClassProperty{
+					{Name: "name", AtDataType: []string{string(schema.DataTypeString)}},
+					{Name: "subject", AtDataType: []string{ThingClass}},
+				},
+			},
+		},
+	}
+
+	return weaviateSchema
+}
+
+// ref creates a reference to an object of the given type
+func (c *conformanceContext) ref(refType connutils.RefType, UUID strfmt.UUID) *models.SingleRef {
+	location := c.serverAddress
+	return &models.SingleRef{
+		LocationURL:  &location,
+		NrDollarCref: UUID,
+		Type:         string(refType),
+	}
+}
+
+// newThing creates a thing of the suite, owned by the root key of the test
+func (c *conformanceContext) newThing(properties map[string]interface{}) *models.Thing {
+	now := connutils.NowUnix()
+	return &models.Thing{
+		ThingCreate: models.ThingCreate{
+			AtClass:   ThingClass,
+			AtContext: "http://example.org",
+			Schema:    properties,
+		},
+		CreationTimeUnix:   now,
+		LastUpdateTimeUnix: now,
+		Key:                c.ref(connutils.RefTypeKey, c.rootKey),
+	}
+}
+
+// addThing adds a thing with the given properties and returns its UUID
+func (c *conformanceContext) addThing(t *testing.T, properties map[string]interface{}) strfmt.UUID {
+	UUID := connutils.GenerateUUID()
+	require.NoError(t, c.connector.AddThing(c.ctx, c.newThing(properties), UUID))
+	return UUID
+}
+
+// newAction creates an action of the suite, owned by the root key of the test
+func (c *conformanceContext) newAction(properties map[string]interface{}) *models.Action {
+	now := connutils.NowUnix()
+	return &models.Action{
+		ActionCreate: models.ActionCreate{
+			AtClass:   ActionClass,
+			AtContext: "http://example.org",
+			Schema:    properties,
+		},
+		CreationTimeUnix:   now,
+		LastUpdateTimeUnix: now,
+		Key:                c.ref(connutils.RefTypeKey, c.rootKey),
+	}
+}
+
+// addAction adds an action with the given properties and returns its UUID
+func (c *conformanceContext) addAction(t *testing.T, properties map[string]interface{}) strfmt.UUID {
+	UUID := connutils.GenerateUUID()
+	require.NoError(t, c.connector.AddAction(c.ctx, c.newAction(properties), UUID))
+	return UUID
+}
+
+// addKey adds a key below the given parent, or a root key without a parent, and returns its UUID
+func (c *conformanceContext) addKey(t *testing.T, parent *strfmt.UUID, email string) strfmt.UUID {
+	UUID := connutils.GenerateUUID()
+	token := connutils.GenerateUUID()
+	require.NoError(t, c.connector.AddKey(c.ctx, c.newKey(parent, email), UUID, connutils.TokenHasher(token)))
+
+	c.tokens[UUID] = token
+	return UUID
+}
+
+// newKey creates a key below the given parent, or a root key without a parent
+func (c *conformanceContext) newKey(parent *strfmt.UUID, email string) *models.Key {
+	key := &models.Key{
+		KeyCreate: models.KeyCreate{
+			Read:           true,
+			Write:          true,
+			Delete:         true,
+			Execute:        true,
+			Email:          email,
+			IPOrigin:       []string{"127.0.0.1"},
+			KeyExpiresUnix: -1,
+		},
+	}
+
+	if parent != nil {
+		key.Parent = c.ref(connutils.RefTypeKey, *parent)
+	}
+
+	return key
+}
+
+// requireSchemaValue checks a value in the schema of a thing or action. Numbers are compared by value, because
+// connectors do not have to keep the Go type of a number.
+func requireSchemaValue(t *testing.T, objectSchema models.Schema, property string, expected interface{}) {
+	properties, ok := objectSchema.(map[string]interface{})
+	require.True(t, ok, "the schema should be a map, got %#v", objectSchema)
+
+	value, ok := properties[property]
+	require.True(t, ok, "the schema should have property '%s'", property)
+
+	if expectedNumber, ok := toFloat(expected); ok {
+		actualNumber, ok := toFloat(value)
+		require.True(t, ok, "property '%s' should be a number, got %#v", property, value)
+		require.Equal(t, expectedNumber, actualNumber, "property '%s'", property)
+		return
+	}
+
+	require.Equal(t, expected, value, "property '%s'", property)
+}
+
+// requireSchemaRef checks a reference in the schema of a thing or action
+func requireSchemaRef(t *testing.T, objectSchema models.Schema, property string, refType connutils.RefType, UUID strfmt.UUID) {
+	properties, ok := objectSchema.(map[string]interface{})
+	require.True(t, ok, "the schema should be a map, got %#v", objectSchema)
+
+	switch ref := properties[property].(type) {
+	case *models.SingleRef:
+		require.Equal(t, UUID, ref.NrDollarCref, "property '%s'", property)
+		require.Equal(t, string(refType), ref.Type, "property '%s'", property)
+	case map[string]interface{}:
+		require.EqualValues(t, UUID, ref["$cref"], "property '%s'", property)
+		require.EqualValues(t, refType, ref["type"], "property '%s'", property)
+		require.NotEmpty(t, ref["locationUrl"], "property '%s'", property)
+	default:
+		t.Fatalf("property '%s' should be a reference, got %#v", property, properties[property])
+	}
+}
+
+// toFloat converts any number to a float64
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+
+	return 0, false
+}
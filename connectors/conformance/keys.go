@@ -0,0 +1,112 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+package conformance
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/require"
+
+	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/models"
+)
+
+// Keys form a tree, in which the children of a key are found by GetKeyChildren
+func testKeyHierarchy(t *testing.T, c *conformanceContext) {
+	child := c.addKey(t, &c.rootKey, "child@conformance.test")
+	grandchild := c.addKey(t, &child, "grandchild@conformance.test")
+
+	response := models.KeyGetResponse{}
+	require.NoError(t, c.connector.GetKey(c.ctx, child, &response))
+	require.Equal(t, child, response.KeyID)
+	require.Equal(t, "child@conformance.test", response.Email)
+	require.True(t, response.Read)
+	require.True(t, response.Write)
+	require.True(t, response.Delete)
+	require.True(t, response.Execute)
+	require.Equal(t, []string{"127.0.0.1"}, response.IPOrigin)
+	require.EqualValues(t, -1, response.KeyExpiresUnix)
+
+	requireKeyChildren(t, c, c.rootKey, child)
+	requireKeyChildren(t, c, child, grandchild)
+	requireKeyChildren(t, c, grandchild)
+}
+
+// The hashed token of a key is returned by ValidateToken, and keys that do not exist give the StaticKeyNotFound error
+func testValidateToken(t *testing.T, c *conformanceContext) {
+	child := c.addKey(t, &c.rootKey, "child@conformance.test")
+
+	response := models.KeyGetResponse{}
+	hashed, err := c.connector.ValidateToken(c.ctx, child, &response)
+	require.NoError(t, err)
+	require.Equal(t, child, response.KeyID)
+	require.True(t, connutils.TokenHashCompare(hashed, c.tokens[child]), "the token of the key should match its hash")
+	require.False(t, connutils.TokenHashCompare(hashed, connutils.GenerateUUID()), "another token should not match the hash")
+
+	_, err = c.connector.ValidateToken(c.ctx, connutils.GenerateUUID(), &models.KeyGetResponse{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), connutils.StaticKeyNotFound)
+
+	err = c.connector.GetKey(c.ctx, connutils.GenerateUUID(), &models.KeyGetResponse{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), connutils.StaticKeyNotFound)
+}
+
+// Updating a key changes its rights and its token
+func testUpdateKey(t *testing.T, c *conformanceContext) {
+	child := c.addKey(t, &c.rootKey, "child@conformance.test")
+
+	key := c.newKey(&c.rootKey, "updated@conformance.test")
+	key.Write = false
+	key.Delete = false
+	token := connutils.GenerateUUID()
+	require.NoError(t, c.connector.UpdateKey(c.ctx, key, child, connutils.TokenHasher(token)))
+
+	response := models.KeyGetResponse{}
+	hashed, err := c.connector.ValidateToken(c.ctx, child, &response)
+	require.NoError(t, err)
+	require.Equal(t, "updated@conformance.test", response.Email)
+	require.True(t, response.Read)
+	require.False(t, response.Write)
+	require.False(t, response.Delete)
+	require.True(t, connutils.TokenHashCompare(hashed, token), "the new token of the key should match its hash")
+
+	requireKeyChildren(t, c, c.rootKey, child)
+}
+
+// A deleted key is not found anymore, and is no child of its parent anymore
+func testDeleteKey(t *testing.T, c *conformanceContext) {
+	child := c.addKey(t, &c.rootKey, "child@conformance.test")
+	require.NoError(t, c.connector.DeleteKey(c.ctx, c.newKey(&c.rootKey, "child@conformance.test"), child))
+
+	err := c.connector.GetKey(c.ctx, child, &models.KeyGetResponse{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), connutils.StaticKeyNotFound)
+
+	requireKeyChildren(t, c, c.rootKey)
+}
+
+// requireKeyChildren checks that the key has exactly the given children
+func requireKeyChildren(t *testing.T, c *conformanceContext, parent strfmt.UUID, expected ...strfmt.UUID) {
+	children := []*models.KeyGetResponse{}
+	require.NoError(t, c.connector.GetKeyChildren(c.ctx, parent, &children))
+
+	actual := []strfmt.UUID{}
+	for _, child := range children {
+		actual = append(actual, child.KeyID)
+	}
+
+	require.ElementsMatch(t, expected, actual, "children of key '%s'", parent)
+}
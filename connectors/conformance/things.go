@@ -0,0 +1,526 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+package conformance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/require"
+
+	"github.com/creativesoftwarefdn/weaviate/connectors"
+	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/models"
+)
+
+// A thing can be added, fetched, updated and deleted, keeping its meta data and key
+func testThingCRUD(t *testing.T, c *conformanceContext) {
+	thing := c.newThing(map[string]interface{}{
+		"name":   "Amsterdam",
+		"count":  int64(800000),
+		"weight": 219.3,
+		"active": true,
+	})
+	UUID := connutils.GenerateUUID()
+	require.NoError(t, c.connector.AddThing(c.ctx, thing, UUID))
+
+	response := models.ThingGetResponse{}
+	require.NoError(t, c.connector.GetThing(c.ctx, UUID, &response))
+	require.Equal(t, UUID, response.ThingID)
+	require.Equal(t, ThingClass, response.AtClass)
+	require.Equal(t, thing.AtContext, response.AtContext)
+	require.Equal(t, thing.CreationTimeUnix, response.CreationTimeUnix)
+	require.Equal(t, thing.LastUpdateTimeUnix, response.LastUpdateTimeUnix)
+	require.NotNil(t, response.Key)
+	require.Equal(t, c.rootKey, response.Key.NrDollarCref)
+	requireSchemaValue(t, response.Schema, "name", "Amsterdam")
+	requireSchemaValue(t, response.Schema, "count", int64(800000))
+	requireSchemaValue(t, response.Schema, "weight", 219.3)
+	requireSchemaValue(t, response.Schema, "active", true)
+
+	thing.Schema = map[string]interface{}{
+		"name":   "Rotterdam",
+		"count":  int64(650000),
+		"weight": 319.4,
+		"active": false,
+	}
+	thing.LastUpdateTimeUnix++
+	require.NoError(t, c.connector.UpdateThing(c.ctx, thing, UUID))
+
+	response = models.ThingGetResponse{}
+	require.NoError(t, c.connector.GetThing(c.ctx, UUID, &response))
+	require.Equal(t, thing.CreationTimeUnix, response.CreationTimeUnix)
+	require.Equal(t, thing.LastUpdateTimeUnix, response.LastUpdateTimeUnix)
+	require.Equal(t, c.rootKey, response.Key.NrDollarCref)
+	requireSchemaValue(t, response.Schema, "name", "Rotterdam")
+	requireSchemaValue(t, response.Schema, "count", int64(650000))
+	requireSchemaValue(t, response.Schema, "weight", 319.4)
+	requireSchemaValue(t, response.Schema, "active", false)
+
+	require.NoError(t, c.connector.DeleteThing(c.ctx, thing, UUID))
+
+	err := c.connector.GetThing(c.ctx, UUID, &models.ThingGetResponse{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), connutils.StaticThingNotFound)
+}
+
+// Fetching a thing that does not exist gives the StaticThingNotFound error
+func testThingNotFound(t *testing.T, c *conformanceContext) {
+	err := c.connector.GetThing(c.ctx, connutils.GenerateUUID(), &models.ThingGetResponse{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), connutils.StaticThingNotFound)
+
+	err = c.connector.GetThings(c.ctx, []strfmt.UUID{connutils.GenerateUUID()}, &models.ThingsListResponse{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), connutils.StaticThingNotFound)
+}
+
+// Multiple things can be fetched at once, in the given order
+func testGetThings(t *testing.T, c *conformanceContext) {
+	first := c.addThing(t, map[string]interface{}{"name": "first"})
+	second := c.addThing(t, map[string]interface{}{"name": "second"})
+
+	response := models.ThingsListResponse{}
+	require.NoError(t, c.connector.GetThings(c.ctx, []strfmt.UUID{second, first}, &response))
+	require.Len(t, response.Things, 2)
+	require.EqualValues(t, 2, response.TotalResults)
+	require.Equal(t, second, response.Things[0].ThingID)
+	require.Equal(t, first, response.Things[1].ThingID)
+	requireSchemaValue(t, response.Things[0].Schema, "name", "second")
+}
+
+// Pages of things do not overlap, are not larger than asked for, and together contain every thing
+func testListThingsPaging(t *testing.T, c *conformanceContext) {
+	added := map[strfmt.UUID]bool{}
+	for i := 0; i < 5; i++ {
+		added[c.addThing(t, map[string]interface{}{"count": int64(i)})] = true
+	}
+
+	const pageSize = 2
+	listed := map[strfmt.UUID]bool{}
+	for offset := 0; ; offset += pageSize {
+		response := models.ThingsListResponse{}
+		require.NoError(t, c.connector.ListThings(c.ctx, pageSize, offset, c.rootKey, "", []*connutils.WhereQuery{}, &response))
+		require.True(t, len(response.Things) <= pageSize, "a page should have at most %d things, got %d", pageSize, len(response.Things))
+
+		if len(response.Things) == 0 {
+			break
+		}
+
+		for _, thing := range response.Things {
+			require.False(t, listed[thing.ThingID], "thing '%s' is listed on more than one page", thing.ThingID)
+			listed[thing.ThingID] = true
+		}
+	}
+
+	for UUID := range added {
+		require.True(t, listed[UUID], "thing '%s' is not listed", UUID)
+	}
+}
+
+// Wheres filter the listed things on the values of their schema
+func testListThingsWheres(t *testing.T, c *conformanceContext) {
+	name := string(connutils.GenerateUUID())
+	matching := c.addThing(t, map[string]interface{}{"name": name, "count": int64(10)})
+	c.addThing(t, map[string]interface{}{"name": name, "count": int64(1)})
+	c.addThing(t, map[string]interface{}{"name": "other", "count": int64(10)})
+
+	wheres := []*connutils.WhereQuery{
+		{Property: "name", Value: connutils.ValueType{Value: name, Operator: connutils.Equal}},
+		{Property: "count", Value: connutils.ValueType{Value: int64(5), Operator: connutils.GreaterThan}},
+	}
+
+	response := models.ThingsListResponse{}
+	require.NoError(t, c.connector.ListThings(c.ctx, 100, 0, c.rootKey, "", wheres, &response))
+	require.Len(t, response.Things, 1)
+	require.Equal(t, matching, response.Things[0].ThingID)
+
+	// The wheres can be limited to the things of a class
+	response = models.ThingsListResponse{}
+	require.NoError(t, c.connector.ListThings(c.ctx, 100, 0, c.rootKey, ThingClass, wheres, &response))
+	require.Len(t, response.Things, 1)
+
+	response = models.ThingsListResponse{}
+	require.NoError(t, c.connector.ListThings(c.ctx, 100, 0, c.rootKey, "NoSuchClass", wheres, &response))
+	require.Empty(t, response.Things)
+}
+
+// The values of an array property are kept in order, replaced on an update, and filterable with Contains
+func testThingArrayProperties(t *testing.T, c *conformanceContext) {
+	tag := string(connutils.GenerateUUID())
+	thing := c.newThing(map[string]interface{}{"name": "tagged", "tags": []interface{}{tag, "b"}})
+	UUID := connutils.GenerateUUID()
+	require.NoError(t, c.connector.AddThing(c.ctx, thing, UUID))
+	c.addThing(t, map[string]interface{}{"name": "single", "tags": []interface{}{"b"}})
+
+	response := models.ThingGetResponse{}
+	require.NoError(t, c.connector.GetThing(c.ctx, UUID, &response))
+	requireSchemaValue(t, response.Schema, "tags", []interface{}{tag, "b"})
+	requireSchemaValue(t, response.Schema, "name", "tagged")
+
+	wheres := []*connutils.WhereQuery{
+		{Property: "tags", Value: connutils.ValueType{Value: tag, Operator: connutils.Contains}},
+	}
+	list := models.ThingsListResponse{}
+	require.NoError(t, c.connector.ListThings(c.ctx, 100, 0, c.rootKey, "", wheres, &list))
+	require.Len(t, list.Things, 1)
+	require.Equal(t, UUID, list.Things[0].ThingID)
+
+	thing.Schema = map[string]interface{}{"name": "tagged", "tags": []interface{}{"c"}}
+	require.NoError(t, c.connector.UpdateThing(c.ctx, thing, UUID))
+
+	response = models.ThingGetResponse{}
+	require.NoError(t, c.connector.GetThing(c.ctx, UUID, &response))
+	requireSchemaValue(t, response.Schema, "tags", []interface{}{"c"})
+
+	list = models.ThingsListResponse{}
+	require.NoError(t, c.connector.ListThings(c.ctx, 100, 0, c.rootKey, "", wheres, &list))
+	require.Empty(t, list.Things)
+}
+
+// Geo coordinates are kept, and filterable by a distance or a bounding box
+func testThingGeoCoordinates(t *testing.T, c *conformanceContext) {
+	name := string(connutils.GenerateUUID())
+	amsterdam := c.addThing(t, map[string]interface{}{"name": name, "location": &models.GeoCoordinates{Latitude: 52.3702, Longitude: 4.8952}})
+	utrecht := c.addThing(t, map[string]interface{}{"name": name, "location": &models.GeoCoordinates{Latitude: 52.0907, Longitude: 5.1214}})
+	berlin := c.addThing(t, map[string]interface{}{"name": name, "location": &models.GeoCoordinates{Latitude: 52.52, Longitude: 13.405}})
+
+	response := models.ThingGetResponse{}
+	require.NoError(t, c.connector.GetThing(c.ctx, berlin, &response))
+	requireSchemaValue(t, response.Schema, "location", &models.GeoCoordinates{Latitude: 52.52, Longitude: 13.405})
+
+	list := func(where *connutils.WhereQuery) []strfmt.UUID {
+		wheres := []*connutils.WhereQuery{
+			{Property: "name", Value: connutils.ValueType{Value: name, Operator: connutils.Equal}},
+			where,
+		}
+		response := models.ThingsListResponse{}
+		require.NoError(t, c.connector.ListThings(c.ctx, 100, 0, c.rootKey, "", wheres, &response))
+
+		var UUIDs []strfmt.UUID
+		for _, thing := range response.Things {
+			UUIDs = append(UUIDs, thing.ThingID)
+		}
+		return UUIDs
+	}
+
+	near := list(&connutils.WhereQuery{Property: "location", Value: connutils.ValueType{
+		Value:    connutils.GeoRange{Coordinates: models.GeoCoordinates{Latitude: 52.3702, Longitude: 4.8952}, Distance: 50},
+		Operator: connutils.WithinDistance,
+	}})
+	require.ElementsMatch(t, []strfmt.UUID{amsterdam, utrecht}, near)
+
+	east := list(&connutils.WhereQuery{Property: "location", Value: connutils.ValueType{
+		Value:    connutils.GeoBoundingBox{SouthWest: models.GeoCoordinates{Latitude: 50, Longitude: 10}, NorthEast: models.GeoCoordinates{Latitude: 55, Longitude: 15}},
+		Operator: connutils.WithinBoundingBox,
+	}})
+	require.Equal(t, []strfmt.UUID{berlin}, east)
+}
+
+// A value of a type that can not be stored is an InvalidValueError, and nothing of the thing is stored
+func testThingInvalidValues(t *testing.T, c *conformanceContext) {
+	for _, value := range []interface{}{[]interface{}{"a", true}, struct{}{}} {
+		UUID := connutils.GenerateUUID()
+		err := c.connector.AddThing(c.ctx, c.newThing(map[string]interface{}{"name": "invalid", "tags": value}), UUID)
+		require.IsType(t, &connutils.InvalidValueError{}, err)
+		require.Error(t, c.connector.GetThing(c.ctx, UUID, &models.ThingGetResponse{}))
+
+		existing := c.addThing(t, map[string]interface{}{"name": "valid"})
+		err = c.connector.UpdateThing(c.ctx, c.newThing(map[string]interface{}{"name": "invalid", "tags": value}), existing)
+		require.IsType(t, &connutils.InvalidValueError{}, err)
+
+		response := models.ThingGetResponse{}
+		require.NoError(t, c.connector.GetThing(c.ctx, existing, &response))
+		requireSchemaValue(t, response.Schema, "name", "valid")
+	}
+}
+
+// Dates are returned in RFC 3339, and filterable by a range
+func testThingDates(t *testing.T, c *conformanceContext) {
+	name := string(connutils.GenerateUUID())
+	date := func(value string) time.Time {
+		parsed, err := time.Parse(time.RFC3339, value)
+		require.NoError(t, err)
+		return parsed
+	}
+	old := c.addThing(t, map[string]interface{}{"name": name, "founded": date("1275-10-27T00:00:00Z")})
+	recent := c.addThing(t, map[string]interface{}{"name": name, "founded": date("2018-03-21T12:30:00.123+01:00")})
+
+	response := models.ThingGetResponse{}
+	require.NoError(t, c.connector.GetThing(c.ctx, recent, &response))
+	requireSchemaValue(t, response.Schema, "founded", "2018-03-21T11:30:00.123Z")
+
+	list := func(operator connutils.Operator, value string) []strfmt.UUID {
+		wheres := []*connutils.WhereQuery{
+			{Property: "name", Value: connutils.ValueType{Value: name, Operator: connutils.Equal}},
+			{Property: "founded", Value: connutils.ValueType{Value: date(value), Operator: operator}},
+		}
+		response := models.ThingsListResponse{}
+		require.NoError(t, c.connector.ListThings(c.ctx, 100, 0, c.rootKey, "", wheres, &response))
+
+		var UUIDs []strfmt.UUID
+		for _, thing := range response.Things {
+			UUIDs = append(UUIDs, thing.ThingID)
+		}
+		return UUIDs
+	}
+
+	require.Equal(t, []strfmt.UUID{recent}, list(connutils.GreaterThan, "2000-01-01T00:00:00Z"))
+	require.Equal(t, []strfmt.UUID{old}, list(connutils.LessThan, "2000-01-01T00:00:00Z"))
+	require.Equal(t, []strfmt.UUID{recent}, list(connutils.GreaterThanEqual, "2018-03-21T11:30:00.123Z"))
+	require.Empty(t, list(connutils.GreaterThan, "2018-03-21T11:30:00.123Z"))
+	require.Equal(t, []strfmt.UUID{old}, list(connutils.Equal, "1275-10-27T00:00:00Z"))
+}
+
+// References between things are kept, and replaced on an update
+func testThingCrefEdges(t *testing.T, c *conformanceContext) {
+	first := c.addThing(t, map[string]interface{}{"name": "first"})
+	second := c.addThing(t, map[string]interface{}{"name": "second"})
+
+	thing := c.newThing(map[string]interface{}{
+		"name":    "referring",
+		"related": c.ref(connutils.RefTypeThing, first),
+	})
+	UUID := connutils.GenerateUUID()
+	require.NoError(t, c.connector.AddThing(c.ctx, thing, UUID))
+
+	response := models.ThingGetResponse{}
+	require.NoError(t, c.connector.GetThing(c.ctx, UUID, &response))
+	requireSchemaRef(t, response.Schema, "related", connutils.RefTypeThing, first)
+	requireSchemaValue(t, response.Schema, "name", "referring")
+
+	thing.Schema = map[string]interface{}{
+		"name":    "referring",
+		"related": c.ref(connutils.RefTypeThing, second),
+	}
+	require.NoError(t, c.connector.UpdateThing(c.ctx, thing, UUID))
+
+	response = models.ThingGetResponse{}
+	require.NoError(t, c.connector.GetThing(c.ctx, UUID, &response))
+	requireSchemaRef(t, response.Schema, "related", connutils.RefTypeThing, second)
+}
+
+// Moving a thing to the history keeps its old values, and marks it deleted when it is deleted
+func testThingHistory(t *testing.T, c *conformanceContext) {
+	thing := c.newThing(map[string]interface{}{"name": "before"})
+	UUID := connutils.GenerateUUID()
+	require.NoError(t, c.connector.AddThing(c.ctx, thing, UUID))
+
+	require.NoError(t, c.connector.MoveToHistoryThing(c.ctx, thing, UUID, false))
+	updated := c.newThing(map[string]interface{}{"name": "after"})
+	require.NoError(t, c.connector.UpdateThing(c.ctx, updated, UUID))
+
+	history := models.ThingHistory{}
+	require.NoError(t, c.connector.HistoryThing(c.ctx, UUID, &history))
+	require.False(t, history.Deleted)
+	require.NotNil(t, history.Key)
+	require.Equal(t, c.rootKey, history.Key.NrDollarCref)
+	require.Len(t, history.PropertyHistory, 1)
+	requireSchemaValue(t, history.PropertyHistory[0].Schema, "name", "before")
+
+	// The history move and the change can be made at once
+	again := c.newThing(map[string]interface{}{"name": "again"})
+	require.NoError(t, c.connector.MoveToHistoryAndUpdateThing(c.ctx, updated, again, UUID))
+
+	response := models.ThingGetResponse{}
+	require.NoError(t, c.connector.GetThing(c.ctx, UUID, &response))
+	requireSchemaValue(t, response.Schema, "name", "again")
+
+	require.NoError(t, c.connector.MoveToHistoryAndDeleteThing(c.ctx, again, UUID))
+	require.Error(t, c.connector.GetThing(c.ctx, UUID, &models.ThingGetResponse{}))
+
+	history = models.ThingHistory{}
+	require.NoError(t, c.connector.HistoryThing(c.ctx, UUID, &history))
+	require.True(t, history.Deleted)
+	require.Len(t, history.PropertyHistory, 3)
+	requireSchemaValue(t, history.PropertyHistory[1].Schema, "name", "after")
+	requireSchemaValue(t, history.PropertyHistory[2].Schema, "name", "again")
+
+	err := c.connector.HistoryThing(c.ctx, connutils.GenerateUUID(), &models.ThingHistory{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), connutils.StaticNoHistoryFound)
+}
+
+// A copied history entry keeps its time and deleted flag, and is not copied twice. Deleted things are found by their
+// history.
+func testAddThingHistory(t *testing.T, c *conformanceContext) {
+	UUID := connutils.GenerateUUID()
+	thing := c.newThing(map[string]interface{}{"name": "copied"})
+	entries := []*models.ThingHistoryObject{
+		{ThingCreate: thing.ThingCreate, CreationTimeUnix: 1000},
+		{ThingCreate: thing.ThingCreate, CreationTimeUnix: 2000},
+	}
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, c.connector.AddThingHistory(c.ctx, UUID, entries[0], thing.Key, false))
+		require.NoError(t, c.connector.AddThingHistory(c.ctx, UUID, entries[1], thing.Key, true))
+	}
+
+	history := models.ThingHistory{}
+	require.NoError(t, c.connector.HistoryThing(c.ctx, UUID, &history))
+	require.True(t, history.Deleted)
+	require.Equal(t, c.rootKey, history.Key.NrDollarCref)
+	require.Len(t, history.PropertyHistory, 2)
+	require.Equal(t, int64(1000), history.PropertyHistory[0].CreationTimeUnix)
+	require.Equal(t, int64(2000), history.PropertyHistory[1].CreationTimeUnix)
+	requireSchemaValue(t, history.PropertyHistory[1].Schema, "name", "copied")
+
+	existing := c.addThing(t, map[string]interface{}{"name": "existing"})
+	require.NoError(t, c.connector.MoveToHistoryThing(c.ctx, thing, existing, false))
+
+	deleted, err := c.connector.DeletedThings(c.ctx)
+	require.NoError(t, err)
+	require.Contains(t, deleted, UUID)
+	require.NotContains(t, deleted, existing)
+}
+
+// A batch adds and updates things at once, including references between them, and moves the updated things to
+// their history
+func testBatchThings(t *testing.T, c *conformanceContext) {
+	existing := c.addThing(t, map[string]interface{}{"name": "existing"})
+	added := connutils.GenerateUUID()
+
+	batch := []*connutils.BatchThing{
+		{
+			Thing: c.newThing(map[string]interface{}{"name": "added"}),
+			UUID:  added,
+		},
+		{
+			Thing: c.newThing(map[string]interface{}{
+				"name":    "updated",
+				"related": c.ref(connutils.RefTypeThing, added),
+			}),
+			UUID:    existing,
+			Update:  true,
+			History: c.newThing(map[string]interface{}{"name": "existing"}),
+		},
+	}
+	require.NoError(t, c.connector.BatchThings(c.ctx, batch))
+
+	response := models.ThingGetResponse{}
+	require.NoError(t, c.connector.GetThing(c.ctx, added, &response))
+	requireSchemaValue(t, response.Schema, "name", "added")
+	require.Equal(t, c.rootKey, response.Key.NrDollarCref)
+
+	response = models.ThingGetResponse{}
+	require.NoError(t, c.connector.GetThing(c.ctx, existing, &response))
+	requireSchemaValue(t, response.Schema, "name", "updated")
+	requireSchemaRef(t, response.Schema, "related", connutils.RefTypeThing, added)
+
+	history := models.ThingHistory{}
+	require.NoError(t, c.connector.HistoryThing(c.ctx, existing, &history))
+	require.Len(t, history.PropertyHistory, 1)
+	requireSchemaValue(t, history.PropertyHistory[0].Schema, "name", "existing")
+}
+
+// A batch that updates a thing which does not exist is not stored, and the connector names the missing item
+func testBatchThingsMissingUpdate(t *testing.T, c *conformanceContext) {
+	added := connutils.GenerateUUID()
+	missing := connutils.GenerateUUID()
+
+	batch := []*connutils.BatchThing{
+		{
+			Thing: c.newThing(map[string]interface{}{"name": "added"}),
+			UUID:  added,
+		},
+		{
+			Thing:  c.newThing(map[string]interface{}{"name": "updated"}),
+			UUID:   missing,
+			Update: true,
+		},
+	}
+	err := c.connector.BatchThings(c.ctx, batch)
+	require.IsType(t, &connutils.BatchItemErrors{}, err)
+	require.Len(t, err.(*connutils.BatchItemErrors).Errors, 1)
+	require.Contains(t, err.(*connutils.BatchItemErrors).Errors, 1)
+
+	response := models.ThingGetResponse{}
+	require.Error(t, c.connector.GetThing(c.ctx, added, &response))
+
+	// Without the missing item, the batch is stored
+	require.NoError(t, c.connector.BatchThings(c.ctx, batch[:1]))
+	require.NoError(t, c.connector.GetThing(c.ctx, added, &response))
+}
+
+func testCountInstances(t *testing.T, c *conformanceContext) {
+	count := func(propertyName string) int64 {
+		n, err := c.connector.CountInstances(c.ctx, connutils.RefTypeThing, ThingClass, propertyName)
+		require.NoError(t, err)
+		return n
+	}
+
+	// Other tests may have added things of the class already
+	things, withCount, withRelated := count(""), count("count"), count("related")
+
+	first := c.addThing(t, map[string]interface{}{"name": "first", "count": int64(1)})
+	c.addThing(t, map[string]interface{}{"name": "second", "related": c.ref(connutils.RefTypeThing, first)})
+
+	require.Equal(t, things+2, count(""))
+	require.Equal(t, withCount+1, count("count"))
+	require.Equal(t, withRelated+1, count("related"))
+
+	n, err := c.connector.CountInstances(c.ctx, connutils.RefTypeThing, "NoSuchClass", "")
+	require.NoError(t, err)
+	require.Equal(t, int64(0), n)
+}
+
+// Things are found by the value of a property, or by the thing that a property refers to
+func testFindInstances(t *testing.T, c *conformanceContext) {
+	finder, ok := c.connector.(dbconnector.InstanceFinder)
+	if !ok {
+		t.Skip("the connector can't find instances by their values")
+	}
+
+	find := func(propertyName string, value interface{}) []strfmt.UUID {
+		UUIDs, err := finder.FindInstances(c.ctx, connutils.RefTypeThing, ThingClass, propertyName, value)
+		require.NoError(t, err)
+		return UUIDs
+	}
+
+	name := "find-" + string(connutils.GenerateUUID())
+	first := c.addThing(t, map[string]interface{}{"name": name, "count": int64(424242)})
+	second := c.addThing(t, map[string]interface{}{"name": "other", "related": c.ref(connutils.RefTypeThing, first)})
+
+	require.Equal(t, []strfmt.UUID{first}, find("name", name))
+	require.Contains(t, find("count", int64(424242)), first)
+	require.Equal(t, []strfmt.UUID{second}, find("related", c.ref(connutils.RefTypeThing, first)))
+	require.Empty(t, find("name", name+"-missing"))
+	require.Empty(t, find("related", c.ref(connutils.RefTypeThing, second)))
+}
+
+// Updating a thing with a nil value removes the property, whether it holds a value or a reference
+func testRemoveThingProperties(t *testing.T, c *conformanceContext) {
+	related := c.addThing(t, map[string]interface{}{"name": "related"})
+	thing := c.newThing(map[string]interface{}{
+		"name":    "Amsterdam",
+		"count":   int64(800000),
+		"related": c.ref(connutils.RefTypeThing, related),
+	})
+	UUID := connutils.GenerateUUID()
+	require.NoError(t, c.connector.AddThing(c.ctx, thing, UUID))
+
+	thing.Schema = map[string]interface{}{
+		"name":    "Amsterdam",
+		"count":   nil,
+		"related": nil,
+	}
+	require.NoError(t, c.connector.UpdateThing(c.ctx, thing, UUID))
+
+	response := models.ThingGetResponse{}
+	require.NoError(t, c.connector.GetThing(c.ctx, UUID, &response))
+	requireSchemaValue(t, response.Schema, "name", "Amsterdam")
+	require.NotContains(t, response.Schema, "count")
+	require.NotContains(t, response.Schema, "related")
+}
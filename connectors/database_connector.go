@@ -40,11 +40,19 @@ type BaseConnector interface {
 
 	AddThing(ctx context.Context, thing *models.Thing, UUID strfmt.UUID) error
 	GetThing(ctx context.Context, UUID strfmt.UUID, thingResponse *models.ThingGetResponse) error
-	ListThings(ctx context.Context, first int, offset int, keyID strfmt.UUID, wheres []*connutils.WhereQuery, thingsResponse *models.ThingsListResponse) error
+	ListThings(ctx context.Context, first int, offset int, keyID strfmt.UUID, className string, wheres []*connutils.WhereQuery, thingsResponse *models.ThingsListResponse) error
 	UpdateThing(ctx context.Context, thing *models.Thing, UUID strfmt.UUID) error
 	DeleteThing(ctx context.Context, thing *models.Thing, UUID strfmt.UUID) error
 	HistoryThing(ctx context.Context, UUID strfmt.UUID, history *models.ThingHistory) error
 	MoveToHistoryThing(ctx context.Context, thing *models.Thing, UUID strfmt.UUID, deleted bool) error
+	MoveToHistoryAndUpdateThing(ctx context.Context, oldThing *models.Thing, thing *models.Thing, UUID strfmt.UUID) error
+	MoveToHistoryAndDeleteThing(ctx context.Context, thing *models.Thing, UUID strfmt.UUID) error
+	// AddThingHistory stores a history entry as it is, with its own time and deleted flag, e.g. when the history is
+	// copied from another database. An entry of the thing with the same time is not stored again.
+	AddThingHistory(ctx context.Context, UUID strfmt.UUID, entry *models.ThingHistoryObject, key *models.SingleRef, deleted bool) error
+	// DeletedThings returns the UUIDs of the things that are deleted, and of which only the history is left.
+	DeletedThings(ctx context.Context) ([]strfmt.UUID, error)
+	BatchThings(ctx context.Context, things []*connutils.BatchThing) error
 
 	AddAction(ctx context.Context, action *models.Action, UUID strfmt.UUID) error
 	GetAction(ctx context.Context, UUID strfmt.UUID, actionResponse *models.ActionGetResponse) error
@@ -53,6 +61,11 @@ type BaseConnector interface {
 	DeleteAction(ctx context.Context, action *models.Action, UUID strfmt.UUID) error
 	HistoryAction(ctx context.Context, UUID strfmt.UUID, history *models.ActionHistory) error
 	MoveToHistoryAction(ctx context.Context, action *models.Action, UUID strfmt.UUID, deleted bool) error
+	MoveToHistoryAndUpdateAction(ctx context.Context, oldAction *models.Action, action *models.Action, UUID strfmt.UUID) error
+	MoveToHistoryAndDeleteAction(ctx context.Context, action *models.Action, UUID strfmt.UUID) error
+	AddActionHistory(ctx context.Context, UUID strfmt.UUID, entry *models.ActionHistoryObject, key *models.SingleRef, deleted bool) error
+	DeletedActions(ctx context.Context) ([]strfmt.UUID, error)
+	BatchActions(ctx context.Context, actions []*connutils.BatchAction) error
 
 	AddKey(ctx context.Context, key *models.Key, UUID strfmt.UUID, token string) error
 	ValidateToken(ctx context.Context, UUID strfmt.UUID, key *models.KeyGetResponse) (token string, err error)
@@ -71,6 +84,31 @@ type DatabaseConnector interface {
 	GetThings(ctx context.Context, UUIDs []strfmt.UUID, thingResponse *models.ThingsListResponse) error
 	GetActions(ctx context.Context, UUIDs []strfmt.UUID, actionResponse *models.ActionsListResponse) error
 	GetKeys(ctx context.Context, UUIDs []strfmt.UUID, keyResponse *[]*models.KeyGetResponse) error
+
+	// CountInstances counts the things or actions of a class. When propertyName is not empty, it only counts those
+	// that have a value or a reference for the property.
+	CountInstances(ctx context.Context, refType connutils.RefType, className string, propertyName string) (int64, error)
+}
+
+// HealthChecker is the interface of connectors that can report the health of their database. Connectors that don't
+// implement it are assumed to be healthy.
+type HealthChecker interface {
+	Health(ctx context.Context, health *models.HealthResponse) error
+}
+
+// SchemaChangeHandler is the interface of connectors that have to update their database when the schema is changed
+// at runtime, e.g. to create the keys of new properties. SetSchema is called with the changed schema before
+// SchemaChanged.
+type SchemaChangeHandler interface {
+	SchemaChanged(ctx context.Context) error
+}
+
+// InstanceFinder is the interface of connectors that can find things or actions by the value of a property. The
+// unique and cardinality constraints of properties are only enforced for connectors that implement it.
+type InstanceFinder interface {
+	// FindInstances returns the UUIDs of the things or actions of a class that have the value for the property. The
+	// value has the type that AddThing and AddAction get, so references are given as *models.SingleRef.
+	FindInstances(ctx context.Context, refType connutils.RefType, className string, propertyName string, value interface{}) ([]strfmt.UUID, error)
 }
 
 // CacheConnector is the interface that all cache-connectors should have
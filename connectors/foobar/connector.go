@@ -236,7 +236,8 @@ func (f *Foobar) GetThings(ctx context.Context, UUIDs []strfmt.UUID, thingRespon
 }
 
 // ListThings fills the given ThingsListResponse with the values from the database, based on the given parameters.
-func (f *Foobar) ListThings(ctx context.Context, first int, offset int, keyID strfmt.UUID, wheres []*connutils.WhereQuery, thingsResponse *models.ThingsListResponse) error {
+// When a class name is given, only the things of that class are listed.
+func (f *Foobar) ListThings(ctx context.Context, first int, offset int, keyID strfmt.UUID, className string, wheres []*connutils.WhereQuery, thingsResponse *models.ThingsListResponse) error {
 
 	// thingsResponse should be populated with the response that comes from the DB.
 	// thingsResponse = based on the ontology
@@ -273,6 +274,37 @@ func (f *Foobar) MoveToHistoryThing(ctx context.Context, thing *models.Thing, UU
 	return nil
 }
 
+// MoveToHistoryAndUpdateThing moves the old values of a thing to history and updates it, in one transaction
+func (f *Foobar) MoveToHistoryAndUpdateThing(ctx context.Context, oldThing *models.Thing, thing *models.Thing, UUID strfmt.UUID) error {
+	return nil
+}
+
+// MoveToHistoryAndDeleteThing moves a thing to history and deletes it, in one transaction
+func (f *Foobar) MoveToHistoryAndDeleteThing(ctx context.Context, thing *models.Thing, UUID strfmt.UUID) error {
+	return nil
+}
+
+// AddThingHistory stores a history entry of a thing as it is, unless it is stored already
+func (f *Foobar) AddThingHistory(ctx context.Context, UUID strfmt.UUID, entry *models.ThingHistoryObject, key *models.SingleRef, deleted bool) error {
+	return nil
+}
+
+// DeletedThings returns the UUIDs of the deleted things that have a history
+func (f *Foobar) DeletedThings(ctx context.Context) ([]strfmt.UUID, error) {
+	return nil, nil
+}
+
+// BatchThings adds or updates all things of a batch in the Foobar database.
+// The things are already validated against the ontology, references between things in the same batch
+// point to the UUIDs given in the batch. The History of updated things is moved to history in the same transaction.
+func (f *Foobar) BatchThings(ctx context.Context, things []*connutils.BatchThing) error {
+
+	// Run the query to add or update all things at once.
+
+	// If success return nil, otherwise return the error
+	return nil
+}
+
 // AddAction adds an action to the Foobar database with the given UUID.
 // Takes the action and a UUID as input.
 // Action is already validated against the ontology
@@ -332,6 +364,37 @@ func (f *Foobar) MoveToHistoryAction(ctx context.Context, action *models.Action,
 	return nil
 }
 
+// MoveToHistoryAndUpdateAction moves the old values of an action to history and updates it, in one transaction
+func (f *Foobar) MoveToHistoryAndUpdateAction(ctx context.Context, oldAction *models.Action, action *models.Action, UUID strfmt.UUID) error {
+	return nil
+}
+
+// MoveToHistoryAndDeleteAction moves an action to history and deletes it, in one transaction
+func (f *Foobar) MoveToHistoryAndDeleteAction(ctx context.Context, action *models.Action, UUID strfmt.UUID) error {
+	return nil
+}
+
+// AddActionHistory stores a history entry of an action as it is, unless it is stored already
+func (f *Foobar) AddActionHistory(ctx context.Context, UUID strfmt.UUID, entry *models.ActionHistoryObject, key *models.SingleRef, deleted bool) error {
+	return nil
+}
+
+// DeletedActions returns the UUIDs of the deleted actions that have a history
+func (f *Foobar) DeletedActions(ctx context.Context) ([]strfmt.UUID, error) {
+	return nil, nil
+}
+
+// BatchActions adds or updates all actions of a batch in the Foobar database.
+// The actions are already validated against the ontology, references between actions in the same batch
+// point to the UUIDs given in the batch. The History of updated actions is moved to history in the same transaction.
+func (f *Foobar) BatchActions(ctx context.Context, actions []*connutils.BatchAction) error {
+
+	// Run the query to add or update all actions at once.
+
+	// If success return nil, otherwise return the error
+	return nil
+}
+
 // AddKey adds a key to the Foobar database with the given UUID and token.
 // UUID  = reference to the key
 // token = is the actual access token used in the API's header
@@ -368,6 +431,13 @@ func (f *Foobar) GetKeys(ctx context.Context, UUIDs []strfmt.UUID, keysResponse
 	return nil
 }
 
+// CountInstances counts the things or actions of the given class in the DB. When a property is given, only the ones
+// with a value for that property are counted.
+func (f *Foobar) CountInstances(ctx context.Context, refType connutils.RefType, className string, propertyName string) (int64, error) {
+	f.trace()
+	return 0, nil
+}
+
 // DeleteKey deletes the Key in the DB at the given UUID.
 func (f *Foobar) DeleteKey(ctx context.Context, key *models.Key, UUID strfmt.UUID) error {
 	f.trace()
@@ -53,3 +53,23 @@ func (f *Janusgraph) HistoryAction(ctx context.Context, UUID strfmt.UUID, histor
 func (f *Janusgraph) MoveToHistoryAction(ctx context.Context, action *models.Action, UUID strfmt.UUID, deleted bool) error {
 	return nil
 }
+
+func (f *Janusgraph) MoveToHistoryAndUpdateAction(ctx context.Context, oldAction *models.Action, action *models.Action, UUID strfmt.UUID) error {
+	return nil
+}
+
+func (f *Janusgraph) MoveToHistoryAndDeleteAction(ctx context.Context, action *models.Action, UUID strfmt.UUID) error {
+	return nil
+}
+
+func (f *Janusgraph) AddActionHistory(ctx context.Context, UUID strfmt.UUID, entry *models.ActionHistoryObject, key *models.SingleRef, deleted bool) error {
+	return nil
+}
+
+func (f *Janusgraph) DeletedActions(ctx context.Context) ([]strfmt.UUID, error) {
+	return nil, nil
+}
+
+func (f *Janusgraph) BatchActions(ctx context.Context, actions []*connutils.BatchAction) error {
+	return nil
+}
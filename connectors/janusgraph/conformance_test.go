@@ -0,0 +1,74 @@
+package janusgraph
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/creativesoftwarefdn/weaviate/config"
+	dbconnector "github.com/creativesoftwarefdn/weaviate/connectors"
+	"github.com/creativesoftwarefdn/weaviate/connectors/conformance"
+	"github.com/creativesoftwarefdn/weaviate/gremlin/fake_server"
+	"github.com/creativesoftwarefdn/weaviate/messages"
+)
+
+// TestConformance runs the connector conformance suite against the Gremlin server at JANUSGRAPH_URL, or against a
+// fake Gremlin server when it is not set
+func TestConformance(t *testing.T) {
+	url := os.Getenv("JANUSGRAPH_URL")
+	if url == "" {
+		server := fake_server.NewServer()
+		defer server.Close()
+		url = server.URL
+	}
+
+	runConformance(t, map[string]interface{}{"url": url})
+}
+
+// TestConformanceWebSocket runs the connector conformance suite over the WebSocket driver, against the Gremlin server
+// at JANUSGRAPH_WS_URL, or against a fake Gremlin server when it is not set
+func TestConformanceWebSocket(t *testing.T) {
+	url := os.Getenv("JANUSGRAPH_WS_URL")
+	if url == "" {
+		server := fake_server.NewServer()
+		defer server.Close()
+		url = "ws" + strings.TrimPrefix(server.URL, "http")
+	}
+
+	runConformance(t, map[string]interface{}{"url": url, "driver": driverWebSocket, "poolSize": 2})
+}
+
+func runConformance(t *testing.T, databaseConfig map[string]interface{}) {
+	suite := &conformance.Suite{
+		New: func(t *testing.T) dbconnector.DatabaseConnector {
+			return newTestConnector(t, databaseConfig)
+		},
+		Skip: map[string]string{
+			"ActionCRUD":     "actions are not supported yet",
+			"ActionNotFound": "actions are not supported yet",
+			"ListActions":    "actions are not supported yet",
+			"ActionHistory":  "actions are not supported yet",
+		},
+	}
+
+	suite.Run(t)
+}
+
+// Connect a connector with the schema of the conformance suite, and initialize it
+func newTestConnector(t *testing.T, databaseConfig map[string]interface{}) *Janusgraph {
+	connector := &Janusgraph{}
+	require.NoError(t, connector.SetConfig(&config.Environment{
+		Database: config.Database{
+			Name:           "janusgraph",
+			DatabaseConfig: databaseConfig,
+		},
+	}))
+	require.NoError(t, connector.SetSchema(conformance.Schema()))
+	require.NoError(t, connector.SetMessaging(&messages.Messaging{}))
+	connector.SetServerAddress("http://localhost")
+	require.NoError(t, connector.Connect())
+	require.NoError(t, connector.Init())
+	return connector
+}
@@ -5,6 +5,8 @@ import (
 	errors_ "errors"
 
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 
@@ -18,6 +20,7 @@ import (
 
 	"github.com/creativesoftwarefdn/weaviate/gremlin"
 	"github.com/creativesoftwarefdn/weaviate/gremlin/http_client"
+	"github.com/creativesoftwarefdn/weaviate/gremlin/websocket_client"
 
 	"github.com/sirupsen/logrus"
 )
@@ -25,13 +28,19 @@ import (
 // Janusgraph has some basic variables.
 // This is mandatory, only change it if you need aditional, global variables
 type Janusgraph struct {
-	client *http_client.Client
-	kind   string
+	client  gremlin.Client
+	breaker *gremlin.CircuitBreaker
+	kind    string
+	// session opens a session for a write, when writes run in sessions
+	session func() (gremlin.Session, error)
 
 	config        Config
 	serverAddress string
-	schema        *schema.WeaviateSchema
 	messaging     *messages.Messaging
+
+	// schema is replaced by SetSchema when the schema changes at runtime, so it is read with currentSchema
+	schema      *schema.WeaviateSchema
+	schemaMutex sync.RWMutex
 }
 
 // Config represents the config outline for Janusgraph. The Database config shoud be of the following form:
@@ -39,12 +48,53 @@ type Janusgraph struct {
 //     "Url": "http://127.0.0.1:8182"
 // }
 // Notice that the port is the GRPC-port.
+//
+// To use the WebSocket protocol of the Gremlin server instead of HTTP, set the driver to "websocket":
+// "database_config" : {
+//     "Url": "ws://127.0.0.1:8182/gremlin",
+//     "Driver": "websocket",
+//     "PoolSize": 4,
+//     "Session": false
+// }
+//
+// Queries time out, failed reads are retried and a circuit breaker stops sending queries while JanusGraph is
+// unavailable. These can be tuned as well, e.g.:
+// "database_config" : {
+//     "Url": "http://127.0.0.1:8182",
+//     "Timeout": 10000,
+//     "Retries": 2,
+//     "CircuitBreakerThreshold": 5,
+//     "CircuitBreakerCooldown": 10000
+// }
 type Config struct {
 	Url          string
 	InitialKey   *string
 	InitialToken *string
+
+	// Driver is either "http" (the default) or "websocket"
+	Driver string
+	// PoolSize is the number of connections of the WebSocket driver, defaults to 1
+	PoolSize int
+	// Session makes the WebSocket driver run every write in a session of its own, which is committed after the write
+	Session bool
+
+	// Timeout is the number of milliseconds a query may take, defaults to 30 seconds
+	Timeout int
+	// Retries is the number of times a read is retried when it fails with a transient error, defaults to 2
+	Retries *int
+	// CircuitBreakerThreshold is the number of queries in a row that may fail because JanusGraph is unavailable,
+	// before the circuit breaker opens; defaults to 5
+	CircuitBreakerThreshold int
+	// CircuitBreakerCooldown is the number of milliseconds the circuit breaker stays open, defaults to 10 seconds
+	CircuitBreakerCooldown int
 }
 
+// The drivers that can be set in the config
+const (
+	driverHTTP      string = "http"
+	driverWebSocket string = "websocket"
+)
+
 // GetName returns a unique connector name, this name is used to define the connector in the weaviate config
 func (f *Janusgraph) GetName() string {
 	return "janusgraph"
@@ -73,6 +123,10 @@ func (f *Janusgraph) SetConfig(configInput *config.Environment) error {
 		return errors_.New("could not get Janusgraph url from config")
 	}
 
+	if f.config.Driver != "" && f.config.Driver != driverHTTP && f.config.Driver != driverWebSocket {
+		return fmt.Errorf("unknown Janusgraph driver '%s' in config, use '%s' or '%s'", f.config.Driver, driverHTTP, driverWebSocket)
+	}
+
 	// If success return nil, otherwise return the error (see above)
 	return nil
 }
@@ -80,8 +134,11 @@ func (f *Janusgraph) SetConfig(configInput *config.Environment) error {
 // SetSchema takes actionSchema and thingsSchema as an input and makes them available globally at f.schema
 // In case you want to modify the schema, this is the place to do so.
 // Note: When this function is called, the schemas (action + things) are already validated, so you don't have to build the validation.
+// It is called again with a copy of the changed schema when the schema is changed at runtime.
 func (f *Janusgraph) SetSchema(schemaInput *schema.WeaviateSchema) error {
+	f.schemaMutex.Lock()
 	f.schema = schemaInput
+	f.schemaMutex.Unlock()
 
 	// If success return nil, otherwise return the error
 	return nil
@@ -109,7 +166,14 @@ func (f *Janusgraph) SetServerAddress(addr string) {
 func (f *Janusgraph) Init() error {
 	f.messaging.DebugMessage("Initializeing JanusGraph")
 
-	err := f.ensureRootKeyExists()
+	ctx := context.Background()
+	report, err := f.ensureSchema(ctx)
+	if err != nil {
+		return err
+	}
+	f.messaging.InfoMessage(report.String())
+
+	err = f.ensureRootKeyExists(ctx)
 	if err != nil {
 		return err
 	}
@@ -117,10 +181,10 @@ func (f *Janusgraph) Init() error {
 	return nil
 }
 
-func (j *Janusgraph) ensureRootKeyExists() error {
+func (j *Janusgraph) ensureRootKeyExists(ctx context.Context) error {
 	q := gremlin.G.V().HasLabel(KEY_LABEL).HasBool("isRoot", true).Count()
 
-	result, err := j.client.Execute(q)
+	result, err := j.client.Execute(ctx, q)
 	if err != nil {
 		return err
 	}
@@ -148,7 +212,6 @@ func (j *Janusgraph) ensureRootKeyExists() error {
 		}
 
 		// Add the root-key to the database
-		ctx := context.Background()
 		err = j.AddKey(ctx, &keyObject, UUID, hashedToken)
 
 		if err != nil {
@@ -163,12 +226,34 @@ func (j *Janusgraph) ensureRootKeyExists() error {
 
 // Connect connects to the Janusgraph websocket
 func (f *Janusgraph) Connect() error {
-	f.client = http_client.NewClient(f.config.Url)
 	logger := logrus.New()
 	logger.Level = logrus.DebugLevel
-	f.client.SetLogger(logger)
 
-	err := f.client.Ping()
+	timeout, retryPolicy := f.guardConfig()
+
+	if f.config.Driver == driverWebSocket {
+		client := websocket_client.NewClient(f.config.Url, f.config.PoolSize)
+		client.SetLogger(logger)
+		client.SetTimeout(timeout)
+		client.SetRetryPolicy(retryPolicy)
+		client.SetCircuitBreaker(f.breaker)
+		f.client = client
+
+		if f.config.Session {
+			f.session = func() (gremlin.Session, error) {
+				return client.Session()
+			}
+		}
+	} else {
+		client := http_client.NewClient(f.config.Url)
+		client.SetLogger(logger)
+		client.SetTimeout(timeout)
+		client.SetRetryPolicy(retryPolicy)
+		client.SetCircuitBreaker(f.breaker)
+		f.client = client
+	}
+
+	err := f.client.Ping(context.Background())
 	if err != nil {
 		return fmt.Errorf("Could not connect to Gremlin server; %v", err)
 	}
@@ -178,6 +263,51 @@ func (f *Janusgraph) Connect() error {
 	return nil
 }
 
+// The timeout and the retry policy of the queries, as configured. Also creates the circuit breaker.
+func (f *Janusgraph) guardConfig() (time.Duration, gremlin.RetryPolicy) {
+	timeout := gremlin.DefaultTimeout
+	if f.config.Timeout > 0 {
+		timeout = time.Duration(f.config.Timeout) * time.Millisecond
+	}
+
+	retryPolicy := gremlin.DefaultRetryPolicy
+	if f.config.Retries != nil {
+		retryPolicy.MaxAttempts = *f.config.Retries + 1
+	}
+
+	threshold := gremlin.DefaultBreakerThreshold
+	if f.config.CircuitBreakerThreshold > 0 {
+		threshold = f.config.CircuitBreakerThreshold
+	}
+	cooldown := gremlin.DefaultBreakerCooldown
+	if f.config.CircuitBreakerCooldown > 0 {
+		cooldown = time.Duration(f.config.CircuitBreakerCooldown) * time.Millisecond
+	}
+	f.breaker = gremlin.NewCircuitBreaker(threshold, cooldown)
+
+	return timeout, retryPolicy
+}
+
+// Health pings JanusGraph and reports the state of the circuit breaker, as the queries before the ping found it. It
+// returns an error when JanusGraph does not answer.
+func (f *Janusgraph) Health(ctx context.Context, health *models.HealthResponse) error {
+	status := f.breaker.Status()
+	err := f.client.Ping(ctx)
+
+	health.CircuitBreaker = &models.CircuitBreakerStatus{
+		State:               string(status.State),
+		ConsecutiveFailures: int64(status.ConsecutiveFailures),
+	}
+	if !status.OpenedAt.IsZero() {
+		health.CircuitBreaker.OpenedAtUnix = status.OpenedAt.UnixNano() / int64(time.Millisecond)
+	}
+	if status.LastError != nil {
+		health.CircuitBreaker.LastError = status.LastError.Error()
+	}
+
+	return err
+}
+
 // Attach can attach something to the request-context
 func (f *Janusgraph) Attach(ctx context.Context) (context.Context, error) {
 	return ctx, nil
@@ -0,0 +1,41 @@
+package janusgraph
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/creativesoftwarefdn/weaviate/gremlin"
+	"github.com/creativesoftwarefdn/weaviate/gremlin/fake_server"
+	"github.com/creativesoftwarefdn/weaviate/models"
+)
+
+func TestHealth(t *testing.T) {
+	server := fake_server.NewServer()
+	connector := newTestConnector(t, map[string]interface{}{
+		"url":                     server.URL,
+		"retries":                 0,
+		"circuitBreakerThreshold": 2,
+	})
+
+	health := &models.HealthResponse{}
+	require.NoError(t, connector.Health(context.Background(), health))
+	require.Equal(t, models.CircuitBreakerStatusStateClosed, health.CircuitBreaker.State)
+
+	server.Close()
+	_, err := connector.client.Execute(context.Background(), gremlin.G.V().Count())
+	require.Error(t, err)
+
+	health = &models.HealthResponse{}
+	require.Error(t, connector.Health(context.Background(), health))
+	require.Equal(t, models.CircuitBreakerStatusStateClosed, health.CircuitBreaker.State)
+	require.Equal(t, int64(1), health.CircuitBreaker.ConsecutiveFailures)
+	require.NotEmpty(t, health.CircuitBreaker.LastError)
+
+	// The failed ping opened the circuit breaker
+	health = &models.HealthResponse{}
+	require.Equal(t, gremlin.ErrCircuitOpen, connector.Health(context.Background(), health))
+	require.Equal(t, models.CircuitBreakerStatusStateOpen, health.CircuitBreaker.State)
+	require.NotZero(t, health.CircuitBreaker.OpenedAtUnix)
+}
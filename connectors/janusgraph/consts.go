@@ -6,4 +6,6 @@ const KEY_LABEL = "_key"      // Which node label to use for keys
 const THING_LABEL = "thing"   // Which node label to use for keys
 const ACTION_LABEL = "action" // Which node label to use for keys
 
+const THING_HISTORY_LABEL = "thingHistory" // Which node label to use for the history of things
+
 const PROPERTY_EDGE_LABEL = "propertyEdge"
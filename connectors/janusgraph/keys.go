@@ -18,10 +18,12 @@ import (
 
 	"github.com/go-openapi/strfmt"
 
+	connutils "github.com/creativesoftwarefdn/weaviate/connectors/utils"
 	"github.com/creativesoftwarefdn/weaviate/gremlin"
 	"github.com/creativesoftwarefdn/weaviate/models"
 
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -49,15 +51,13 @@ func (f *Janusgraph) AddKey(ctx context.Context, key *models.Key, UUID strfmt.UU
 				HasString("uuid", key.Parent.NrDollarCref.String()))
 	}
 
-	_, err := f.client.Execute(q)
-
-	return err
+	return f.write(ctx, q)
 }
 
 func (f *Janusgraph) GetKey(ctx context.Context, UUID strfmt.UUID, keyResponse *models.KeyGetResponse) error {
 	q := gremlin.G.V().HasLabel(KEY_LABEL).HasString("uuid", string(UUID))
 
-	result, err := f.client.Execute(q)
+	result, err := f.client.Execute(ctx, q)
 
 	if err != nil {
 		return err
@@ -70,7 +70,7 @@ func (f *Janusgraph) GetKey(ctx context.Context, UUID strfmt.UUID, keyResponse *
 	}
 
 	if len(vertices) == 0 {
-		return fmt.Errorf("No key found")
+		return errors.New(connutils.StaticKeyNotFound)
 	}
 
 	if len(vertices) != 1 {
@@ -78,9 +78,7 @@ func (f *Janusgraph) GetKey(ctx context.Context, UUID strfmt.UUID, keyResponse *
 	}
 
 	vertex := vertices[0]
-	fillKeyResponseFromVertex(&vertex, keyResponse)
-
-	return nil
+	return fillKeyResponseFromVertex(&vertex, keyResponse)
 }
 
 func (f *Janusgraph) GetKeys(ctx context.Context, UUIDs []strfmt.UUID, keysResponse *[]*models.KeyGetResponse) error {
@@ -92,9 +90,7 @@ func (f *Janusgraph) DeleteKey(ctx context.Context, key *models.Key, UUID strfmt
 	q := gremlin.G.V().HasLabel(KEY_LABEL).
 		HasString("uuid", string(UUID)).Drop()
 
-	_, err := f.client.Execute(q)
-
-	return err
+	return f.write(ctx, q)
 }
 
 // GetKeyChildren fills the given KeyGetResponse array with the values from the database, based on the given UUID.
@@ -102,7 +98,7 @@ func (f *Janusgraph) GetKeyChildren(ctx context.Context, UUID strfmt.UUID, child
 	// Fetch the child vertices directly, so that we can run just _one_ query instead of 1 + len(children)
 	q := gremlin.G.V().HasLabel(KEY_LABEL).HasString("uuid", string(UUID)).InEWithLabel("parent").OutV()
 
-	result, err := f.client.Execute(q)
+	result, err := f.client.Execute(ctx, q)
 	if err != nil {
 		return err
 	}
@@ -113,7 +109,9 @@ func (f *Janusgraph) GetKeyChildren(ctx context.Context, UUID strfmt.UUID, child
 
 	for _, vertex := range vertices {
 		child := models.KeyGetResponse{}
-		fillKeyResponseFromVertex(&vertex, &child)
+		if err := fillKeyResponseFromVertex(&vertex, &child); err != nil {
+			return err
+		}
 		*children = append(*children, &child)
 	}
 
@@ -134,7 +132,5 @@ func (f *Janusgraph) UpdateKey(ctx context.Context, key *models.Key, UUID strfmt
 		Int64Property("keyExpiresUnix", key.KeyExpiresUnix).
 		StringProperty("__token", base64.StdEncoding.EncodeToString([]byte(token)))
 
-	_, err := f.client.Execute(q)
-
-	return err
+	return f.write(ctx, q)
 }
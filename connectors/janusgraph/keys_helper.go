@@ -1,6 +1,7 @@
 package janusgraph
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/creativesoftwarefdn/weaviate/gremlin"
@@ -9,26 +10,74 @@ import (
 	"github.com/go-openapi/strfmt"
 )
 
-func fillKeyResponseFromVertex(vertex *gremlin.Vertex, keyResponse *models.KeyGetResponse) {
-	keyResponse.KeyID = strfmt.UUID(vertex.AssertPropertyValue("uuid").AssertString())
-	keyResponse.KeyExpiresUnix = vertex.AssertPropertyValue("keyExpiresUnix").AssertInt64()
-	keyResponse.Write = vertex.AssertPropertyValue("write").AssertBool()
-	keyResponse.Email = vertex.AssertPropertyValue("email").AssertString()
-	keyResponse.Read = vertex.AssertPropertyValue("read").AssertBool()
-	keyResponse.Delete = vertex.AssertPropertyValue("delete").AssertBool()
-	keyResponse.Execute = vertex.AssertPropertyValue("execute").AssertBool()
-	keyResponse.IPOrigin = strings.Split(vertex.AssertPropertyValue("IPOrigin").AssertString(), ";")
-
-	isRoot := vertex.AssertPropertyValue("isRoot").AssertBool()
+func fillKeyResponseFromVertex(vertex *gremlin.Vertex, keyResponse *models.KeyGetResponse) error {
+	uuid, err := vertex.StringValue("uuid")
+	if err != nil {
+		return err
+	}
+	keyResponse.KeyID = strfmt.UUID(uuid)
+
+	if keyResponse.KeyExpiresUnix, err = vertex.Int64Value("keyExpiresUnix"); err != nil {
+		return err
+	}
+	if keyResponse.Write, err = vertex.BoolValue("write"); err != nil {
+		return err
+	}
+	if keyResponse.Email, err = vertex.StringValue("email"); err != nil {
+		return err
+	}
+	if keyResponse.Read, err = vertex.BoolValue("read"); err != nil {
+		return err
+	}
+	if keyResponse.Delete, err = vertex.BoolValue("delete"); err != nil {
+		return err
+	}
+	if keyResponse.Execute, err = vertex.BoolValue("execute"); err != nil {
+		return err
+	}
+
+	ipOrigin, err := vertex.StringValue("IPOrigin")
+	if err != nil {
+		return err
+	}
+	keyResponse.IPOrigin = strings.Split(ipOrigin, ";")
+
+	isRoot, err := vertex.BoolValue("isRoot")
+	if err != nil {
+		return err
+	}
 	keyResponse.IsRoot = &isRoot
+
+	return nil
 }
 
 // Build a reference to a key (used to link actions and things to a key), from a path from the action or thing, to the key.
-func newKeySingleRefFromKeyPath(path *gremlin.Path) *models.SingleRef {
-	location := path.Segments[0].AssertEdge().AssertPropertyValue("locationUrl").AssertString()
+func newKeySingleRefFromKeyPath(path *gremlin.Path) (*models.SingleRef, error) {
+	if len(path.Segments) < 2 {
+		return nil, fmt.Errorf("Expected a path from a thing or action to its key, but got %d segments", len(path.Segments))
+	}
+
+	edge, err := path.Segments[0].Edge()
+	if err != nil {
+		return nil, err
+	}
+	location, err := edge.StringValue("locationUrl")
+	if err != nil {
+		return nil, err
+	}
+
+	vertex, err := path.Segments[1].Vertex()
+	if err != nil {
+		return nil, err
+	}
+	uuid, err := vertex.StringValue("uuid")
+	if err != nil {
+		return nil, err
+	}
+
 	return &models.SingleRef{
-		NrDollarCref: strfmt.UUID(path.Segments[1].AssertVertex().AssertPropertyValue("uuid").AssertString()),
+		NrDollarCref: strfmt.UUID(uuid),
 		Type:         "Key",
 		LocationURL:  &location,
-	}
+	}, nil
 }
@@ -0,0 +1,352 @@
+package janusgraph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+
+	connutils "github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/gremlin"
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/creativesoftwarefdn/weaviate/schema"
+)
+
+// How long Init waits for a new index to become enabled
+const indexEnableTimeout = 30 * time.Second
+
+// The property keys that all vertices and edges of weaviate use, with their data types. The keys of the schema
+// properties of things and actions are derived from the weaviate schema.
+var basePropertyKeys = []propertyKey{
+	// Things, actions and their history
+	{"uuid", gremlin.DataTypeString},
+	{"atClass", gremlin.DataTypeString},
+	{"context", gremlin.DataTypeString},
+	{"creationTimeUnix", gremlin.DataTypeLong},
+	{"lastUpdateTimeUnix", gremlin.DataTypeLong},
+	{"schema", gremlin.DataTypeString},
+	{"deleted", gremlin.DataTypeBoolean},
+	{"keyUuid", gremlin.DataTypeString},
+	{"keyLocationUrl", gremlin.DataTypeString},
+
+	// Keys
+	{"isRoot", gremlin.DataTypeBoolean},
+	{"delete", gremlin.DataTypeBoolean},
+	{"execute", gremlin.DataTypeBoolean},
+	{"read", gremlin.DataTypeBoolean},
+	{"write", gremlin.DataTypeBoolean},
+	{"email", gremlin.DataTypeString},
+	{"IPOrigin", gremlin.DataTypeString},
+	{"keyExpiresUnix", gremlin.DataTypeLong},
+	{"__token", gremlin.DataTypeString},
+
+	// Edges
+	{PROPERTY_EDGE_LABEL, gremlin.DataTypeString},
+	{"$cref", gremlin.DataTypeString},
+	{"type", gremlin.DataTypeString},
+	{"locationUrl", gremlin.DataTypeString},
+}
+
+// The composite indexes, by the property keys that they index
+var compositeIndexes = []struct {
+	name string
+	keys []string
+}{
+	{"byUuid", []string{"uuid"}},
+	{"byAtClass", []string{"atClass"}},
+}
+
+type propertyKey struct {
+	name     string
+	dataType gremlin.DataType
+}
+
+// The property key of a schema property; array properties have a key with the cardinality LIST, which stores every
+// value of the array as a value of the property.
+type schemaPropertyKey struct {
+	propertyKey
+	cardinality gremlin.Cardinality
+}
+
+// What ensureSchema found and made in the JanusGraph schema.
+type schemaReport struct {
+	// The descriptions of the schema elements that were made
+	Created []string
+	// The indexes that are enabled
+	Enabled []string
+	// The indexes that were not enabled in time, e.g. because existing data has to be reindexed first
+	NotEnabled []string
+	// The schema properties that have different data types in different classes, so their key accepts any value, or
+	// that are an array in one class but not in another, so their key has the cardinality LIST
+	Conflicts []string
+}
+
+func (r *schemaReport) String() string {
+	var parts []string
+	if len(r.Created) > 0 {
+		parts = append(parts, fmt.Sprintf("created %s", strings.Join(r.Created, ", ")))
+	} else {
+		parts = append(parts, "the schema is up to date")
+	}
+	if len(r.NotEnabled) > 0 {
+		parts = append(parts, fmt.Sprintf("the indexes %s are not enabled yet", strings.Join(r.NotEnabled, ", ")))
+	}
+	if len(r.Conflicts) > 0 {
+		parts = append(parts, fmt.Sprintf("the properties %s have different data types in different classes", strings.Join(r.Conflicts, ", ")))
+	}
+	return "JanusGraph schema: " + strings.Join(parts, "; ")
+}
+
+// Make the vertex labels, the property keys and the indexes, when they do not exist yet, and wait until the indexes
+// are enabled.
+func (f *Janusgraph) ensureSchema(ctx context.Context) (*schemaReport, error) {
+	report := &schemaReport{}
+
+	m := gremlin.OpenManagement()
+	for _, label := range []string{KEY_LABEL, THING_LABEL, ACTION_LABEL, THING_HISTORY_LABEL} {
+		m = m.MakeVertexLabel(label)
+	}
+
+	for _, key := range basePropertyKeys {
+		m = m.MakePropertyKey(key.name, key.dataType)
+	}
+
+	keys, conflicts := f.schemaPropertyKeys()
+	report.Conflicts = conflicts
+	for _, key := range keys {
+		m = m.MakePropertyKeyWithCardinality(key.name, key.dataType, key.cardinality)
+	}
+
+	for _, index := range compositeIndexes {
+		m = m.BuildCompositeIndex(index.name, index.keys, false)
+	}
+
+	result, err := f.client.Execute(ctx, m.Commit())
+	if err != nil {
+		return nil, fmt.Errorf("could not create the JanusGraph schema; %v", err)
+	}
+	report.Created, err = result.StringSlice()
+	if err != nil {
+		return nil, fmt.Errorf("could not read the created JanusGraph schema; %v", err)
+	}
+
+	for _, index := range compositeIndexes {
+		// The server waits for the index, so give the query more time than it
+		await := gremlin.AwaitGraphIndex(index.name, indexEnableTimeout).WithTimeout(2 * indexEnableTimeout)
+		result, err := f.client.Execute(ctx, await)
+		if err != nil {
+			return nil, fmt.Errorf("could not wait for the index '%s'; %v", index.name, err)
+		}
+
+		first, err := result.First()
+		if err != nil {
+			return nil, fmt.Errorf("could not wait for the index '%s'; %v", index.name, err)
+		}
+
+		if enabled, ok := first.Datum.(bool); ok && enabled {
+			report.Enabled = append(report.Enabled, index.name)
+		} else {
+			report.NotEnabled = append(report.NotEnabled, index.name)
+		}
+	}
+
+	return report, nil
+}
+
+// SchemaChanged creates the property keys of the properties that were added to the weaviate schema at runtime.
+func (f *Janusgraph) SchemaChanged(ctx context.Context) error {
+	report, err := f.ensureSchema(ctx)
+	if err != nil {
+		return err
+	}
+
+	f.messaging.InfoMessage(report.String())
+	return nil
+}
+
+// CountInstances counts the things or actions of a class, or the ones that have a value or a reference for a property.
+func (f *Janusgraph) CountInstances(ctx context.Context, refType connutils.RefType, className string, propertyName string) (int64, error) {
+	// Actions are not stored yet
+	if refType == connutils.RefTypeAction {
+		return 0, nil
+	}
+
+	q := gremlin.G.V().HasLabel(THING_LABEL).HasString("atClass", className)
+	if propertyName != "" {
+		q = q.Coalesce(
+			gremlin.Current().HasKey("schema__"+propertyName),
+			gremlin.Current().OutEWithLabel("thingEdge").HasString(PROPERTY_EDGE_LABEL, "schema__"+propertyName).Limit(1),
+		)
+	}
+
+	result, err := f.client.Execute(ctx, q.Count())
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := result.OneInt()
+	return int64(count), err
+}
+
+// FindInstances returns the UUIDs of the things of a class that have the value for the property. References are
+// found by the edges that they are stored as.
+func (f *Janusgraph) FindInstances(ctx context.Context, refType connutils.RefType, className string, propertyName string, value interface{}) ([]strfmt.UUID, error) {
+	// Actions are not stored yet
+	if refType == connutils.RefTypeAction {
+		return nil, nil
+	}
+
+	key := "schema__" + propertyName
+	q := gremlin.G.V().HasLabel(THING_LABEL).HasString("atClass", className)
+	switch t := value.(type) {
+	case *models.SingleRef:
+		q = q.OutEWithLabel("thingEdge").
+			HasString(PROPERTY_EDGE_LABEL, key).
+			HasString("$cref", t.NrDollarCref.String()).
+			HasString("locationUrl", *t.LocationURL).
+			OutV()
+	case time.Time:
+		// Dates are stored as epoch milliseconds
+		q = q.Has(key, gremlin.Eq(connutils.MakeUnixMillisecond(t)))
+	default:
+		q = q.Has(key, gremlin.Eq(value))
+	}
+
+	result, err := f.client.Execute(ctx, q.Values([]string{"uuid"}))
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := result.StringSlice()
+	if err != nil {
+		return nil, err
+	}
+
+	UUIDs := make([]strfmt.UUID, 0, len(values))
+	for _, UUID := range values {
+		UUIDs = append(UUIDs, strfmt.UUID(UUID))
+	}
+	return UUIDs, nil
+}
+
+// The property keys of the schema properties of all classes. References are stored as edges, so they don't have a
+// key. Properties with the same name but different data types in different classes get a key of any type; the key of
+// a property that is an array in any class has the cardinality LIST. JanusGraph can not change a key once it is made,
+// so ensureSchema fails when a conflict changes the key of a property that already has one.
+func (f *Janusgraph) schemaPropertyKeys() ([]schemaPropertyKey, []string) {
+	var keys []schemaPropertyKey
+	var conflicts []string
+	index := map[string]int{}
+	conflicting := map[string]bool{}
+
+	current := f.currentSchema()
+	for _, semanticSchema := range []*models.SemanticSchema{current.ThingSchema.Schema, current.ActionSchema.Schema} {
+		if semanticSchema == nil {
+			continue
+		}
+
+		for _, class := range semanticSchema.Classes {
+			for _, property := range class.Properties {
+				dataType, err := schema.GetPropertyDataType(class, property.Name)
+				if err != nil || *dataType == schema.DataTypeCRef {
+					continue
+				}
+
+				key := schemaPropertyKey{
+					propertyKey: propertyKey{name: "schema__" + property.Name, dataType: janusgraphDataType(schema.ElementDataType(*dataType))},
+					cardinality: gremlin.CardinalitySingle,
+				}
+				if schema.IsArrayDataType(*dataType) {
+					key.cardinality = gremlin.CardinalityList
+				}
+
+				i, ok := index[key.name]
+				if !ok {
+					index[key.name] = len(keys)
+					keys = append(keys, key)
+					continue
+				}
+
+				conflict := false
+				if keys[i].dataType != key.dataType && keys[i].dataType != gremlin.DataTypeObject {
+					keys[i].dataType = gremlin.DataTypeObject
+					conflict = true
+				}
+				if keys[i].cardinality != key.cardinality {
+					keys[i].cardinality = gremlin.CardinalityList
+					conflict = true
+				}
+				if conflict && !conflicting[property.Name] {
+					conflicting[property.Name] = true
+					conflicts = append(conflicts, property.Name)
+				}
+			}
+		}
+	}
+
+	return keys, conflicts
+}
+
+// The schema that was last given to SetSchema.
+func (f *Janusgraph) currentSchema() *schema.WeaviateSchema {
+	f.schemaMutex.RLock()
+	defer f.schemaMutex.RUnlock()
+
+	return f.schema
+}
+
+// The names of the property keys with the cardinality LIST.
+func (f *Janusgraph) listPropertyKeys() map[string]bool {
+	keys, _ := f.schemaPropertyKeys()
+	listKeys := map[string]bool{}
+	for _, key := range keys {
+		if key.cardinality == gremlin.CardinalityList {
+			listKeys[key.name] = true
+		}
+	}
+	return listKeys
+}
+
+// The data type of the property of a class, or nil when the class or property does not exist.
+func (f *Janusgraph) propertyDataType(className string, propertyName string) *schema.DataType {
+	current := f.currentSchema()
+	for _, semanticSchema := range []*models.SemanticSchema{current.ThingSchema.Schema, current.ActionSchema.Schema} {
+		if semanticSchema == nil {
+			continue
+		}
+
+		class, err := schema.GetClassByName(semanticSchema, className)
+		if err != nil {
+			continue
+		}
+
+		dataType, err := schema.GetPropertyDataType(class, propertyName)
+		if err != nil {
+			return nil
+		}
+		return dataType
+	}
+
+	return nil
+}
+
+// The data type of the property key of a weaviate data type. Dates are stored as epoch milliseconds.
+func janusgraphDataType(dataType schema.DataType) gremlin.DataType {
+	switch dataType {
+	case schema.DataTypeInt:
+		return gremlin.DataTypeLong
+	case schema.DataTypeNumber:
+		return gremlin.DataTypeDouble
+	case schema.DataTypeBoolean:
+		return gremlin.DataTypeBoolean
+	case schema.DataTypeString:
+		return gremlin.DataTypeString
+	case schema.DataTypeDate:
+		return gremlin.DataTypeLong
+	case schema.DataTypeGeoCoordinates:
+		return gremlin.DataTypeGeoshape
+	}
+	return gremlin.DataTypeObject
+}
@@ -0,0 +1,99 @@
+package janusgraph
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/creativesoftwarefdn/weaviate/connectors/conformance"
+	connutils "github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/gremlin/fake_server"
+	"github.com/creativesoftwarefdn/weaviate/models"
+	libschema "github.com/creativesoftwarefdn/weaviate/schema"
+)
+
+func TestEnsureSchema(t *testing.T) {
+	server := fake_server.NewServer()
+	defer server.Close()
+
+	// Init already made the schema
+	connector := newTestConnector(t, map[string]interface{}{"url": server.URL})
+	report, err := connector.ensureSchema(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, report.Created)
+	require.Equal(t, []string{"byUuid", "byAtClass"}, report.Enabled)
+	require.Empty(t, report.NotEnabled)
+	require.Equal(t, "JanusGraph schema: the schema is up to date", report.String())
+
+	// A new property of the schema gets a new property key
+	schema := conformance.Schema()
+	class := schema.ThingSchema.Schema.Classes[0]
+	class.Properties = append(class.Properties, &models.SemanticSchemaClassProperty{Name: "height", AtDataType: []string{"number"}})
+	require.NoError(t, connector.SetSchema(schema))
+
+	report, err = connector.ensureSchema(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"property key schema__height"}, report.Created)
+
+	// The values of properties should have the data type of their key
+	thing := &models.Thing{
+		ThingCreate: models.ThingCreate{
+			AtClass: conformance.ThingClass,
+			Schema:  map[string]interface{}{"count": "three"},
+		},
+		Key: newAtomicRef(connutils.RefTypeKey, connutils.GenerateUUID()),
+	}
+	err = connector.AddThing(context.Background(), thing, connutils.GenerateUUID())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "schema__count")
+
+	// Property keys can not change, so a property that becomes an array in another class can not be stored
+	action := schema.ActionSchema.Schema.Classes[0]
+	action.Properties = append(action.Properties, &models.SemanticSchemaClassProperty{Name: "height", AtDataType: []string{"number[]"}})
+	require.NoError(t, connector.SetSchema(schema))
+
+	_, err = connector.ensureSchema(context.Background())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "schema__height (Double, LIST)")
+}
+
+func TestSchemaPropertyKeys(t *testing.T) {
+	schema := conformance.Schema()
+	schema.ActionSchema.Schema.Classes[0].Properties = append(schema.ActionSchema.Schema.Classes[0].Properties,
+		&models.SemanticSchemaClassProperty{Name: "count", AtDataType: []string{"string"}})
+
+	connector := &Janusgraph{schema: schema}
+	keys, conflicts := connector.schemaPropertyKeys()
+
+	require.Equal(t, []schemaPropertyKey{
+		{propertyKey{"schema__name", "String"}, "SINGLE"},
+		{propertyKey{"schema__count", "Object"}, "SINGLE"},
+		{propertyKey{"schema__weight", "Double"}, "SINGLE"},
+		{propertyKey{"schema__active", "Boolean"}, "SINGLE"},
+		{propertyKey{"schema__tags", "String"}, "LIST"},
+		{propertyKey{"schema__location", "Geoshape"}, "SINGLE"},
+		{propertyKey{"schema__founded", "Long"}, "SINGLE"},
+	}, keys)
+	require.Equal(t, []string{"count"}, conflicts)
+}
+
+func TestArrayPropertyKeys(t *testing.T) {
+	schema := conformance.Schema()
+	schema.ActionSchema.Schema.Classes[0].Properties = append(schema.ActionSchema.Schema.Classes[0].Properties,
+		&models.SemanticSchemaClassProperty{Name: "weight", AtDataType: []string{"number[]"}},
+		&models.SemanticSchemaClassProperty{Name: "tags", AtDataType: []string{"string"}})
+
+	connector := &Janusgraph{schema: schema}
+	keys, conflicts := connector.schemaPropertyKeys()
+
+	require.Contains(t, keys, schemaPropertyKey{propertyKey{"schema__weight", "Double"}, "LIST"})
+	require.Contains(t, keys, schemaPropertyKey{propertyKey{"schema__tags", "String"}, "LIST"})
+	require.Equal(t, []string{"weight", "tags"}, conflicts)
+	require.Equal(t, map[string]bool{"schema__weight": true, "schema__tags": true}, connector.listPropertyKeys())
+
+	require.Equal(t, libschema.DataTypeStringArray, *connector.propertyDataType(conformance.ThingClass, "tags"))
+	require.Equal(t, libschema.DataTypeString, *connector.propertyDataType(conformance.ThingClass, "name"))
+	require.Nil(t, connector.propertyDataType(conformance.ThingClass, "unknown"))
+	require.Nil(t, connector.propertyDataType("Unknown", "name"))
+}
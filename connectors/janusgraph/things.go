@@ -17,7 +17,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"reflect"
 	"time"
 
 	"github.com/go-openapi/strfmt"
@@ -40,52 +39,9 @@ func (f *Janusgraph) AddThing(ctx context.Context, thing *models.Thing, UUID str
 		Int64Property("creationTimeUnix", thing.CreationTimeUnix).
 		Int64Property("lastUpdateTimeUnix", thing.LastUpdateTimeUnix)
 
-	type edgeToAdd struct {
-		PropertyName string
-		Type         string
-		Reference    string
-		Location     string
-	}
-
-	var edgesToAdd []edgeToAdd
-
-	schema, schema_ok := thing.Schema.(map[string]interface{})
-	if schema_ok {
-		for key, value := range schema {
-			janusgraphPropertyName := "schema__" + key
-			switch t := value.(type) {
-			case string:
-				q = q.StringProperty(janusgraphPropertyName, t)
-			case int:
-				q = q.Int64Property(janusgraphPropertyName, int64(t))
-			case int8:
-				q = q.Int64Property(janusgraphPropertyName, int64(t))
-			case int16:
-				q = q.Int64Property(janusgraphPropertyName, int64(t))
-			case int32:
-				q = q.Int64Property(janusgraphPropertyName, int64(t))
-			case int64:
-				q = q.Int64Property(janusgraphPropertyName, t)
-			case bool:
-				q = q.BoolProperty(janusgraphPropertyName, t)
-			case float32:
-				q = q.Float64Property(janusgraphPropertyName, float64(t))
-			case float64:
-				q = q.Float64Property(janusgraphPropertyName, t)
-			case time.Time:
-				q = q.StringProperty(janusgraphPropertyName, time.Time.String(t))
-			case *models.SingleRef:
-				// Postpone creation of edges
-				edgesToAdd = append(edgesToAdd, edgeToAdd{
-					PropertyName: janusgraphPropertyName,
-					Reference:    t.NrDollarCref.String(),
-					Type:         t.Type,
-					Location:     *t.LocationURL,
-				})
-			default:
-				f.messaging.ExitError(78, "The type "+reflect.TypeOf(value).String()+" is not supported for Thing properties.")
-			}
-		}
+	q, edgesToAdd, err := f.addThingSchemaProperties(q, thing.Schema)
+	if err != nil {
+		return err
 	}
 
 	// Add edges to all referened things.
@@ -105,9 +61,7 @@ func (f *Janusgraph) AddThing(ctx context.Context, thing *models.Thing, UUID str
 		FromRef("newThing").
 		ToQuery(gremlin.G.V().HasLabel(KEY_LABEL).HasString("uuid", thing.Key.NrDollarCref.String()))
 
-	_, err := f.client.Execute(q)
-
-	return err
+	return f.write(ctx, q)
 }
 
 func (f *Janusgraph) GetThing(ctx context.Context, UUID strfmt.UUID, thingResponse *models.ThingGetResponse) error {
@@ -121,9 +75,14 @@ func (f *Janusgraph) GetThing(ctx context.Context, UUID strfmt.UUID, thingRespon
 		V().
 		HasLabel(THING_LABEL).
 		HasString("uuid", string(UUID)).
-		Raw(`.optional(outE("thingEdge").as("thingEdge").as("ref")).choose(select("ref"), select("thing", "key", "ref"), select("thing", "key"))`)
+		Optional(gremlin.Current().OutEWithLabel("thingEdge").As("thingEdge").As("ref")).
+		Choose(
+			gremlin.Current().Select([]string{"ref"}),
+			gremlin.Current().Select([]string{"thing", "key", "ref"}),
+			gremlin.Current().Select([]string{"thing", "key"}),
+		)
 
-	result, err := f.client.Execute(q)
+	result, err := f.client.Execute(ctx, q)
 
 	if err != nil {
 		return err
@@ -134,8 +93,23 @@ func (f *Janusgraph) GetThing(ctx context.Context, UUID strfmt.UUID, thingRespon
 	}
 
 	// The outputs 'thing' and 'key' will be repeated over all results. Just get them for one for now.
-	thingVertex := result.Data[0].AssertKey("thing").AssertVertex()
-	keyPath := result.Data[0].AssertKey("key").AssertPath()
+	thingDatum, err := result.Data[0].Key("thing")
+	if err != nil {
+		return err
+	}
+	thingVertex, err := thingDatum.Vertex()
+	if err != nil {
+		return err
+	}
+
+	keyDatum, err := result.Data[0].Key("key")
+	if err != nil {
+		return err
+	}
+	keyPath, err := keyDatum.Path()
+	if err != nil {
+		return err
+	}
 
 	// However, we can get multiple refs. In that case, we'll have multiple datums,
 	// each with the same thing & key, but a different ref.
@@ -143,13 +117,23 @@ func (f *Janusgraph) GetThing(ctx context.Context, UUID strfmt.UUID, thingRespon
 	var refEdges []*gremlin.Edge
 	for _, datum := range result.Data {
 		ref, err := datum.Key("ref")
-		if err == nil {
-			refEdges = append(refEdges, ref.AssertEdge())
+		if err != nil {
+			continue
+		}
+
+		refEdge, err := ref.Edge()
+		if err != nil {
+			return err
 		}
+		refEdges = append(refEdges, refEdge)
 	}
 
-	thingResponse.Key = newKeySingleRefFromKeyPath(keyPath)
-	return fillThingResponseFromVertexAndEdges(thingVertex, refEdges, thingResponse)
+	thingResponse.Key, err = newKeySingleRefFromKeyPath(keyPath)
+	if err != nil {
+		return err
+	}
+
+	return fillThingResponseFromVertexAndEdges(thingVertex, refEdges, thingResponse, f.propertyDataType)
 }
 
 // TODO check
@@ -174,19 +158,28 @@ func (f *Janusgraph) GetThings(ctx context.Context, UUIDs []strfmt.UUID, respons
 	return nil
 }
 
-// TODO check
-func (f *Janusgraph) ListThings(ctx context.Context, first int, offset int, keyID strfmt.UUID, wheres []*connutils.WhereQuery, response *models.ThingsListResponse) error {
+// List the things of the class, or of any class if it is empty, that match all of the wheres. An array property
+// matches when one of its values does.
+func (f *Janusgraph) ListThings(ctx context.Context, first int, offset int, keyID strfmt.UUID, className string, wheres []*connutils.WhereQuery, response *models.ThingsListResponse) error {
+	q := gremlin.G.V().
+		HasLabel(THING_LABEL)
 
-	if len(wheres) > 0 {
-		return errors.New("Wheres are not supported in LisThings")
+	if className != "" {
+		q = q.HasString("atClass", className)
 	}
 
-	q := gremlin.G.V().
-		HasLabel(THING_LABEL).
-		Range(offset, first).
+	for _, where := range wheres {
+		predicate, err := wherePredicate(where)
+		if err != nil {
+			return err
+		}
+		q = q.Has("schema__"+where.Property, predicate)
+	}
+
+	q = q.Range(offset, offset+first).
 		Values([]string{"uuid"})
 
-	result, err := f.client.Execute(q)
+	result, err := f.client.Execute(ctx, q)
 
 	if err != nil {
 		return err
@@ -196,7 +189,10 @@ func (f *Janusgraph) ListThings(ctx context.Context, first int, offset int, keyI
 	response.Things = make([]*models.ThingGetResponse, 0)
 
 	// Get the UUIDs from the first query.
-	UUIDs := result.AssertStringSlice()
+	UUIDs, err := result.StringSlice()
+	if err != nil {
+		return err
+	}
 
 	for _, uuid := range UUIDs {
 		var thing_response models.ThingGetResponse
@@ -213,9 +209,68 @@ func (f *Janusgraph) ListThings(ctx context.Context, first int, offset int, keyI
 	return nil
 }
 
+// The predicate that the values of the property of a where have to match. Dates are stored as epoch milliseconds.
+func wherePredicate(where *connutils.WhereQuery) (*gremlin.Predicate, error) {
+	value := where.Value.Value
+	if t, ok := value.(time.Time); ok {
+		value = connutils.MakeUnixMillisecond(t)
+	}
+
+	switch where.Value.Operator {
+	case connutils.Equal, connutils.Contains:
+		return gremlin.Eq(value), nil
+	case connutils.NotEqual:
+		return gremlin.Neq(value), nil
+	case connutils.GreaterThan:
+		return gremlin.Gt(value), nil
+	case connutils.GreaterThanEqual:
+		return gremlin.Gte(value), nil
+	case connutils.LessThan:
+		return gremlin.Lt(value), nil
+	case connutils.LessThanEqual:
+		return gremlin.Lte(value), nil
+	case connutils.WithinDistance:
+		if r, ok := value.(connutils.GeoRange); ok {
+			return gremlin.GeoWithinDistance(r.Coordinates.Latitude, r.Coordinates.Longitude, r.Distance), nil
+		}
+	case connutils.WithinBoundingBox:
+		if box, ok := value.(connutils.GeoBoundingBox); ok {
+			return gremlin.GeoWithinBoundingBox(geoPoint(&box.SouthWest), geoPoint(&box.NorthEast)), nil
+		}
+	}
+
+	return nil, fmt.Errorf("the operator of the where on property '%s' is not supported with the value %#v", where.Property, where.Value.Value)
+}
+
 func (f *Janusgraph) UpdateThing(ctx context.Context, thing *models.Thing, UUID strfmt.UUID) error {
+	q, err := f.updateThingQuery(gremlin.G.V(), thing, UUID)
+	if err != nil {
+		return err
+	}
+
+	return f.write(ctx, q)
+}
+
+// Move the old values of a thing to the history and update it, in one traversal, so that the history only has the old
+// values when the thing is updated.
+func (f *Janusgraph) MoveToHistoryAndUpdateThing(ctx context.Context, oldThing *models.Thing, thing *models.Thing, UUID strfmt.UUID) error {
+	q, err := addThingHistory(nil, oldThing, UUID, false)
+	if err != nil {
+		return err
+	}
+
+	q, err = f.updateThingQuery(q.V(), thing, UUID)
+	if err != nil {
+		return err
+	}
+
+	return f.write(ctx, q)
+}
+
+// Extend a query of all vertices with the update of a thing.
+func (f *Janusgraph) updateThingQuery(vertices *gremlin.Query, thing *models.Thing, UUID strfmt.UUID) (*gremlin.Query, error) {
 	// Base settings
-	q := gremlin.G.V().HasLabel(THING_LABEL).
+	q := vertices.HasLabel(THING_LABEL).
 		HasString("uuid", string(UUID)).
 		As("thing").
 		StringProperty("atClass", thing.AtClass).
@@ -223,59 +278,18 @@ func (f *Janusgraph) UpdateThing(ctx context.Context, thing *models.Thing, UUID
 		Int64Property("creationTimeUnix", thing.CreationTimeUnix).
 		Int64Property("lastUpdateTimeUnix", thing.LastUpdateTimeUnix)
 
-	type expectedEdge struct {
-		PropertyName string
-		Type         string
-		Reference    string
-		Location     string
-	}
-
-	var expectedEdges []expectedEdge
-
-	schema, schema_ok := thing.Schema.(map[string]interface{})
-	if schema_ok {
-		for key, value := range schema {
-			janusgraphPropertyName := "schema__" + key
-			switch t := value.(type) {
-			case string:
-				q = q.StringProperty(janusgraphPropertyName, t)
-			case int:
-				q = q.Int64Property(janusgraphPropertyName, int64(t))
-			case int8:
-				q = q.Int64Property(janusgraphPropertyName, int64(t))
-			case int16:
-				q = q.Int64Property(janusgraphPropertyName, int64(t))
-			case int32:
-				q = q.Int64Property(janusgraphPropertyName, int64(t))
-			case int64:
-				q = q.Int64Property(janusgraphPropertyName, t)
-			case bool:
-				q = q.BoolProperty(janusgraphPropertyName, t)
-			case float32:
-				q = q.Float64Property(janusgraphPropertyName, float64(t))
-			case float64:
-				q = q.Float64Property(janusgraphPropertyName, t)
-			case time.Time:
-				q = q.StringProperty(janusgraphPropertyName, time.Time.String(t))
-			case *models.SingleRef:
-				// Postpone creation of edges
-				expectedEdges = append(expectedEdges, expectedEdge{
-					PropertyName: janusgraphPropertyName,
-					Reference:    t.NrDollarCref.String(),
-					Type:         t.Type,
-					Location:     *t.LocationURL,
-				})
-			default:
-				f.messaging.ExitError(78, "The type "+reflect.TypeOf(value).String()+" is not supported for Thing properties.")
-			}
-		}
+	q, expectedEdges, err := f.addThingSchemaProperties(q, thing.Schema)
+	if err != nil {
+		return nil, err
 	}
 
-	// Update all edges to all referened things.
+	// Update all edges to all referened things. All changes are made in this single traversal, so that a failure
+	// halfway, e.g. a reference to a thing that does not exist, rolls back the whole update.
 	// TODO: verify what to if we're not mentioning some reference? how should we remove such a reference?
 	for _, edge := range expectedEdges {
 		// First drop the edge
-		q = q.Optional(gremlin.Current().OutEWithLabel("thingEdge").HasString(PROPERTY_EDGE_LABEL, edge.PropertyName).Drop()).
+		q = q.Select([]string{"thing"}).
+			Optional(gremlin.Current().OutEWithLabel("thingEdge").HasString(PROPERTY_EDGE_LABEL, edge.PropertyName).Drop()).
 			AddE("thingEdge").
 			FromRef("thing").
 			ToQuery(gremlin.G.V().HasLabel(THING_LABEL).HasString("uuid", edge.Reference)).
@@ -288,27 +302,310 @@ func (f *Janusgraph) UpdateThing(ctx context.Context, thing *models.Thing, UUID
 	// Don't update the key.
 	// TODO verify that indeed this is the desired behaviour.
 
-	_, err := f.client.Execute(q)
-
-	return err
+	return q, nil
 }
 
+// Delete the thing, with its edges to other things and its key, in one traversal.
 func (f *Janusgraph) DeleteThing(ctx context.Context, thing *models.Thing, UUID strfmt.UUID) error {
-	q := gremlin.G.V().HasLabel(THING_LABEL).
+	return f.write(ctx, deleteThingQuery(gremlin.G.V(), UUID))
+}
+
+// Move the values of a thing to the history, marked as deleted, and delete it, in one traversal.
+func (f *Janusgraph) MoveToHistoryAndDeleteThing(ctx context.Context, thing *models.Thing, UUID strfmt.UUID) error {
+	q, err := addThingHistory(nil, thing, UUID, true)
+	if err != nil {
+		return err
+	}
+
+	return f.write(ctx, deleteThingQuery(q.V(), UUID))
+}
+
+// Extend a query of all vertices with the deletion of a thing.
+func deleteThingQuery(vertices *gremlin.Query, UUID strfmt.UUID) *gremlin.Query {
+	return vertices.HasLabel(THING_LABEL).
 		HasString("uuid", string(UUID)).
+		SideEffect(gremlin.Current().OutEWithLabel("thingEdge").Drop()).
+		SideEffect(gremlin.Current().OutEWithLabel(KEY_LABEL).Drop()).
 		Drop()
+}
+
+// Add or update all things of the batch in one traversal, and move the current values of the updated things to the
+// history. Every thing in the batch is labeled, so that edges to other things in the same batch can point to that label.
+// The traversal stops at an updated thing that does not exist, so those are looked up first; when there are any, nothing
+// is stored, and they are returned as connutils.BatchItemErrors.
+func (f *Janusgraph) BatchThings(ctx context.Context, things []*connutils.BatchThing) error {
+	if len(things) == 0 {
+		return nil
+	}
+
+	if err := f.checkBatchUpdates(ctx, things); err != nil {
+		return err
+	}
+
+	refs := make(map[string]string)
+	for i, batchThing := range things {
+		refs[string(batchThing.UUID)] = fmt.Sprintf("batchThing%d", i)
+	}
+
+	// First add or update all vertices, so that all labels exist before the edges are created.
+	var q *gremlin.Query
+	edges := make([][]thingEdge, len(things))
+	for i, batchThing := range things {
+		ref := refs[string(batchThing.UUID)]
+		thing := batchThing.Thing
+
+		if batchThing.Update {
+			// The history only has the current values when the update is stored
+			if batchThing.History != nil {
+				var err error
+				q, err = addThingHistory(q, batchThing.History, batchThing.UUID, false)
+				if err != nil {
+					return err
+				}
+			}
+
+			if q == nil {
+				q = gremlin.G.V()
+			} else {
+				q = q.V()
+			}
+			q = q.HasLabel(THING_LABEL).
+				HasString("uuid", string(batchThing.UUID)).
+				As(ref)
+		} else {
+			if q == nil {
+				q = gremlin.G.AddV(THING_LABEL)
+			} else {
+				q = q.AddV(THING_LABEL)
+			}
+			q = q.As(ref).
+				StringProperty("uuid", string(batchThing.UUID))
+		}
+
+		q = q.StringProperty("atClass", thing.AtClass).
+			StringProperty("context", thing.AtContext).
+			Int64Property("creationTimeUnix", thing.CreationTimeUnix).
+			Int64Property("lastUpdateTimeUnix", thing.LastUpdateTimeUnix)
+
+		var err error
+		q, edges[i], err = f.addThingSchemaProperties(q, thing.Schema)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i, batchThing := range things {
+		ref := refs[string(batchThing.UUID)]
+
+		for _, edge := range edges[i] {
+			if batchThing.Update {
+				// Drop the old edge first
+				q = q.Select([]string{ref}).
+					Optional(gremlin.Current().OutEWithLabel("thingEdge").HasString(PROPERTY_EDGE_LABEL, edge.PropertyName).Drop())
+			}
 
-	_, err := f.client.Execute(q)
+			q = q.AddE("thingEdge").
+				FromRef(ref)
 
-	return err
+			// Point to the label if the referenced thing is part of this batch.
+			if target, ok := refs[edge.Reference]; ok {
+				q = q.ToRef(target)
+			} else {
+				q = q.ToQuery(gremlin.G.V().HasLabel(THING_LABEL).HasString("uuid", edge.Reference))
+			}
+
+			q = q.StringProperty(PROPERTY_EDGE_LABEL, edge.PropertyName).
+				StringProperty("$cref", edge.Reference).
+				StringProperty("type", edge.Type).
+				StringProperty("locationUrl", edge.Location)
+		}
+
+		// Link new things to the key, don't update the key of existing things.
+		if !batchThing.Update {
+			q = q.AddE(KEY_LABEL).
+				StringProperty("locationUrl", *batchThing.Thing.Key.LocationURL).
+				FromRef(ref).
+				ToQuery(gremlin.G.V().HasLabel(KEY_LABEL).HasString("uuid", batchThing.Thing.Key.NrDollarCref.String()))
+		}
+	}
+
+	// The traversal ends with one traverser, unless it stopped halfway because a thing was deleted meanwhile
+	result, err := f.writeWithResult(ctx, q.Count())
+	if err != nil {
+		return err
+	}
+
+	count, err := result.OneInt()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return fmt.Errorf("the batch is not stored completely, because a thing that it updates was deleted while it was stored")
+	}
+
+	return nil
+}
+
+// Return the updated things of a batch that do not exist as connutils.BatchItemErrors.
+func (f *Janusgraph) checkBatchUpdates(ctx context.Context, things []*connutils.BatchThing) error {
+	var UUIDs []interface{}
+	for _, batchThing := range things {
+		if batchThing.Update {
+			UUIDs = append(UUIDs, string(batchThing.UUID))
+		}
+	}
+	if len(UUIDs) == 0 {
+		return nil
+	}
+
+	result, err := f.client.Execute(ctx, gremlin.G.V().HasLabel(THING_LABEL).Has("uuid", gremlin.Within(UUIDs...)).Values([]string{"uuid"}))
+	if err != nil {
+		return err
+	}
+
+	found, err := result.StringSlice()
+	if err != nil {
+		return err
+	}
+	exists := map[string]bool{}
+	for _, UUID := range found {
+		exists[UUID] = true
+	}
+
+	missing := &connutils.BatchItemErrors{Errors: map[int]error{}}
+	for i, batchThing := range things {
+		if batchThing.Update && !exists[string(batchThing.UUID)] {
+			missing.Errors[i] = errors.New(connutils.StaticThingNotFound)
+		}
+	}
+	if len(missing.Errors) > 0 {
+		return missing
+	}
+
+	return nil
 }
 
+// Fill the history of a thing with all of its history vertices, the oldest first.
 func (f *Janusgraph) HistoryThing(ctx context.Context, UUID strfmt.UUID, history *models.ThingHistory) error {
+	q := gremlin.G.V().HasLabel(THING_HISTORY_LABEL).
+		HasString("uuid", string(UUID)).
+		Order().By("creationTimeUnix")
+
+	result, err := f.client.Execute(ctx, q)
+	if err != nil {
+		return err
+	}
+
+	vertices, err := result.Vertices()
+	if err != nil {
+		return err
+	}
+
+	if len(vertices) == 0 {
+		return errors.New(connutils.StaticNoHistoryFound)
+	}
+
+	history.PropertyHistory = make([]*models.ThingHistoryObject, 0, len(vertices))
+	for i := range vertices {
+		historyObject, err := fillThingHistoryFromVertex(&vertices[i], history)
+		if err != nil {
+			return err
+		}
+		history.PropertyHistory = append(history.PropertyHistory, historyObject)
+	}
+
 	return nil
 }
 
+// Store the current values of a thing in a history vertex. The history vertex is not linked to the thing, so that it
+// outlives the thing when the thing is deleted. It is added in a single traversal, so it's either fully stored or not
+// at all.
 func (f *Janusgraph) MoveToHistoryThing(ctx context.Context, thing *models.Thing, UUID strfmt.UUID, deleted bool) error {
-	return nil
+	q, err := addThingHistory(nil, thing, UUID, deleted)
+	if err != nil {
+		return err
+	}
+
+	return f.write(ctx, q)
+}
+
+// Extend the query with a history vertex with the current values of a thing. A nil query starts a new query.
+func addThingHistory(q *gremlin.Query, thing *models.Thing, UUID strfmt.UUID, deleted bool) (*gremlin.Query, error) {
+	if q == nil {
+		q = gremlin.G.AddV(THING_HISTORY_LABEL)
+	} else {
+		q = q.AddV(THING_HISTORY_LABEL)
+	}
+
+	return thingHistoryProperties(q, &thing.ThingCreate, thing.Key, UUID, deleted, connutils.NowUnix())
+}
+
+// Add the properties of a history vertex of a thing to the query, which adds the vertex.
+func thingHistoryProperties(q *gremlin.Query, thing *models.ThingCreate, key *models.SingleRef, UUID strfmt.UUID, deleted bool, creationTimeUnix int64) (*gremlin.Query, error) {
+	schema, err := json.Marshal(thing.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	q = q.StringProperty("uuid", string(UUID)).
+		StringProperty("atClass", thing.AtClass).
+		StringProperty("context", thing.AtContext).
+		StringProperty("schema", string(schema)).
+		BoolProperty("deleted", deleted).
+		Int64Property("creationTimeUnix", creationTimeUnix)
+
+	if key != nil && key.LocationURL != nil {
+		q = q.StringProperty("keyUuid", key.NrDollarCref.String()).
+			StringProperty("keyLocationUrl", *key.LocationURL)
+	}
+
+	return q, nil
+}
+
+// Store a history entry of a thing as it is. The entry is only added when the thing has no history vertex with the
+// same time yet, in the same traversal, so that copying a history again does not duplicate its entries.
+func (f *Janusgraph) AddThingHistory(ctx context.Context, UUID strfmt.UUID, entry *models.ThingHistoryObject, key *models.SingleRef, deleted bool) error {
+	add, err := thingHistoryProperties(gremlin.Current().AddV(THING_HISTORY_LABEL), &entry.ThingCreate, key, UUID, deleted, entry.CreationTimeUnix)
+	if err != nil {
+		return err
+	}
+
+	q := gremlin.G.V().HasLabel(THING_HISTORY_LABEL).
+		HasString("uuid", string(UUID)).
+		Has("creationTimeUnix", gremlin.Eq(entry.CreationTimeUnix)).
+		Fold().
+		Coalesce(gremlin.Current().Unfold(), add)
+
+	return f.write(ctx, q)
+}
+
+// The UUIDs of the deleted things, from the history vertices that record their deletion.
+func (f *Janusgraph) DeletedThings(ctx context.Context) ([]strfmt.UUID, error) {
+	q := gremlin.G.V().HasLabel(THING_HISTORY_LABEL).
+		HasBool("deleted", true).
+		Values([]string{"uuid"})
+
+	result, err := f.client.Execute(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := result.StringSlice()
+	if err != nil {
+		return nil, err
+	}
+
+	// A thing that is deleted again, after it was restored with the same UUID, has more than one of them
+	seen := map[string]bool{}
+	UUIDs := []strfmt.UUID{}
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			UUIDs = append(UUIDs, strfmt.UUID(value))
+		}
+	}
+
+	return UUIDs, nil
 }
 
 func debug(result interface{}) {
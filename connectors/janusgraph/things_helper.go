@@ -1,54 +1,255 @@
 package janusgraph
 
 import (
+	"encoding/json"
+	"fmt"
 	"strings"
+	"time"
 
+	connutils "github.com/creativesoftwarefdn/weaviate/connectors/utils"
 	"github.com/creativesoftwarefdn/weaviate/gremlin"
 	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/creativesoftwarefdn/weaviate/schema"
 
 	"github.com/go-openapi/strfmt"
 )
 
-func fillThingResponseFromVertexAndEdges(vertex *gremlin.Vertex, refEdges []*gremlin.Edge, thingResponse *models.ThingGetResponse) error {
+// Fill the response of a thing from its vertex and the edges of its references. The values of the properties are
+// returned as the data type that dataTypeOf gives for them, e.g. as a list for arrays.
+func fillThingResponseFromVertexAndEdges(vertex *gremlin.Vertex, refEdges []*gremlin.Edge, thingResponse *models.ThingGetResponse, dataTypeOf func(className string, propertyName string) *schema.DataType) error {
 	// TODO: We should actually read stuff from the database schema, then get only that stuff from JanusGraph.
 	// At this moment, we're just parsing whetever there is in JanusGraph, which might not agree with the database schema
 	// that is defined in Weaviate.
 
-	thingResponse.ThingID = strfmt.UUID(vertex.AssertPropertyValue("uuid").AssertString())
-	thingResponse.AtClass = vertex.AssertPropertyValue("atClass").AssertString()
-	thingResponse.AtContext = vertex.AssertPropertyValue("context").AssertString()
+	uuid, err := vertex.StringValue("uuid")
+	if err != nil {
+		return err
+	}
+	thingResponse.ThingID = strfmt.UUID(uuid)
+
+	if thingResponse.AtClass, err = vertex.StringValue("atClass"); err != nil {
+		return err
+	}
+	if thingResponse.AtContext, err = vertex.StringValue("context"); err != nil {
+		return err
+	}
 
-	thingResponse.CreationTimeUnix = vertex.AssertPropertyValue("creationTimeUnix").AssertInt64()
-	thingResponse.LastUpdateTimeUnix = vertex.AssertPropertyValue("lastUpdateTimeUnix").AssertInt64()
+	if thingResponse.CreationTimeUnix, err = vertex.Int64Value("creationTimeUnix"); err != nil {
+		return err
+	}
+	if thingResponse.LastUpdateTimeUnix, err = vertex.Int64Value("lastUpdateTimeUnix"); err != nil {
+		return err
+	}
 
-	schema := make(map[string]interface{})
+	schemaValues := make(map[string]interface{})
 
 	// Walk through all properties, check if they start with 'schema__', and then consider them to be 'schema' properties.
 	// Just copy in the value directly. We're not doing any sanity check/casting to proper types for now.
 	for key, val := range vertex.Properties {
 		if strings.HasPrefix(key, "schema__") {
-			key = key[8:len(key)]
-			schema[key] = val.Value.Value
+			name := key[8:len(key)]
+			dataType := dataTypeOf(thingResponse.AtClass, name)
+			if point, ok := val.Value.GeoPoint(); ok {
+				schemaValues[name] = &models.GeoCoordinates{Latitude: point.Latitude, Longitude: point.Longitude}
+			} else if dataType != nil && schema.IsArrayDataType(*dataType) {
+				values := []interface{}{}
+				for _, value := range vertex.PropertyValues(key) {
+					values = append(values, responseValue(schema.ElementDataType(*dataType), value))
+				}
+				schemaValues[name] = values
+			} else if dataType != nil {
+				schemaValues[name] = responseValue(*dataType, val.Value)
+			} else {
+				schemaValues[name] = val.Value.Value
+			}
 		}
 	}
 
 	// For each of the connected edges, get the property values,
 	// and store the reference.
 	for _, edge := range refEdges {
-		locationUrl := edge.AssertPropertyValue("locationUrl").AssertString()
-		type_ := edge.AssertPropertyValue("type").AssertString()
-		edgeName := edge.AssertPropertyValue("propertyEdge").AssertString()
-		uuid := edge.AssertPropertyValue("$cref").AssertString()
+		locationUrl, err := edge.StringValue("locationUrl")
+		if err != nil {
+			return err
+		}
+		type_, err := edge.StringValue("type")
+		if err != nil {
+			return err
+		}
+		edgeName, err := edge.StringValue("propertyEdge")
+		if err != nil {
+			return err
+		}
+		uuid, err := edge.StringValue("$cref")
+		if err != nil {
+			return err
+		}
 
 		key := edgeName[8:len(edgeName)]
 		ref := make(map[string]interface{})
 		ref["$cref"] = uuid
 		ref["locationUrl"] = locationUrl
 		ref["type"] = type_
-		schema[key] = ref
+		schemaValues[key] = ref
 	}
 
-	thingResponse.Schema = schema
+	thingResponse.Schema = schemaValues
 
 	return nil
 }
+
+// The value of a property as it is returned for its data type. Dates are stored as epoch milliseconds and returned in
+// RFC 3339; dates that were stored as strings by an older version are returned as they were.
+func responseValue(dataType schema.DataType, value gremlin.PropertyValue) interface{} {
+	if dataType == schema.DataTypeDate {
+		if ms, ok := value.Int64(); ok {
+			return connutils.TimeFromUnixMillisecond(ms).Format(time.RFC3339Nano)
+		}
+	}
+	return value.Value
+}
+
+// Build a history object from a history vertex. The history is marked deleted if any of its vertices is, and its key
+// is the key of the latest vertex.
+func fillThingHistoryFromVertex(vertex *gremlin.Vertex, history *models.ThingHistory) (*models.ThingHistoryObject, error) {
+	historyObject := &models.ThingHistoryObject{}
+
+	var err error
+	if historyObject.AtClass, err = vertex.StringValue("atClass"); err != nil {
+		return nil, err
+	}
+	if historyObject.AtContext, err = vertex.StringValue("context"); err != nil {
+		return nil, err
+	}
+	if historyObject.CreationTimeUnix, err = vertex.Int64Value("creationTimeUnix"); err != nil {
+		return nil, err
+	}
+
+	schema, err := vertex.StringValue("schema")
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(schema), &historyObject.Schema); err != nil {
+		return nil, err
+	}
+
+	deleted, err := vertex.BoolValue("deleted")
+	if err != nil {
+		return nil, err
+	}
+	if deleted {
+		history.Deleted = true
+	}
+
+	if _, ok := vertex.Properties["keyUuid"]; ok {
+		keyUUID, err := vertex.StringValue("keyUuid")
+		if err != nil {
+			return nil, err
+		}
+		location, err := vertex.StringValue("keyLocationUrl")
+		if err != nil {
+			return nil, err
+		}
+		history.Key = &models.SingleRef{
+			NrDollarCref: strfmt.UUID(keyUUID),
+			Type:         "Key",
+			LocationURL:  &location,
+		}
+	}
+
+	return historyObject, nil
+}
+
+// A reference in the schema of a thing, stored as an edge.
+type thingEdge struct {
+	PropertyName string
+	Type         string
+	Reference    string
+	Location     string
+}
+
+// Add the schema values of a thing as properties to the query, and remove the properties whose value is nil.
+// References are not added; they are returned, so that the caller can create the edges. The values of an array replace
+// the values of its property. A value of a type that can not be stored is an InvalidValueError.
+func (f *Janusgraph) addThingSchemaProperties(q *gremlin.Query, thingSchema interface{}) (*gremlin.Query, []thingEdge, error) {
+	var edges []thingEdge
+
+	schema, schema_ok := thingSchema.(map[string]interface{})
+	if schema_ok {
+		listKeys := f.listPropertyKeys()
+		for key, value := range schema {
+			janusgraphPropertyName := "schema__" + key
+
+			// A property key with the cardinality LIST keeps its values when a value is added, so drop them first
+			if listKeys[janusgraphPropertyName] {
+				q = q.SideEffect(gremlin.Current().Properties([]string{janusgraphPropertyName}).Drop())
+			}
+
+			switch t := value.(type) {
+			case string:
+				q = q.StringProperty(janusgraphPropertyName, t)
+			case int:
+				q = q.Int64Property(janusgraphPropertyName, int64(t))
+			case int8:
+				q = q.Int64Property(janusgraphPropertyName, int64(t))
+			case int16:
+				q = q.Int64Property(janusgraphPropertyName, int64(t))
+			case int32:
+				q = q.Int64Property(janusgraphPropertyName, int64(t))
+			case int64:
+				q = q.Int64Property(janusgraphPropertyName, t)
+			case bool:
+				q = q.BoolProperty(janusgraphPropertyName, t)
+			case float32:
+				q = q.Float64Property(janusgraphPropertyName, float64(t))
+			case float64:
+				q = q.Float64Property(janusgraphPropertyName, t)
+			case time.Time:
+				q = q.Int64Property(janusgraphPropertyName, connutils.MakeUnixMillisecond(t))
+			case nil:
+				// A property without a value is removed, e.g. when a migration renamed it
+				q = q.SideEffect(gremlin.Current().Properties([]string{janusgraphPropertyName}).Drop()).
+					SideEffect(gremlin.Current().OutEWithLabel("thingEdge").HasString(PROPERTY_EDGE_LABEL, janusgraphPropertyName).Drop())
+			case []interface{}:
+				for _, element := range t {
+					switch e := element.(type) {
+					case string:
+						q = q.StringListProperty(janusgraphPropertyName, e)
+					case int64:
+						q = q.Int64ListProperty(janusgraphPropertyName, e)
+					case float64:
+						q = q.Float64ListProperty(janusgraphPropertyName, e)
+					case time.Time:
+						q = q.Int64ListProperty(janusgraphPropertyName, connutils.MakeUnixMillisecond(e))
+					default:
+						return nil, nil, &connutils.InvalidValueError{
+							Message: fmt.Sprintf("the type %T of a value of property '%s' is not supported for values of Thing array properties", element, key),
+						}
+					}
+				}
+			case *models.GeoCoordinates:
+				point := geoPoint(t)
+				q = q.GeoPointProperty(janusgraphPropertyName, point.Latitude, point.Longitude)
+			case *models.SingleRef:
+				// Postpone creation of edges
+				edges = append(edges, thingEdge{
+					PropertyName: janusgraphPropertyName,
+					Reference:    t.NrDollarCref.String(),
+					Type:         t.Type,
+					Location:     *t.LocationURL,
+				})
+			default:
+				return nil, nil, &connutils.InvalidValueError{
+					Message: fmt.Sprintf("the type %T of property '%s' is not supported for Thing properties", value, key),
+				}
+			}
+		}
+	}
+
+	return q, edges, nil
+}
+
+// The geographic point of coordinates, as stored in JanusGraph.
+func geoPoint(coordinates *models.GeoCoordinates) gremlin.GeoPoint {
+	return gremlin.GeoPoint{Latitude: coordinates.Latitude, Longitude: coordinates.Longitude}
+}
@@ -18,7 +18,7 @@ import (
 func (f *Janusgraph) ValidateToken(ctx context.Context, UUID strfmt.UUID, keyResponse *models.KeyGetResponse) (token string, err error) {
 	q := gremlin.G.V().HasLabel(KEY_LABEL).HasString("uuid", string(UUID))
 
-	result, err := f.client.Execute(q)
+	result, err := f.client.Execute(ctx, q)
 
 	if err != nil {
 		return "", err
@@ -41,8 +41,16 @@ func (f *Janusgraph) ValidateToken(ctx context.Context, UUID strfmt.UUID, keyRes
 	}
 
 	vertex := vertices[0]
-	fillKeyResponseFromVertex(&vertex, keyResponse)
-	tokenToReturn, err := base64.StdEncoding.DecodeString(vertex.AssertPropertyValue("__token").AssertString())
+	if err := fillKeyResponseFromVertex(&vertex, keyResponse); err != nil {
+		return "", err
+	}
+
+	encodedToken, err := vertex.StringValue("__token")
+	if err != nil {
+		return "", err
+	}
+
+	tokenToReturn, err := base64.StdEncoding.DecodeString(encodedToken)
 
 	if err != nil {
 		return "", err
@@ -0,0 +1,40 @@
+package janusgraph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/creativesoftwarefdn/weaviate/gremlin"
+)
+
+// Execute a query that changes the graph, as one transaction. Every write is a single traversal, which the Gremlin
+// server runs in a transaction of its own, and rolls back when it fails halfway. When writes run in sessions, every
+// write opens a session of its own on a connection of the pool, so the transactions of concurrent writes don't
+// overlap; the transaction is committed after the query, or rolled back when the query failed.
+func (f *Janusgraph) write(ctx context.Context, q *gremlin.Query) error {
+	_, err := f.writeWithResult(ctx, q)
+	return err
+}
+
+// Execute a query that changes the graph, like write, and return its result.
+func (f *Janusgraph) writeWithResult(ctx context.Context, q *gremlin.Query) (*gremlin.Response, error) {
+	if f.session == nil {
+		return f.client.Execute(ctx, q)
+	}
+
+	session, err := f.session()
+	if err != nil {
+		return nil, &gremlin.UnavailableError{Err: fmt.Errorf("Could not open a session on the Gremlin server; %v", err)}
+	}
+	defer session.Close()
+
+	result, err := session.Execute(ctx, q)
+	if err != nil {
+		if rollbackErr := session.Rollback(); rollbackErr != nil {
+			return nil, fmt.Errorf("%v; could not roll back the transaction: %v", err, rollbackErr)
+		}
+		return nil, err
+	}
+
+	return result, session.Commit()
+}
@@ -0,0 +1,214 @@
+package janusgraph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/require"
+
+	"github.com/creativesoftwarefdn/weaviate/connectors/conformance"
+	connutils "github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/gremlin"
+	"github.com/creativesoftwarefdn/weaviate/gremlin/fake_server"
+	"github.com/creativesoftwarefdn/weaviate/gremlin/http_client"
+	"github.com/creativesoftwarefdn/weaviate/models"
+)
+
+// TestWritesAreAtomic makes writes fail halfway, and checks that none of their changes are kept
+func TestWritesAreAtomic(t *testing.T) {
+	t.Run("HTTP", func(t *testing.T) {
+		testWritesAreAtomic(t, false)
+	})
+	t.Run("Session", func(t *testing.T) {
+		testWritesAreAtomic(t, true)
+	})
+}
+
+func testWritesAreAtomic(t *testing.T, session bool) {
+	server := fake_server.NewServer()
+	defer server.Close()
+
+	databaseConfig := map[string]interface{}{"url": server.URL}
+	if session {
+		databaseConfig = map[string]interface{}{
+			"url":     "ws" + strings.TrimPrefix(server.URL, "http"),
+			"driver":  driverWebSocket,
+			"session": true,
+		}
+	}
+
+	connector := newTestConnector(t, databaseConfig)
+
+	ctx := context.Background()
+	keyUUID := connutils.GenerateUUID()
+	key := &models.Key{KeyCreate: models.KeyCreate{Email: "atomic@example.org", KeyExpiresUnix: -1}}
+	require.NoError(t, connector.AddKey(ctx, key, keyUUID, connutils.TokenHasher(connutils.GenerateUUID())))
+
+	first, second, referring := connutils.GenerateUUID(), connutils.GenerateUUID(), connutils.GenerateUUID()
+	require.NoError(t, connector.AddThing(ctx, newAtomicThing(keyUUID, map[string]interface{}{"name": "first"}), first))
+	require.NoError(t, connector.AddThing(ctx, newAtomicThing(keyUUID, map[string]interface{}{"name": "second"}), second))
+	require.NoError(t, connector.AddThing(ctx, newAtomicThing(keyUUID, map[string]interface{}{
+		"name":    "referring",
+		"related": newAtomicRef(connutils.RefTypeThing, first),
+	}), referring))
+
+	requireUnchanged := func() {
+		response := models.ThingGetResponse{}
+		require.NoError(t, connector.GetThing(ctx, referring, &response))
+		properties := response.Schema.(map[string]interface{})
+		require.Equal(t, "referring", properties["name"])
+		require.Equal(t, first.String(), properties["related"].(map[string]interface{})["$cref"])
+		require.Equal(t, keyUUID, response.Key.NrDollarCref)
+	}
+
+	// The old edge is dropped before the new edge is added
+	server.InjectFailure(failAt("addE", 1))
+	err := connector.UpdateThing(ctx, newAtomicThing(keyUUID, map[string]interface{}{
+		"name":    "updated",
+		"related": newAtomicRef(connutils.RefTypeThing, second),
+	}), referring)
+	require.Error(t, err)
+	requireUnchanged()
+
+	// The edges to other things and to the key are dropped before the thing itself
+	server.InjectFailure(failAt("drop", 3))
+	require.Error(t, connector.DeleteThing(ctx, nil, referring))
+	requireUnchanged()
+
+	server.InjectFailure(failAt("addV", 1))
+	require.Error(t, connector.MoveToHistoryThing(ctx, newAtomicThing(keyUUID, nil), referring, false))
+	err = connector.HistoryThing(ctx, referring, &models.ThingHistory{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), connutils.StaticNoHistoryFound)
+
+	// The history vertex is added before the thing is changed, and is rolled back with the change
+	server.InjectFailure(failAt("addE", 1))
+	err = connector.MoveToHistoryAndUpdateThing(ctx, newAtomicThing(keyUUID, nil), newAtomicThing(keyUUID, map[string]interface{}{
+		"name":    "updated",
+		"related": newAtomicRef(connutils.RefTypeThing, second),
+	}), referring)
+	require.Error(t, err)
+	requireUnchanged()
+	err = connector.HistoryThing(ctx, referring, &models.ThingHistory{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), connutils.StaticNoHistoryFound)
+
+	server.InjectFailure(failAt("drop", 3))
+	require.Error(t, connector.MoveToHistoryAndDeleteThing(ctx, newAtomicThing(keyUUID, nil), referring))
+	requireUnchanged()
+	err = connector.HistoryThing(ctx, referring, &models.ThingHistory{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), connutils.StaticNoHistoryFound)
+
+	// The new things are added before the edges between them
+	server.InjectFailure(failAt("addE", 2))
+	added := connutils.GenerateUUID()
+	err = connector.BatchThings(ctx, []*connutils.BatchThing{
+		{Thing: newAtomicThing(keyUUID, map[string]interface{}{"name": "added"}), UUID: added},
+		{
+			Thing: newAtomicThing(keyUUID, map[string]interface{}{
+				"name":    "updated",
+				"related": newAtomicRef(connutils.RefTypeThing, added),
+			}),
+			UUID:    referring,
+			Update:  true,
+			History: newAtomicThing(keyUUID, nil),
+		},
+	})
+	require.Error(t, err)
+	requireUnchanged()
+	require.Error(t, connector.GetThing(ctx, added, &models.ThingGetResponse{}))
+	err = connector.HistoryThing(ctx, referring, &models.ThingHistory{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), connutils.StaticNoHistoryFound)
+
+	// Successful writes are committed, so that they can be seen outside of the session
+	server.InjectFailure(nil)
+	require.NoError(t, connector.DeleteThing(ctx, nil, first))
+	result, err := http_client.NewClient(server.URL).Execute(context.Background(), gremlin.G.V().HasLabel(THING_LABEL).Count())
+	require.NoError(t, err)
+	count, err := result.OneInt()
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+}
+
+// TestConcurrentSessionWrites checks that concurrent writes in sessions are all committed, each in a session of its own
+func TestConcurrentSessionWrites(t *testing.T) {
+	server := fake_server.NewServer()
+	defer server.Close()
+
+	connector := newTestConnector(t, map[string]interface{}{
+		"url":     "ws" + strings.TrimPrefix(server.URL, "http"),
+		"driver":  driverWebSocket,
+		"session": true,
+	})
+
+	ctx := context.Background()
+	keyUUID := connutils.GenerateUUID()
+	key := &models.Key{KeyCreate: models.KeyCreate{Email: "concurrent@example.org", KeyExpiresUnix: -1}}
+	require.NoError(t, connector.AddKey(ctx, key, keyUUID, connutils.TokenHasher(connutils.GenerateUUID())))
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs <- connector.AddThing(ctx, newAtomicThing(keyUUID, map[string]interface{}{"name": fmt.Sprintf("thing %d", i)}), connutils.GenerateUUID())
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	result, err := http_client.NewClient(server.URL).Execute(ctx, gremlin.G.V().HasLabel(THING_LABEL).Count())
+	require.NoError(t, err)
+	count, err := result.OneInt()
+	require.NoError(t, err)
+	require.Equal(t, 10, count)
+}
+
+// Fail at the n-th time that the step is evaluated.
+func failAt(step string, n int) func(string) error {
+	return func(name string) error {
+		if name != step {
+			return nil
+		}
+		n--
+		if n == 0 {
+			return errors.New("injected failure")
+		}
+		return nil
+	}
+}
+
+func newAtomicThing(keyUUID strfmt.UUID, properties map[string]interface{}) *models.Thing {
+	now := connutils.NowUnix()
+	return &models.Thing{
+		ThingCreate: models.ThingCreate{
+			AtClass:   conformance.ThingClass,
+			AtContext: "http://example.org",
+			Schema:    properties,
+		},
+		CreationTimeUnix:   now,
+		LastUpdateTimeUnix: now,
+		Key:                newAtomicRef(connutils.RefTypeKey, keyUUID),
+	}
+}
+
+func newAtomicRef(refType connutils.RefType, UUID strfmt.UUID) *models.SingleRef {
+	location := "http://localhost"
+	return &models.SingleRef{
+		LocationURL:  &location,
+		NrDollarCref: UUID,
+		Type:         string(refType),
+	}
+}
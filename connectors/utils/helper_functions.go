@@ -14,6 +14,7 @@
 package connutils
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -133,6 +134,11 @@ func MakeUnixMillisecond(t time.Time) int64 {
 	return t.UnixNano() / int64(time.Millisecond)
 }
 
+// TimeFromUnixMillisecond returns the time of the given millisecond unix-version, in UTC
+func TimeFromUnixMillisecond(ms int64) time.Time {
+	return time.Unix(0, ms*int64(time.Millisecond)).UTC()
+}
+
 // GenerateUUID returns a new UUID
 func GenerateUUID() strfmt.UUID {
 
@@ -163,10 +169,13 @@ func WhereStringToStruct(prop string, where string) (WhereQuery, error) {
 	// Make a regex which can compile a string like 'firstName>=~John'
 	re1, _ := regexp.Compile(`^([a-zA-Z0-9]*)([:<>!=]*)([~]*)([^~]*)$`)
 	result := re1.FindStringSubmatch(where)
+	if result == nil {
+		return whereQuery, errors.New("the query is not a property, an operator and a value")
+	}
 
 	// Set which property
 	whereQuery.Property = prop
-	if len(result[1]) > 1 && len(result[4]) != 0 {
+	if len(result[1]) > 0 && len(result[4]) != 0 {
 		whereQuery.Property = fmt.Sprintf("%s.%s", prop, result[1])
 	}
 
@@ -177,15 +186,14 @@ func WhereStringToStruct(prop string, where string) (WhereQuery, error) {
 		whereQuery.Value.Operator = Equal
 	case "!:", "!=":
 		whereQuery.Value.Operator = NotEqual
-	// TODO: https://github.com/creativesoftwarefdn/weaviate/issues/202
-	// case ">":
-	// 	whereQuery.Value.Operator = GreaterThan
-	// case ">:", ">=":
-	// 	whereQuery.Value.Operator = GreaterThanEqual
-	// case "<":
-	// 	whereQuery.Value.Operator = LessThan
-	// case "<:", "<=":
-	// 	whereQuery.Value.Operator = LessThanEqual
+	case ">":
+		whereQuery.Value.Operator = GreaterThan
+	case ">:", ">=":
+		whereQuery.Value.Operator = GreaterThanEqual
+	case "<":
+		whereQuery.Value.Operator = LessThan
+	case "<:", "<=":
+		whereQuery.Value.Operator = LessThanEqual
 	default:
 		return whereQuery, errors.New("invalid operator set in query")
 	}
@@ -210,6 +218,21 @@ func WhereStringToStruct(prop string, where string) (WhereQuery, error) {
 	return whereQuery, nil
 }
 
+// The key of the wheres in a context
+type wheresContextKey struct{}
+
+// ContextWithWheres returns a context that carries the where queries of a GraphQL request to the connector, which
+// resolves the request with GetGraph.
+func ContextWithWheres(ctx context.Context, wheres []*WhereQuery) context.Context {
+	return context.WithValue(ctx, wheresContextKey{}, wheres)
+}
+
+// WheresFromContext returns the where queries that all results of a GraphQL request have to match, if any.
+func WheresFromContext(ctx context.Context) []*WhereQuery {
+	wheres, _ := ctx.Value(wheresContextKey{}).([]*WhereQuery)
+	return wheres
+}
+
 // DoExternalRequest does a request to an external Weaviate Instance based on given parameters
 func DoExternalRequest(instance config.Instance, endpoint string, uuid strfmt.UUID) (response *http.Response, err error) {
 	// Create the transport and HTTP client
@@ -13,6 +13,16 @@
 
 package connutils
 
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/creativesoftwarefdn/weaviate/models"
+)
+
 type (
 	// Operator is a representation of the operator for queries
 	Operator uint16
@@ -40,6 +50,12 @@ const (
 	LessThan
 	// LessThanEqual represents an operator for an operation to be less or equal than the value
 	LessThanEqual
+	// Contains represents an operator for an operation to have the value as one of the values of an array
+	Contains
+	// WithinDistance represents an operator for an operation to be a location within a GeoRange
+	WithinDistance
+	// WithinBoundingBox represents an operator for an operation to be a location within a GeoBoundingBox
+	WithinBoundingBox
 
 	// StaticNoRootKey message when no root key is found
 	StaticNoRootKey string = "No root-key found."
@@ -67,8 +83,69 @@ type ValueType struct {
 	Contains bool        // Has 'contains' mark
 }
 
+// GeoRange is the value of a WithinDistance query; the locations at most the distance in kilometers from the
+// coordinates
+type GeoRange struct {
+	Coordinates models.GeoCoordinates
+	Distance    float64
+}
+
+// GeoBoundingBox is the value of a WithinBoundingBox query; the locations between its south west and north east
+// corners
+type GeoBoundingBox struct {
+	SouthWest models.GeoCoordinates
+	NorthEast models.GeoCoordinates
+}
+
 // WhereQuery represents the query itself
 type WhereQuery struct {
 	Property string
 	Value    ValueType
 }
+
+// BatchThing represents a single thing in a batch, stored at the given UUID
+type BatchThing struct {
+	Thing   *models.Thing
+	UUID    strfmt.UUID
+	Update  bool          // Update the existing thing at UUID instead of adding a new one
+	History *models.Thing // The current values of an updated thing, which are moved to the history with the batch
+}
+
+// BatchAction represents a single action in a batch, stored at the given UUID
+type BatchAction struct {
+	Action  *models.Action
+	UUID    strfmt.UUID
+	Update  bool           // Update the existing action at UUID instead of adding a new one
+	History *models.Action // The current values of an updated action, which are moved to the history with the batch
+}
+
+// InvalidValueError is returned by a connector for a value that it can not store, e.g. of an unsupported type,
+// so the request that holds the value is invalid
+type InvalidValueError struct {
+	Message string
+}
+
+func (e *InvalidValueError) Error() string {
+	return e.Message
+}
+
+// BatchItemErrors is returned by a connector for the items of a batch that it can not store, e.g. updates of things
+// that do not exist, by their index in the batch. Nothing of the batch is stored then, so the other items can be
+// given again without them.
+type BatchItemErrors struct {
+	Errors map[int]error
+}
+
+func (e *BatchItemErrors) Error() string {
+	indices := make([]int, 0, len(e.Errors))
+	for i := range e.Errors {
+		indices = append(indices, i)
+	}
+	sort.Ints(indices)
+
+	messages := make([]string, 0, len(indices))
+	for _, i := range indices {
+		messages = append(messages, fmt.Sprintf("item %d: %v", i, e.Errors[i]))
+	}
+	return strings.Join(messages, "; ")
+}
@@ -4,17 +4,18 @@ import (
 	"fmt"
 )
 
+// Compute the centroid of vectors for the Euclidean metric; see DistanceMetric.ComputeCentroid for other metrics.
 func ComputeCentroid(vectors []Vector) (*Vector, error) {
-	var weights []float32 = make([]float32, len(vectors))
-
-	for i := 0; i < len(vectors); i++ {
-		weights[i] = 1.0
-	}
-
-	return ComputeWeightedCentroid(vectors, weights)
+	return Euclidean.ComputeCentroid(vectors)
 }
 
+// Compute the weighted centroid of vectors for the Euclidean metric; see DistanceMetric.ComputeWeightedCentroid
+// for other metrics.
 func ComputeWeightedCentroid(vectors []Vector, weights []float32) (*Vector, error) {
+	return Euclidean.ComputeWeightedCentroid(vectors, weights)
+}
+
+func computeWeightedCentroid(vectors []Vector, weights []float32) (*Vector, error) {
 
 	if len(vectors) == 0 {
 		return nil, fmt.Errorf("Can not compute centroid of empty slice")
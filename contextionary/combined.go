@@ -9,6 +9,7 @@ type CombinedIndex struct {
 	indices       []combinedIndex
 	total_size    int
 	vector_length int
+	metric        DistanceMetric
 }
 
 type combinedIndex struct {
@@ -33,6 +34,7 @@ func CombineVectorIndices(indices []Contextionary) (*CombinedIndex, error) {
 	var offset int = 0
 
 	vector_length := indices[0].GetVectorLength()
+	metric := indices[0].GetDistanceMetric()
 
 	for i := 0; i < len(indices); i++ {
 		size := indices[i].GetNumberOfItems()
@@ -50,9 +52,14 @@ func CombineVectorIndices(indices []Contextionary) (*CombinedIndex, error) {
 		if my_length != vector_length {
 			return nil, fmt.Errorf("vector length not equal")
 		}
+
+		// The distances of the indices can only be compared if they are of the same metric
+		if indices[i].GetDistanceMetric() != metric {
+			return nil, fmt.Errorf("distance metric not equal")
+		}
 	}
 
-	return &CombinedIndex{indices: combined_indices, total_size: offset, vector_length: vector_length}, nil
+	return &CombinedIndex{indices: combined_indices, total_size: offset, vector_length: vector_length, metric: metric}, nil
 }
 
 // Verify that all the indices are disjoint
@@ -87,6 +94,10 @@ func (ci *CombinedIndex) GetVectorLength() int {
 	return ci.vector_length
 }
 
+func (ci *CombinedIndex) GetDistanceMetric() DistanceMetric {
+	return ci.metric
+}
+
 func (ci *CombinedIndex) WordToItemIndex(word string) ItemIndex {
 	for _, item := range ci.indices {
 		item_index := (*item.index).WordToItemIndex(word)
@@ -145,7 +156,7 @@ func (ci *CombinedIndex) GetDistance(a ItemIndex, b ItemIndex) (float32, error)
 		return 0.0, err
 	}
 
-	dist, err := v1.Distance(v2)
+	dist, err := ci.metric.Distance(v1, v2)
 	if err != nil {
 		return 0.0, err
 	}
@@ -15,6 +15,9 @@ type Contextionary interface {
 	// Returns the length of the used vectors.
 	GetVectorLength() int
 
+	// Returns the metric of the distances between vectors.
+	GetDistanceMetric() DistanceMetric
+
 	// Look up a word, return an index.
 	// Check for presence of the index with index.IsPresent()
 	WordToItemIndex(word string) ItemIndex
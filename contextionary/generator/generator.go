@@ -2,23 +2,28 @@ package generator
 
 import (
 	"bufio"
-	"bytes"
 	"encoding/binary"
-	"encoding/gob"
 	"encoding/json"
-	annoy "github.com/creativesoftwarefdn/weaviate/contextionary/annoyindex"
-	"github.com/syndtr/goleveldb/leveldb"
+	"io"
 	"log"
 	"os"
-	"strconv"
-	"strings"
+	"sort"
+
+	annoy "github.com/creativesoftwarefdn/weaviate/contextionary/annoyindex"
 )
 
 type Options struct {
-	VectorCSVPath string `short:"c" long:"vector-csv-path" description:"Path to the output file of Glove" required:"true"`
-	TempDBPath    string `short:"t" long:"temp-db-path" description:"Location for the temporary database" default:".tmp_import"`
+	VectorCSVPath string `short:"c" long:"vector-csv-path" description:"Path to the file with the word vectors" required:"true"`
+	Format        string `short:"f" long:"format" description:"The format of the word vectors: GloVe text, word2vec binary or fastText .vec text" choice:"glove" choice:"word2vec" choice:"fasttext" default:"glove"`
 	OutputPrefix  string `short:"p" long:"output-prefix" description:"The prefix of the names of the files" required:"true"`
 	K             int    `short:"k" description:"number of forrests to generate" default:"20"`
+	Metric        string `short:"m" long:"distance-metric" description:"The distance metric of the vectors" choice:"euclidean" choice:"cosine" choice:"dot" choice:"manhattan" default:"euclidean"`
+	StopWordsPath string `long:"stop-words" description:"Path to a file with words to leave out, one per line"`
+	CountsPath    string `long:"vocabulary-counts" description:"Path to a file with the frequency of the words, a word and its count per line, like the vocabulary of GloVe"`
+	MinFrequency  int    `long:"min-frequency" description:"Leave out the words that occur less often, according to the vocabulary counts"`
+	MaxWords      int    `short:"n" long:"max-words" description:"Keep only this many of the most frequent words"`
+	Lowercase     bool   `long:"lowercase" description:"Lower case the words"`
+	Normalize     bool   `long:"normalize" description:"Strip punctuation and symbols from the ends of the words, and leave out words without letters or digits"`
 }
 
 type WordVectorInfo struct {
@@ -29,84 +34,102 @@ type WordVectorInfo struct {
 }
 
 type JsonMetadata struct {
-	K int `json:"k"` // the number of parallel forrests.
+	K              int    `json:"k"`               // the number of parallel forrests.
+	DistanceMetric string `json:"distance_metric"` // the metric that the k-nn index is built for.
 }
 
+// Generate the word list and the k-nn index of a contextionary from a file of word vectors.
+// The file is read twice: first the words are read, to decide which are kept and in what order they are written,
+// then the vectors of the kept words are added to the k-nn index. Only the words are kept in memory.
 func Generate(options Options) {
-	db, err := leveldb.OpenFile(options.TempDBPath, nil)
-	defer db.Close()
+	if options.Metric == "" {
+		options.Metric = "euclidean"
+	}
 
+	vocabulary, err := newVocabulary(options)
 	if err != nil {
-		log.Fatalf("Could not open temporary database file %+v", err)
+		log.Fatalf("Could not read the vocabulary options: %v", err)
 	}
 
-	file, err := os.Open(options.VectorCSVPath)
-	if err != nil {
-		log.Fatal(err)
+	log.Print("Reading the words")
+	words, entries, vectorWidth := readWords(options, vocabulary)
+	if len(words) == 0 {
+		log.Fatal("No words are left to generate a contextionary from")
 	}
-	defer file.Close()
+	log.Printf("Keeping %d words of %d", len(words), len(entries))
 
-	log.Print("Processing and ordering raw trained data")
-	info := readVectorsFromFileAndInsertIntoLevelDB(db, file)
+	// The word list is sorted, so that words can be looked up by binary search; the k-nn index uses the same order.
+	order := make([]int, len(words))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool { return words[order[a]] < words[order[b]] })
 
-	info.k = options.K
-	info.metadata = JsonMetadata{options.K}
+	sortedWords := make([]string, len(words))
+	itemIndices := make([]int, len(words))
+	for item, word := range order {
+		sortedWords[item] = words[word]
+		itemIndices[word] = item
+	}
+
+	info := WordVectorInfo{
+		numberOfWords: len(words),
+		vectorWidth:   vectorWidth,
+		k:             options.K,
+		metadata:      JsonMetadata{options.K, options.Metric},
+	}
 
 	log.Print("Generating wordlist")
-	createWordList(db, info, options.OutputPrefix+".idx")
+	createWordList(sortedWords, info, options.OutputPrefix+".idx")
 
 	log.Print("Generating k-nn index")
-	createKnn(db, info, options.OutputPrefix+".knn")
-
-	db.Close()
-	os.RemoveAll(options.TempDBPath)
+	createKnn(options, entries, itemIndices, info, options.OutputPrefix+".knn")
 }
 
-// read word vectors, insert them into level db, also return the dimension of the vectors.
-func readVectorsFromFileAndInsertIntoLevelDB(db *leveldb.DB, file *os.File) WordVectorInfo {
-	var vector_length int = -1
-	var nr_words int = 0
-
-	scanner := bufio.NewScanner(file)
-
-	for scanner.Scan() {
-		nr_words += 1
-		parts := strings.Split(scanner.Text(), " ")
-
-		word := parts[0]
-		if vector_length == -1 {
-			vector_length = len(parts) - 1
-		}
+// Open the file of word vectors, reporting the progress of reading it.
+func openVectors(options Options, step string) (*os.File, vectorReader) {
+	file, err := os.Open(options.VectorCSVPath)
+	if err != nil {
+		log.Fatal(err)
+	}
 
-		if vector_length != len(parts)-1 {
-			log.Fatal("Data corruption; not all words have the same vector length")
-		}
+	reader, err := newVectorReader(options.Format, newProgressReader(file, step))
+	if err != nil {
+		log.Fatalf("Could not read %s: %v", options.VectorCSVPath, err)
+	}
 
-		// pre-allocate a vector for speed.
-		vector := make([]float32, vector_length)
+	return file, reader
+}
 
-		for i := 1; i <= vector_length; i++ {
-			float, err := strconv.ParseFloat(parts[i], 64)
+// Read the words of the word vectors, and decide which are kept.
+// Returns the kept words, for every word in the file the index of the kept word or -1, and the vector length.
+func readWords(options Options, vocabulary *vocabulary) ([]string, []int, int) {
+	file, reader := openVectors(options, "Reading the words")
+	defer file.Close()
 
-			if err != nil {
-				log.Fatal("Error parsing float")
-			}
+	words := make([]string, 0)
+	entries := make([]int, 0)
 
-			vector[i-1] = float32(float)
+	for !vocabulary.full() {
+		word, _, err := reader.next(false)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			log.Fatalf("Could not read %s: %v", options.VectorCSVPath, err)
 		}
 
-		var buf bytes.Buffer
-		if err := gob.NewEncoder(&buf).Encode(vector); err != nil {
-			log.Fatal("Could not encode vector for temp db storage")
+		if kept, ok := vocabulary.add(word); ok {
+			entries = append(entries, len(words))
+			words = append(words, kept)
+		} else {
+			entries = append(entries, -1)
 		}
-
-		db.Put([]byte(word), buf.Bytes(), nil)
 	}
 
-	return WordVectorInfo{numberOfWords: nr_words, vectorWidth: vector_length}
+	return words, entries, reader.vectorLength()
 }
 
-func createWordList(db *leveldb.DB, info WordVectorInfo, outputFileName string) {
+func createWordList(words []string, info WordVectorInfo, outputFileName string) {
 	file, err := os.Create(outputFileName)
 	if err != nil {
 		log.Fatal("Could not open wordlist output file")
@@ -152,11 +175,8 @@ func createWordList(db *leveldb.DB, info WordVectorInfo, outputFileName string)
 
 	var orig_word_offset = word_offset
 
-	// Iterate first time over all data, computing indices for all words.
-	iter := db.NewIterator(nil, nil)
-	for iter.Next() {
-		key := iter.Key()
-		word := string(key)
+	// Iterate first time over all words, computing indices for all words.
+	for _, word := range words {
 		length := len(word)
 		err = binary.Write(wbuf, binary.LittleEndian, uint64(word_offset))
 
@@ -171,14 +191,10 @@ func createWordList(db *leveldb.DB, info WordVectorInfo, outputFileName string)
 		word_offset += padding
 	}
 
-	iter.Release()
 	word_offset = orig_word_offset
 
-	// Iterate second time over all data, now inserting the words
-	iter = db.NewIterator(nil, nil)
-	for iter.Next() {
-		key := iter.Key()
-		word := string(key)
+	// Iterate second time over all words, now inserting the words
+	for _, word := range words {
 		length := len(word)
 		wbuf.Write([]byte(word))
 		wbuf.WriteByte(byte(0))
@@ -193,27 +209,45 @@ func createWordList(db *leveldb.DB, info WordVectorInfo, outputFileName string)
 		word_offset += padding
 	}
 	wbuf.Flush()
-	iter.Release()
 }
 
-func createKnn(db *leveldb.DB, info WordVectorInfo, outputFileName string) {
-	var knn annoy.AnnoyIndex = annoy.NewAnnoyIndexEuclidean(info.vectorWidth)
-	var idx int = -1
-
-	iter := db.NewIterator(nil, nil)
+// Stream the vectors of the kept words into the k-nn index, at the index of the word in the word list.
+func createKnn(options Options, entries []int, itemIndices []int, info WordVectorInfo, outputFileName string) {
+	var knn annoy.AnnoyIndex = newKnn(info.metadata.DistanceMetric, info.vectorWidth)
 
-	for iter.Next() {
-		idx += 1
+	file, reader := openVectors(options, "Adding the vectors")
+	defer file.Close()
 
-		vector := make([]float32, info.vectorWidth)
-		err := gob.NewDecoder(bytes.NewBuffer(iter.Value())).Decode(&vector)
+	for _, word := range entries {
+		_, vector, err := reader.next(word >= 0)
 		if err != nil {
-			log.Fatalf("Could not decode vector value %+v", err)
+			log.Fatalf("Could not read %s: %v", options.VectorCSVPath, err)
+		}
+
+		if word >= 0 {
+			knn.AddItem(itemIndices[word], vector)
 		}
-		knn.AddItem(idx, vector)
 	}
 
-	knn.Build(info.k) // Hardcoded for now. Must be tweaked.
+	log.Printf("Building %d trees", info.k)
+	knn.Build(info.k)
 	knn.Save(outputFileName)
 	knn.Unload()
 }
+
+// Create the Annoy index for a distance metric. This has to be the same kind of index the contextionary package
+// loads for the metric that is recorded in the metadata: there is no dot product index, so both cosine and dot
+// product distances are searched in an angular index.
+func newKnn(metric string, vectorWidth int) annoy.AnnoyIndex {
+	switch metric {
+	case "euclidean":
+		return annoy.NewAnnoyIndexEuclidean(vectorWidth)
+	case "cosine", "dot":
+		return annoy.NewAnnoyIndexAngular(vectorWidth)
+	case "manhattan":
+		return annoy.NewAnnoyIndexManhattan(vectorWidth)
+	default:
+		log.Fatalf("Unknown distance metric '%s'", metric)
+		return nil
+	}
+}
@@ -0,0 +1,165 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type wordVector struct {
+	word   string
+	vector []float32
+}
+
+var testVectors = []wordVector{
+	{"the", []float32{0.5, 1}},
+	{"Apple", []float32{1, 0}},
+	{"apple", []float32{0.9, 0}},
+	{"pie,", []float32{0, 1}},
+	{"...", []float32{0, 0}},
+}
+
+func readAll(t *testing.T, format string, input []byte) ([]wordVector, int) {
+	reader, err := newVectorReader(format, bytes.NewReader(input))
+	if err != nil {
+		t.Fatalf("Could not create a %s reader: %v", format, err)
+	}
+
+	var read []wordVector
+	for {
+		word, vector, err := reader.next(true)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("Could not read %s: %v", format, err)
+		}
+		read = append(read, wordVector{word, vector})
+	}
+
+	return read, reader.vectorLength()
+}
+
+func glove(vectors []wordVector) []byte {
+	var buf bytes.Buffer
+	for _, v := range vectors {
+		buf.WriteString(v.word)
+		for _, x := range v.vector {
+			fmt.Fprintf(&buf, " %v", x)
+		}
+		buf.WriteString("\n")
+	}
+	return buf.Bytes()
+}
+
+func fastText(vectors []wordVector) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%d %d\n", len(vectors), len(vectors[0].vector))
+	for _, v := range vectors {
+		buf.WriteString(v.word)
+		for _, x := range v.vector {
+			fmt.Fprintf(&buf, " %v", x)
+		}
+		buf.WriteString(" \n")
+	}
+	return buf.Bytes()
+}
+
+func word2Vec(vectors []wordVector) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%d %d\n", len(vectors), len(vectors[0].vector))
+	for _, v := range vectors {
+		buf.WriteString(v.word + " ")
+		binary.Write(&buf, binary.LittleEndian, v.vector)
+		buf.WriteString("\n")
+	}
+	return buf.Bytes()
+}
+
+func TestReadFormats(t *testing.T) {
+	for format, input := range map[string][]byte{
+		FormatGlove:    glove(testVectors),
+		FormatFastText: fastText(testVectors),
+		FormatWord2Vec: word2Vec(testVectors),
+	} {
+		read, vectorLength := readAll(t, format, input)
+		if vectorLength != 2 {
+			t.Errorf("expected vector length 2 for %s, got %d", format, vectorLength)
+		}
+		if !reflect.DeepEqual(read, testVectors) {
+			t.Errorf("expected %v from %s, got %v", testVectors, format, read)
+		}
+	}
+}
+
+func TestReadCorruptVectors(t *testing.T) {
+	reader, _ := newVectorReader(FormatGlove, strings.NewReader("apple 1 0\npie 1\n"))
+	reader.next(true)
+	if _, _, err := reader.next(true); err == nil {
+		t.Errorf("expected an error for vectors of different lengths")
+	}
+
+	reader, _ = newVectorReader(FormatWord2Vec, bytes.NewReader(word2Vec(testVectors)[:30]))
+	reader.next(true)
+	if _, _, err := reader.next(true); err == nil {
+		t.Errorf("expected an error for a truncated word2vec file")
+	}
+
+	if _, err := newVectorReader(FormatFastText, strings.NewReader("apple 1 0\n")); err == nil {
+		t.Errorf("expected an error for a fastText file without a header")
+	}
+}
+
+func keptWords(v *vocabulary) []string {
+	var kept []string
+	for _, vector := range testVectors {
+		if word, ok := v.add(vector.word); ok {
+			kept = append(kept, word)
+		}
+	}
+	return kept
+}
+
+func TestVocabulary(t *testing.T) {
+	dir, err := ioutil.TempDir("", "weaviate-generator-test")
+	if err != nil {
+		t.Fatalf("Could not create temporary directory, %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	ioutil.WriteFile(dir+"/stop.txt", []byte("The\n\n"), 0644)
+	ioutil.WriteFile(dir+"/vocab.txt", []byte("the 100\nApple 50\napple 20\npie, 5\n... 3\n"), 0644)
+
+	tests := []struct {
+		name     string
+		options  Options
+		expected []string
+	}{
+		{"everything", Options{}, []string{"the", "Apple", "apple", "pie,", "..."}},
+		{"lowercase keeps the first word", Options{Lowercase: true}, []string{"the", "apple", "pie,", "..."}},
+		{"normalize", Options{Normalize: true}, []string{"the", "Apple", "apple", "pie"}},
+		{"stop words", Options{Lowercase: true, StopWordsPath: dir + "/stop.txt"}, []string{"apple", "pie,", "..."}},
+		{"minimum frequency", Options{CountsPath: dir + "/vocab.txt", MinFrequency: 20}, []string{"the", "Apple", "apple"}},
+		{"top words", Options{Lowercase: true, MaxWords: 2}, []string{"the", "apple"}},
+	}
+
+	for _, test := range tests {
+		v, err := newVocabulary(test.options)
+		if err != nil {
+			t.Fatalf("%s: could not create the vocabulary: %v", test.name, err)
+		}
+
+		if kept := keptWords(v); !reflect.DeepEqual(kept, test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, kept)
+		}
+	}
+
+	if _, err := newVocabulary(Options{MinFrequency: 10}); err == nil {
+		t.Errorf("expected an error for a minimum frequency without vocabulary counts")
+	}
+}
@@ -0,0 +1,210 @@
+package generator
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// The formats of word vectors that can be read.
+const (
+	// GloVe text: a line per word, with the word and the values of its vector separated by spaces.
+	FormatGlove = "glove"
+	// fastText .vec text: like GloVe, after a header line with the number of words and the vector length.
+	FormatFastText = "fasttext"
+	// word2vec binary: a header line with the number of words and the vector length, and then per word the word,
+	// a space and the values of its vector as little endian float32s.
+	FormatWord2Vec = "word2vec"
+)
+
+// Reads the words and their vectors from a file of word vectors, in the order of the file.
+type vectorReader interface {
+	// Read the next word, and its vector if parseVector is set. Returns io.EOF after the last word.
+	next(parseVector bool) (string, []float32, error)
+	// The length of the vectors; only known after the first word for GloVe files.
+	vectorLength() int
+}
+
+func newVectorReader(format string, input io.Reader) (vectorReader, error) {
+	buffered := bufio.NewReaderSize(input, 1<<20)
+
+	switch format {
+	case "", FormatGlove:
+		return &textReader{input: buffered, vectorWidth: -1, numberOfWords: -1}, nil
+	case FormatFastText:
+		numberOfWords, vectorWidth, err := readHeader(buffered)
+		if err != nil {
+			return nil, err
+		}
+		return &textReader{input: buffered, vectorWidth: vectorWidth, numberOfWords: numberOfWords}, nil
+	case FormatWord2Vec:
+		numberOfWords, vectorWidth, err := readHeader(buffered)
+		if err != nil {
+			return nil, err
+		}
+		return &word2VecReader{input: buffered, vectorWidth: vectorWidth, numberOfWords: numberOfWords}, nil
+	default:
+		return nil, fmt.Errorf("unknown format '%s', use glove, fasttext or word2vec", format)
+	}
+}
+
+// Read the header line of word2vec and fastText files: the number of words and the length of the vectors.
+func readHeader(input *bufio.Reader) (int, int, error) {
+	line, err := input.ReadString('\n')
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not read the header: %v", err)
+	}
+
+	parts := strings.Fields(line)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("the header '%s' is not the number of words and the vector length", strings.TrimSpace(line))
+	}
+
+	numberOfWords, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("the number of words '%s' in the header is not a number", parts[0])
+	}
+
+	vectorWidth, err := strconv.Atoi(parts[1])
+	if err != nil || vectorWidth <= 0 {
+		return 0, 0, fmt.Errorf("the vector length '%s' in the header is not a positive number", parts[1])
+	}
+
+	return numberOfWords, vectorWidth, nil
+}
+
+type textReader struct {
+	input         *bufio.Reader
+	vectorWidth   int
+	numberOfWords int
+	read          int
+}
+
+func (r *textReader) vectorLength() int {
+	return r.vectorWidth
+}
+
+func (r *textReader) next(parseVector bool) (string, []float32, error) {
+	if r.numberOfWords >= 0 && r.read >= r.numberOfWords {
+		return "", nil, io.EOF
+	}
+
+	line, err := r.input.ReadString('\n')
+	if err == io.EOF && line == "" {
+		return "", nil, io.EOF
+	} else if err != nil && err != io.EOF {
+		return "", nil, err
+	}
+
+	// fastText ends every line with a space
+	parts := strings.Split(strings.TrimRight(line, " \r\n"), " ")
+	r.read++
+
+	if r.vectorWidth == -1 {
+		r.vectorWidth = len(parts) - 1
+	}
+
+	if r.vectorWidth != len(parts)-1 {
+		return "", nil, fmt.Errorf("data corruption; the vector of '%s' has length %d instead of %d", parts[0], len(parts)-1, r.vectorWidth)
+	}
+
+	if !parseVector {
+		return parts[0], nil, nil
+	}
+
+	vector := make([]float32, r.vectorWidth)
+	for i := 1; i <= r.vectorWidth; i++ {
+		float, err := strconv.ParseFloat(parts[i], 32)
+		if err != nil {
+			return "", nil, fmt.Errorf("error parsing float '%s' of '%s'", parts[i], parts[0])
+		}
+
+		vector[i-1] = float32(float)
+	}
+
+	return parts[0], vector, nil
+}
+
+type word2VecReader struct {
+	input         *bufio.Reader
+	vectorWidth   int
+	numberOfWords int
+	read          int
+	buffer        []byte
+}
+
+func (r *word2VecReader) vectorLength() int {
+	return r.vectorWidth
+}
+
+func (r *word2VecReader) next(parseVector bool) (string, []float32, error) {
+	if r.read >= r.numberOfWords {
+		return "", nil, io.EOF
+	}
+
+	word, err := r.input.ReadString(' ')
+	if err != nil {
+		return "", nil, fmt.Errorf("could not read word %d of %d: %v", r.read+1, r.numberOfWords, err)
+	}
+	// The vector of the previous word may be followed by a newline
+	word = strings.TrimLeft(word[:len(word)-1], "\n")
+	r.read++
+
+	if r.buffer == nil {
+		r.buffer = make([]byte, 4*r.vectorWidth)
+	}
+
+	if _, err := io.ReadFull(r.input, r.buffer); err != nil {
+		return "", nil, fmt.Errorf("could not read the vector of '%s': %v", word, err)
+	}
+
+	if !parseVector {
+		return word, nil, nil
+	}
+
+	vector := make([]float32, r.vectorWidth)
+	for i := range vector {
+		vector[i] = math.Float32frombits(binary.LittleEndian.Uint32(r.buffer[4*i:]))
+	}
+
+	return word, vector, nil
+}
+
+// Logs how much of a file is read, every ten percent.
+type progressReader struct {
+	file     *os.File
+	step     string
+	size     int64
+	read     int64
+	reported int64
+}
+
+func newProgressReader(file *os.File, step string) *progressReader {
+	progress := &progressReader{file: file, step: step}
+	if info, err := file.Stat(); err == nil {
+		progress.size = info.Size()
+	}
+
+	return progress
+}
+
+func (p *progressReader) Read(buffer []byte) (int, error) {
+	n, err := p.file.Read(buffer)
+	p.read += int64(n)
+
+	if p.size > 0 {
+		percentage := 100 * p.read / p.size
+		if percentage >= p.reported+10 {
+			p.reported = percentage - percentage%10
+			log.Printf("%s: %d%%", p.step, p.reported)
+		}
+	}
+
+	return n, err
+}
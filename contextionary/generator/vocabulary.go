@@ -0,0 +1,139 @@
+package generator
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Decides which words of the word vectors end up in the contextionary, and how they are written.
+// Word vectors are ordered from the most to the least frequent word, so when two words are the same after they are
+// lower cased or normalized, the first one is kept.
+type vocabulary struct {
+	lowercase    bool
+	normalize    bool
+	stopWords    map[string]bool
+	counts       map[string]int
+	minFrequency int
+	maxWords     int
+	kept         map[string]bool
+}
+
+func newVocabulary(options Options) (*vocabulary, error) {
+	v := &vocabulary{
+		lowercase:    options.Lowercase,
+		normalize:    options.Normalize,
+		stopWords:    map[string]bool{},
+		minFrequency: options.MinFrequency,
+		maxWords:     options.MaxWords,
+		kept:         map[string]bool{},
+	}
+
+	if options.StopWordsPath != "" {
+		err := readLines(options.StopWordsPath, func(line string) error {
+			if word := v.clean(strings.TrimSpace(line)); word != "" {
+				v.stopWords[word] = true
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if options.MinFrequency > 0 && options.CountsPath == "" {
+		return nil, fmt.Errorf("a minimum frequency needs the vocabulary counts")
+	}
+
+	if options.CountsPath != "" {
+		v.counts = map[string]int{}
+		err := readLines(options.CountsPath, func(line string) error {
+			parts := strings.Fields(line)
+			if len(parts) == 0 {
+				return nil
+			}
+
+			if len(parts) != 2 {
+				return fmt.Errorf("the line '%s' of the vocabulary counts is not a word and its count", line)
+			}
+
+			count, err := strconv.Atoi(parts[1])
+			if err != nil {
+				return fmt.Errorf("the count of '%s' in the vocabulary counts is not a number", parts[0])
+			}
+
+			v.counts[parts[0]] = count
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return v, nil
+}
+
+// Returns how a word of the word vectors is written to the contextionary, and whether it is kept.
+func (v *vocabulary) add(word string) (string, bool) {
+	if v.full() {
+		return "", false
+	}
+
+	if v.counts != nil && v.counts[word] < v.minFrequency {
+		return "", false
+	}
+
+	cleaned := v.clean(word)
+	if cleaned == "" || v.stopWords[cleaned] || v.kept[cleaned] {
+		return "", false
+	}
+
+	v.kept[cleaned] = true
+	return cleaned, true
+}
+
+// Whether the maximum number of words is kept.
+func (v *vocabulary) full() bool {
+	return v.maxWords > 0 && len(v.kept) >= v.maxWords
+}
+
+// Lower cases and normalizes a word, if that is asked for. Returns an empty string for words that are left out.
+func (v *vocabulary) clean(word string) string {
+	// The word list stores words as C-style strings
+	if strings.ContainsRune(word, 0) {
+		return ""
+	}
+
+	if v.normalize {
+		word = strings.TrimFunc(word, func(r rune) bool { return unicode.IsPunct(r) || unicode.IsSymbol(r) })
+		if strings.IndexFunc(word, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }) == -1 {
+			return ""
+		}
+	}
+
+	if v.lowercase {
+		word = strings.ToLower(word)
+	}
+
+	return word
+}
+
+func readLines(path string, handle func(line string) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if err := handle(scanner.Text()); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
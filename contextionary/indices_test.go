@@ -1,6 +1,8 @@
 package contextionary
 
 import (
+	"bytes"
+	"encoding/binary"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -52,7 +54,6 @@ func TestMMappedIndex(t *testing.T) {
 		// Now build an index based on this
 		var gen_opts generator.Options
 		gen_opts.VectorCSVPath = tempdir + "/glove.txt"
-		gen_opts.TempDBPath = tempdir + "/tempdb"
 		gen_opts.OutputPrefix = tempdir + "/glove"
 		gen_opts.K = 3
 		generator.Generate(gen_opts)
@@ -67,6 +68,68 @@ func TestMMappedIndex(t *testing.T) {
 	shared_tests(t, vi)
 }
 
+func TestMMappedIndexFromWord2Vec(t *testing.T) {
+	tempdir, err := ioutil.TempDir("", "weaviate-word2vec-test")
+	if err != nil {
+		t.Fatalf("Could not create temporary directory, %v", err)
+	}
+	defer os.RemoveAll(tempdir)
+
+	// A word2vec binary file of the test data, with words that are pruned
+	var dataset bytes.Buffer
+	fmt.Fprintf(&dataset, "%d 3\n", len(vectorTests)+2)
+	for _, vt := range append([]struct {
+		word string
+		vec  []float32
+	}{{"The", []float32{1, 1, 1}}, {"Apple", []float32{2, 0, 0}}}, vectorTests...) {
+		dataset.WriteString(vt.word + " ")
+		binary.Write(&dataset, binary.LittleEndian, vt.vec)
+		dataset.WriteString("\n")
+	}
+
+	ioutil.WriteFile(tempdir+"/vectors.bin", dataset.Bytes(), 0644)
+	ioutil.WriteFile(tempdir+"/stop_words.txt", []byte("the\n"), 0644)
+
+	generator.Generate(generator.Options{
+		VectorCSVPath: tempdir + "/vectors.bin",
+		Format:        "word2vec",
+		OutputPrefix:  tempdir + "/word2vec",
+		K:             3,
+		StopWordsPath: tempdir + "/stop_words.txt",
+		MaxWords:      4,
+		Lowercase:     true,
+	})
+
+	vi, err := LoadVectorFromDisk(tempdir+"/word2vec.knn", tempdir+"/word2vec.idx")
+	if err != nil {
+		t.Fatalf("Could not load vectors from disk: %v", err)
+	}
+
+	// "The" is a stop word, "apple" is the lower cased "Apple", and "company" is not in the top 4
+	if (*vi).GetNumberOfItems() != 4 {
+		t.Errorf("expected 4 words, got %d", (*vi).GetNumberOfItems())
+	}
+
+	for word, expected := range map[string][]float32{"apple": {2, 0, 0}, "pie": {0, 1, 0}, "computer": {0, 0, 1}, "fruit": {0.8, 0, 0}} {
+		idx := (*vi).WordToItemIndex(word)
+		if !idx.IsPresent() {
+			t.Errorf("expected %s to be present", word)
+			continue
+		}
+
+		vector, _ := (*vi).GetVectorForItemIndex(idx)
+		if equal, _ := vector.Equal(&Vector{expected}); !equal {
+			t.Errorf("expected the vector of %s to be %v, got %v", word, expected, vector.ToString())
+		}
+	}
+
+	for _, word := range []string{"the", "The", "Apple", "company"} {
+		if idx := (*vi).WordToItemIndex(word); idx.IsPresent() {
+			t.Errorf("expected %s not to be present", word)
+		}
+	}
+}
+
 func TestInMemoryIndex(t *testing.T) {
 	builder := InMemoryBuilder(3)
 	for i := 0; i < len(vectorTests); i++ {
@@ -198,7 +261,7 @@ func shared_tests(t *testing.T, vi *Contextionary) {
 					t.Errorf("Could not compute distance")
 				}
 
-				simple_dist, err := vt_a_vec.Distance(&vt_b_vec)
+				simple_dist, err := (*vi).GetDistanceMetric().Distance(&vt_a_vec, &vt_b_vec)
 				if err != nil {
 					panic("should be same length")
 				}
@@ -313,7 +376,7 @@ func shared_tests(t *testing.T, vi *Contextionary) {
 			panic("could not fetch pie vector")
 		}
 
-		distance_to_fruit, err := apple_pie.Distance(v_fruit)
+		distance_to_fruit, err := (*vi).GetDistanceMetric().Distance(&apple_pie, v_fruit)
 		if err != nil {
 			panic("should be same length")
 		}
@@ -321,7 +384,7 @@ func shared_tests(t *testing.T, vi *Contextionary) {
 			t.Errorf("Wrong distance for fruit, expect %v, got %v", distance_to_fruit, distances[0])
 		}
 
-		distance_to_apple, err := apple_pie.Distance(v_apple)
+		distance_to_apple, err := (*vi).GetDistanceMetric().Distance(&apple_pie, v_apple)
 		if err != nil {
 			panic("should be same length")
 		}
@@ -329,7 +392,7 @@ func shared_tests(t *testing.T, vi *Contextionary) {
 			t.Errorf("Wrong distance for apple, got %v", distances[1])
 		}
 
-		distance_to_pie, err := apple_pie.Distance(v_pie)
+		distance_to_pie, err := (*vi).GetDistanceMetric().Distance(&apple_pie, v_pie)
 		if err != nil {
 			panic("should be same size")
 		}
@@ -0,0 +1,74 @@
+package contextionary
+
+// This file contains helpers to inspect a contextionary, e.g. to find out why the centroid of a class ends up where
+// it does.
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A word near another word, and the distance between their vectors.
+type Neighbour struct {
+	Word     string
+	Distance float32
+}
+
+// Look up a word in lower case, like the words of the names and keywords in the schema are.
+// The centroids of classes and properties, like $THING[City], are looked up as they are.
+func LookupWord(c Contextionary, word string) ItemIndex {
+	if strings.HasPrefix(word, "$") {
+		return c.WordToItemIndex(word)
+	}
+
+	return c.WordToItemIndex(strings.ToLower(word))
+}
+
+// Get the n nearest neighbours of an item, nearest first, without the item itself.
+func NearestNeighbours(c Contextionary, item ItemIndex, n int) ([]Neighbour, error) {
+	items, distances, err := c.GetNnsByItem(item, n+1, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	neighbours := make([]Neighbour, 0, n)
+	for i, neighbour := range items {
+		if neighbour == item || len(neighbours) == n {
+			continue
+		}
+
+		word, err := c.ItemIndexToWord(neighbour)
+		if err != nil {
+			return nil, err
+		}
+
+		neighbours = append(neighbours, Neighbour{Word: word, Distance: distances[i]})
+	}
+
+	return neighbours, nil
+}
+
+// Compute the vector of a phrase, which is the centroid of the vectors of its words.
+// Returns an error if the phrase has no words, or if one of them is not in the contextionary.
+func ComputePhraseVector(c Contextionary, phrase string) (*Vector, error) {
+	words := strings.Fields(phrase)
+	if len(words) == 0 {
+		return nil, fmt.Errorf("the phrase '%s' has no words", phrase)
+	}
+
+	vectors := make([]Vector, 0, len(words))
+	for _, word := range words {
+		idx := LookupWord(c, word)
+		if !idx.IsPresent() {
+			return nil, fmt.Errorf("the word '%s' is not in the contextionary", word)
+		}
+
+		vector, err := c.GetVectorForItemIndex(idx)
+		if err != nil {
+			return nil, err
+		}
+		vectors = append(vectors, *vector)
+	}
+
+	return c.GetDistanceMetric().ComputeCentroid(vectors)
+}
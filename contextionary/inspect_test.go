@@ -0,0 +1,82 @@
+package contextionary
+
+import (
+	"testing"
+)
+
+func inspectContextionary(t *testing.T) Contextionary {
+	words := InMemoryBuilder(2)
+	words.AddWord("city", NewVector([]float32{0, 0}))
+	words.AddWord("town", NewVector([]float32{1, 0}))
+	words.AddWord("village", NewVector([]float32{3, 0}))
+	words.AddWord("river", NewVector([]float32{0, 4}))
+
+	centroids := InMemoryBuilder(2)
+	centroids.AddWord("$THING[City]", NewVector([]float32{0, 1}))
+
+	combined, err := CombineVectorIndices([]Contextionary{centroids.Build(3), words.Build(3)})
+	if err != nil {
+		t.Fatalf("Could not combine the indices; %v", err)
+	}
+
+	return combined
+}
+
+func TestLookupWord(t *testing.T) {
+	c := inspectContextionary(t)
+
+	for _, word := range []string{"city", "City", "$THING[City]"} {
+		idx := LookupWord(c, word)
+		if !idx.IsPresent() {
+			t.Errorf("%s should be present", word)
+		}
+	}
+
+	for _, word := range []string{"lake", "$thing[city]"} {
+		idx := LookupWord(c, word)
+		if idx.IsPresent() {
+			t.Errorf("%s should not be present", word)
+		}
+	}
+}
+
+func TestNearestNeighbours(t *testing.T) {
+	c := inspectContextionary(t)
+
+	neighbours, err := NearestNeighbours(c, LookupWord(c, "city"), 3)
+	if err != nil {
+		t.Fatalf("Could not get the neighbours; %v", err)
+	}
+
+	expected := []Neighbour{{"$THING[City]", 1}, {"town", 1}, {"village", 3}}
+	if len(neighbours) != len(expected) {
+		t.Fatalf("expected neighbours %v, got %v", expected, neighbours)
+	}
+	for i, neighbour := range neighbours {
+		if neighbour != expected[i] {
+			t.Errorf("expected neighbour %v to be %v, got %v", i, expected[i], neighbour)
+		}
+	}
+}
+
+func TestComputePhraseVector(t *testing.T) {
+	c := inspectContextionary(t)
+
+	vector, err := ComputePhraseVector(c, "Town  village")
+	if err != nil {
+		t.Fatalf("Could not compute the vector of the phrase; %v", err)
+	}
+
+	expected := NewVector([]float32{2, 0})
+	if equal, _ := vector.Equal(&expected); !equal {
+		t.Errorf("expected vector %v, got %v", expected.ToString(), vector.ToString())
+	}
+
+	if _, err := ComputePhraseVector(c, "town lake"); err == nil {
+		t.Errorf("expected an error for a word that is not in the contextionary")
+	}
+
+	if _, err := ComputePhraseVector(c, " "); err == nil {
+		t.Errorf("expected an error for a phrase without words")
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 type MemoryIndex struct {
 	dimensions int
+	metric     DistanceMetric
 	words      []string
 	knn        annoy.AnnoyIndex
 }
@@ -22,6 +23,11 @@ func (mi *MemoryIndex) GetVectorLength() int {
 	return mi.dimensions
 }
 
+// Returns the metric of the distances between vectors.
+func (mi *MemoryIndex) GetDistanceMetric() DistanceMetric {
+	return mi.metric
+}
+
 // Look up a word, return an index.
 // Perform binary search.
 func (mi *MemoryIndex) WordToItemIndex(word string) ItemIndex {
@@ -58,7 +64,11 @@ func (mi *MemoryIndex) GetVectorForItemIndex(item ItemIndex) (*Vector, error) {
 // Compute the distance between two items.
 func (mi MemoryIndex) GetDistance(a ItemIndex, b ItemIndex) (float32, error) {
 	if a >= 0 && b >= 0 && int(a) <= len(mi.words) && int(b) <= len(mi.words) {
-		return mi.knn.GetDistance(int(a), int(b)), nil
+		var vectorA, vectorB []float32
+		mi.knn.GetItem(int(a), &vectorA)
+		mi.knn.GetItem(int(b), &vectorB)
+
+		return mi.metric.distance(vectorA, vectorB), nil
 	} else {
 		return 0, fmt.Errorf("Index out of bounds")
 	}
@@ -68,16 +78,10 @@ func (mi MemoryIndex) GetDistance(a ItemIndex, b ItemIndex) (float32, error) {
 // Returns an array of indices, and of distances between item and the n-nearest neighbors.
 func (mi *MemoryIndex) GetNnsByItem(item ItemIndex, n int, k int) ([]ItemIndex, []float32, error) {
 	if item >= 0 && int(item) <= len(mi.words) {
-		var items []int
-		var distances []float32
-
-		mi.knn.GetNnsByItem(int(item), n, k, &items, &distances)
-
-		var indices []ItemIndex = make([]ItemIndex, len(items))
-		for i, x := range items {
-			indices[i] = ItemIndex(x)
-		}
+		var vector []float32
+		mi.knn.GetItem(int(item), &vector)
 
+		indices, distances := searchNeighbours(mi.knn, mi.metric, mi.metric, vector, n, k)
 		return indices, distances, nil
 	} else {
 		return nil, nil, fmt.Errorf("Index out of bounds")
@@ -88,16 +92,7 @@ func (mi *MemoryIndex) GetNnsByItem(item ItemIndex, n int, k int) ([]ItemIndex,
 // Returns an array of indices, and of distances between item and the n-nearest neighbors.
 func (mi *MemoryIndex) GetNnsByVector(vector Vector, n int, k int) ([]ItemIndex, []float32, error) {
 	if len(vector.vector) == mi.dimensions {
-		var items []int
-		var distances []float32
-
-		mi.knn.GetNnsByVector(vector.vector, n, k, &items, &distances)
-
-		var indices []ItemIndex = make([]ItemIndex, len(items))
-		for i, x := range items {
-			indices[i] = ItemIndex(x)
-		}
-
+		indices, distances := searchNeighbours(mi.knn, mi.metric, mi.metric, vector.vector, n, k)
 		return indices, distances, nil
 	} else {
 		return nil, nil, fmt.Errorf("Wrong vector length provided")
@@ -109,6 +104,7 @@ func (mi *MemoryIndex) GetNnsByVector(vector Vector, n int, k int) ([]ItemIndex,
 
 type MemoryIndexBuilder struct {
 	dimensions   int
+	metric       DistanceMetric
 	word_vectors mib_pairs
 }
 
@@ -124,10 +120,16 @@ func (a mib_pairs) Len() int           { return len(a) }
 func (a mib_pairs) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 func (a mib_pairs) Less(i, j int) bool { return a[i].word < a[j].word }
 
-// Construct a new builder.
+// Construct a new builder, for an index with Euclidean distances.
 func InMemoryBuilder(dimensions int) *MemoryIndexBuilder {
+	return InMemoryBuilderWithDistanceMetric(dimensions, Euclidean)
+}
+
+// Construct a new builder, for an index with the distances of a metric.
+func InMemoryBuilderWithDistanceMetric(dimensions int, metric DistanceMetric) *MemoryIndexBuilder {
 	mib := MemoryIndexBuilder{
 		dimensions:   dimensions,
+		metric:       metric,
 		word_vectors: make([]mib_pair, 0),
 	}
 
@@ -144,8 +146,9 @@ func (mib *MemoryIndexBuilder) AddWord(word string, vector Vector) {
 func (mib *MemoryIndexBuilder) Build(trees int) *MemoryIndex {
 	mi := MemoryIndex{
 		dimensions: mib.dimensions,
+		metric:     mib.metric,
 		words:      make([]string, 0),
-		knn:        annoy.NewAnnoyIndexEuclidean(mib.dimensions),
+		knn:        mib.metric.newAnnoyIndex(mib.dimensions),
 	}
 
 	// First sort the words; this way we can do binary search on the words.
@@ -0,0 +1,179 @@
+package contextionary
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	annoy "github.com/creativesoftwarefdn/weaviate/contextionary/annoyindex"
+)
+
+// A distance metric defines how far apart two vectors are, and therefore which words are the nearest neighbours of
+// a word or a centroid.
+type DistanceMetric string
+
+const (
+	// The length of the difference between the vectors. This is the default.
+	Euclidean DistanceMetric = "euclidean"
+	// One minus the cosine of the angle between the vectors, from 0 to 2, which ignores their lengths.
+	// This is how GloVe and fastText embeddings are usually compared.
+	Cosine DistanceMetric = "cosine"
+	// The negated dot product of the vectors, so that vectors with a larger dot product are nearer.
+	DotProduct DistanceMetric = "dot"
+	// The sum of the absolute differences between the values of the vectors.
+	Manhattan DistanceMetric = "manhattan"
+)
+
+// How many more candidates are searched for in an Annoy index, when the metric ranks neighbours differently than
+// the index does.
+const rankingCandidates = 4
+
+// Parse the name of a distance metric. An empty name is the default, Euclidean.
+func ParseDistanceMetric(name string) (DistanceMetric, error) {
+	switch metric := DistanceMetric(strings.ToLower(name)); metric {
+	case "":
+		return Euclidean, nil
+	case Euclidean, Cosine, DotProduct, Manhattan:
+		return metric, nil
+	default:
+		return "", fmt.Errorf("Unknown distance metric '%s', use euclidean, cosine, dot or manhattan", name)
+	}
+}
+
+// Compute the distance between two vectors.
+func (m DistanceMetric) Distance(a *Vector, b *Vector) (float32, error) {
+	if len(a.vector) != len(b.vector) {
+		return 0.0, fmt.Errorf("Vectors have different dimensions")
+	}
+
+	return m.distance(a.vector, b.vector), nil
+}
+
+func (m DistanceMetric) distance(a []float32, b []float32) float32 {
+	switch m {
+	case Cosine:
+		var dot, lengthA, lengthB float64
+		for i := range a {
+			dot += float64(a[i]) * float64(b[i])
+			lengthA += float64(a[i]) * float64(a[i])
+			lengthB += float64(b[i]) * float64(b[i])
+		}
+
+		// A vector without a direction is as far from every other vector as a perpendicular one.
+		if lengthA == 0 || lengthB == 0 {
+			return 1.0
+		}
+		return float32(1 - dot/math.Sqrt(lengthA*lengthB))
+	case DotProduct:
+		var dot float32
+		for i := range a {
+			dot += a[i] * b[i]
+		}
+		return -dot
+	case Manhattan:
+		var sum float32
+		for i := range a {
+			sum += float32(math.Abs(float64(a[i] - b[i])))
+		}
+		return sum
+	default:
+		var sum float32
+		for i := range a {
+			x := a[i] - b[i]
+			sum += x * x
+		}
+		return float32(math.Sqrt(float64(sum)))
+	}
+}
+
+// Compute the centroid of vectors, in which every vector weighs the same.
+func (m DistanceMetric) ComputeCentroid(vectors []Vector) (*Vector, error) {
+	var weights []float32 = make([]float32, len(vectors))
+
+	for i := 0; i < len(vectors); i++ {
+		weights[i] = 1.0
+	}
+
+	return m.ComputeWeightedCentroid(vectors, weights)
+}
+
+// Compute the weighted centroid of vectors.
+// For the cosine metric only the directions of the vectors count, so they are scaled to the same length first;
+// otherwise a long vector would pull the centroid towards itself.
+func (m DistanceMetric) ComputeWeightedCentroid(vectors []Vector, weights []float32) (*Vector, error) {
+	if m != Cosine {
+		return computeWeightedCentroid(vectors, weights)
+	}
+
+	normalized := make([]Vector, len(vectors))
+	for i, v := range vectors {
+		normalized[i] = v.normalize()
+	}
+
+	return computeWeightedCentroid(normalized, weights)
+}
+
+// The metric of the Annoy index that finds the nearest neighbours for the metric.
+// Annoy has no dot product index; the angular index finds the candidates that are then ranked by their dot product.
+func (m DistanceMetric) annoyMetric() DistanceMetric {
+	switch m {
+	case Cosine, DotProduct:
+		return Cosine
+	case Manhattan:
+		return Manhattan
+	default:
+		return Euclidean
+	}
+}
+
+// Create an empty Annoy index for the metric.
+func (m DistanceMetric) newAnnoyIndex(dimensions int) annoy.AnnoyIndex {
+	switch m.annoyMetric() {
+	case Cosine:
+		return annoy.NewAnnoyIndexAngular(dimensions)
+	case Manhattan:
+		return annoy.NewAnnoyIndexManhattan(dimensions)
+	default:
+		return annoy.NewAnnoyIndexEuclidean(dimensions)
+	}
+}
+
+// Search an Annoy index, that is built for the index metric, for the n nearest neighbours of a vector by the metric.
+// The candidates of the search are ranked by their distance by the metric, so that the distances are exact even if
+// the index orders them differently.
+func searchNeighbours(knn annoy.AnnoyIndex, indexMetric DistanceMetric, metric DistanceMetric, vector []float32, n int, k int) ([]ItemIndex, []float32) {
+	candidates := n
+	if metric == DotProduct || metric.annoyMetric() != indexMetric.annoyMetric() {
+		candidates = n * rankingCandidates
+	}
+
+	var items []int
+	var distances []float32
+	knn.GetNnsByVector(vector, candidates, k, &items, &distances)
+
+	for i, item := range items {
+		var floats []float32
+		knn.GetItem(item, &floats)
+		distances[i] = metric.distance(vector, floats)
+	}
+
+	order := make([]int, len(items))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return distances[order[i]] < distances[order[j]] })
+
+	if len(order) > n {
+		order = order[:n]
+	}
+
+	indices := make([]ItemIndex, len(order))
+	nearest := make([]float32, len(order))
+	for i, o := range order {
+		indices[i] = ItemIndex(items[o])
+		nearest[i] = distances[o]
+	}
+
+	return indices, nearest
+}
@@ -0,0 +1,176 @@
+package contextionary
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/creativesoftwarefdn/weaviate/contextionary/generator"
+)
+
+func TestParseDistanceMetric(t *testing.T) {
+	for name, expected := range map[string]DistanceMetric{"": Euclidean, "euclidean": Euclidean, "Cosine": Cosine, "dot": DotProduct, "manhattan": Manhattan} {
+		metric, err := ParseDistanceMetric(name)
+		if err != nil || metric != expected {
+			t.Errorf("expected '%s' to be %v, got %v (%v)", name, expected, metric, err)
+		}
+	}
+
+	if _, err := ParseDistanceMetric("hamming"); err == nil {
+		t.Errorf("expected an error for an unknown metric")
+	}
+}
+
+func TestDistanceMetrics(t *testing.T) {
+	a := NewVector([]float32{1, 0})
+	b := NewVector([]float32{3, 4})
+
+	for metric, expected := range map[DistanceMetric]float32{Euclidean: 4.472136, Cosine: 0.4, DotProduct: -3, Manhattan: 6} {
+		distance, err := metric.Distance(&a, &b)
+		if err != nil {
+			t.Errorf("Could not compute the %v distance; %v", metric, err)
+		}
+
+		if !equal_float_epsilon(distance, expected, 0.00001) {
+			t.Errorf("expected the %v distance to be %v, got %v", metric, expected, distance)
+		}
+	}
+
+	other := NewVector([]float32{1, 0, 0})
+	if _, err := Cosine.Distance(&a, &other); err == nil {
+		t.Errorf("expected an error for vectors of different dimensions")
+	}
+}
+
+func TestCosineCentroid(t *testing.T) {
+	vectors := []Vector{NewVector([]float32{10, 0}), NewVector([]float32{0, 1})}
+
+	euclidean, _ := Euclidean.ComputeCentroid(vectors)
+	expected := NewVector([]float32{5, 0.5})
+	if equal, _ := euclidean.Equal(&expected); !equal {
+		t.Errorf("expected the euclidean centroid to be %v, got %v", expected.ToString(), euclidean.ToString())
+	}
+
+	// The directions count, not the lengths
+	cosine, _ := Cosine.ComputeCentroid(vectors)
+	expected = NewVector([]float32{0.5, 0.5})
+	if equal, _ := cosine.Equal(&expected); !equal {
+		t.Errorf("expected the cosine centroid to be %v, got %v", expected.ToString(), cosine.ToString())
+	}
+}
+
+func TestNeighboursByMetric(t *testing.T) {
+	// "near" points the same way as "query" but is short, "far" points almost the same way but is long
+	words := []struct {
+		word string
+		vec  []float32
+	}{
+		{"query", []float32{1, 0}},
+		{"near", []float32{0.1, 0}},
+		{"far", []float32{10, 1}},
+		{"side", []float32{0, 1}},
+	}
+
+	expected := map[DistanceMetric][]string{
+		Euclidean:  {"near", "side", "far"},
+		Manhattan:  {"near", "side", "far"},
+		Cosine:     {"near", "far", "side"},
+		DotProduct: {"far", "near", "side"},
+	}
+
+	for metric, nearest := range expected {
+		builder := InMemoryBuilderWithDistanceMetric(2, metric)
+		for _, w := range words {
+			builder.AddWord(w.word, NewVector(w.vec))
+		}
+		index := builder.Build(3)
+
+		if index.GetDistanceMetric() != metric {
+			t.Errorf("expected the index to have metric %v, got %v", metric, index.GetDistanceMetric())
+		}
+
+		items, distances, err := index.GetNnsByItem(index.WordToItemIndex("query"), 4, -1)
+		if err != nil {
+			t.Fatalf("Could not get the neighbours by %v; %v", metric, err)
+		}
+
+		var found []string
+		for i, item := range items {
+			word, _ := index.ItemIndexToWord(item)
+			if word == "query" {
+				continue
+			}
+			found = append(found, word)
+
+			distance, _ := index.GetDistance(index.WordToItemIndex("query"), item)
+			if !equal_float_epsilon(distance, distances[i], 0.00001) {
+				t.Errorf("expected the %v distance to %s to be %v, got %v", metric, word, distance, distances[i])
+			}
+		}
+
+		if fmt.Sprint(found) != fmt.Sprint(nearest) {
+			t.Errorf("expected the neighbours by %v to be %v, got %v", metric, nearest, found)
+		}
+	}
+}
+
+func TestMMappedIndexMetric(t *testing.T) {
+	tempdir, err := ioutil.TempDir("", "weaviate-metric-test")
+	if err != nil {
+		t.Fatalf("Could not create temporary directory, %v", err)
+	}
+	defer os.RemoveAll(tempdir)
+
+	err = ioutil.WriteFile(tempdir+"/glove.txt", []byte("apple 1 0\nfruit 10 1\npie 0 1\n"), 0644)
+	if err != nil {
+		t.Fatalf("Could not create input file: %v", err)
+	}
+
+	generator.Generate(generator.Options{
+		VectorCSVPath: tempdir + "/glove.txt",
+		OutputPrefix:  tempdir + "/glove",
+		K:             3,
+		Metric:        "cosine",
+	})
+
+	// The metric that is recorded in the word list is used by default
+	c, err := LoadVectorFromDisk(tempdir+"/glove.knn", tempdir+"/glove.idx")
+	if err != nil {
+		t.Fatalf("Could not load vectors from disk: %v", err)
+	}
+	if (*c).GetDistanceMetric() != Cosine {
+		t.Errorf("expected the recorded metric cosine, got %v", (*c).GetDistanceMetric())
+	}
+
+	apple := (*c).WordToItemIndex("apple")
+	items, _, _ := (*c).GetNnsByItem(apple, 2, -1)
+	if len(items) != 2 {
+		t.Fatalf("expected 2 neighbours, got %v", items)
+	}
+	if word, _ := (*c).ItemIndexToWord(items[1]); word != "fruit" {
+		t.Errorf("expected fruit to be nearest to apple by cosine, got %s", word)
+	}
+
+	// Another metric ranks the candidates of the same index
+	c, err = LoadVectorFromDiskWithDistanceMetric(tempdir+"/glove.knn", tempdir+"/glove.idx", Euclidean)
+	if err != nil {
+		t.Fatalf("Could not load vectors from disk: %v", err)
+	}
+	items, _, _ = (*c).GetNnsByItem(apple, 2, -1)
+	if word, _ := (*c).ItemIndexToWord(items[1]); word != "pie" {
+		t.Errorf("expected pie to be nearest to apple by euclidean distance, got %s", word)
+	}
+}
+
+func TestCombineDifferentMetrics(t *testing.T) {
+	euclidean := InMemoryBuilder(2)
+	euclidean.AddWord("a", NewVector([]float32{1, 0}))
+	cosine := InMemoryBuilderWithDistanceMetric(2, Cosine)
+	cosine.AddWord("b", NewVector([]float32{0, 1}))
+
+	_, err := CombineVectorIndices([]Contextionary{euclidean.Build(3), cosine.Build(3)})
+	if err == nil {
+		t.Errorf("expected an error for indices with different metrics")
+	}
+}
@@ -8,6 +8,10 @@ import (
 type mmappedIndex struct {
 	word_index *Wordlist
 	knn        annoy.AnnoyIndex
+
+	// The metric that the Annoy index is built for, and the metric of the distances, which may differ.
+	index_metric DistanceMetric
+	metric       DistanceMetric
 }
 
 func (m *mmappedIndex) GetNumberOfItems() int {
@@ -19,6 +23,11 @@ func (m *mmappedIndex) GetVectorLength() int {
 	return int(m.word_index.vectorWidth)
 }
 
+// Returns the metric of the distances between vectors.
+func (m *mmappedIndex) GetDistanceMetric() DistanceMetric {
+	return m.metric
+}
+
 func (m *mmappedIndex) WordToItemIndex(word string) ItemIndex {
 	return m.word_index.FindIndexByWord(word)
 }
@@ -45,7 +54,11 @@ func (m *mmappedIndex) GetVectorForItemIndex(item ItemIndex) (*Vector, error) {
 // Compute the distance between two items.
 func (m *mmappedIndex) GetDistance(a ItemIndex, b ItemIndex) (float32, error) {
 	if a >= 0 && b >= 0 && a <= m.word_index.GetNumberOfWords() && b <= m.word_index.GetNumberOfWords() {
-		return m.knn.GetDistance(int(a), int(b)), nil
+		var vectorA, vectorB []float32
+		m.knn.GetItem(int(a), &vectorA)
+		m.knn.GetItem(int(b), &vectorB)
+
+		return m.metric.distance(vectorA, vectorB), nil
 	} else {
 		return 0, fmt.Errorf("Index out of bounds")
 	}
@@ -53,16 +66,10 @@ func (m *mmappedIndex) GetDistance(a ItemIndex, b ItemIndex) (float32, error) {
 
 func (m *mmappedIndex) GetNnsByItem(item ItemIndex, n int, k int) ([]ItemIndex, []float32, error) {
 	if item >= 0 && item <= m.word_index.GetNumberOfWords() {
-		var items []int
-		var distances []float32
-
-		m.knn.GetNnsByItem(int(item), n, k, &items, &distances)
-
-		var indices []ItemIndex = make([]ItemIndex, len(items))
-		for i, x := range items {
-			indices[i] = ItemIndex(x)
-		}
+		var vector []float32
+		m.knn.GetItem(int(item), &vector)
 
+		indices, distances := searchNeighbours(m.knn, m.index_metric, m.metric, vector, n, k)
 		return indices, distances, nil
 	} else {
 		return nil, nil, fmt.Errorf("Index out of bounds")
@@ -71,35 +78,45 @@ func (m *mmappedIndex) GetNnsByItem(item ItemIndex, n int, k int) ([]ItemIndex,
 
 func (m *mmappedIndex) GetNnsByVector(vector Vector, n int, k int) ([]ItemIndex, []float32, error) {
 	if len(vector.vector) == m.GetVectorLength() {
-		var items []int
-		var distances []float32
-
-		m.knn.GetNnsByVector(vector.vector, n, k, &items, &distances)
-
-		var indices []ItemIndex = make([]ItemIndex, len(items))
-		for i, x := range items {
-			indices[i] = ItemIndex(x)
-		}
-
+		indices, distances := searchNeighbours(m.knn, m.index_metric, m.metric, vector.vector, n, k)
 		return indices, distances, nil
 	} else {
 		return nil, nil, fmt.Errorf("Wrong vector length provided")
 	}
 }
 
+// Load a contextionary from disk, with the distance metric that its index is built for.
 func LoadVectorFromDisk(annoy_index string, word_index_file_name string) (*Contextionary, error) {
+	return LoadVectorFromDiskWithDistanceMetric(annoy_index, word_index_file_name, "")
+}
+
+// Load a contextionary from disk, with the distances of a metric. The neighbours are searched in the index that is
+// built for the metric that is recorded in the word list, and ranked by the given metric. Without a metric, the
+// recorded metric is used.
+func LoadVectorFromDiskWithDistanceMetric(annoy_index string, word_index_file_name string, metric DistanceMetric) (*Contextionary, error) {
 	word_index, err := LoadWordlist(word_index_file_name)
 
 	if err != nil {
 		return nil, fmt.Errorf("Could not load vector: %+v", err)
 	}
 
-	knn := annoy.NewAnnoyIndexEuclidean(int(word_index.vectorWidth))
+	index_metric, err := word_index.distanceMetric()
+	if err != nil {
+		return nil, fmt.Errorf("Could not load vector: %+v", err)
+	}
+
+	if metric == "" {
+		metric = index_metric
+	}
+
+	knn := index_metric.newAnnoyIndex(int(word_index.vectorWidth))
 	knn.Load(annoy_index)
 
 	var idx *mmappedIndex = new(mmappedIndex)
 	idx.word_index = word_index
 	idx.knn = knn
+	idx.index_metric = index_metric
+	idx.metric = metric
 
 	var blah Contextionary = Contextionary(idx)
 	return &blah, nil
@@ -66,17 +66,35 @@ func (v *Vector) ToString() string {
 	return str
 }
 
-func (v *Vector) Distance(other *Vector) (float32, error) {
-	var sum float32
+// Compute the Euclidean distance to another vector. The distances of an index are in the metric of the index, so use
+// its DistanceMetric to compare vectors with the distances of an index.
+func (v *Vector) EuclideanDistance(other *Vector) (float32, error) {
+	return Euclidean.Distance(v, other)
+}
 
-	if len(v.vector) != len(other.vector) {
-		return 0.0, fmt.Errorf("Vectors have different dimensions")
+// Scale the vector to a length of one. A vector of length zero is returned as it is.
+func (v *Vector) normalize() Vector {
+	var sum float64
+	for _, x := range v.vector {
+		sum += float64(x) * float64(x)
+	}
+
+	if sum == 0 {
+		return *v
 	}
 
-	for i := 0; i < len(v.vector); i++ {
-		x := v.vector[i] - other.vector[i]
-		sum += x * x
+	length := float32(math.Sqrt(sum))
+	normalized := make([]float32, len(v.vector))
+	for i, x := range v.vector {
+		normalized[i] = x / length
 	}
 
-	return float32(math.Sqrt(float64(sum))), nil
+	return NewVector(normalized)
+}
+
+// Returns a copy of the values of the vector.
+func (v *Vector) ToArray() []float32 {
+	values := make([]float32, len(v.vector))
+	copy(values, v.vector)
+	return values
 }
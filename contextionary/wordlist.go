@@ -69,6 +69,13 @@ func (w *Wordlist) GetNumberOfWords() ItemIndex {
 	return ItemIndex(w.numberOfWords)
 }
 
+// The distance metric that the index of the word list is built for. Word lists that don't record it are built for
+// Euclidean distances.
+func (w *Wordlist) distanceMetric() (DistanceMetric, error) {
+	name, _ := w.metadata["distance_metric"].(string)
+	return ParseDistanceMetric(name)
+}
+
 func (w *Wordlist) FindIndexByWord(_needle string) ItemIndex {
 	var needle = string([]byte(_needle))
 	needle += "\x00"
@@ -76,11 +83,15 @@ func (w *Wordlist) FindIndexByWord(_needle string) ItemIndex {
 	var bytes_needle = []byte(needle)
 
 	var low ItemIndex = 0
-	var high ItemIndex = ItemIndex(w.numberOfWords)
+	var high ItemIndex = ItemIndex(w.numberOfWords) - 1
 
 	for low <= high {
 		var midpoint ItemIndex = (low + high) / 2
-		word_ptr := w.getWordPtr(midpoint)[0:len(bytes_needle)]
+		word_ptr := w.getWordPtr(midpoint)
+		// The last word may end closer to the end of the file than the needle is long
+		if len(word_ptr) > len(bytes_needle) {
+			word_ptr = word_ptr[0:len(bytes_needle)]
+		}
 
 		var cmp = bytes.Compare(bytes_needle, word_ptr)
 
@@ -28,7 +28,7 @@ var dbConnector dbconnector.DatabaseConnector
 // 2) the (dynamic) database schema from Weaviate
 
 func (g *GraphQL) buildGraphqlSchema() error {
-	rootFieldsObject, err := assembleFullSchema(g)
+	rootFieldsObject, classObjects, err := assembleFullSchema(g)
 
 	if err != nil {
 		return fmt.Errorf("could not build GraphQL schema, because: %v", err)
@@ -57,6 +57,8 @@ func (g *GraphQL) buildGraphqlSchema() error {
 
 		g.weaviateGraphQLSchema, err = graphql.NewSchema(graphql.SchemaConfig{
 			Query: graphql.NewObject(schemaObject),
+			// A class that other classes extend is only reachable through its interface, so all classes are listed
+			Types: classObjects,
 		})
 	}()
 
@@ -66,36 +68,38 @@ func (g *GraphQL) buildGraphqlSchema() error {
 	return nil
 }
 
-func assembleFullSchema(g *GraphQL) (graphql.Fields, error) {
+func assembleFullSchema(g *GraphQL) (graphql.Fields, []graphql.Type, error) {
 	// This map is used to store all the Thing and Action Objects, so that we can use them in references.
 	getActionsAndThings := make(map[string]*graphql.Object)
 	// this map is used to store all the Filter InputObjects, so that we can use them in references.
 	filterOptions := make(map[string]*graphql.InputObject)
+	// The interfaces and reference unions that classes share with the classes that extend them
+	hierarchy := newClassHierarchy(g.databaseSchema)
 
-	localGetActions, err := genActionClassFieldsFromSchema(g, &getActionsAndThings)
+	localGetActions, err := genActionClassFieldsFromSchema(g, hierarchy, &getActionsAndThings)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate action fields from schema for local Get because: %v", err)
+		return nil, nil, fmt.Errorf("failed to generate action fields from schema for local Get because: %v", err)
 	}
 
-	localGetThings, err := genThingClassFieldsFromSchema(g, &getActionsAndThings)
+	localGetThings, err := genThingClassFieldsFromSchema(g, hierarchy, &getActionsAndThings)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate thing fields from schema for local Get because: %v", err)
+		return nil, nil, fmt.Errorf("failed to generate thing fields from schema for local Get because: %v", err)
 	}
 
 	classParentTypeIsAction := true
 	localGetMetaActions, err := genMetaClassFieldsFromSchema(g.databaseSchema.ActionSchema.Schema.Classes, classParentTypeIsAction)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate action fields from schema for local MetaGet because: %v", err)
+		return nil, nil, fmt.Errorf("failed to generate action fields from schema for local MetaGet because: %v", err)
 	}
 
 	classParentTypeIsAction = false
 	localGetMetaThings, err := genMetaClassFieldsFromSchema(g.databaseSchema.ThingSchema.Schema.Classes, classParentTypeIsAction)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate thing fields from schema for local MetaGet because: %v", err)
+		return nil, nil, fmt.Errorf("failed to generate thing fields from schema for local MetaGet because: %v", err)
 	}
 
 	localGetObject := genThingsAndActionsFieldsForWeaviateLocalGetObj(localGetActions, localGetThings)
@@ -118,7 +122,12 @@ func assembleFullSchema(g *GraphQL) (graphql.Fields, error) {
 		"Network": nil,
 	}
 
-	return rootFields, nil
+	classObjects := []graphql.Type{}
+	for _, classObject := range getActionsAndThings {
+		classObjects = append(classObjects, classObject)
+	}
+
+	return rootFields, classObjects, nil
 }
 
 // generate the static parts of the schema
@@ -209,6 +218,11 @@ func genGetAndGetMetaFields(localGetObject *graphql.Object, localGetMetaObject *
 				},
 			},
 			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				p, err := resolveWhere(p)
+				if err != nil {
+					return nil, err
+				}
+
 				result, err := dbConnector.GetGraph(p)
 				return result, err
 			},
@@ -231,6 +245,11 @@ func genGetAndGetMetaFields(localGetObject *graphql.Object, localGetMetaObject *
 				},
 			},
 			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				p, err := resolveWhere(p)
+				if err != nil {
+					return nil, err
+				}
+
 				result, err := dbConnector.GetGraph(p)
 				return result, err
 			},
@@ -0,0 +1,145 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+// Package graphqlapi provides the graphql endpoint for Weaviate
+package graphqlapi
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/creativesoftwarefdn/weaviate/schema"
+	"github.com/graphql-go/graphql"
+)
+
+// classHierarchy holds the interfaces of the classes that other classes extend, and the unions of the references of
+// the classes. A class and its subclasses share them, as a graphql schema can contain a named type only once.
+type classHierarchy struct {
+	databaseSchema *schema.WeaviateSchema
+	interfaces     map[string]*graphql.Interface
+	unions         map[string]*graphql.Union
+}
+
+func newClassHierarchy(databaseSchema *schema.WeaviateSchema) *classHierarchy {
+	return &classHierarchy{
+		databaseSchema: databaseSchema,
+		interfaces:     map[string]*graphql.Interface{},
+		unions:         map[string]*graphql.Union{},
+	}
+}
+
+// The subclasses of a thing or action class.
+func (h *classHierarchy) subclasses(className string) []*models.SemanticSchemaClass {
+	subclasses := schema.GetSubclasses(h.databaseSchema.ThingSchema.Schema, className)
+	return append(subclasses, schema.GetSubclasses(h.databaseSchema.ActionSchema.Schema, className)...)
+}
+
+// The parent class of a thing or action class, or nil when it extends no class.
+func (h *classHierarchy) parent(class *models.SemanticSchemaClass) *models.SemanticSchemaClass {
+	if class.Extends == "" {
+		return nil
+	}
+
+	for _, semanticSchema := range []*models.SemanticSchema{h.databaseSchema.ThingSchema.Schema, h.databaseSchema.ActionSchema.Schema} {
+		if parent, err := schema.GetClassByName(semanticSchema, class.Extends); err == nil {
+			return parent
+		}
+	}
+	return nil
+}
+
+// genParentInterface generates the interface of a class that other classes extend, with the fields of the class. The
+// class, and the classes that extend it, implement the interface, so a Get of the class returns the instances of its
+// subclasses as well. Classes that no class extends have no interface.
+func (h *classHierarchy) genParentInterface(class *models.SemanticSchemaClass, fields graphql.FieldsThunk) *graphql.Interface {
+	if len(h.subclasses(class.Class)) == 0 {
+		return nil
+	}
+
+	parentInterface := graphql.NewInterface(graphql.InterfaceConfig{
+		Name:   fmt.Sprintf("%s%s", class.Class, "Interface"),
+		Fields: fields,
+		ResolveType: func(p graphql.ResolveTypeParams) *graphql.Object {
+			return nil
+		},
+		Description: class.Description,
+	})
+
+	h.interfaces[class.Class] = parentInterface
+	return parentInterface
+}
+
+// The interfaces that a class implements: the interfaces of the class itself and of its parent classes.
+func (h *classHierarchy) interfacesOf(class *models.SemanticSchemaClass) graphql.InterfacesThunk {
+	return func() []*graphql.Interface {
+		interfaces := []*graphql.Interface{}
+		for seen := map[string]bool{}; class != nil && !seen[class.Class]; class = h.parent(class) {
+			seen[class.Class] = true
+			if parentInterface, ok := h.interfaces[class.Class]; ok {
+				interfaces = append(interfaces, parentInterface)
+			}
+		}
+		return interfaces
+	}
+}
+
+// genRefUnion generates the union of the classes that a reference property can refer to, which are the classes in
+// its data type and their subclasses. A property that a class inherits has the union of the class that declares it.
+func (h *classHierarchy) genRefUnion(class *models.SemanticSchemaClass, property *models.SemanticSchemaClassProperty, getActionsAndThings *map[string]*graphql.Object) (*graphql.Union, error) {
+	declaringClass := class
+	for parent := h.parent(class); parent != nil; parent = h.parent(parent) {
+		if _, err := schema.GetPropertyByName(parent, property.Name); err != nil {
+			break
+		}
+		declaringClass = parent
+	}
+
+	name := fmt.Sprintf("%s%s%s", declaringClass.Class, strings.Title(property.Name), "Obj")
+	if union, ok := h.unions[name]; ok {
+		return union, nil
+	}
+
+	dataTypeClasses := []*graphql.Object{}
+	added := map[string]bool{}
+	for _, dataType := range property.AtDataType {
+		classNames := []string{dataType}
+		for _, subclass := range h.subclasses(dataType) {
+			classNames = append(classNames, subclass.Class)
+		}
+
+		for _, className := range classNames {
+			thingOrActionType, ok := (*getActionsAndThings)[className]
+			if !ok {
+				return nil, fmt.Errorf("no such thing/action class '%s'", className)
+			}
+			if !added[className] {
+				dataTypeClasses = append(dataTypeClasses, thingOrActionType)
+				added[className] = true
+			}
+		}
+	}
+
+	dataTypeUnionConf := graphql.UnionConfig{
+		Name:  name,
+		Types: dataTypeClasses,
+		ResolveType: func(p graphql.ResolveTypeParams) *graphql.Object {
+			return nil
+		},
+		Description: property.Description,
+	}
+
+	union := graphql.NewUnion(dataTypeUnionConf)
+	h.unions[name] = union
+	return union, nil
+}
@@ -24,11 +24,11 @@ import (
 )
 
 // Build the dynamically generated Get Actions part of the schema
-func genActionClassFieldsFromSchema(g *GraphQL, getActionsAndThings *map[string]*graphql.Object) (*graphql.Object, error) {
+func genActionClassFieldsFromSchema(g *GraphQL, hierarchy *classHierarchy, getActionsAndThings *map[string]*graphql.Object) (*graphql.Object, error) {
 	actionClassFields := graphql.Fields{}
 
 	for _, class := range g.databaseSchema.ActionSchema.Schema.Classes {
-		singleActionClassField, singleActionClassObject := genSingleActionClassField(class, getActionsAndThings)
+		singleActionClassField, singleActionClassObject := genSingleActionClassField(class, hierarchy, getActionsAndThings)
 		actionClassFields[class.Class] = singleActionClassField
 		// this line assigns the created class to a Hashmap which is used in thunks to handle cyclical relationships (Classes with other Classes as properties)
 		(*getActionsAndThings)[class.Class] = singleActionClassObject
@@ -43,25 +43,34 @@ func genActionClassFieldsFromSchema(g *GraphQL, getActionsAndThings *map[string]
 	return graphql.NewObject(localGetActions), nil
 }
 
-func genSingleActionClassField(class *models.SemanticSchemaClass, getActionsAndThings *map[string]*graphql.Object) (*graphql.Field, *graphql.Object) {
-	singleActionClassPropertyFields := graphql.ObjectConfig{
-		Name: class.Class,
-		Fields: (graphql.FieldsThunk)(func() graphql.Fields {
-			singleActionClassPropertyFields, err := genSingleActionClassPropertyFields(class, getActionsAndThings)
+func genSingleActionClassField(class *models.SemanticSchemaClass, hierarchy *classHierarchy, getActionsAndThings *map[string]*graphql.Object) (*graphql.Field, *graphql.Object) {
+	fields := (graphql.FieldsThunk)(func() graphql.Fields {
+		singleActionClassPropertyFields, err := genSingleActionClassPropertyFields(class, hierarchy, getActionsAndThings)
 
-			if err != nil {
-				panic("Failed to generate single Action Class property fields")
-			}
+		if err != nil {
+			panic("Failed to generate single Action Class property fields")
+		}
+
+		return singleActionClassPropertyFields
+	})
 
-			return singleActionClassPropertyFields
-		}),
+	singleActionClassPropertyFields := graphql.ObjectConfig{
+		Name:        class.Class,
+		Fields:      fields,
+		Interfaces:  hierarchy.interfacesOf(class),
 		Description: class.Description,
 	}
 
 	singleActionClassPropertyFieldsObj := graphql.NewObject(singleActionClassPropertyFields)
 
+	// A class that other classes extend returns the instances of its subclasses as well, through its interface
+	var singleActionClassType graphql.Output = singleActionClassPropertyFieldsObj
+	if parentInterface := hierarchy.genParentInterface(class, fields); parentInterface != nil {
+		singleActionClassType = parentInterface
+	}
+
 	singleActionClassPropertyFieldsField := &graphql.Field{
-		Type:        graphql.NewList(singleActionClassPropertyFieldsObj),
+		Type:        graphql.NewList(singleActionClassType),
 		Description: class.Description,
 		Args: graphql.FieldConfigArgument{
 			"first": &graphql.ArgumentConfig{
@@ -81,7 +90,7 @@ func genSingleActionClassField(class *models.SemanticSchemaClass, getActionsAndT
 	return singleActionClassPropertyFieldsField, singleActionClassPropertyFieldsObj
 }
 
-func genSingleActionClassPropertyFields(class *models.SemanticSchemaClass, getActionsAndThings *map[string]*graphql.Object) (graphql.Fields, error) {
+func genSingleActionClassPropertyFields(class *models.SemanticSchemaClass, hierarchy *classHierarchy, getActionsAndThings *map[string]*graphql.Object) (graphql.Fields, error) {
 	singleActionClassPropertyFields := graphql.Fields{}
 
 	for _, property := range class.Properties {
@@ -93,30 +102,12 @@ func genSingleActionClassPropertyFields(class *models.SemanticSchemaClass, getAc
 
 		if *propertyType == schema.DataTypeCRef {
 			capitalizedPropertyName := strings.Title(property.Name)
-			numberOfDataTypes := len(property.AtDataType)
-			dataTypeClasses := make([]*graphql.Object, numberOfDataTypes)
-
-			for index, dataType := range property.AtDataType {
-				thingOrActionType, ok := (*getActionsAndThings)[dataType]
-
-				if !ok {
-					return nil, fmt.Errorf("no such thing/action class '%s'", property.AtDataType[index])
-				}
-
-				dataTypeClasses[index] = thingOrActionType
-			}
+			multipleClassDataTypesUnion, err := hierarchy.genRefUnion(class, property, getActionsAndThings)
 
-			dataTypeUnionConf := graphql.UnionConfig{
-				Name:  fmt.Sprintf("%s%s%s", class.Class, capitalizedPropertyName, "Obj"),
-				Types: dataTypeClasses,
-				ResolveType: func(p graphql.ResolveTypeParams) *graphql.Object {
-					return nil
-				},
-				Description: property.Description,
+			if err != nil {
+				return nil, err
 			}
 
-			multipleClassDataTypesUnion := graphql.NewUnion(dataTypeUnionConf)
-
 			singleActionClassPropertyFields[capitalizedPropertyName] = &graphql.Field{
 				Type:        multipleClassDataTypesUnion,
 				Description: property.Description,
@@ -149,11 +140,11 @@ func genSingleActionClassPropertyFields(class *models.SemanticSchemaClass, getAc
 }
 
 // Build the dynamically generated Get Things part of the schema
-func genThingClassFieldsFromSchema(g *GraphQL, getActionsAndThings *map[string]*graphql.Object) (*graphql.Object, error) {
+func genThingClassFieldsFromSchema(g *GraphQL, hierarchy *classHierarchy, getActionsAndThings *map[string]*graphql.Object) (*graphql.Object, error) {
 	thingClassFields := graphql.Fields{}
 
 	for _, class := range g.databaseSchema.ThingSchema.Schema.Classes {
-		singleThingClassField, singleThingClassObject := genSingleThingClassField(class, getActionsAndThings)
+		singleThingClassField, singleThingClassObject := genSingleThingClassField(class, hierarchy, getActionsAndThings)
 		thingClassFields[class.Class] = singleThingClassField
 		// this line assigns the created class to a Hashmap which is used in thunks to handle cyclical relationships (Classes with other Classes as properties)
 		(*getActionsAndThings)[class.Class] = singleThingClassObject
@@ -168,22 +159,32 @@ func genThingClassFieldsFromSchema(g *GraphQL, getActionsAndThings *map[string]*
 	return graphql.NewObject(localGetThings), nil
 }
 
-func genSingleThingClassField(class *models.SemanticSchemaClass, getActionsAndThings *map[string]*graphql.Object) (*graphql.Field, *graphql.Object) {
+func genSingleThingClassField(class *models.SemanticSchemaClass, hierarchy *classHierarchy, getActionsAndThings *map[string]*graphql.Object) (*graphql.Field, *graphql.Object) {
+	fields := (graphql.FieldsThunk)(func() graphql.Fields {
+		singleThingClassPropertyFields, err := genSingleThingClassPropertyFields(class, hierarchy, getActionsAndThings)
+		if err != nil {
+			panic(fmt.Errorf("failed to assemble single Thing Class field for Class %s", class.Class))
+		}
+		return singleThingClassPropertyFields
+	})
+
 	singleThingClassPropertyFieldsObj := graphql.ObjectConfig{
-		Name: class.Class,
-		Fields: (graphql.FieldsThunk)(func() graphql.Fields {
-			singleThingClassPropertyFields, err := genSingleThingClassPropertyFields(class, getActionsAndThings)
-			if err != nil {
-				panic(fmt.Errorf("failed to assemble single Thing Class field for Class %s", class.Class))
-			}
-			return singleThingClassPropertyFields
-		}),
+		Name:        class.Class,
+		Fields:      fields,
+		Interfaces:  hierarchy.interfacesOf(class),
 		Description: class.Description,
 	}
 
 	thingClassPropertyFieldsObject := graphql.NewObject(singleThingClassPropertyFieldsObj)
+
+	// A class that other classes extend returns the instances of its subclasses as well, through its interface
+	var thingClassType graphql.Output = thingClassPropertyFieldsObject
+	if parentInterface := hierarchy.genParentInterface(class, fields); parentInterface != nil {
+		thingClassType = parentInterface
+	}
+
 	thingClassPropertyFieldsField := &graphql.Field{
-		Type:        graphql.NewList(thingClassPropertyFieldsObject),
+		Type:        graphql.NewList(thingClassType),
 		Description: class.Description,
 		Args: graphql.FieldConfigArgument{
 			"first": &graphql.ArgumentConfig{
@@ -203,7 +204,7 @@ func genSingleThingClassField(class *models.SemanticSchemaClass, getActionsAndTh
 	return thingClassPropertyFieldsField, thingClassPropertyFieldsObject
 }
 
-func genSingleThingClassPropertyFields(class *models.SemanticSchemaClass, getActionsAndThings *map[string]*graphql.Object) (graphql.Fields, error) {
+func genSingleThingClassPropertyFields(class *models.SemanticSchemaClass, hierarchy *classHierarchy, getActionsAndThings *map[string]*graphql.Object) (graphql.Fields, error) {
 	singleThingClassPropertyFields := graphql.Fields{}
 
 	for _, property := range class.Properties {
@@ -216,30 +217,12 @@ func genSingleThingClassPropertyFields(class *models.SemanticSchemaClass, getAct
 
 		if *propertyType == schema.DataTypeCRef {
 			capitalizedPropertyName := strings.Title(property.Name)
-			numberOfDataTypes := len(property.AtDataType)
-			dataTypeClasses := make([]*graphql.Object, numberOfDataTypes)
+			multipleClassDataTypesUnion, err := hierarchy.genRefUnion(class, property, getActionsAndThings)
 
-			for index, dataType := range property.AtDataType {
-				thingOrActionType, ok := (*getActionsAndThings)[dataType]
-
-				if !ok {
-					return nil, fmt.Errorf("no such thing/action class '%s'", property.AtDataType[index])
-				}
-
-				dataTypeClasses[index] = thingOrActionType
-			}
-
-			dataTypeUnionConf := graphql.UnionConfig{
-				Name:  fmt.Sprintf("%s%s%s", class.Class, capitalizedPropertyName, "Obj"),
-				Types: dataTypeClasses,
-				ResolveType: func(p graphql.ResolveTypeParams) *graphql.Object {
-					return nil
-				},
-				Description: property.Description,
+			if err != nil {
+				return nil, err
 			}
 
-			multipleClassDataTypesUnion := graphql.NewUnion(dataTypeUnionConf)
-
 			singleThingClassPropertyFields[capitalizedPropertyName] = &graphql.Field{
 				Type:        multipleClassDataTypesUnion,
 				Description: property.Description,
@@ -323,7 +306,42 @@ func handleGetNonObjectDataTypes(dataType schema.DataType, property *models.Sema
 			},
 		}, nil
 
+	case schema.DataTypeGeoCoordinates:
+		return &graphql.Field{
+			Description: property.Description,
+			Type:        geoCoordinatesObject,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				result, err := dbConnector.GetGraph(p)
+				return result, err
+			},
+		}, nil
+
+	case schema.DataTypeStringArray, schema.DataTypeIntArray, schema.DataTypeNumberArray, schema.DataTypeDateArray:
+		// A list of values is a list of the type of its values
+		field, err := handleGetNonObjectDataTypes(schema.ElementDataType(dataType), property)
+		if err != nil {
+			return nil, err
+		}
+		field.Type = graphql.NewList(field.Type)
+		return field, nil
+
 	default:
 		return nil, fmt.Errorf(schema.ErrorNoSuchDatatype)
 	}
 }
+
+// geoCoordinatesObject is shared by all geoCoordinates properties, as a graphql schema can contain a named type only once
+var geoCoordinatesObject = graphql.NewObject(graphql.ObjectConfig{
+	Name: "GeoCoordinates",
+	Fields: graphql.Fields{
+		"latitude": &graphql.Field{
+			Description: "The latitude of the point, in degrees",
+			Type:        graphql.Float,
+		},
+		"longitude": &graphql.Field{
+			Description: "The longitude of the point, in degrees",
+			Type:        graphql.Float,
+		},
+	},
+	Description: "A point on earth",
+})
@@ -141,6 +141,7 @@ func handleGetMetaNonObjectDataTypes(dataType schema.DataType, class *models.Sem
 	metaClassBooleanPropertyFields := genMetaClassBooleanPropertyFields(class, property)
 	metaClassDatePropertyFields := genMetaClassDatePropertyFields(class, property)
 	metaClassCRefPropertyFields := genMetaClassCRefPropertyObj(class, property)
+	metaClassGeoCoordinatesPropertyFields := genMetaClassGeoCoordinatesPropertyFields(class, property)
 
 	switch dataType {
 
@@ -189,6 +190,15 @@ func handleGetMetaNonObjectDataTypes(dataType schema.DataType, class *models.Sem
 			},
 		}, nil
 
+	case schema.DataTypeGeoCoordinates:
+		return &graphql.Field{
+			Description: fmt.Sprintf(`%s"%s"`, "Meta information about the property ", property.Name),
+			Type:        metaClassGeoCoordinatesPropertyFields,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return nil, fmt.Errorf("not supported")
+			},
+		}, nil
+
 	case schema.DataTypeCRef:
 		return &graphql.Field{
 			Description: fmt.Sprintf(`%s"%s"`, "Meta information about the property ", property.Name),
@@ -198,6 +208,10 @@ func handleGetMetaNonObjectDataTypes(dataType schema.DataType, class *models.Sem
 			},
 		}, nil
 
+	case schema.DataTypeStringArray, schema.DataTypeIntArray, schema.DataTypeNumberArray, schema.DataTypeDateArray:
+		// The meta information of a list is about all of its values
+		return handleGetMetaNonObjectDataTypes(schema.ElementDataType(dataType), class, property)
+
 	default:
 		return nil, fmt.Errorf(schema.ErrorNoSuchDatatype)
 	}
@@ -473,9 +487,82 @@ func genMetaClassBooleanPropertyFields(class *models.SemanticSchemaClass, proper
 	return graphql.NewObject(getMetaBooleanProperty)
 }
 
-// a duplicate of the string function, this is a separate function to account for future expansions of functionality
+func genMetaClassGeoCoordinatesPropertyFields(class *models.SemanticSchemaClass, property *models.SemanticSchemaClassProperty) *graphql.Object {
+	boundingBoxFields := graphql.Fields{
+
+		"southWest": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%s%s", "Meta", class.Class, property.Name, "SouthWest"),
+			Description: "The most south-western point of all values of this property in the dataset",
+			Type:        geoCoordinatesObject,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				result, err := dbConnector.GetGraph(p)
+				return result, err
+			},
+		},
+
+		"northEast": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%s%s", "Meta", class.Class, property.Name, "NorthEast"),
+			Description: "The most north-eastern point of all values of this property in the dataset",
+			Type:        geoCoordinatesObject,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				result, err := dbConnector.GetGraph(p)
+				return result, err
+			},
+		},
+	}
+
+	boundingBox := graphql.NewObject(graphql.ObjectConfig{
+		Name:        fmt.Sprintf("%s%s%s%s", "Meta", class.Class, property.Name, "BoundingBoxObj"),
+		Fields:      boundingBoxFields,
+		Description: "The smallest box that contains all values of this property in the dataset",
+	})
+
+	getMetaGeoCoordinatesFields := graphql.Fields{
+
+		"type": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%s%s", "Meta", class.Class, property.Name, "Type"),
+			Description: propertyType,
+			Type:        graphql.String,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				result, err := dbConnector.GetGraph(p)
+				return result, err
+			},
+		},
+
+		"count": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%s%s", "Meta", class.Class, property.Name, "Count"),
+			Description: propertyCount,
+			Type:        graphql.Int,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				result, err := dbConnector.GetGraph(p)
+				return result, err
+			},
+		},
+
+		"boundingBox": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%s%s", "Meta", class.Class, property.Name, "BoundingBox"),
+			Description: "The smallest box that contains all values of this property in the dataset",
+			Type:        boundingBox,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				result, err := dbConnector.GetGraph(p)
+				return result, err
+			},
+		},
+	}
+
+	getMetaGeoCoordinatesProperty := graphql.ObjectConfig{
+		Name:        fmt.Sprintf("%s%s%s%s", "Meta", class.Class, property.Name, "Obj"),
+		Fields:      getMetaGeoCoordinatesFields,
+		Description: propertyObject,
+	}
+
+	return graphql.NewObject(getMetaGeoCoordinatesProperty)
+}
+
+// a duplicate of the string function, with the earliest and latest dates and a histogram of the dates over time
 func genMetaClassDatePropertyFields(class *models.SemanticSchemaClass, property *models.SemanticSchemaClassProperty) *graphql.Object {
 	topOccurrencesFields := genMetaClassDatePropertyTopOccurrencesFields(class, property)
+	histogramFields := genMetaClassDatePropertyHistogramFields(class, property)
 
 	getMetaDateFields := graphql.Fields{
 
@@ -499,6 +586,42 @@ func genMetaClassDatePropertyFields(class *models.SemanticSchemaClass, property
 			},
 		},
 
+		"earliest": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%s%s", "Meta", class.Class, property.Name, "Earliest"),
+			Description: "The earliest date of this property in the dataset, in RFC 3339",
+			Type:        graphql.String,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				result, err := dbConnector.GetGraph(p)
+				return result, err
+			},
+		},
+
+		"latest": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%s%s", "Meta", class.Class, property.Name, "Latest"),
+			Description: "The latest date of this property in the dataset, in RFC 3339",
+			Type:        graphql.String,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				result, err := dbConnector.GetGraph(p)
+				return result, err
+			},
+		},
+
+		"histogram": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%s%s", "Meta", class.Class, property.Name, "Histogram"),
+			Description: "The number of dates of this property in the dataset per period of time, from the earliest to the latest period",
+			Type:        graphql.NewList(histogramFields),
+			Args: graphql.FieldConfigArgument{
+				"interval": &graphql.ArgumentConfig{
+					Description: "The length of the periods of time that the dates are counted in",
+					Type:        graphql.NewNonNull(dateIntervalEnum),
+				},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				result, err := dbConnector.GetGraph(p)
+				return result, err
+			},
+		},
+
 		"topOccurrences": &graphql.Field{
 			Name:        fmt.Sprintf("%s%s%s%s", "Meta", class.Class, property.Name, "TopOccurrences"),
 			Description: propertyTopOccurrences,
@@ -529,6 +652,53 @@ func genMetaClassDatePropertyFields(class *models.SemanticSchemaClass, property
 	return graphql.NewObject(getMetaDateProperty)
 }
 
+// dateIntervalEnum is shared by all date histograms, as a graphql schema can contain a named type only once
+var dateIntervalEnum = graphql.NewEnum(graphql.EnumConfig{
+	Name: "MetaDateIntervalEnum",
+	Values: graphql.EnumValueConfigMap{
+		"Minute": &graphql.EnumValueConfig{},
+		"Hour":   &graphql.EnumValueConfig{},
+		"Day":    &graphql.EnumValueConfig{},
+		"Week":   &graphql.EnumValueConfig{},
+		"Month":  &graphql.EnumValueConfig{},
+		"Year":   &graphql.EnumValueConfig{},
+	},
+	Description: "Enumeration of the periods of time of a date histogram",
+})
+
+func genMetaClassDatePropertyHistogramFields(class *models.SemanticSchemaClass, property *models.SemanticSchemaClassProperty) *graphql.Object {
+	getMetaHistogramFields := graphql.Fields{
+
+		"start": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%s%s", "Meta", class.Class, property.Name, "HistogramStart"),
+			Description: "The start of the period of time, in RFC 3339",
+			Type:        graphql.String,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				result, err := dbConnector.GetGraph(p)
+				return result, err
+			},
+		},
+
+		"count": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%s%s", "Meta", class.Class, property.Name, "HistogramCount"),
+			Description: "The number of dates in the period of time",
+			Type:        graphql.Int,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				result, err := dbConnector.GetGraph(p)
+				return result, err
+			},
+		},
+	}
+
+	getMetaHistogram := graphql.ObjectConfig{
+		Name:        fmt.Sprintf("%s%s%s%s", "Meta", class.Class, property.Name, "HistogramObj"),
+		Fields:      getMetaHistogramFields,
+		Description: "The number of dates of the property in a period of time",
+	}
+
+	return graphql.NewObject(getMetaHistogram)
+}
+
 func genMetaClassDatePropertyTopOccurrencesFields(class *models.SemanticSchemaClass, property *models.SemanticSchemaClassProperty) *graphql.Object {
 	getMetaMetaPointingFields := graphql.Fields{
 
@@ -15,6 +15,12 @@
 package graphqlapi
 
 import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/models"
 	"github.com/graphql-go/graphql"
 )
 
@@ -62,6 +68,18 @@ func genStaticWhereFilterElements() graphql.InputObjectConfigFieldMap {
 			Type:        graphql.String,
 			Description: "String value that the property at the provided path will be compared to by an operator",
 		},
+		"valueDate": &graphql.InputObjectFieldConfig{
+			Type:        graphql.String,
+			Description: "Date value in RFC 3339 that the property at the provided path will be compared to by an operator, e.g. 'GreaterThan' for the dates after it",
+		},
+		"valueGeoRange": &graphql.InputObjectFieldConfig{
+			Type:        genGeoRangeInputObject(),
+			Description: "Circle that the geoCoordinates at the provided path have to lie within, used by the 'WithinDistance' operator",
+		},
+		"valueGeoBoundingBox": &graphql.InputObjectFieldConfig{
+			Type:        genGeoBoundingBoxInputObject(),
+			Description: "Box that the geoCoordinates at the provided path have to lie within, used by the 'WithinBoundingBox' operator",
+		},
 	}
 
 	return staticFilterElements
@@ -69,15 +87,18 @@ func genStaticWhereFilterElements() graphql.InputObjectConfigFieldMap {
 
 func genOperatorObject() *graphql.Enum {
 	enumFilterOptionsMap := graphql.EnumValueConfigMap{
-		"And":              &graphql.EnumValueConfig{},
-		"Or":               &graphql.EnumValueConfig{},
-		"Equal":            &graphql.EnumValueConfig{},
-		"Not":              &graphql.EnumValueConfig{},
-		"NotEqual":         &graphql.EnumValueConfig{},
-		"GreaterThan":      &graphql.EnumValueConfig{},
-		"GreaterThanEqual": &graphql.EnumValueConfig{},
-		"LessThan":         &graphql.EnumValueConfig{},
-		"LessThanEqual":    &graphql.EnumValueConfig{},
+		"And":               &graphql.EnumValueConfig{},
+		"Or":                &graphql.EnumValueConfig{},
+		"Equal":             &graphql.EnumValueConfig{},
+		"Not":               &graphql.EnumValueConfig{},
+		"NotEqual":          &graphql.EnumValueConfig{},
+		"GreaterThan":       &graphql.EnumValueConfig{},
+		"GreaterThanEqual":  &graphql.EnumValueConfig{},
+		"LessThan":          &graphql.EnumValueConfig{},
+		"LessThanEqual":     &graphql.EnumValueConfig{},
+		"Contains":          &graphql.EnumValueConfig{},
+		"WithinDistance":    &graphql.EnumValueConfig{},
+		"WithinBoundingBox": &graphql.EnumValueConfig{},
 	}
 
 	enumFilterOptionsConf := graphql.EnumConfig{
@@ -89,6 +110,95 @@ func genOperatorObject() *graphql.Enum {
 	return graphql.NewEnum(enumFilterOptionsConf)
 }
 
+func genGeoCoordinatesInputObject() *graphql.InputObject {
+	return graphql.NewInputObject(
+		graphql.InputObjectConfig{
+			Name: "WhereGeoCoordinatesInpObj",
+			Fields: graphql.InputObjectConfigFieldMap{
+				"latitude": &graphql.InputObjectFieldConfig{
+					Type:        graphql.NewNonNull(graphql.Float),
+					Description: "The latitude of the point, in degrees",
+				},
+				"longitude": &graphql.InputObjectFieldConfig{
+					Type:        graphql.NewNonNull(graphql.Float),
+					Description: "The longitude of the point, in degrees",
+				},
+			},
+			Description: "A point on earth in the 'where' filter field",
+		},
+	)
+}
+
+func genGeoRangeInputObject() *graphql.InputObject {
+	geoCoordinates := genGeoCoordinatesInputObject()
+
+	distance := graphql.NewInputObject(
+		graphql.InputObjectConfig{
+			Name: "WhereGeoRangeDistanceInpObj",
+			Fields: graphql.InputObjectConfigFieldMap{
+				"max": &graphql.InputObjectFieldConfig{
+					Type:        graphql.NewNonNull(graphql.Float),
+					Description: "The maximum distance from the center, in kilometers",
+				},
+			},
+			Description: "Distance from the center of the 'valueGeoRange' circle",
+		},
+	)
+
+	return graphql.NewInputObject(
+		graphql.InputObjectConfig{
+			Name: "WhereGeoRangeInpObj",
+			Fields: graphql.InputObjectConfigFieldMap{
+				"geoCoordinates": &graphql.InputObjectFieldConfig{
+					Type:        graphql.NewNonNull(geoCoordinates),
+					Description: "The center of the circle",
+				},
+				"distance": &graphql.InputObjectFieldConfig{
+					Type:        graphql.NewNonNull(distance),
+					Description: "The radius of the circle",
+				},
+			},
+			Description: "Circle on earth in the 'where' filter field",
+		},
+	)
+}
+
+func genGeoBoundingBoxInputObject() *graphql.InputObject {
+	corner := graphql.NewInputObject(
+		graphql.InputObjectConfig{
+			Name: "WhereGeoBoundingBoxCornerInpObj",
+			Fields: graphql.InputObjectConfigFieldMap{
+				"latitude": &graphql.InputObjectFieldConfig{
+					Type:        graphql.NewNonNull(graphql.Float),
+					Description: "The latitude of the corner, in degrees",
+				},
+				"longitude": &graphql.InputObjectFieldConfig{
+					Type:        graphql.NewNonNull(graphql.Float),
+					Description: "The longitude of the corner, in degrees",
+				},
+			},
+			Description: "Corner of the 'valueGeoBoundingBox' box",
+		},
+	)
+
+	return graphql.NewInputObject(
+		graphql.InputObjectConfig{
+			Name: "WhereGeoBoundingBoxInpObj",
+			Fields: graphql.InputObjectConfigFieldMap{
+				"southWest": &graphql.InputObjectFieldConfig{
+					Type:        graphql.NewNonNull(corner),
+					Description: "The south-western corner of the box",
+				},
+				"northEast": &graphql.InputObjectFieldConfig{
+					Type:        graphql.NewNonNull(corner),
+					Description: "The north-eastern corner of the box",
+				},
+			},
+			Description: "Box on earth in the 'where' filter field",
+		},
+	)
+}
+
 // use a thunk to avoid a cyclical relationship (filters refer to filters refer to .... ad infinitum)
 func genOperandsObject(filterOptions map[string]*graphql.InputObject, staticFilterElements graphql.InputObjectConfigFieldMap) *graphql.InputObject {
 	outputObject := graphql.NewInputObject(
@@ -117,3 +227,136 @@ func genOperandsObjectFields(filterOptions map[string]*graphql.InputObject, stat
 
 	return outputFieldConfigMap
 }
+
+// The operators of the 'where' filter field that compare a property with a value
+var whereOperators = map[string]connutils.Operator{
+	"Equal":             connutils.Equal,
+	"NotEqual":          connutils.NotEqual,
+	"GreaterThan":       connutils.GreaterThan,
+	"GreaterThanEqual":  connutils.GreaterThanEqual,
+	"LessThan":          connutils.LessThan,
+	"LessThanEqual":     connutils.LessThanEqual,
+	"Contains":          connutils.Contains,
+	"WithinDistance":    connutils.WithinDistance,
+	"WithinBoundingBox": connutils.WithinBoundingBox,
+}
+
+// resolveWhere passes the 'where' argument of Get or GetMeta on to the connector, in the context of the request. A
+// filter that the connector can't resolve is refused.
+func resolveWhere(p graphql.ResolveParams) (graphql.ResolveParams, error) {
+	where, ok := p.Args["where"].(map[string]interface{})
+	if !ok {
+		return p, nil
+	}
+
+	wheres, err := parseWhere(where)
+	if err != nil {
+		return p, err
+	}
+
+	if p.Context == nil {
+		p.Context = context.Background()
+	}
+	p.Context = connutils.ContextWithWheres(p.Context, wheres)
+	return p, nil
+}
+
+// parseWhere converts the 'where' filter field to the where queries of the connectors, which all have to hold. The
+// operands of 'And' are added to them; 'Or' and 'Not' can't be given to the connectors.
+func parseWhere(where map[string]interface{}) ([]*connutils.WhereQuery, error) {
+	operator, _ := where["operator"].(string)
+	if operator == "And" {
+		operands, _ := where["operands"].([]interface{})
+		wheres := []*connutils.WhereQuery{}
+		for _, operand := range operands {
+			operandWhere, ok := operand.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			operandWheres, err := parseWhere(operandWhere)
+			if err != nil {
+				return nil, err
+			}
+			wheres = append(wheres, operandWheres...)
+		}
+		return wheres, nil
+	}
+
+	query := &connutils.WhereQuery{}
+	var ok bool
+	if query.Value.Operator, ok = whereOperators[operator]; !ok {
+		return nil, fmt.Errorf("the operator '%s' of the 'where' filter is not supported", operator)
+	}
+
+	path, _ := where["path"].([]interface{})
+	if len(path) != 3 {
+		return nil, fmt.Errorf("the path of the 'where' filter has to be 'Things' or 'Actions', a class and one of its properties, e.g. [\"Things\", \"City\", \"name\"]")
+	}
+	query.Property, _ = path[2].(string)
+
+	value, err := whereFilterValue(where, query.Value.Operator)
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'where' filter on property '%s': %v", query.Property, err)
+	}
+	query.Value.Value = value
+
+	return []*connutils.WhereQuery{query}, nil
+}
+
+// The value of the 'where' filter field that the operator compares the property with.
+func whereFilterValue(where map[string]interface{}, operator connutils.Operator) (interface{}, error) {
+	switch operator {
+	case connutils.WithinDistance:
+		geoRange, ok := where["valueGeoRange"].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("the operator 'WithinDistance' needs a 'valueGeoRange'")
+		}
+		coordinates, _ := geoRange["geoCoordinates"].(map[string]interface{})
+		distance, _ := geoRange["distance"].(map[string]interface{})
+		max, _ := distance["max"].(float64)
+		return connutils.GeoRange{Coordinates: whereGeoCoordinates(coordinates), Distance: max}, nil
+	case connutils.WithinBoundingBox:
+		box, ok := where["valueGeoBoundingBox"].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("the operator 'WithinBoundingBox' needs a 'valueGeoBoundingBox'")
+		}
+		southWest, _ := box["southWest"].(map[string]interface{})
+		northEast, _ := box["northEast"].(map[string]interface{})
+		return connutils.GeoBoundingBox{SouthWest: whereGeoCoordinates(southWest), NorthEast: whereGeoCoordinates(northEast)}, nil
+	}
+
+	var values []interface{}
+	if value, ok := where["valueInt"].(int); ok {
+		values = append(values, int64(value))
+	}
+	if value, ok := where["valueNumber"].(float64); ok {
+		values = append(values, value)
+	}
+	if value, ok := where["valueBoolean"].(bool); ok {
+		values = append(values, value)
+	}
+	if value, ok := where["valueString"].(string); ok {
+		values = append(values, value)
+	}
+	if value, ok := where["valueDate"].(string); ok {
+		// The connectors compare dates as time.Time
+		date, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("the 'valueDate' '%s' is not a date in RFC 3339", value)
+		}
+		values = append(values, date)
+	}
+
+	if len(values) != 1 {
+		return nil, fmt.Errorf("give one of 'valueInt', 'valueNumber', 'valueBoolean', 'valueString' or 'valueDate'")
+	}
+	return values[0], nil
+}
+
+// The coordinates of a point of the 'valueGeoRange' or 'valueGeoBoundingBox' filter fields.
+func whereGeoCoordinates(point map[string]interface{}) models.GeoCoordinates {
+	latitude, _ := point["latitude"].(float64)
+	longitude, _ := point["longitude"].(float64)
+	return models.GeoCoordinates{Latitude: latitude, Longitude: longitude}
+}
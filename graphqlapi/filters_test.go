@@ -0,0 +1,142 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+package graphqlapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/require"
+)
+
+// Run a query against a schema whose Get field has the 'where' filter, and return the wheres that reach the connector.
+func resolveTestWhere(t *testing.T, query string) ([]*connutils.WhereQuery, []error) {
+	var wheres []*connutils.WhereQuery
+
+	filterFields := genFilterFields(map[string]*graphql.InputObject{})
+	testSchema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "WeaviateTestObj",
+			Fields: graphql.Fields{
+				"Get": &graphql.Field{
+					Type: graphql.String,
+					Args: graphql.FieldConfigArgument{
+						"where": &graphql.ArgumentConfig{
+							Type: graphql.NewInputObject(graphql.InputObjectConfig{
+								Name:   "WeaviateTestWhereInpObj",
+								Fields: filterFields,
+							}),
+						},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						p, err := resolveWhere(p)
+						if err != nil {
+							return nil, err
+						}
+
+						wheres = connutils.WheresFromContext(p.Context)
+						return "", nil
+					},
+				},
+			},
+		}),
+	})
+	require.Nil(t, err)
+
+	result := graphql.Do(graphql.Params{Schema: testSchema, RequestString: query, Context: context.Background()})
+	var errs []error
+	for _, err := range result.Errors {
+		errs = append(errs, err)
+	}
+	return wheres, errs
+}
+
+func TestWhereWithinDistance(t *testing.T) {
+	wheres, errs := resolveTestWhere(t, `{ Get(where: {
+		operator: WithinDistance,
+		path: ["Things", "City", "location"],
+		valueGeoRange: {geoCoordinates: {latitude: 52.5, longitude: 4.75}, distance: {max: 50}}
+	}) }`)
+	require.Empty(t, errs)
+	require.Len(t, wheres, 1)
+
+	require.Equal(t, "location", wheres[0].Property)
+	require.Equal(t, connutils.WithinDistance, wheres[0].Value.Operator)
+	require.Equal(t, connutils.GeoRange{Coordinates: models.GeoCoordinates{Latitude: 52.5, Longitude: 4.75}, Distance: 50}, wheres[0].Value.Value)
+}
+
+func TestWhereWithinBoundingBox(t *testing.T) {
+	wheres, errs := resolveTestWhere(t, `{ Get(where: {
+		operator: And,
+		operands: [{
+			operator: WithinBoundingBox,
+			path: ["Things", "City", "location"],
+			valueGeoBoundingBox: {southWest: {latitude: 50, longitude: 10}, northEast: {latitude: 55, longitude: 15}}
+		}, {
+			operator: GreaterThan,
+			path: ["Things", "City", "population"],
+			valueInt: 1000
+		}]
+	}) }`)
+	require.Empty(t, errs)
+	require.Len(t, wheres, 2)
+
+	require.Equal(t, connutils.WithinBoundingBox, wheres[0].Value.Operator)
+	require.Equal(t, connutils.GeoBoundingBox{
+		SouthWest: models.GeoCoordinates{Latitude: 50, Longitude: 10},
+		NorthEast: models.GeoCoordinates{Latitude: 55, Longitude: 15},
+	}, wheres[0].Value.Value)
+
+	require.Equal(t, "population", wheres[1].Property)
+	require.Equal(t, connutils.GreaterThan, wheres[1].Value.Operator)
+	require.Equal(t, int64(1000), wheres[1].Value.Value)
+}
+
+func TestWhereDate(t *testing.T) {
+	wheres, errs := resolveTestWhere(t, `{ Get(where: {
+		operator: GreaterThanEqual,
+		path: ["Things", "City", "founded"],
+		valueDate: "1275-10-27T00:00:00+01:00"
+	}) }`)
+	require.Empty(t, errs)
+	require.Len(t, wheres, 1)
+
+	founded, ok := wheres[0].Value.Value.(time.Time)
+	require.True(t, ok)
+	require.True(t, founded.Equal(time.Date(1275, 10, 26, 23, 0, 0, 0, time.UTC)))
+	require.Equal(t, connutils.GreaterThanEqual, wheres[0].Value.Operator)
+}
+
+func TestInvalidWhere(t *testing.T) {
+	queries := []string{
+		// The geo operators need their value
+		`{ Get(where: {operator: WithinDistance, path: ["Things", "City", "location"], valueInt: 5}) }`,
+		`{ Get(where: {operator: WithinBoundingBox, path: ["Things", "City", "location"]}) }`,
+		// Dates are given in RFC 3339
+		`{ Get(where: {operator: LessThan, path: ["Things", "City", "founded"], valueDate: "27-10-1275"}) }`,
+		// The connectors can't resolve these
+		`{ Get(where: {operator: Or, operands: []}) }`,
+		`{ Get(where: {operator: Equal, path: ["Things", "City", "inCountry", "Country", "name"], valueString: "NL"}) }`,
+		`{ Get(where: {operator: Equal, path: ["Things", "City", "name"], valueString: "Amsterdam", valueInt: 5}) }`,
+	}
+
+	for _, query := range queries {
+		_, errs := resolveTestWhere(t, query)
+		require.NotEmpty(t, errs, query)
+	}
+}
@@ -0,0 +1,157 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+// Package graphqlapi provides the graphql endpoint for Weaviate
+package graphqlapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+)
+
+// The scalars that every GraphQL schema has, which are not written in the SDL
+var builtInScalars = map[string]bool{"String": true, "Int": true, "Float": true, "Boolean": true, "ID": true}
+
+// SDL writes the generated GraphQL schema in the schema definition language, with the types in alphabetical order.
+func (g *GraphQL) SDL() string {
+	typeMap := g.weaviateGraphQLSchema.TypeMap()
+	names := make([]string, 0, len(typeMap))
+	for name := range typeMap {
+		if !strings.HasPrefix(name, "__") && !builtInScalars[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var sdl strings.Builder
+	fmt.Fprintf(&sdl, "schema {\n  query: %s\n}\n", g.weaviateGraphQLSchema.QueryType().Name())
+
+	for _, name := range names {
+		sdl.WriteString("\n")
+		switch namedType := typeMap[name].(type) {
+		case *graphql.Object:
+			writeSDLDescription(&sdl, "", namedType.Description())
+			fmt.Fprintf(&sdl, "type %s%s {\n", name, sdlImplements(namedType.Interfaces()))
+			writeSDLFields(&sdl, namedType.Fields())
+			sdl.WriteString("}\n")
+		case *graphql.Interface:
+			writeSDLDescription(&sdl, "", namedType.Description())
+			fmt.Fprintf(&sdl, "interface %s {\n", name)
+			writeSDLFields(&sdl, namedType.Fields())
+			sdl.WriteString("}\n")
+		case *graphql.Union:
+			writeSDLDescription(&sdl, "", namedType.Description())
+			members := []string{}
+			for _, member := range namedType.Types() {
+				members = append(members, member.Name())
+			}
+			fmt.Fprintf(&sdl, "union %s = %s\n", name, strings.Join(members, " | "))
+		case *graphql.Enum:
+			writeSDLDescription(&sdl, "", namedType.Description())
+			fmt.Fprintf(&sdl, "enum %s {\n", name)
+			// Values returns the slice of the schema itself, which must not be reordered
+			values := append([]*graphql.EnumValueDefinition{}, namedType.Values()...)
+			sort.Slice(values, func(i, j int) bool { return values[i].Name < values[j].Name })
+			for _, value := range values {
+				writeSDLDescription(&sdl, "  ", value.Description)
+				fmt.Fprintf(&sdl, "  %s\n", value.Name)
+			}
+			sdl.WriteString("}\n")
+		case *graphql.InputObject:
+			writeSDLDescription(&sdl, "", namedType.Description())
+			fmt.Fprintf(&sdl, "input %s {\n", name)
+			fields := namedType.Fields()
+			for _, fieldName := range sortedKeys(fields) {
+				field := fields[fieldName]
+				writeSDLDescription(&sdl, "  ", field.Description())
+				fmt.Fprintf(&sdl, "  %s: %s%s\n", fieldName, field.Type, sdlDefaultValue(field.DefaultValue))
+			}
+			sdl.WriteString("}\n")
+		case *graphql.Scalar:
+			writeSDLDescription(&sdl, "", namedType.Description())
+			fmt.Fprintf(&sdl, "scalar %s\n", name)
+		}
+	}
+
+	return sdl.String()
+}
+
+func writeSDLFields(sdl *strings.Builder, fields graphql.FieldDefinitionMap) {
+	for _, name := range sortedKeys(fields) {
+		field := fields[name]
+		writeSDLDescription(sdl, "  ", field.Description)
+
+		args := []string{}
+		for _, arg := range field.Args {
+			args = append(args, fmt.Sprintf("%s: %s%s", arg.Name(), arg.Type, sdlDefaultValue(arg.DefaultValue)))
+		}
+
+		if len(args) > 0 {
+			fmt.Fprintf(sdl, "  %s(%s): %s\n", name, strings.Join(args, ", "), field.Type)
+		} else {
+			fmt.Fprintf(sdl, "  %s: %s\n", name, field.Type)
+		}
+	}
+}
+
+func writeSDLDescription(sdl *strings.Builder, indent string, description string) {
+	if description == "" {
+		return
+	}
+	// GraphQL strings are escaped like JSON strings
+	quoted, _ := json.Marshal(description)
+	fmt.Fprintf(sdl, "%s%s\n", indent, quoted)
+}
+
+func sdlImplements(interfaces []*graphql.Interface) string {
+	if len(interfaces) == 0 {
+		return ""
+	}
+	names := []string{}
+	for _, implemented := range interfaces {
+		names = append(names, implemented.Name())
+	}
+	return " implements " + strings.Join(names, " & ")
+}
+
+// The default value of an argument or input field, which is written like JSON for the scalars that Weaviate uses
+func sdlDefaultValue(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	literal, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return " = " + string(literal)
+}
+
+func sortedKeys(fields interface{}) []string {
+	names := []string{}
+	switch fields := fields.(type) {
+	case graphql.FieldDefinitionMap:
+		for name := range fields {
+			names = append(names, name)
+		}
+	case graphql.InputObjectFieldMap:
+		for name := range fields {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
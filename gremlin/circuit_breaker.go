@@ -0,0 +1,123 @@
+package gremlin
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// The state of a circuit breaker.
+type CircuitState string
+
+const (
+	// Queries are sent to the server.
+	CircuitClosed CircuitState = "closed"
+	// The server is unavailable; queries fail immediately, until the cooldown passed.
+	CircuitOpen CircuitState = "open"
+	// The cooldown passed; a single query is sent to find out if the server is available again.
+	CircuitHalfOpen CircuitState = "halfOpen"
+)
+
+// The status of a circuit breaker, at some moment.
+type CircuitStatus struct {
+	State CircuitState
+	// The number of queries in a row that failed because the server was unavailable.
+	ConsecutiveFailures int
+	// When the circuit breaker last opened; zero when it never opened.
+	OpenedAt time.Time
+	// The last error that showed that the server was unavailable, if any.
+	LastError error
+}
+
+// A CircuitBreaker stops sending queries to a server that is unavailable, so that callers fail fast instead of waiting
+// for timeouts. It opens after a number of queries in a row failed because the server was unavailable, and lets a
+// single query through after the cooldown, to find out if the server is available again.
+type CircuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mutex    sync.Mutex
+	status   CircuitStatus
+	trialing bool // whether a query is sent in the half open state
+
+	// The clock, which tests can replace
+	now func() time.Time
+}
+
+// NewCircuitBreaker creates a circuit breaker that opens after threshold failures in a row, for the cooldown.
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	if threshold < 1 {
+		threshold = 1
+	}
+
+	return &CircuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		status:    CircuitStatus{State: CircuitClosed},
+		now:       time.Now,
+	}
+}
+
+// Allow returns ErrCircuitOpen if a query must not be sent, and nil if it can be sent. Record the result of every
+// query that is allowed.
+func (b *CircuitBreaker) Allow() error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	switch b.status.State {
+	case CircuitOpen:
+		if b.now().Sub(b.status.OpenedAt) < b.cooldown {
+			return ErrCircuitOpen
+		}
+		b.status.State = CircuitHalfOpen
+		b.trialing = true
+		return nil
+	case CircuitHalfOpen:
+		if b.trialing {
+			return ErrCircuitOpen
+		}
+		b.trialing = true
+		return nil
+	}
+
+	return nil
+}
+
+// Record the result of a query. Only errors that show that the server is unavailable count as failures; other errors
+// show that the server is available. Queries that the caller canceled show neither.
+func (b *CircuitBreaker) Record(err error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.trialing = false
+
+	if err == context.Canceled || err == context.DeadlineExceeded {
+		return
+	}
+
+	if !isUnavailable(err) {
+		b.status.State = CircuitClosed
+		b.status.ConsecutiveFailures = 0
+		return
+	}
+
+	b.status.ConsecutiveFailures++
+	b.status.LastError = err
+	if b.status.State == CircuitHalfOpen || b.status.ConsecutiveFailures >= b.threshold {
+		b.status.State = CircuitOpen
+		b.status.OpenedAt = b.now()
+	}
+}
+
+// Status returns the current status of the circuit breaker.
+func (b *CircuitBreaker) Status() CircuitStatus {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	status := b.status
+	if status.State == CircuitOpen && b.now().Sub(status.OpenedAt) >= b.cooldown {
+		// The next query will be let through
+		status.State = CircuitHalfOpen
+	}
+	return status
+}
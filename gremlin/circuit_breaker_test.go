@@ -0,0 +1,88 @@
+package gremlin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreakerOpensAfterThreshold(t *testing.T) {
+	now := time.Date(2018, 7, 1, 12, 0, 0, 0, time.UTC)
+	breaker := NewCircuitBreaker(3, 10*time.Second)
+	breaker.now = func() time.Time { return now }
+
+	unavailable := &UnavailableError{Err: errors.New("connection refused")}
+	for i := 0; i < 2; i++ {
+		if err := breaker.Allow(); err != nil {
+			t.Fatalf("Expected query %d to be allowed, but got %v", i+1, err)
+		}
+		breaker.Record(unavailable)
+	}
+	if state := breaker.Status().State; state != CircuitClosed {
+		t.Errorf("Expected the breaker to be closed after 2 failures, but it is %s", state)
+	}
+
+	// Script errors show that the server is available
+	breaker.Record(&ServerError{StatusCode: 597, Message: "No such property"})
+	if failures := breaker.Status().ConsecutiveFailures; failures != 0 {
+		t.Errorf("Expected a script error to reset the failures, but there are %d", failures)
+	}
+
+	for i := 0; i < 3; i++ {
+		breaker.Allow()
+		breaker.Record(unavailable)
+	}
+
+	status := breaker.Status()
+	if status.State != CircuitOpen || !status.OpenedAt.Equal(now) || status.LastError != unavailable {
+		t.Errorf("Expected the breaker to be open since %v, but got %+v", now, status)
+	}
+	if err := breaker.Allow(); err != ErrCircuitOpen {
+		t.Errorf("Expected an open breaker to reject queries, but got %v", err)
+	}
+}
+
+func TestCircuitBreakerHalfOpen(t *testing.T) {
+	now := time.Date(2018, 7, 1, 12, 0, 0, 0, time.UTC)
+	breaker := NewCircuitBreaker(1, 10*time.Second)
+	breaker.now = func() time.Time { return now }
+
+	unavailable := &ServerError{StatusCode: 503, Message: "Service Unavailable"}
+	breaker.Allow()
+	breaker.Record(unavailable)
+
+	now = now.Add(10 * time.Second)
+	if state := breaker.Status().State; state != CircuitHalfOpen {
+		t.Errorf("Expected the breaker to be half open after the cooldown, but it is %s", state)
+	}
+
+	// A single trial is let through
+	if err := breaker.Allow(); err != nil {
+		t.Fatalf("Expected the trial to be allowed, but got %v", err)
+	}
+	if err := breaker.Allow(); err != ErrCircuitOpen {
+		t.Errorf("Expected a second query during the trial to be rejected, but got %v", err)
+	}
+
+	// A failed trial opens the breaker again
+	breaker.Record(unavailable)
+	if status := breaker.Status(); status.State != CircuitOpen || !status.OpenedAt.Equal(now) {
+		t.Errorf("Expected the breaker to open again after a failed trial, but got %+v", status)
+	}
+
+	// A canceled trial does not count
+	now = now.Add(10 * time.Second)
+	breaker.Allow()
+	breaker.Record(context.Canceled)
+	if state := breaker.Status().State; state != CircuitHalfOpen {
+		t.Errorf("Expected a canceled trial to leave the breaker half open, but it is %s", state)
+	}
+
+	// A successful trial closes it
+	breaker.Allow()
+	breaker.Record(nil)
+	if status := breaker.Status(); status.State != CircuitClosed || status.ConsecutiveFailures != 0 {
+		t.Errorf("Expected a successful trial to close the breaker, but got %+v", status)
+	}
+}
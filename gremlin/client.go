@@ -0,0 +1,34 @@
+package gremlin
+
+import (
+	"context"
+)
+
+// A Client sends queries to a Gremlin server. Both the HTTP and the WebSocket driver implement it.
+type Client interface {
+	// Execute a query, and return all of its results. The query is abandoned when the context is canceled.
+	Execute(ctx context.Context, query *Query) (*Response, error)
+
+	// Check that the server can be reached, and evaluates queries.
+	Ping(ctx context.Context) error
+}
+
+// A Transactional client runs all of its queries in a transaction, which is only persisted when it is committed, like
+// a session of the WebSocket driver. Clients without sessions run every query in a transaction of its own.
+type Transactional interface {
+	Client
+
+	// Persist the changes of the queries since the last commit or rollback.
+	Commit() error
+
+	// Undo the changes of the queries since the last commit or rollback.
+	Rollback() error
+}
+
+// A Session is a Transactional client with state on the server, which is released when the session is closed.
+type Session interface {
+	Transactional
+
+	// Close the session, which rolls back the open transaction.
+	Close() error
+}
@@ -1,31 +1,32 @@
 package gremlin
 
-import (
-	"fmt"
-)
-
 type Graph struct{}
 
 // This is the starting point for building queries.
 var G Graph
 
 func (g *Graph) V() *Query {
-	q := Query{query: "g.V()"}
-	return &q
+	return extend_query(&Query{}, "g.V()")
 }
 
 func Current() *Query {
-	return &Query{query: "__"}
+	return extend_query(&Query{}, "__")
 }
 
 func (g *Graph) AddV(label string) *Query {
-	query := fmt.Sprintf(`g.addV("%s")`, escapeString(label))
-	q := Query{query: query}
-	return &q
+	return extend_query(&Query{}, "g").AddV(label)
 }
 
 func (g *Graph) AddE(label string) *Query {
-	query := fmt.Sprintf(`g.addE("%s")`, escapeString(label))
-	q := Query{query: query}
-	return &q
+	return extend_query(&Query{}, "g").AddE(label)
+}
+
+// Commit the open transaction of a session.
+func (g *Graph) Commit() *Query {
+	return mutating(extend_query(&Query{}, "g.tx().commit()"))
+}
+
+// Roll back the open transaction of a session.
+func (g *Graph) Rollback() *Query {
+	return mutating(extend_query(&Query{}, "g.tx().rollback()"))
 }
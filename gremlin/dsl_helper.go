@@ -5,15 +5,65 @@ import (
 	"strings"
 )
 
-// Escape a string so that it can be used without risk of SQL-injection like escapes.
-// TODO figure out other ways of doing string interpolation in Groovy and escape them.
-func escapeString(str string) string {
-	s := strings.Replace(str, `"`, `\"`, -1)
-	s = strings.Replace(s, `$`, `\$`, -1)
-	return s
+// A part of a query; either a piece of script, or a value that is sent as a binding.
+type fragment struct {
+	script  string
+	value   interface{}
+	isValue bool
 }
 
+// A piece of script that is added to the query as it is, instead of as a binding.
+type script string
+
+// Extend a query. Every %v in the format is replaced by one of the values; a script or a *Query is inserted in the
+// script, all other values are added as bindings, so that they are never interpreted as Groovy.
 func extend_query(query *Query, format string, vals ...interface{}) *Query {
-	r := Query{query: query.query + fmt.Sprintf(format, vals...)}
-	return &r
+	parts := strings.Split(format, "%v")
+	if len(parts) != len(vals)+1 {
+		panic(fmt.Sprintf("the format '%s' does not have a placeholder for each of the %d values", format, len(vals)))
+	}
+
+	fragments := make([]fragment, len(query.fragments), len(query.fragments)+2*len(parts))
+	copy(fragments, query.fragments)
+	mutates := query.mutates
+
+	for i, part := range parts {
+		if part != "" {
+			fragments = append(fragments, fragment{script: part})
+		}
+
+		if i == len(vals) {
+			break
+		}
+
+		switch val := vals[i].(type) {
+		case script:
+			fragments = append(fragments, fragment{script: string(val)})
+		case *Query:
+			fragments = append(fragments, val.fragments...)
+			mutates = mutates || val.mutates
+		default:
+			fragments = append(fragments, fragment{value: val, isValue: true})
+		}
+	}
+
+	return &Query{fragments: fragments, mutates: mutates, timeout: query.timeout}
+}
+
+// Mark a query that was just extended as one that changes the graph.
+func mutating(query *Query) *Query {
+	query.mutates = true
+	return query
+}
+
+// Quote a list of values as bound arguments of a step.
+func bindAll(format string, vals []string) *Query {
+	placeholders := make([]string, 0, len(vals))
+	values := make([]interface{}, 0, len(vals))
+	for _, val := range vals {
+		placeholders = append(placeholders, "%v")
+		values = append(values, val)
+	}
+
+	return extend_query(&Query{}, fmt.Sprintf(format, strings.Join(placeholders, ",")), values...)
 }
@@ -0,0 +1,105 @@
+package gremlin
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// The data type of a property key
+type DataType string
+
+const (
+	DataTypeString  DataType = "String"
+	DataTypeLong    DataType = "Long"
+	DataTypeDouble  DataType = "Double"
+	DataTypeBoolean DataType = "Boolean"
+	DataTypeObject  DataType = "Object"
+	// A geographic shape of JanusGraph, like a point with a latitude and a longitude
+	DataTypeGeoshape DataType = "Geoshape"
+)
+
+// The cardinality of a property key; whether a vertex has a single value or a list of values for it
+type Cardinality string
+
+const (
+	CardinalitySingle Cardinality = "SINGLE"
+	CardinalityList   Cardinality = "LIST"
+)
+
+// Management builds a script that changes the schema of a JanusGraph graph in one management transaction. Every
+// schema element is only made when it does not exist yet, so the script can be run again. The script returns the
+// descriptions of the elements that it made. Property keys can not be changed once they are made, so the script fails
+// without changing anything when an existing key has another data type or cardinality.
+type Management struct {
+	query *Query
+}
+
+// Open a management transaction.
+func OpenManagement() *Management {
+	return &Management{query: RawQuery("mgmt = graph.openManagement()\ncreated = []\nmismatched = []\n")}
+}
+
+// Make a vertex label.
+func (m *Management) MakeVertexLabel(label string) *Management {
+	return m.extend(
+		"if (mgmt.getVertexLabel(%v) == null) { mgmt.makeVertexLabel(%v).make(); created.add(%v) }\n",
+		label, label, "vertex label "+label,
+	)
+}
+
+// Make a property key with a single value of the data type.
+func (m *Management) MakePropertyKey(name string, dataType DataType) *Management {
+	return m.MakePropertyKeyWithCardinality(name, dataType, CardinalitySingle)
+}
+
+// Make a property key with values of the data type; a key with the cardinality LIST can have many values per vertex.
+// An existing key with another data type or cardinality makes the commit fail.
+func (m *Management) MakePropertyKeyWithCardinality(name string, dataType DataType, cardinality Cardinality) *Management {
+	return m.extend(
+		"if (mgmt.getPropertyKey(%v) == null) { mgmt.makePropertyKey(%v).dataType(%v.class).cardinality(Cardinality.%v).make(); created.add(%v) } "+
+			"else if (mgmt.getPropertyKey(%v).dataType() != %v.class || mgmt.getPropertyKey(%v).cardinality() != Cardinality.%v) { mismatched.add(%v) }\n",
+		name, name, script(dataType), script(cardinality), "property key "+name,
+		name, script(dataType), name, script(cardinality), fmt.Sprintf("%s (%s, %s)", name, dataType, cardinality),
+	)
+}
+
+// Build a composite index of vertices on the property keys, which makes lookups by equality fast.
+func (m *Management) BuildCompositeIndex(name string, keys []string, unique bool) *Management {
+	addKeys := make([]string, 0, len(keys))
+	values := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		addKeys = append(addKeys, ".addKey(mgmt.getPropertyKey(%v))")
+		values = append(values, key)
+	}
+
+	build := ".buildCompositeIndex()"
+	if unique {
+		build = ".unique()" + build
+	}
+
+	index := extend_query(&Query{}, "mgmt.buildIndex(%v, Vertex.class)"+strings.Join(addKeys, "")+build, append([]interface{}{name}, values...)...)
+	return m.extend("if (mgmt.getGraphIndex(%v) == null) { %v; created.add(%v) }\n", name, index, "index "+name)
+}
+
+// Commit the management transaction; the query returns the descriptions of the elements that were made. When an
+// existing property key has another data type or cardinality than the one to make, the transaction is rolled back and
+// the query fails with an error that names the keys.
+func (m *Management) Commit() *Query {
+	return extend_query(m.query,
+		"if (!mismatched.isEmpty()) { mgmt.rollback(); throw new IllegalStateException(%v + mismatched.join(\", \") + %v) }\nmgmt.commit()\ncreated",
+		"the existing property keys ", " have another data type or cardinality, and JanusGraph can not change a property key",
+	)
+}
+
+func (m *Management) extend(format string, vals ...interface{}) *Management {
+	return &Management{query: extend_query(m.query, format, vals...)}
+}
+
+// Wait until the index is enabled, or the timeout passed. The query returns whether the index is enabled.
+func AwaitGraphIndex(name string, timeout time.Duration) *Query {
+	return extend_query(&Query{},
+		"ManagementSystem.awaitGraphIndexStatus(graph, %v).status(SchemaStatus.ENABLED).timeout((long) %v, ChronoUnit.MILLIS).call().getSucceeded()",
+		name, int64(timeout/time.Millisecond),
+	)
+}
@@ -0,0 +1,90 @@
+package gremlin
+
+import (
+	"strings"
+)
+
+// A predicate to filter values with, in Has() and Is(). The values of a predicate are sent as bindings.
+type Predicate struct {
+	query *Query
+}
+
+// Build a predicate that calls the function with all values as arguments.
+func newPredicate(function string, values ...interface{}) *Predicate {
+	placeholders := make([]string, 0, len(values))
+	for range values {
+		placeholders = append(placeholders, "%v")
+	}
+
+	return &Predicate{query: extend_query(&Query{}, function+"("+strings.Join(placeholders, ", ")+")", values...)}
+}
+
+// Eq matches values that are equal to the given value.
+func Eq(value interface{}) *Predicate {
+	return newPredicate("P.eq", value)
+}
+
+// Neq matches values that are not equal to the given value.
+func Neq(value interface{}) *Predicate {
+	return newPredicate("P.neq", value)
+}
+
+// Gt matches values that are greater than the given value.
+func Gt(value interface{}) *Predicate {
+	return newPredicate("P.gt", value)
+}
+
+// Gte matches values that are greater than or equal to the given value.
+func Gte(value interface{}) *Predicate {
+	return newPredicate("P.gte", value)
+}
+
+// Lt matches values that are less than the given value.
+func Lt(value interface{}) *Predicate {
+	return newPredicate("P.lt", value)
+}
+
+// Lte matches values that are less than or equal to the given value.
+func Lte(value interface{}) *Predicate {
+	return newPredicate("P.lte", value)
+}
+
+// Between matches values from the lower bound up to, but not including, the upper bound.
+func Between(lower interface{}, upper interface{}) *Predicate {
+	return newPredicate("P.between", lower, upper)
+}
+
+// Within matches values that are equal to one of the given values.
+func Within(values ...interface{}) *Predicate {
+	return newPredicate("P.within", values...)
+}
+
+// Without matches values that are equal to none of the given values.
+func Without(values ...interface{}) *Predicate {
+	return newPredicate("P.without", values...)
+}
+
+// TextContains matches text that contains the given word, using the full text index of JanusGraph.
+func TextContains(word string) *Predicate {
+	return newPredicate("Text.textContains", word)
+}
+
+// TextContainsPrefix matches text that contains a word that starts with the given prefix, using the full text index
+// of JanusGraph.
+func TextContainsPrefix(prefix string) *Predicate {
+	return newPredicate("Text.textContainsPrefix", prefix)
+}
+
+// GeoWithinDistance matches geographic points that are at most the distance in kilometers from the point at the
+// latitude and longitude.
+func GeoWithinDistance(latitude float64, longitude float64, kilometers float64) *Predicate {
+	return &Predicate{query: extend_query(&Query{}, "Geo.geoWithin(Geoshape.circle((double) %v, (double) %v, (double) %v))", latitude, longitude, kilometers)}
+}
+
+// GeoWithinBoundingBox matches geographic points within the box from the south west corner to the north east corner.
+func GeoWithinBoundingBox(southWest GeoPoint, northEast GeoPoint) *Predicate {
+	return &Predicate{query: extend_query(&Query{},
+		"Geo.geoWithin(Geoshape.box((double) %v, (double) %v, (double) %v, (double) %v))",
+		southWest.Latitude, southWest.Longitude, northEast.Latitude, northEast.Longitude,
+	)}
+}
@@ -2,26 +2,85 @@ package gremlin
 
 import (
 	"fmt"
-	"strconv"
 	"strings"
+	"time"
 )
 
-// A query represents the (partial) query build with the DSL
+// The order in which the Order step sorts
+type Order string
+
+const (
+	Ascending  Order = "incr"
+	Descending Order = "decr"
+	Shuffle    Order = "shuffle"
+)
+
+// A query represents the (partial) query build with the DSL. The values in a query are not part of its script, but
+// are sent as bindings. That way, they can't escape from the script, and the server can cache the compiled script.
 type Query struct {
-	query string
+	fragments []fragment
+
+	// Whether the query changes the graph; queries that don't can safely be sent again when they fail.
+	mutates bool
+
+	// How long the query may take; zero means the default of the client.
+	timeout time.Duration
+}
+
+// Whether the query only reads from the graph. Read only queries are retried when they fail with a transient error.
+// Raw queries are assumed to change the graph.
+func (q *Query) IsReadOnly() bool {
+	return !q.mutates
+}
+
+// Return a copy of the query that may take at most the timeout, instead of the default timeout of the client.
+func (q *Query) WithTimeout(timeout time.Duration) *Query {
+	copied := *q
+	copied.timeout = timeout
+	return &copied
 }
 
-// Return the string representation of this Query.
+// How long the query may take; zero means the default of the client.
+func (q *Query) Timeout() time.Duration {
+	return q.timeout
+}
+
+// Return the string representation of this Query. Bound values are referred to by their binding name.
 func (q *Query) Query() string {
-	return q.query
+	var s string
+	i := 0
+	for _, f := range q.fragments {
+		if f.isValue {
+			s += bindingName(i)
+			i++
+		} else {
+			s += f.script
+		}
+	}
+	return s
+}
+
+// Return the values that are bound to the names in the query.
+func (q *Query) Bindings() map[string]interface{} {
+	bindings := make(map[string]interface{})
+	i := 0
+	for _, f := range q.fragments {
+		if f.isValue {
+			bindings[bindingName(i)] = f.value
+			i++
+		}
+	}
+	return bindings
 }
 
-func RawQuery(query string) *Query {
-	return &Query{query: query}
+// The binding names are numbered in the order of the values in the query, so that queries that differ only in their
+// values have the same script.
+func bindingName(i int) string {
+	return fmt.Sprintf("_%d", i)
 }
 
-func (q *Query) Raw(query string) *Query {
-	return extend_query(q, query)
+func RawQuery(query string) *Query {
+	return mutating(extend_query(&Query{}, "%v", script(query)))
 }
 
 func (q *Query) V() *Query {
@@ -38,65 +97,76 @@ func (q *Query) Count() *Query {
 }
 
 func (q *Query) Select(refs []string) *Query {
-	sanitized := make([]string, 0)
-
-	for _, ref := range refs {
-		sanitized = append(sanitized, fmt.Sprintf(`"%s"`, escapeString(ref)))
-	}
-
-	return extend_query(q, ".select(%s)", strings.Join(sanitized, ","))
+	return extend_query(q, "%v", bindAll(".select(%s)", refs))
 }
 
 // Get the values of these property names.
 func (q *Query) Values(propNames []string) *Query {
-	sanitized := make([]string, 0)
-
-	for _, propName := range propNames {
-		sanitized = append(sanitized, fmt.Sprintf(`"%s"`, escapeString(propName)))
-	}
+	return extend_query(q, "%v", bindAll(".values(%s)", propNames))
+}
 
-	return extend_query(q, ".values(%s)", strings.Join(sanitized, ","))
+// Get the properties with these names, e.g. to drop them.
+func (q *Query) Properties(propNames []string) *Query {
+	return extend_query(q, "%v", bindAll(".properties(%s)", propNames))
 }
 
 func (q *Query) Range(offset int, limit int) *Query {
-	return extend_query(q, ".range(%d, %d)", offset, limit)
+	return extend_query(q, ".range((long) %v, (long) %v)", offset, limit)
 }
 
 func (q *Query) AddV(label string) *Query {
-	return extend_query(q, `.addV("%s")`, escapeString(label))
+	return mutating(extend_query(q, `.addV(%v)`, label))
 }
 
 func (q *Query) AddE(label string) *Query {
-	return extend_query(q, `.addE("%s")`, escapeString(label))
+	return mutating(extend_query(q, `.addE(%v)`, label))
 }
 
 // Set the expected label of the vertex/edge.
 func (q *Query) HasLabel(label string) *Query {
-	return extend_query(q, `.hasLabel("%s")`, escapeString(label))
+	return extend_query(q, `.hasLabel(%v)`, label)
 }
 
 func (q *Query) HasString(key string, value string) *Query {
-	return extend_query(q, `.has("%s", "%s")`, escapeString(key), escapeString(value))
+	return extend_query(q, `.has(%v, %v)`, key, value)
 }
 
 func (q *Query) HasBool(key string, value bool) *Query {
-	return extend_query(q, `.has("%s", %v)`, escapeString(key), value)
+	return extend_query(q, `.has(%v, %v)`, key, value)
 }
 
 func (q *Query) StringProperty(key string, value string) *Query {
-	return extend_query(q, `.property("%s", "%s")`, escapeString(key), escapeString(value))
+	return mutating(extend_query(q, `.property(%v, %v)`, key, value))
 }
 
 func (q *Query) BoolProperty(key string, value bool) *Query {
-	return extend_query(q, `.property("%s", %v)`, escapeString(key), value)
+	return mutating(extend_query(q, `.property(%v, %v)`, key, value))
 }
 
 func (q *Query) Int64Property(key string, value int64) *Query {
-	return extend_query(q, `.property("%s", (long) %v)`, escapeString(key), value)
+	return mutating(extend_query(q, `.property(%v, (long) %v)`, key, value))
 }
 
 func (q *Query) Float64Property(key string, value float64) *Query {
-	return extend_query(q, `.property("%s", (double) %v)`, escapeString(key), strconv.FormatFloat(value, 'g', -1, 64))
+	return mutating(extend_query(q, `.property(%v, (double) %v)`, key, value))
+}
+
+// Set a property to the point at the latitude and longitude, as a Geoshape of JanusGraph.
+func (q *Query) GeoPointProperty(key string, latitude float64, longitude float64) *Query {
+	return mutating(extend_query(q, `.property(%v, Geoshape.point((double) %v, (double) %v))`, key, latitude, longitude))
+}
+
+// Add a value to a property key with the cardinality LIST, which keeps the values that the property has already.
+func (q *Query) StringListProperty(key string, value string) *Query {
+	return mutating(extend_query(q, `.property(list, %v, %v)`, key, value))
+}
+
+func (q *Query) Int64ListProperty(key string, value int64) *Query {
+	return mutating(extend_query(q, `.property(list, %v, (long) %v)`, key, value))
+}
+
+func (q *Query) Float64ListProperty(key string, value float64) *Query {
+	return mutating(extend_query(q, `.property(list, %v, (double) %v)`, key, value))
 }
 
 func (q *Query) In() *Query {
@@ -104,7 +174,7 @@ func (q *Query) In() *Query {
 }
 
 func (q *Query) InWithLabel(label string) *Query {
-	return extend_query(q, `.in("%s")`, escapeString(label))
+	return extend_query(q, `.in(%v)`, label)
 }
 
 func (q *Query) Out() *Query {
@@ -112,7 +182,7 @@ func (q *Query) Out() *Query {
 }
 
 func (q *Query) OutWithLabel(label string) *Query {
-	return extend_query(q, `.out("%s")`, escapeString(label))
+	return extend_query(q, `.out(%v)`, label)
 }
 
 func (q *Query) InE() *Query {
@@ -120,7 +190,7 @@ func (q *Query) InE() *Query {
 }
 
 func (q *Query) InEWithLabel(label string) *Query {
-	return extend_query(q, `.inE("%s")`, escapeString(label))
+	return extend_query(q, `.inE(%v)`, label)
 }
 
 func (q *Query) OutE() *Query {
@@ -128,7 +198,7 @@ func (q *Query) OutE() *Query {
 }
 
 func (q *Query) OutEWithLabel(label string) *Query {
-	return extend_query(q, `.outE("%s")`, escapeString(label))
+	return extend_query(q, `.outE(%v)`, label)
 }
 
 func (q *Query) InV() *Query {
@@ -146,22 +216,123 @@ func (q *Query) Path() *Query {
 
 // Create a reference
 func (q *Query) As(name string) *Query {
-	return extend_query(q, `.as("%s")`, escapeString(name))
+	return extend_query(q, `.as(%v)`, name)
 }
 
 // Point to a reference
 func (q *Query) FromRef(reference string) *Query {
-	return extend_query(q, `.from("%s")`, escapeString(reference))
+	return extend_query(q, `.from(%v)`, reference)
+}
+
+// Point the edge to a reference
+func (q *Query) ToRef(reference string) *Query {
+	return extend_query(q, `.to(%v)`, reference)
 }
 
 func (q *Query) ToQuery(query *Query) *Query {
-	return extend_query(q, `.to(%s)`, query.Query())
+	return extend_query(q, `.to(%v)`, query)
 }
 
 func (q *Query) Optional(query *Query) *Query {
-	return extend_query(q, `.optional(%s)`, query.Query())
+	return extend_query(q, `.optional(%v)`, query)
+}
+
+// Continue with the trueQuery if the condition has a result, and with the falseQuery otherwise.
+func (q *Query) Choose(condition *Query, trueQuery *Query, falseQuery *Query) *Query {
+	return extend_query(q, `.choose(%v, %v, %v)`, condition, trueQuery, falseQuery)
 }
 
 func (q *Query) Drop() *Query {
-	return extend_query(q, ".drop()")
+	return mutating(extend_query(q, ".drop()"))
+}
+
+// Filter on a property whose value matches the predicate.
+func (q *Query) Has(key string, predicate *Predicate) *Query {
+	return extend_query(q, `.has(%v, %v)`, key, predicate.query)
+}
+
+// Filter on elements that have a value for the property.
+func (q *Query) HasKey(key string) *Query {
+	return extend_query(q, `.has(%v)`, key)
+}
+
+// Filter the current values on the predicate.
+func (q *Query) Is(predicate *Predicate) *Query {
+	return extend_query(q, `.is(%v)`, predicate.query)
+}
+
+// Sort the results; use the By steps to set on what to sort.
+func (q *Query) Order() *Query {
+	return extend_query(q, ".order()")
+}
+
+// Modulate the previous step (like Order, Project or Group) by the value of a property.
+func (q *Query) By(key string) *Query {
+	return extend_query(q, `.by(%v)`, key)
+}
+
+// Modulate the previous Order step by the value of a property, in the given order.
+func (q *Query) ByWithOrder(key string, order Order) *Query {
+	return extend_query(q, `.by(%v, %v)`, key, script(order))
+}
+
+// Modulate the previous step (like Order, Project or Group) by the result of a query.
+func (q *Query) ByQuery(query *Query) *Query {
+	return extend_query(q, `.by(%v)`, query)
+}
+
+func (q *Query) Limit(limit int) *Query {
+	return extend_query(q, ".limit((long) %v)", limit)
+}
+
+// Group the results in a map; use the By steps to set the keys and values.
+func (q *Query) Group() *Query {
+	return extend_query(q, ".group()")
+}
+
+// Count the results per group; use a By step to set the key.
+func (q *Query) GroupCount() *Query {
+	return extend_query(q, ".groupCount()")
+}
+
+// Collect all results in a single list.
+func (q *Query) Fold() *Query {
+	return extend_query(q, ".fold()")
+}
+
+// Turn lists into their separate elements.
+func (q *Query) Unfold() *Query {
+	return extend_query(q, ".unfold()")
+}
+
+// Continue with the results of the first query that has any results.
+func (q *Query) Coalesce(queries ...*Query) *Query {
+	return extend_query(q, ".coalesce(%v)", joinQueries(queries))
+}
+
+// Continue with the results of all queries.
+func (q *Query) Union(queries ...*Query) *Query {
+	return extend_query(q, ".union(%v)", joinQueries(queries))
+}
+
+// Build a map with the given keys; use a By step for each key to set its value.
+func (q *Query) Project(keys []string) *Query {
+	return extend_query(q, "%v", bindAll(".project(%s)", keys))
+}
+
+// Run the query for each result, and continue with the results themselves.
+func (q *Query) SideEffect(query *Query) *Query {
+	return extend_query(q, ".sideEffect(%v)", query)
+}
+
+// Join queries as the arguments of a step.
+func joinQueries(queries []*Query) *Query {
+	placeholders := make([]string, 0, len(queries))
+	values := make([]interface{}, 0, len(queries))
+	for _, query := range queries {
+		placeholders = append(placeholders, "%v")
+		values = append(values, query)
+	}
+
+	return extend_query(&Query{}, strings.Join(placeholders, ", "), values...)
 }
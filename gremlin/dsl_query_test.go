@@ -0,0 +1,204 @@
+package gremlin
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValuesAreBound(t *testing.T) {
+	q := G.V().HasLabel("thing").HasString("uuid", `"); g.V().drop(); ("`).Range(10, 20)
+
+	expectedScript := `g.V().hasLabel(_0).has(_1, _2).range((long) _3, (long) _4)`
+	if q.Query() != expectedScript {
+		t.Errorf("expected script %s, got %s", expectedScript, q.Query())
+	}
+
+	expectedBindings := map[string]interface{}{
+		"_0": "thing",
+		"_1": "uuid",
+		"_2": `"); g.V().drop(); ("`,
+		"_3": 10,
+		"_4": 20,
+	}
+	if !reflect.DeepEqual(q.Bindings(), expectedBindings) {
+		t.Errorf("expected bindings %v, got %v", expectedBindings, q.Bindings())
+	}
+}
+
+func TestNestedQueriesShareBindings(t *testing.T) {
+	q := G.AddV("thing").As("new").
+		AddE("ref").FromRef("new").
+		ToQuery(G.V().HasString("uuid", "other")).
+		Optional(Current().OutEWithLabel("ref").Drop())
+
+	expectedScript := `g.addV(_0).as(_1).addE(_2).from(_3).to(g.V().has(_4, _5)).optional(__.outE(_6).drop())`
+	if q.Query() != expectedScript {
+		t.Errorf("expected script %s, got %s", expectedScript, q.Query())
+	}
+
+	if len(q.Bindings()) != 7 || q.Bindings()["_5"] != "other" {
+		t.Errorf("unexpected bindings %v", q.Bindings())
+	}
+}
+
+func TestScriptDoesNotDependOnValues(t *testing.T) {
+	a := G.V().HasString("uuid", "a").Int64Property("count", 1)
+	b := G.V().HasString("uuid", "b").Int64Property("count", 2)
+
+	if a.Query() != b.Query() {
+		t.Errorf("expected the same script, got %s and %s", a.Query(), b.Query())
+	}
+}
+
+func TestTypedSteps(t *testing.T) {
+	tests := []struct {
+		name             string
+		query            *Query
+		expectedScript   string
+		expectedBindings map[string]interface{}
+	}{
+		{
+			name:             "within",
+			query:            G.V().Has("name", Within("a", "b")),
+			expectedScript:   `g.V().has(_0, P.within(_1, _2))`,
+			expectedBindings: map[string]interface{}{"_0": "name", "_1": "a", "_2": "b"},
+		},
+		{
+			name:             "gt and lt",
+			query:            G.V().Has("age", Gt(18)).Has("age", Lt(65)),
+			expectedScript:   `g.V().has(_0, P.gt(_1)).has(_2, P.lt(_3))`,
+			expectedBindings: map[string]interface{}{"_0": "age", "_1": 18, "_2": "age", "_3": 65},
+		},
+		{
+			name:             "between",
+			query:            G.V().Values([]string{"age"}).Is(Between(18, 65)),
+			expectedScript:   `g.V().values(_0).is(P.between(_1, _2))`,
+			expectedBindings: map[string]interface{}{"_0": "age", "_1": 18, "_2": 65},
+		},
+		{
+			name:             "textContains",
+			query:            G.V().Has("description", TextContains("city")),
+			expectedScript:   `g.V().has(_0, Text.textContains(_1))`,
+			expectedBindings: map[string]interface{}{"_0": "description", "_1": "city"},
+		},
+		{
+			name:             "order and limit",
+			query:            G.V().Order().ByWithOrder("age", Descending).By("name").Limit(3),
+			expectedScript:   `g.V().order().by(_0, decr).by(_1).limit((long) _2)`,
+			expectedBindings: map[string]interface{}{"_0": "age", "_1": "name", "_2": 3},
+		},
+		{
+			name:             "group and groupCount",
+			query:            G.V().Group().By("kind").ByQuery(Current().Values([]string{"name"}).Fold()).Select([]string{"a"}).GroupCount().By("kind"),
+			expectedScript:   `g.V().group().by(_0).by(__.values(_1).fold()).select(_2).groupCount().by(_3)`,
+			expectedBindings: map[string]interface{}{"_0": "kind", "_1": "name", "_2": "a", "_3": "kind"},
+		},
+		{
+			name:             "fold and unfold",
+			query:            G.V().Fold().Unfold(),
+			expectedScript:   `g.V().fold().unfold()`,
+			expectedBindings: map[string]interface{}{},
+		},
+		{
+			name:             "coalesce",
+			query:            G.V().Coalesce(Current().OutWithLabel("a"), Current().OutWithLabel("b")),
+			expectedScript:   `g.V().coalesce(__.out(_0), __.out(_1))`,
+			expectedBindings: map[string]interface{}{"_0": "a", "_1": "b"},
+		},
+		{
+			name: "choose",
+			query: G.V().Choose(
+				Current().HasBool("atContext", true),
+				Current().Values([]string{"a"}),
+				Current().Values([]string{"b"}),
+			),
+			expectedScript:   `g.V().choose(__.has(_0, _1), __.values(_2), __.values(_3))`,
+			expectedBindings: map[string]interface{}{"_0": "atContext", "_1": true, "_2": "a", "_3": "b"},
+		},
+		{
+			name:             "project",
+			query:            G.V().Project([]string{"id", "edges"}).By("uuid").ByQuery(Current().OutE().Count()),
+			expectedScript:   `g.V().project(_0,_1).by(_2).by(__.outE().count())`,
+			expectedBindings: map[string]interface{}{"_0": "id", "_1": "edges", "_2": "uuid"},
+		},
+		{
+			name:             "union",
+			query:            G.V().Union(Current().In(), Current().Out()),
+			expectedScript:   `g.V().union(__.in(), __.out())`,
+			expectedBindings: map[string]interface{}{},
+		},
+		{
+			name:             "list properties",
+			query:            G.V().StringListProperty("tags", "a").Int64ListProperty("sizes", 1).Float64ListProperty("weights", 0.5),
+			expectedScript:   `g.V().property(list, _0, _1).property(list, _2, (long) _3).property(list, _4, (double) _5)`,
+			expectedBindings: map[string]interface{}{"_0": "tags", "_1": "a", "_2": "sizes", "_3": int64(1), "_4": "weights", "_5": 0.5},
+		},
+		{
+			name:             "geo point property",
+			query:            G.V().GeoPointProperty("location", 52.37, 4.89),
+			expectedScript:   `g.V().property(_0, Geoshape.point((double) _1, (double) _2))`,
+			expectedBindings: map[string]interface{}{"_0": "location", "_1": 52.37, "_2": 4.89},
+		},
+		{
+			name:             "geo predicates",
+			query:            G.V().Has("location", GeoWithinDistance(52.37, 4.89, 10)).Has("location", GeoWithinBoundingBox(GeoPoint{50, 3}, GeoPoint{54, 7})),
+			expectedScript:   `g.V().has(_0, Geo.geoWithin(Geoshape.circle((double) _1, (double) _2, (double) _3))).has(_4, Geo.geoWithin(Geoshape.box((double) _5, (double) _6, (double) _7, (double) _8)))`,
+			expectedBindings: map[string]interface{}{"_0": "location", "_1": 52.37, "_2": 4.89, "_3": float64(10), "_4": "location", "_5": float64(50), "_6": float64(3), "_7": float64(54), "_8": float64(7)},
+		},
+		{
+			name:             "sideEffect",
+			query:            G.V().SideEffect(Current().OutE().Drop()).Count(),
+			expectedScript:   `g.V().sideEffect(__.outE().drop()).count()`,
+			expectedBindings: map[string]interface{}{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.query.Query() != test.expectedScript {
+				t.Errorf("expected script %s, got %s", test.expectedScript, test.query.Query())
+			}
+			if !reflect.DeepEqual(test.query.Bindings(), test.expectedBindings) {
+				t.Errorf("expected bindings %v, got %v", test.expectedBindings, test.query.Bindings())
+			}
+		})
+	}
+}
+
+func TestManagement(t *testing.T) {
+	q := OpenManagement().
+		MakeVertexLabel("thing").
+		MakePropertyKey("uuid", DataTypeString).
+		BuildCompositeIndex("byUuid", []string{"uuid"}, false).
+		MakePropertyKeyWithCardinality("tags", DataTypeString, CardinalityList).
+		Commit()
+
+	expectedScript := "mgmt = graph.openManagement()\n" +
+		"created = []\n" +
+		"mismatched = []\n" +
+		"if (mgmt.getVertexLabel(_0) == null) { mgmt.makeVertexLabel(_1).make(); created.add(_2) }\n" +
+		"if (mgmt.getPropertyKey(_3) == null) { mgmt.makePropertyKey(_4).dataType(String.class).cardinality(Cardinality.SINGLE).make(); created.add(_5) } " +
+		"else if (mgmt.getPropertyKey(_6).dataType() != String.class || mgmt.getPropertyKey(_7).cardinality() != Cardinality.SINGLE) { mismatched.add(_8) }\n" +
+		"if (mgmt.getGraphIndex(_9) == null) { mgmt.buildIndex(_10, Vertex.class).addKey(mgmt.getPropertyKey(_11)).buildCompositeIndex(); created.add(_12) }\n" +
+		"if (mgmt.getPropertyKey(_13) == null) { mgmt.makePropertyKey(_14).dataType(String.class).cardinality(Cardinality.LIST).make(); created.add(_15) } " +
+		"else if (mgmt.getPropertyKey(_16).dataType() != String.class || mgmt.getPropertyKey(_17).cardinality() != Cardinality.LIST) { mismatched.add(_18) }\n" +
+		"if (!mismatched.isEmpty()) { mgmt.rollback(); throw new IllegalStateException(_19 + mismatched.join(\", \") + _20) }\n" +
+		"mgmt.commit()\n" +
+		"created"
+	if q.Query() != expectedScript {
+		t.Errorf("expected script %s, got %s", expectedScript, q.Query())
+	}
+
+	if q.Bindings()["_2"] != "vertex label thing" || q.Bindings()["_11"] != "uuid" || q.Bindings()["_12"] != "index byUuid" ||
+		q.Bindings()["_18"] != "tags (String, LIST)" {
+		t.Errorf("unexpected bindings %v", q.Bindings())
+	}
+}
+
+func TestTransactionQueriesMutate(t *testing.T) {
+	for _, q := range []*Query{G.Commit(), G.Rollback()} {
+		if q.IsReadOnly() {
+			t.Errorf("expected '%s' to change the graph", q.Query())
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package gremlin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrCircuitOpen is returned instead of sending a query, while the circuit breaker is open.
+var ErrCircuitOpen = errors.New("The Gremlin server is unavailable; the circuit breaker is open")
+
+// A ServerError is an error that the Gremlin server reported for a query.
+type ServerError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *ServerError) Error() string {
+	return fmt.Sprintf("Server error: %s (status code %d)", e.Message, e.StatusCode)
+}
+
+// An UnavailableError is returned when the Gremlin server could not be reached, or did not answer in time.
+type UnavailableError struct {
+	Err error
+}
+
+func (e *UnavailableError) Error() string {
+	return e.Err.Error()
+}
+
+// Whether a query that failed with the error might succeed when it is sent again. That is the case when the server
+// could not be reached, or when it reported a server error (5xx over HTTP, 597 over WebSockets), e.g. because of a
+// locking conflict or a timeout in the storage backend.
+func IsTransient(err error) bool {
+	switch e := err.(type) {
+	case *UnavailableError:
+		return true
+	case *ServerError:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// Whether the error shows that the server is unavailable, instead of that a query failed. Only these errors open the
+// circuit breaker; a server that reports that a script failed is still available.
+func isUnavailable(err error) bool {
+	switch e := err.(type) {
+	case *UnavailableError:
+		return true
+	case *ServerError:
+		return e.StatusCode == http.StatusBadGateway || e.StatusCode == http.StatusServiceUnavailable ||
+			e.StatusCode == http.StatusGatewayTimeout
+	}
+	return false
+}
@@ -0,0 +1,817 @@
+package fake_server
+
+import (
+	"fmt"
+	"sort"
+)
+
+// A traverser walks through the graph; it holds the current object, and the path that lead to it.
+type traverser struct {
+	object interface{}
+	path   []*pathEntry
+}
+
+type pathEntry struct {
+	labels []string
+	object interface{}
+}
+
+// Create a new traverser that moved on to the given object.
+func (t *traverser) move(object interface{}) *traverser {
+	p := make([]*pathEntry, len(t.path), len(t.path)+1)
+	copy(p, t.path)
+	return &traverser{
+		object: object,
+		path:   append(p, &pathEntry{object: object}),
+	}
+}
+
+// Label the current object of the traverser.
+func (t *traverser) label(label string) {
+	if len(t.path) == 0 {
+		t.path = []*pathEntry{{object: t.object}}
+	}
+
+	last := t.path[len(t.path)-1]
+	labeled := &pathEntry{labels: append(append([]string{}, last.labels...), label), object: last.object}
+	t.path = append(append([]*pathEntry{}, t.path[:len(t.path)-1]...), labeled)
+}
+
+// Find the object with the given label, the latest one if the label is used more than once.
+func (t *traverser) selectLabel(label string) (interface{}, bool) {
+	for i := len(t.path) - 1; i >= 0; i-- {
+		if containsString(t.path[i].labels, label) {
+			return t.path[i].object, true
+		}
+	}
+	return nil, false
+}
+
+// Evaluate a parsed query against the graph, and return the resulting objects.
+func (g *graph) evaluate(query interface{}) ([]interface{}, error) {
+	switch q := query.(type) {
+	case *sum:
+		var total int64
+		for _, term := range q.terms {
+			total += term
+		}
+		return []interface{}{total}, nil
+	case *traversal:
+		if q.source != "g" {
+			return nil, fmt.Errorf("a query should start with 'g', not with '%s'", q.source)
+		}
+
+		traversers, err := g.run(q, []*traverser{{}})
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]interface{}, 0, len(traversers))
+		for _, t := range traversers {
+			result = append(result, t.object)
+		}
+		return result, nil
+	}
+
+	return nil, fmt.Errorf("unsupported query %#v", query)
+}
+
+// Run all steps of a traversal, starting with the given traversers.
+func (g *graph) run(t *traversal, traversers []*traverser) ([]*traverser, error) {
+	for _, s := range t.steps {
+		if g.failure != nil {
+			if err := g.failure(s.name); err != nil {
+				return nil, err
+			}
+		}
+
+		var err error
+		traversers, err = g.step(s, traversers)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return traversers, nil
+}
+
+// Run a nested traversal for a single traverser. Traversals that start at `g` ignore the traverser.
+func (g *graph) runNested(t *traversal, from *traverser) ([]*traverser, error) {
+	if t.source == "g" {
+		return g.run(t, []*traverser{{}})
+	}
+	// Copy the traverser, so that labels added by the nested traversal do not leak out of it
+	return g.run(t, []*traverser{{object: from.object, path: from.path}})
+}
+
+func (g *graph) step(s *step, traversers []*traverser) ([]*traverser, error) {
+	switch s.name {
+	case "V":
+		return g.flatMap(traversers, func(t *traverser) ([]interface{}, error) {
+			vertices := make([]interface{}, 0, len(g.vertices))
+			for _, v := range g.vertices {
+				vertices = append(vertices, v)
+			}
+			return vertices, nil
+		})
+	case "E":
+		return g.flatMap(traversers, func(t *traverser) ([]interface{}, error) {
+			edges := make([]interface{}, 0, len(g.edges))
+			for _, e := range g.edges {
+				edges = append(edges, e)
+			}
+			return edges, nil
+		})
+	case "addV":
+		return g.addV(s, traversers)
+	case "addE":
+		return g.addE(s, traversers)
+	case "property":
+		key, value, cardinality, err := propertyArgs(s)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range traversers {
+			if err := g.setProperty(t.object, key, value, cardinality); err != nil {
+				return nil, err
+			}
+		}
+		return traversers, nil
+	case "hasLabel":
+		labels, err := stringArgs(s)
+		if err != nil {
+			return nil, err
+		}
+		return filter(traversers, func(t *traverser) bool {
+			l, ok := label(t.object)
+			return ok && containsString(labels, l)
+		}), nil
+	case "has":
+		if len(s.args) != 1 && len(s.args) != 2 {
+			return nil, fmt.Errorf("has() is only supported with a key, or a key and a value")
+		}
+		key, ok := s.args[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("the key of has() should be a string")
+		}
+		if len(s.args) == 1 {
+			return filter(traversers, func(t *traverser) bool {
+				_, ok := propertyValue(t.object, key)
+				return ok
+			}), nil
+		}
+		p, ok := s.args[1].(*predicate)
+		if !ok {
+			p = &predicate{name: "eq", args: s.args[1:]}
+		}
+		// A property with many values matches when one of its values does
+		return filterErr(traversers, func(t *traverser) (bool, error) {
+			for _, value := range propertyValues(t.object, key) {
+				if ok, err := p.test(value); ok || err != nil {
+					return ok, err
+				}
+			}
+			return false, nil
+		})
+	case "is":
+		if len(s.args) != 1 {
+			return nil, fmt.Errorf("is() needs exactly one argument")
+		}
+		p, ok := s.args[0].(*predicate)
+		if !ok {
+			p = &predicate{name: "eq", args: s.args}
+		}
+		return filterErr(traversers, func(t *traverser) (bool, error) {
+			return p.test(t.object)
+		})
+	case "as":
+		labels, err := stringArgs(s)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range traversers {
+			for _, l := range labels {
+				t.label(l)
+			}
+		}
+		return traversers, nil
+	case "select":
+		return g.selectLabels(s, traversers)
+	case "values":
+		keys, err := stringArgs(s)
+		if err != nil {
+			return nil, err
+		}
+		return g.flatMap(traversers, func(t *traverser) ([]interface{}, error) {
+			var values []interface{}
+			for _, key := range keys {
+				values = append(values, propertyValues(t.object, key)...)
+			}
+			return values, nil
+		})
+	case "properties":
+		keys, err := stringArgs(s)
+		if err != nil {
+			return nil, err
+		}
+		return g.flatMap(traversers, func(t *traverser) ([]interface{}, error) {
+			var properties []interface{}
+			for _, key := range keys {
+				if _, ok := propertyValue(t.object, key); ok {
+					properties = append(properties, &elementProperty{element: t.object, key: key})
+				}
+			}
+			return properties, nil
+		})
+	case "range":
+		if len(s.args) != 2 {
+			return nil, fmt.Errorf("range() needs a low and a high bound")
+		}
+		lowFloat, lowOk := toFloat(s.args[0])
+		highFloat, highOk := toFloat(s.args[1])
+		if !lowOk || !highOk {
+			return nil, fmt.Errorf("the bounds of range() should be numbers")
+		}
+		low, high := int64(lowFloat), int64(highFloat)
+		if low > int64(len(traversers)) {
+			low = int64(len(traversers))
+		}
+		if high < 0 || high > int64(len(traversers)) {
+			high = int64(len(traversers))
+		}
+		if high < low {
+			high = low
+		}
+		return traversers[low:high], nil
+	case "limit":
+		if len(s.args) != 1 {
+			return nil, fmt.Errorf("limit() needs exactly one argument")
+		}
+		f, ok := toFloat(s.args[0])
+		if !ok {
+			return nil, fmt.Errorf("the argument of limit() should be a number")
+		}
+		if limit := int(f); limit >= 0 && limit < len(traversers) {
+			return traversers[:limit], nil
+		}
+		return traversers, nil
+	case "count":
+		return []*traverser{(&traverser{}).move(int64(len(traversers)))}, nil
+	case "fold":
+		objects := make([]interface{}, 0, len(traversers))
+		for _, t := range traversers {
+			objects = append(objects, t.object)
+		}
+		return []*traverser{(&traverser{}).move(objects)}, nil
+	case "unfold":
+		return g.flatMap(traversers, func(t *traverser) ([]interface{}, error) {
+			if list, ok := t.object.([]interface{}); ok {
+				return list, nil
+			}
+			return []interface{}{t.object}, nil
+		})
+	case "order":
+		return g.order(s, traversers)
+	case "project":
+		return g.project(s, traversers)
+	case "group", "groupCount":
+		return g.group(s, traversers)
+	case "out", "in", "outE", "inE":
+		labels, err := stringArgs(s)
+		if err != nil {
+			return nil, err
+		}
+		out := s.name == "out" || s.name == "outE"
+		toVertex := s.name == "out" || s.name == "in"
+		return g.flatMap(traversers, func(t *traverser) ([]interface{}, error) {
+			v, ok := t.object.(*vertex)
+			if !ok {
+				return nil, fmt.Errorf("%s() can only be used on vertices", s.name)
+			}
+			var result []interface{}
+			for _, e := range g.vertexEdges(v, out, labels) {
+				switch {
+				case !toVertex:
+					result = append(result, e)
+				case out:
+					result = append(result, e.inV)
+				default:
+					result = append(result, e.outV)
+				}
+			}
+			return result, nil
+		})
+	case "inV", "outV":
+		return g.flatMap(traversers, func(t *traverser) ([]interface{}, error) {
+			e, ok := t.object.(*edge)
+			if !ok {
+				return nil, fmt.Errorf("%s() can only be used on edges", s.name)
+			}
+			if s.name == "inV" {
+				return []interface{}{e.inV}, nil
+			}
+			return []interface{}{e.outV}, nil
+		})
+	case "path":
+		return g.path(s, traversers)
+	case "optional":
+		nested, err := traversalArg(s, 0)
+		if err != nil {
+			return nil, err
+		}
+		var result []*traverser
+		for _, t := range traversers {
+			found, err := g.runNested(nested, t)
+			if err != nil {
+				return nil, err
+			}
+			if len(found) == 0 {
+				result = append(result, t)
+			} else {
+				result = append(result, found...)
+			}
+		}
+		return result, nil
+	case "choose":
+		if len(s.args) != 3 {
+			return nil, fmt.Errorf("choose() is only supported with a condition, a true and a false traversal")
+		}
+		var branches [3]*traversal
+		for i := range branches {
+			var err error
+			if branches[i], err = traversalArg(s, i); err != nil {
+				return nil, err
+			}
+		}
+		var result []*traverser
+		for _, t := range traversers {
+			found, err := g.runNested(branches[0], t)
+			if err != nil {
+				return nil, err
+			}
+			branch := branches[2]
+			if len(found) > 0 {
+				branch = branches[1]
+			}
+			chosen, err := g.runNested(branch, t)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, chosen...)
+		}
+		return result, nil
+	case "coalesce", "union":
+		var result []*traverser
+		for _, t := range traversers {
+			for i := range s.args {
+				nested, err := traversalArg(s, i)
+				if err != nil {
+					return nil, err
+				}
+				found, err := g.runNested(nested, t)
+				if err != nil {
+					return nil, err
+				}
+				result = append(result, found...)
+				if s.name == "coalesce" && len(found) > 0 {
+					break
+				}
+			}
+		}
+		return result, nil
+	case "sideEffect":
+		nested, err := traversalArg(s, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range traversers {
+			if _, err := g.runNested(nested, t); err != nil {
+				return nil, err
+			}
+		}
+		return traversers, nil
+	case "drop":
+		for _, t := range traversers {
+			if err := g.drop(t.object); err != nil {
+				return nil, err
+			}
+		}
+		return []*traverser{}, nil
+	}
+
+	return nil, fmt.Errorf("the step '%s()' is not supported by the fake server", s.name)
+}
+
+// Replace every traverser by traversers for the objects that are returned by the function.
+func (g *graph) flatMap(traversers []*traverser, f func(t *traverser) ([]interface{}, error)) ([]*traverser, error) {
+	var result []*traverser
+	for _, t := range traversers {
+		objects, err := f(t)
+		if err != nil {
+			return nil, err
+		}
+		for _, object := range objects {
+			result = append(result, t.move(object))
+		}
+	}
+	return result, nil
+}
+
+func filter(traversers []*traverser, keep func(t *traverser) bool) []*traverser {
+	var result []*traverser
+	for _, t := range traversers {
+		if keep(t) {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+func filterErr(traversers []*traverser, keep func(t *traverser) (bool, error)) ([]*traverser, error) {
+	var result []*traverser
+	for _, t := range traversers {
+		ok, err := keep(t)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result = append(result, t)
+		}
+	}
+	return result, nil
+}
+
+func (g *graph) addV(s *step, traversers []*traverser) ([]*traverser, error) {
+	vertexLabel, err := stringArg(s, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*traverser
+	for _, t := range traversers {
+		v := g.addVertex(vertexLabel)
+		for _, modulator := range s.modulators {
+			key, value, cardinality, err := propertyArgs(modulator)
+			if err != nil {
+				return nil, err
+			}
+			if err := g.setProperty(v, key, value, cardinality); err != nil {
+				return nil, err
+			}
+		}
+		result = append(result, t.move(v))
+	}
+	return result, nil
+}
+
+// Add an edge for every traverser. The edge goes from and to the current object, unless `from()` or `to()`
+// point to a label or a traversal.
+func (g *graph) addE(s *step, traversers []*traverser) ([]*traverser, error) {
+	edgeLabel, err := stringArg(s, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*traverser
+	for _, t := range traversers {
+		outV, inV := t.object, t.object
+		var properties []*step
+
+		for _, modulator := range s.modulators {
+			if modulator.name == "property" {
+				properties = append(properties, modulator)
+				continue
+			}
+
+			target, err := g.resolveVertex(modulator, t)
+			if err != nil {
+				return nil, err
+			}
+			if modulator.name == "from" {
+				outV = target
+			} else {
+				inV = target
+			}
+		}
+
+		out, outOk := outV.(*vertex)
+		in, inOk := inV.(*vertex)
+		if !outOk || !inOk {
+			return nil, fmt.Errorf("the edge '%s' should be added between two vertices", edgeLabel)
+		}
+
+		e := g.addEdge(edgeLabel, out, in)
+		for _, property := range properties {
+			key, value, cardinality, err := propertyArgs(property)
+			if err != nil {
+				return nil, err
+			}
+			if err := g.setProperty(e, key, value, cardinality); err != nil {
+				return nil, err
+			}
+		}
+		result = append(result, t.move(e))
+	}
+	return result, nil
+}
+
+// Resolve the argument of a `from()` or `to()` modulator, which is either a label or a traversal.
+func (g *graph) resolveVertex(modulator *step, t *traverser) (interface{}, error) {
+	if len(modulator.args) != 1 {
+		return nil, fmt.Errorf("%s() needs exactly one argument", modulator.name)
+	}
+
+	switch arg := modulator.args[0].(type) {
+	case string:
+		object, ok := t.selectLabel(arg)
+		if !ok {
+			return nil, fmt.Errorf("%s() refers to the unknown label '%s'", modulator.name, arg)
+		}
+		return object, nil
+	case *traversal:
+		found, err := g.runNested(arg, t)
+		if err != nil {
+			return nil, err
+		}
+		if len(found) == 0 {
+			return nil, fmt.Errorf("the traversal in %s() did not find a vertex", modulator.name)
+		}
+		return found[0].object, nil
+	}
+
+	return nil, fmt.Errorf("unsupported argument for %s()", modulator.name)
+}
+
+// Select one labeled object, or a map of several labeled objects. Traversers that lack a label are filtered.
+func (g *graph) selectLabels(s *step, traversers []*traverser) ([]*traverser, error) {
+	labels, err := stringArgs(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(labels) == 0 {
+		return nil, fmt.Errorf("select() needs at least one label")
+	}
+
+	return g.flatMap(traversers, func(t *traverser) ([]interface{}, error) {
+		if len(labels) == 1 {
+			object, ok := t.selectLabel(labels[0])
+			if !ok {
+				return nil, nil
+			}
+			return []interface{}{object}, nil
+		}
+
+		selected := map[string]interface{}{}
+		for _, l := range labels {
+			object, ok := t.selectLabel(l)
+			if !ok {
+				return nil, nil
+			}
+			selected[l] = object
+		}
+		return []interface{}{selected}, nil
+	})
+}
+
+// Emit the path of every traverser, starting at the label given by `from()` if there is one.
+func (g *graph) path(s *step, traversers []*traverser) ([]*traverser, error) {
+	fromLabel := ""
+	for _, modulator := range s.modulators {
+		l, err := stringArg(modulator, 0)
+		if err != nil {
+			return nil, err
+		}
+		fromLabel = l
+	}
+
+	return g.flatMap(traversers, func(t *traverser) ([]interface{}, error) {
+		start := 0
+		if fromLabel != "" {
+			start = -1
+			for i := len(t.path) - 1; i >= 0; i-- {
+				if containsString(t.path[i].labels, fromLabel) {
+					start = i
+					break
+				}
+			}
+			if start < 0 {
+				return nil, nil
+			}
+		}
+
+		p := &path{}
+		for _, entry := range t.path[start:] {
+			p.labels = append(p.labels, append([]string{}, entry.labels...))
+			p.objects = append(p.objects, entry.object)
+		}
+		return []interface{}{p}, nil
+	})
+}
+
+// Sort the traversers by the values of the `by()` modulators, or by their objects if there are none. Shuffling keeps
+// the order, so that the results of the fake server are predictable.
+func (g *graph) order(s *step, traversers []*traverser) ([]*traverser, error) {
+	modulators := s.modulators
+	if len(modulators) == 0 {
+		modulators = []*step{{name: "by"}}
+	}
+
+	keys := make([][]interface{}, len(traversers))
+	for i, t := range traversers {
+		for _, modulator := range modulators {
+			value, _, err := g.byValue(modulator, t)
+			if err != nil {
+				return nil, err
+			}
+			keys[i] = append(keys[i], value)
+		}
+	}
+
+	sorted := make([]int, len(traversers))
+	for i := range sorted {
+		sorted[i] = i
+	}
+	sort.SliceStable(sorted, func(a, b int) bool {
+		for m, modulator := range modulators {
+			c, _ := compareValues(keys[sorted[a]][m], keys[sorted[b]][m])
+			if c == 0 {
+				continue
+			}
+			switch byOrder(modulator) {
+			case "decr":
+				return c > 0
+			case "shuffle":
+				return false
+			}
+			return c < 0
+		}
+		return false
+	})
+
+	result := make([]*traverser, 0, len(traversers))
+	for _, i := range sorted {
+		result = append(result, traversers[i])
+	}
+	return result, nil
+}
+
+// The order of a `by()` modulator of `order()`.
+func byOrder(modulator *step) orderToken {
+	if len(modulator.args) == 2 {
+		if o, ok := modulator.args[1].(orderToken); ok {
+			return o
+		}
+	}
+	return "incr"
+}
+
+// Emit a map with the given keys for every traverser; the `by()` modulators set the values of the keys in turn.
+// Keys without a modulator get the object itself, and keys whose modulator has no value are left out.
+func (g *graph) project(s *step, traversers []*traverser) ([]*traverser, error) {
+	keys, err := stringArgs(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.flatMap(traversers, func(t *traverser) ([]interface{}, error) {
+		projected := map[string]interface{}{}
+		for i, key := range keys {
+			modulator := &step{name: "by"}
+			if len(s.modulators) > 0 {
+				modulator = s.modulators[i%len(s.modulators)]
+			}
+
+			value, ok, err := g.byValue(modulator, t)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				projected[key] = value
+			}
+		}
+		return []interface{}{projected}, nil
+	})
+}
+
+// Group the traversers in a single map. The first `by()` modulator sets the key, the second one the values in the
+// lists of `group()`. Unlike Gremlin, the second modulator is applied to every grouped object, not to the whole group.
+func (g *graph) group(s *step, traversers []*traverser) ([]*traverser, error) {
+	keyModulator, valueModulator := &step{name: "by"}, &step{name: "by"}
+	if len(s.modulators) > 0 {
+		keyModulator = s.modulators[0]
+	}
+	if len(s.modulators) > 1 {
+		valueModulator = s.modulators[1]
+	}
+
+	groups := map[string]interface{}{}
+	for _, t := range traversers {
+		key, ok, err := g.byValue(keyModulator, t)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		groupKey := fmt.Sprint(key)
+
+		if s.name == "groupCount" {
+			count, _ := groups[groupKey].(int64)
+			groups[groupKey] = count + 1
+			continue
+		}
+
+		value, ok, err := g.byValue(valueModulator, t)
+		if err != nil {
+			return nil, err
+		}
+		list, _ := groups[groupKey].([]interface{})
+		if ok {
+			list = append(list, value)
+		}
+		groups[groupKey] = list
+	}
+
+	return []*traverser{(&traverser{}).move(groups)}, nil
+}
+
+// The value of a `by()` modulator for a traverser: the object itself, the value of a property, or the first result of
+// a traversal. Returns false if there is no such value.
+func (g *graph) byValue(modulator *step, t *traverser) (interface{}, bool, error) {
+	if len(modulator.args) == 0 {
+		return t.object, true, nil
+	}
+
+	switch arg := modulator.args[0].(type) {
+	case string:
+		value, ok := propertyValue(t.object, arg)
+		return value, ok, nil
+	case *traversal:
+		found, err := g.runNested(arg, t)
+		if err != nil || len(found) == 0 {
+			return nil, false, err
+		}
+		return found[0].object, true, nil
+	case orderToken:
+		return t.object, true, nil
+	}
+
+	return nil, false, fmt.Errorf("unsupported argument for by()")
+}
+
+func stringArg(s *step, i int) (string, error) {
+	if i >= len(s.args) {
+		return "", fmt.Errorf("%s() needs at least %d arguments", s.name, i+1)
+	}
+	str, ok := s.args[i].(string)
+	if !ok {
+		return "", fmt.Errorf("argument %d of %s() should be a string", i+1, s.name)
+	}
+	return str, nil
+}
+
+func stringArgs(s *step) ([]string, error) {
+	strs := make([]string, 0, len(s.args))
+	for i := range s.args {
+		str, err := stringArg(s, i)
+		if err != nil {
+			return nil, err
+		}
+		strs = append(strs, str)
+	}
+	return strs, nil
+}
+
+func traversalArg(s *step, i int) (*traversal, error) {
+	if i >= len(s.args) {
+		return nil, fmt.Errorf("%s() needs at least %d arguments", s.name, i+1)
+	}
+	t, ok := s.args[i].(*traversal)
+	if !ok {
+		return nil, fmt.Errorf("argument %d of %s() should be a traversal", i+1, s.name)
+	}
+	return t, nil
+}
+
+func propertyArgs(s *step) (string, interface{}, cardinalityToken, error) {
+	var cardinality cardinalityToken
+	args := s.args
+	if len(args) == 3 {
+		token, ok := args[0].(cardinalityToken)
+		if !ok {
+			return "", nil, "", fmt.Errorf("the first of three arguments of property() should be a cardinality")
+		}
+		cardinality = token
+		args = args[1:]
+	}
+	if len(args) != 2 {
+		return "", nil, "", fmt.Errorf("property() is only supported with a key and a value, optionally after a cardinality")
+	}
+	key, ok := args[0].(string)
+	if !ok {
+		return "", nil, "", fmt.Errorf("the key of property() should be a string")
+	}
+	if _, ok := args[1].(*traversal); ok {
+		return "", nil, "", fmt.Errorf("the value of property() should be a literal")
+	}
+	return key, args[1], cardinality, nil
+}
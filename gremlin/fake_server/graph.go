@@ -0,0 +1,296 @@
+package fake_server
+
+import (
+	"fmt"
+)
+
+// An in-memory property graph, which is queried by the evaluator.
+type graph struct {
+	vertices []*vertex
+	edges    []*edge
+
+	nextID int64
+	schema graphSchema
+
+	// Called before every step; when it returns an error, the evaluation fails at that step
+	failure func(step string) error
+}
+
+type vertex struct {
+	id         int64
+	label      string
+	properties map[string][]*vertexProperty // more than one value for a property key with the cardinality LIST
+	keys       []string                     // the property keys, in the order in which they were added
+}
+
+type vertexProperty struct {
+	id    string
+	value interface{}
+}
+
+type edge struct {
+	id         string
+	label      string
+	outV       *vertex
+	inV        *vertex
+	properties map[string]interface{}
+	keys       []string // the property keys, in the order in which they were added
+}
+
+// A property of a vertex or an edge, as returned by the `properties()` step.
+type elementProperty struct {
+	element interface{}
+	key     string
+}
+
+// A path through the graph, as returned by the `path()` step.
+type path struct {
+	labels  [][]string
+	objects []interface{}
+}
+
+func newGraph() *graph {
+	return &graph{nextID: 1, schema: newGraphSchema()}
+}
+
+// Copy the graph, so that it can be restored when a transaction is rolled back.
+func (g *graph) clone() *graph {
+	c := &graph{nextID: g.nextID, schema: g.schema.clone(), failure: g.failure}
+
+	vertices := make(map[*vertex]*vertex, len(g.vertices))
+	for _, v := range g.vertices {
+		copied := &vertex{
+			id:         v.id,
+			label:      v.label,
+			properties: make(map[string][]*vertexProperty, len(v.properties)),
+			keys:       append([]string{}, v.keys...),
+		}
+		for key, values := range v.properties {
+			for _, p := range values {
+				copied.properties[key] = append(copied.properties[key], &vertexProperty{id: p.id, value: p.value})
+			}
+		}
+		vertices[v] = copied
+		c.vertices = append(c.vertices, copied)
+	}
+
+	for _, e := range g.edges {
+		copied := &edge{
+			id:         e.id,
+			label:      e.label,
+			outV:       vertices[e.outV],
+			inV:        vertices[e.inV],
+			properties: make(map[string]interface{}, len(e.properties)),
+			keys:       append([]string{}, e.keys...),
+		}
+		for key, value := range e.properties {
+			copied.properties[key] = value
+		}
+		c.edges = append(c.edges, copied)
+	}
+
+	return c
+}
+
+func (g *graph) id() int64 {
+	id := g.nextID
+	g.nextID++
+	return id
+}
+
+func (g *graph) addVertex(label string) *vertex {
+	v := &vertex{
+		id:         g.id(),
+		label:      label,
+		properties: map[string][]*vertexProperty{},
+	}
+	g.vertices = append(g.vertices, v)
+	return v
+}
+
+func (g *graph) addEdge(label string, outV *vertex, inV *vertex) *edge {
+	e := &edge{
+		id:         fmt.Sprintf("e%d", g.id()),
+		label:      label,
+		outV:       outV,
+		inV:        inV,
+		properties: map[string]interface{}{},
+	}
+	g.edges = append(g.edges, e)
+	return e
+}
+
+// Set a property of a vertex or an edge. A vertex property replaces the value that the vertex has, unless its key has
+// the cardinality LIST; then the value is added to the values of the vertex.
+func (g *graph) setProperty(element interface{}, key string, value interface{}, cardinality cardinalityToken) error {
+	value, err := g.schema.convert(key, value)
+	if err != nil {
+		return err
+	}
+
+	isList := g.schema.listKeys[key]
+	if cardinality == "list" && !isList {
+		return fmt.Errorf("the property key [%s] does not have the cardinality LIST", key)
+	}
+
+	switch e := element.(type) {
+	case *vertex:
+		if _, ok := e.properties[key]; !ok {
+			e.keys = append(e.keys, key)
+		}
+		property := &vertexProperty{id: fmt.Sprintf("p%d", g.id()), value: value}
+		if isList {
+			e.properties[key] = append(e.properties[key], property)
+		} else {
+			e.properties[key] = []*vertexProperty{property}
+		}
+	case *edge:
+		if cardinality == "list" {
+			return fmt.Errorf("edge properties can not have the cardinality LIST")
+		}
+		if _, ok := e.properties[key]; !ok {
+			e.keys = append(e.keys, key)
+		}
+		e.properties[key] = value
+	default:
+		return fmt.Errorf("property() can only be set on vertices and edges, not on %#v", element)
+	}
+
+	return nil
+}
+
+// Remove a vertex with its edges, a single edge or a property.
+func (g *graph) drop(element interface{}) error {
+	switch e := element.(type) {
+	case *vertex:
+		vertices := make([]*vertex, 0, len(g.vertices))
+		for _, v := range g.vertices {
+			if v != e {
+				vertices = append(vertices, v)
+			}
+		}
+		g.vertices = vertices
+
+		edges := make([]*edge, 0, len(g.edges))
+		for _, edge := range g.edges {
+			if edge.outV != e && edge.inV != e {
+				edges = append(edges, edge)
+			}
+		}
+		g.edges = edges
+	case *edge:
+		edges := make([]*edge, 0, len(g.edges))
+		for _, edge := range g.edges {
+			if edge != e {
+				edges = append(edges, edge)
+			}
+		}
+		g.edges = edges
+	case *elementProperty:
+		switch owner := e.element.(type) {
+		case *vertex:
+			delete(owner.properties, e.key)
+			owner.keys = removeString(owner.keys, e.key)
+		case *edge:
+			delete(owner.properties, e.key)
+			owner.keys = removeString(owner.keys, e.key)
+		}
+	default:
+		return fmt.Errorf("drop() can only remove vertices, edges and properties, not %#v", element)
+	}
+
+	return nil
+}
+
+// The edges of a vertex in the given direction, limited to the given labels if there are any.
+func (g *graph) vertexEdges(v *vertex, out bool, labels []string) []*edge {
+	var edges []*edge
+	for _, e := range g.edges {
+		if (out && e.outV != v) || (!out && e.inV != v) {
+			continue
+		}
+		if len(labels) > 0 && !containsString(labels, e.label) {
+			continue
+		}
+		edges = append(edges, e)
+	}
+	return edges
+}
+
+// Look up the value of a property of a vertex or an edge; the first value of a property with many values.
+func propertyValue(element interface{}, key string) (interface{}, bool) {
+	values := propertyValues(element, key)
+	if len(values) == 0 {
+		return nil, false
+	}
+	return values[0], true
+}
+
+// Look up all values of a property of a vertex or an edge.
+func propertyValues(element interface{}, key string) []interface{} {
+	switch e := element.(type) {
+	case *vertex:
+		var values []interface{}
+		for _, p := range e.properties[key] {
+			values = append(values, p.value)
+		}
+		return values
+	case *edge:
+		if value, ok := e.properties[key]; ok {
+			return []interface{}{value}
+		}
+	}
+
+	return nil
+}
+
+func label(element interface{}) (string, bool) {
+	switch e := element.(type) {
+	case *vertex:
+		return e.label, true
+	case *edge:
+		return e.label, true
+	}
+
+	return "", false
+}
+
+// Compare two values as Gremlin does, regardless of the type of a number.
+func equalValues(a interface{}, b interface{}) bool {
+	if fa, ok := toFloat(a); ok {
+		fb, ok := toFloat(b)
+		return ok && fa == fb
+	}
+
+	return a == b
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+
+	return 0, false
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func removeString(values []string, value string) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if v != value {
+			result = append(result, v)
+		}
+	}
+	return result
+}
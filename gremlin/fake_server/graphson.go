@@ -0,0 +1,139 @@
+package fake_server
+
+import (
+	"strings"
+
+	"github.com/creativesoftwarefdn/weaviate/gremlin"
+)
+
+// The version of GraphSON that is requested by the mime type of a request. Like the Gremlin Server, GraphSON 1.0 is
+// used when no specific version is requested.
+func graphSONVersion(mimeType string) int {
+	switch {
+	case strings.Contains(mimeType, gremlin.MimeTypeGraphSONv3):
+		return 3
+	case strings.Contains(mimeType, gremlin.MimeTypeGraphSONv2):
+		return 2
+	}
+	return 1
+}
+
+// Convert the objects of the graph to GraphSON, as returned by JanusGraph. GraphSON 1.0 has untyped values, 2.0 wraps
+// values in a typed envelope, and 3.0 also types lists and maps.
+func toGraphSON(object interface{}, version int) interface{} {
+	switch o := object.(type) {
+	case *vertex:
+		properties := map[string]interface{}{}
+		for _, key := range o.keys {
+			values := make([]interface{}, 0, len(o.properties[key]))
+			for _, p := range o.properties[key] {
+				property := map[string]interface{}{
+					"id":    relationIdentifier(p.id, version),
+					"value": toGraphSON(p.value, version),
+				}
+				if version > 1 {
+					property["label"] = key
+				}
+				values = append(values, typedValue("g:VertexProperty", property, version))
+			}
+			properties[key] = values
+		}
+		return typedValue("g:Vertex", map[string]interface{}{
+			"id":         toGraphSON(o.id, version),
+			"label":      o.label,
+			"type":       "vertex",
+			"properties": properties,
+		}, version)
+	case *edge:
+		e := map[string]interface{}{
+			"id":        relationIdentifier(o.id, version),
+			"label":     o.label,
+			"type":      "edge",
+			"inVLabel":  o.inV.label,
+			"outVLabel": o.outV.label,
+			"inV":       toGraphSON(o.inV.id, version),
+			"outV":      toGraphSON(o.outV.id, version),
+		}
+		if len(o.keys) > 0 {
+			properties := map[string]interface{}{}
+			for _, key := range o.keys {
+				value := toGraphSON(o.properties[key], version)
+				if version > 1 {
+					value = typedValue("g:Property", map[string]interface{}{"key": key, "value": value}, version)
+				}
+				properties[key] = value
+			}
+			e["properties"] = properties
+		}
+		if version > 1 {
+			delete(e, "type")
+		}
+		return typedValue("g:Edge", e, version)
+	case *path:
+		labels := make([]interface{}, 0, len(o.labels))
+		for _, l := range o.labels {
+			set := make([]interface{}, 0, len(l))
+			for _, label := range l {
+				set = append(set, label)
+			}
+			labels = append(labels, typedValue("g:Set", set, version-1))
+		}
+		objects := make([]interface{}, 0, len(o.objects))
+		for _, object := range o.objects {
+			objects = append(objects, toGraphSON(object, version))
+		}
+		return typedValue("g:Path", map[string]interface{}{
+			"labels":  typedValue("g:List", labels, version-1),
+			"objects": typedValue("g:List", objects, version-1),
+		}, version)
+	case []interface{}:
+		list := make([]interface{}, 0, len(o))
+		for _, item := range o {
+			list = append(list, toGraphSON(item, version))
+		}
+		return typedValue("g:List", list, version-1)
+	case map[string]interface{}:
+		if version > 2 {
+			// Maps of GraphSON 3.0 are lists of keys and values
+			list := make([]interface{}, 0, 2*len(o))
+			for key, value := range o {
+				list = append(list, key, toGraphSON(value, version))
+			}
+			return typedValue("g:Map", list, version)
+		}
+		m := map[string]interface{}{}
+		for key, value := range o {
+			m[key] = toGraphSON(value, version)
+		}
+		return m
+	case geoPoint:
+		// A point in GeoJSON has the coordinates [longitude, latitude]
+		return typedValue("janusgraph:Geoshape", map[string]interface{}{
+			"type":        "Point",
+			"coordinates": typedValue("g:List", []interface{}{toGraphSON(o.longitude, version), toGraphSON(o.latitude, version)}, version-1),
+		}, version)
+	case int64:
+		return typedValue("g:Int64", o, version)
+	case float64:
+		return typedValue("g:Double", o, version)
+	}
+
+	return object
+}
+
+// Wrap a value in a typed envelope, like {"@type": "g:Int64", "@value": 1}, from GraphSON 2.0 on.
+func typedValue(typeName string, value interface{}, version int) interface{} {
+	if version < 2 {
+		return value
+	}
+	return map[string]interface{}{"@type": typeName, "@value": value}
+}
+
+// The ID of an edge or a vertex property, which JanusGraph types as a relation identifier. GraphSON 1.0 has the ID as a
+// plain string.
+func relationIdentifier(id string, version int) interface{} {
+	if version < 2 {
+		return id
+	}
+	return typedValue("janusgraph:RelationIdentifier", map[string]interface{}{"relationId": id}, version)
+}
@@ -0,0 +1,224 @@
+package fake_server
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// The schema of the graph, as made by management scripts. Property keys restrict the values of properties to their
+// data type; indexes do not change how queries are evaluated.
+type graphSchema struct {
+	vertexLabels map[string]bool
+	propertyKeys map[string]string // the data type of every property key
+	listKeys     map[string]bool   // the property keys with the cardinality LIST
+	indexes      map[string][]string
+}
+
+func newGraphSchema() graphSchema {
+	return graphSchema{
+		vertexLabels: map[string]bool{},
+		propertyKeys: map[string]string{},
+		listKeys:     map[string]bool{},
+		indexes:      map[string][]string{},
+	}
+}
+
+func (s graphSchema) clone() graphSchema {
+	c := newGraphSchema()
+	for label := range s.vertexLabels {
+		c.vertexLabels[label] = true
+	}
+	for key, dataType := range s.propertyKeys {
+		c.propertyKeys[key] = dataType
+	}
+	for key := range s.listKeys {
+		c.listKeys[key] = true
+	}
+	for name, keys := range s.indexes {
+		c.indexes[name] = append([]string{}, keys...)
+	}
+	return c
+}
+
+// The statements of the management scripts that are emitted by the gremlin DSL, one per line.
+var (
+	openManagementStatement = regexp.MustCompile(`^mgmt = graph\.openManagement\(\)$`)
+	createdStatement        = regexp.MustCompile(`^created = \[\]$`)
+	mismatchedStatement     = regexp.MustCompile(`^mismatched = \[\]$`)
+	vertexLabelStatement    = regexp.MustCompile(`^if \(mgmt\.getVertexLabel\((\w+)\) == null\) \{ mgmt\.makeVertexLabel\((\w+)\)\.make\(\); created\.add\((\w+)\) \}$`)
+	propertyKeyStatement    = regexp.MustCompile(`^if \(mgmt\.getPropertyKey\((\w+)\) == null\) \{ mgmt\.makePropertyKey\((\w+)\)\.dataType\((\w+)\.class\)\.cardinality\(Cardinality\.(SINGLE|LIST)\)\.make\(\); created\.add\((\w+)\) \} else if \(mgmt\.getPropertyKey\(\w+\)\.dataType\(\) != \w+\.class \|\| mgmt\.getPropertyKey\(\w+\)\.cardinality\(\) != Cardinality\.(?:SINGLE|LIST)\) \{ mismatched\.add\((\w+)\) \}$`)
+	indexStatement          = regexp.MustCompile(`^if \(mgmt\.getGraphIndex\((\w+)\) == null\) \{ mgmt\.buildIndex\((\w+), Vertex\.class\)((?:\.addKey\(mgmt\.getPropertyKey\(\w+\)\))+)(?:\.unique\(\))?\.buildCompositeIndex\(\); created\.add\((\w+)\) \}$`)
+	indexKeyStatement       = regexp.MustCompile(`\.addKey\(mgmt\.getPropertyKey\((\w+)\)\)`)
+	mismatchStatement       = regexp.MustCompile(`^if \(!mismatched\.isEmpty\(\)\) \{ mgmt\.rollback\(\); throw new IllegalStateException\((\w+) \+ mismatched\.join\(", "\) \+ (\w+)\) \}$`)
+	commitStatement         = regexp.MustCompile(`^mgmt\.commit\(\)$`)
+	returnCreatedStatement  = regexp.MustCompile(`^created$`)
+	awaitIndexStatement     = regexp.MustCompile(`^ManagementSystem\.awaitGraphIndexStatus\(graph, (\w+)\)\.status\(SchemaStatus\.ENABLED\)\.timeout\(\(long\) \w+, ChronoUnit\.MILLIS\)\.call\(\)\.getSucceeded\(\)$`)
+)
+
+// Whether the query is a management script, instead of a traversal.
+func isManagement(query string) bool {
+	return strings.HasPrefix(query, "mgmt = graph.openManagement()") || strings.HasPrefix(query, "ManagementSystem.")
+}
+
+// Run a management script against the schema of the graph.
+func (g *graph) manage(query string, bindings map[string]interface{}) ([]interface{}, error) {
+	var result []interface{}
+	created := []interface{}{}
+	var mismatched []string
+
+	for _, line := range strings.Split(query, "\n") {
+		var err error
+		switch {
+		case openManagementStatement.MatchString(line), createdStatement.MatchString(line), mismatchedStatement.MatchString(line),
+			commitStatement.MatchString(line):
+		case returnCreatedStatement.MatchString(line):
+			result = created
+		case vertexLabelStatement.MatchString(line):
+			created, err = g.makeVertexLabel(vertexLabelStatement.FindStringSubmatch(line), bindings, created)
+		case propertyKeyStatement.MatchString(line):
+			created, mismatched, err = g.makePropertyKey(propertyKeyStatement.FindStringSubmatch(line), bindings, created, mismatched)
+		case mismatchStatement.MatchString(line):
+			if len(mismatched) == 0 {
+				break
+			}
+			values, err := stringBindings(bindings, mismatchStatement.FindStringSubmatch(line)[1:]...)
+			if err != nil {
+				return nil, err
+			}
+			return nil, fmt.Errorf("%s%s%s", values[0], strings.Join(mismatched, ", "), values[1])
+		case indexStatement.MatchString(line):
+			created, err = g.buildIndex(indexStatement.FindStringSubmatch(line), bindings, created)
+		case awaitIndexStatement.MatchString(line):
+			var name string
+			if name, err = stringBinding(bindings, awaitIndexStatement.FindStringSubmatch(line)[1]); err != nil {
+				return nil, err
+			}
+			if _, ok := g.schema.indexes[name]; !ok {
+				return nil, fmt.Errorf("the index '%s' does not exist", name)
+			}
+			result = []interface{}{true}
+		default:
+			return nil, fmt.Errorf("the management statement '%s' is not supported by the fake server", line)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (g *graph) makeVertexLabel(match []string, bindings map[string]interface{}, created []interface{}) ([]interface{}, error) {
+	values, err := stringBindings(bindings, match[2], match[3])
+	if err != nil {
+		return nil, err
+	}
+
+	if !g.schema.vertexLabels[values[0]] {
+		g.schema.vertexLabels[values[0]] = true
+		created = append(created, values[1])
+	}
+	return created, nil
+}
+
+func (g *graph) makePropertyKey(match []string, bindings map[string]interface{}, created []interface{}, mismatched []string) ([]interface{}, []string, error) {
+	values, err := stringBindings(bindings, match[2], match[5], match[6])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	list := match[4] == "LIST"
+	dataType, ok := g.schema.propertyKeys[values[0]]
+	switch {
+	case !ok:
+		g.schema.propertyKeys[values[0]] = match[3]
+		if list {
+			g.schema.listKeys[values[0]] = true
+		}
+		created = append(created, values[1])
+	case dataType != match[3] || g.schema.listKeys[values[0]] != list:
+		mismatched = append(mismatched, values[2])
+	}
+	return created, mismatched, nil
+}
+
+func (g *graph) buildIndex(match []string, bindings map[string]interface{}, created []interface{}) ([]interface{}, error) {
+	values, err := stringBindings(bindings, match[2], match[4])
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := g.schema.indexes[values[0]]; ok {
+		return created, nil
+	}
+
+	var keys []string
+	for _, keyMatch := range indexKeyStatement.FindAllStringSubmatch(match[3], -1) {
+		key, err := stringBinding(bindings, keyMatch[1])
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := g.schema.propertyKeys[key]; !ok {
+			return nil, fmt.Errorf("the index '%s' needs the property key '%s', which does not exist", values[0], key)
+		}
+		keys = append(keys, key)
+	}
+
+	g.schema.indexes[values[0]] = keys
+	return append(created, values[1]), nil
+}
+
+// Convert a value to the data type of its property key, like JanusGraph does. Properties without a key can have any
+// value.
+func (s graphSchema) convert(key string, value interface{}) (interface{}, error) {
+	dataType, ok := s.propertyKeys[key]
+	if !ok {
+		return value, nil
+	}
+
+	f, isNumber := toFloat(value)
+	switch {
+	case dataType == "Object":
+		return value, nil
+	case dataType == "Long" && isNumber && f == float64(int64(f)):
+		return int64(f), nil
+	case dataType == "Double" && isNumber:
+		return f, nil
+	case dataType == "String":
+		if _, ok := value.(string); ok {
+			return value, nil
+		}
+	case dataType == "Boolean":
+		if _, ok := value.(bool); ok {
+			return value, nil
+		}
+	case dataType == "Geoshape":
+		if _, ok := value.(geoPoint); ok {
+			return value, nil
+		}
+	}
+
+	return nil, fmt.Errorf("value [%v] is not an instance of the expected data type for property key [%s] and cannot be converted", value, key)
+}
+
+func stringBinding(bindings map[string]interface{}, name string) (string, error) {
+	value, ok := bindings[name].(string)
+	if !ok {
+		return "", fmt.Errorf("the binding '%s' should be a string", name)
+	}
+	return value, nil
+}
+
+func stringBindings(bindings map[string]interface{}, names ...string) ([]string, error) {
+	values := make([]string, 0, len(names))
+	for _, name := range names {
+		value, err := stringBinding(bindings, name)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
@@ -0,0 +1,448 @@
+package fake_server
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// A traversal, as parsed from a query. The source is either "g" for traversals that start at the graph, or "__" for
+// anonymous traversals that start at the current traverser.
+type traversal struct {
+	source string
+	steps  []*step
+}
+
+// A single step of a traversal, like `has("uuid", "...")`.
+type step struct {
+	name string
+	args []interface{} // string, int64, float64, bool, geoPoint, *geoShape, *traversal, *predicate or a token
+
+	// Modulating steps that follow the step, like `from()` and `to()` after `addE()`, and `property()` after `addV()`
+	modulators []*step
+}
+
+// A predicate, like `P.gt(_0)` or `Text.textContains(_0)`.
+type predicate struct {
+	name string
+	args []interface{}
+}
+
+// A token of the Order step, like `incr` or `decr`.
+type orderToken string
+
+// A point of the Geoshape data type, like `Geoshape.point(_0, _1)`.
+type geoPoint struct {
+	latitude  float64
+	longitude float64
+}
+
+// A geographic shape to filter points with, like `Geoshape.circle(_0, _1, _2)` in `Geo.geoWithin()`.
+type geoShape struct {
+	name string
+	args []float64
+}
+
+// The cardinality of the property step, like `list` in `property(list, _0, _1)`.
+type cardinalityToken string
+
+// An expression that is not a traversal, like the `1+41` that is used by the client to ping the server.
+type sum struct {
+	terms []int64
+}
+
+// Parse a query as it is emitted by the gremlin DSL, with the values of its bindings. Returns either a *traversal or a
+// *sum.
+func parse(query string, bindings map[string]interface{}) (interface{}, error) {
+	p := &parser{input: query, bindings: bindings}
+
+	p.skipSpace()
+	if p.peek() >= '0' && p.peek() <= '9' {
+		result, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		return result, p.expectEnd()
+	}
+
+	t, err := p.parseTraversal()
+	if err != nil {
+		return nil, err
+	}
+
+	return t, p.expectEnd()
+}
+
+type parser struct {
+	input    string
+	pos      int
+	bindings map[string]interface{}
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("could not parse query at position %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) peek() byte {
+	if p.pos >= len(p.input) {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+func (p *parser) skipSpace() {
+	for p.pos < len(p.input) && unicode.IsSpace(rune(p.input[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *parser) expect(c byte) error {
+	p.skipSpace()
+	if p.peek() != c {
+		return p.errorf("expected '%c'", c)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *parser) expectEnd() error {
+	p.skipSpace()
+	if p.pos != len(p.input) {
+		return p.errorf("unexpected '%s'", p.input[p.pos:])
+	}
+	return nil
+}
+
+func (p *parser) parseIdentifier() string {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.input) {
+		c := rune(p.input[p.pos])
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) && c != '_' {
+			break
+		}
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+func (p *parser) parseSum() (*sum, error) {
+	s := &sum{}
+	for {
+		n, err := p.parseNumber()
+		if err != nil {
+			return nil, err
+		}
+
+		i, ok := n.(int64)
+		if !ok {
+			return nil, p.errorf("only integers can be added")
+		}
+		s.terms = append(s.terms, i)
+
+		p.skipSpace()
+		if p.peek() != '+' {
+			return s, nil
+		}
+		p.pos++
+	}
+}
+
+// Parse a traversal like `g.V().has("a", 1)`, `__.outE()` or the anonymous `outE().as("a")`.
+func (p *parser) parseTraversal() (*traversal, error) {
+	t := &traversal{source: "__"}
+
+	start := p.pos
+	name := p.parseIdentifier()
+	p.skipSpace()
+
+	switch {
+	case (name == "g" || name == "__") && p.peek() == '.':
+		t.source = name
+		p.pos++
+	case name == "__":
+		return t, nil
+	default:
+		// An anonymous traversal that starts with a step
+		p.pos = start
+	}
+
+	for {
+		s, err := p.parseStep()
+		if err != nil {
+			return nil, err
+		}
+		t.addStep(s)
+
+		p.skipSpace()
+		if p.peek() != '.' {
+			return t, nil
+		}
+		p.pos++
+	}
+}
+
+// Add a step, or fold it into the previous step if it modulates that step.
+func (t *traversal) addStep(s *step) {
+	if len(t.steps) > 0 {
+		previous := t.steps[len(t.steps)-1]
+		switch {
+		case (previous.name == "addE") && (s.name == "from" || s.name == "to" || s.name == "property"):
+			previous.modulators = append(previous.modulators, s)
+			return
+		case previous.name == "addV" && s.name == "property":
+			previous.modulators = append(previous.modulators, s)
+			return
+		case previous.name == "path" && s.name == "from":
+			previous.modulators = append(previous.modulators, s)
+			return
+		case (previous.name == "order" || previous.name == "project" || previous.name == "group" || previous.name == "groupCount") && s.name == "by":
+			previous.modulators = append(previous.modulators, s)
+			return
+		}
+	}
+
+	t.steps = append(t.steps, s)
+}
+
+func (p *parser) parseStep() (*step, error) {
+	name := p.parseIdentifier()
+	if name == "" {
+		return nil, p.errorf("expected a step")
+	}
+
+	s := &step{name: name}
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+
+	p.skipSpace()
+	if p.peek() == ')' {
+		p.pos++
+		return s, nil
+	}
+
+	for {
+		arg, err := p.parseArgument()
+		if err != nil {
+			return nil, err
+		}
+		s.args = append(s.args, arg)
+
+		p.skipSpace()
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case ')':
+			p.pos++
+			return s, nil
+		default:
+			return nil, p.errorf("expected ',' or ')' in the arguments of '%s'", name)
+		}
+	}
+}
+
+func (p *parser) parseArgument() (interface{}, error) {
+	p.skipSpace()
+	c := p.peek()
+
+	switch {
+	case c == '"' || c == '\'':
+		return p.parseString()
+	case c == '-' || (c >= '0' && c <= '9'):
+		return p.parseNumber()
+	case c == '(':
+		return p.parseCast()
+	}
+
+	start := p.pos
+	identifier := p.parseIdentifier()
+	switch identifier {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "incr", "decr", "shuffle":
+		return orderToken(identifier), nil
+	case "list", "single":
+		return cardinalityToken(identifier), nil
+	case "P", "Text", "Geo":
+		return p.parsePredicate()
+	case "Geoshape":
+		return p.parseGeoshape()
+	}
+
+	// An identifier that is not followed by a step is a binding
+	p.skipSpace()
+	if identifier != "" && identifier != "__" && p.peek() != '(' && p.peek() != '.' {
+		return p.binding(identifier)
+	}
+
+	p.pos = start
+	return p.parseTraversal()
+}
+
+// Parse the rest of a predicate, after the `P` or `Text`.
+func (p *parser) parsePredicate() (*predicate, error) {
+	if err := p.expect('.'); err != nil {
+		return nil, err
+	}
+
+	s, err := p.parseStep()
+	if err != nil {
+		return nil, err
+	}
+
+	return &predicate{name: s.name, args: s.args}, nil
+}
+
+// Parse the rest of a geographic shape, after the `Geoshape`. A point is a value, other shapes are only used in
+// predicates.
+func (p *parser) parseGeoshape() (interface{}, error) {
+	if err := p.expect('.'); err != nil {
+		return nil, err
+	}
+
+	s, err := p.parseStep()
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]float64, 0, len(s.args))
+	for _, arg := range s.args {
+		f, ok := toFloat(arg)
+		if !ok {
+			return nil, p.errorf("the arguments of Geoshape.%s() should be numbers", s.name)
+		}
+		args = append(args, f)
+	}
+
+	switch {
+	case s.name == "point" && len(args) == 2:
+		return geoPoint{latitude: args[0], longitude: args[1]}, nil
+	case s.name == "circle" && len(args) == 3, s.name == "box" && len(args) == 4:
+		return &geoShape{name: s.name, args: args}, nil
+	}
+	return nil, p.errorf("the shape Geoshape.%s() with %d arguments is not supported", s.name, len(args))
+}
+
+// Look up the value of a binding.
+func (p *parser) binding(name string) (interface{}, error) {
+	value, ok := p.bindings[name]
+	if !ok {
+		return nil, p.errorf("no such property: %s", name)
+	}
+
+	switch v := value.(type) {
+	case string, bool, float64, int64:
+		return value, nil
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i, nil
+		}
+		if f, err := v.Float64(); err == nil {
+			return f, nil
+		}
+	}
+
+	return nil, p.errorf("unsupported value %#v for binding '%s'", value, name)
+}
+
+// Parse a string literal, undoing the escaping of the gremlin DSL.
+func (p *parser) parseString() (string, error) {
+	quote := p.peek()
+	p.pos++
+
+	var builder strings.Builder
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		p.pos++
+
+		switch c {
+		case quote:
+			return builder.String(), nil
+		case '\\':
+			if p.pos >= len(p.input) {
+				return "", p.errorf("unterminated string")
+			}
+			builder.WriteByte(p.input[p.pos])
+			p.pos++
+		default:
+			builder.WriteByte(c)
+		}
+	}
+
+	return "", p.errorf("unterminated string")
+}
+
+func (p *parser) parseNumber() (interface{}, error) {
+	p.skipSpace()
+	start := p.pos
+	if p.peek() == '-' {
+		p.pos++
+	}
+
+	isFloat := false
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		if c == '.' || c == 'e' || c == 'E' || ((c == '+' || c == '-') && isFloat && (p.input[p.pos-1] == 'e' || p.input[p.pos-1] == 'E')) {
+			isFloat = true
+		} else if c < '0' || c > '9' {
+			break
+		}
+		p.pos++
+	}
+
+	literal := p.input[start:p.pos]
+	if isFloat {
+		f, err := strconv.ParseFloat(literal, 64)
+		if err != nil {
+			return nil, p.errorf("invalid number '%s'", literal)
+		}
+		return f, nil
+	}
+
+	i, err := strconv.ParseInt(literal, 10, 64)
+	if err != nil {
+		return nil, p.errorf("invalid number '%s'", literal)
+	}
+	return i, nil
+}
+
+// Parse a casted number or binding, like `(long) 42` or `(double) _1`.
+func (p *parser) parseCast() (interface{}, error) {
+	p.pos++
+	cast := p.parseIdentifier()
+	if err := p.expect(')'); err != nil {
+		return nil, err
+	}
+
+	var n interface{}
+	var err error
+	p.skipSpace()
+	if c := p.peek(); c == '-' || (c >= '0' && c <= '9') {
+		n, err = p.parseNumber()
+	} else {
+		n, err = p.binding(p.parseIdentifier())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch cast {
+	case "long", "int":
+		if f, ok := n.(float64); ok {
+			return int64(f), nil
+		}
+		return n, nil
+	case "double", "float":
+		if i, ok := n.(int64); ok {
+			return float64(i), nil
+		}
+		return n, nil
+	}
+
+	return nil, p.errorf("unsupported cast to '%s'", cast)
+}
@@ -0,0 +1,139 @@
+package fake_server
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// The mean radius of the earth in kilometers, as used by JanusGraph for distances between points
+const earthRadius = 6371.0087714
+
+// Test a value against a predicate, like `P.gt(_0)` or `Text.textContains(_0)`.
+func (p *predicate) test(value interface{}) (bool, error) {
+	switch p.name {
+	case "eq", "neq", "gt", "gte", "lt", "lte", "textContains", "textContainsPrefix", "geoWithin":
+		if len(p.args) != 1 {
+			return false, fmt.Errorf("the predicate %s() needs exactly one argument", p.name)
+		}
+	case "between":
+		if len(p.args) != 2 {
+			return false, fmt.Errorf("the predicate between() needs a lower and an upper bound")
+		}
+	}
+
+	switch p.name {
+	case "eq":
+		return equalValues(value, p.args[0]), nil
+	case "neq":
+		return !equalValues(value, p.args[0]), nil
+	case "gt", "gte", "lt", "lte":
+		c, ok := compareValues(value, p.args[0])
+		if !ok {
+			return false, nil
+		}
+		switch p.name {
+		case "gt":
+			return c > 0, nil
+		case "gte":
+			return c >= 0, nil
+		case "lt":
+			return c < 0, nil
+		}
+		return c <= 0, nil
+	case "between":
+		lower, lowerOk := compareValues(value, p.args[0])
+		upper, upperOk := compareValues(value, p.args[1])
+		return lowerOk && upperOk && lower >= 0 && upper < 0, nil
+	case "within", "without":
+		found := false
+		for _, arg := range p.args {
+			if equalValues(value, arg) {
+				found = true
+				break
+			}
+		}
+		return found == (p.name == "within"), nil
+	case "textContains", "textContainsPrefix":
+		text, ok := value.(string)
+		word, wordOk := p.args[0].(string)
+		if !ok || !wordOk {
+			return false, nil
+		}
+		word = strings.ToLower(word)
+		for _, w := range words(text) {
+			if w == word || (p.name == "textContainsPrefix" && strings.HasPrefix(w, word)) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case "geoWithin":
+		shape, ok := p.args[0].(*geoShape)
+		if !ok {
+			return false, fmt.Errorf("the predicate geoWithin() needs a circle or a box")
+		}
+		point, ok := value.(geoPoint)
+		if !ok {
+			return false, nil
+		}
+		return shape.contains(point), nil
+	}
+
+	return false, fmt.Errorf("the predicate '%s()' is not supported by the fake server", p.name)
+}
+
+// Whether the point is within the circle around a point with a radius in kilometers, or within the box from its south
+// west to its north east corner.
+func (s *geoShape) contains(point geoPoint) bool {
+	if s.name == "circle" {
+		return distance(geoPoint{latitude: s.args[0], longitude: s.args[1]}, point) <= s.args[2]
+	}
+
+	return point.latitude >= s.args[0] && point.latitude <= s.args[2] &&
+		point.longitude >= s.args[1] && point.longitude <= s.args[3]
+}
+
+// The distance in kilometers between two points on earth, by the haversine formula.
+func distance(a geoPoint, b geoPoint) float64 {
+	radians := func(degrees float64) float64 { return degrees * math.Pi / 180 }
+	dLatitude := radians(b.latitude - a.latitude)
+	dLongitude := radians(b.longitude - a.longitude)
+
+	h := math.Sin(dLatitude/2)*math.Sin(dLatitude/2) +
+		math.Cos(radians(a.latitude))*math.Cos(radians(b.latitude))*math.Sin(dLongitude/2)*math.Sin(dLongitude/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
+
+// Compare two numbers or two strings; the result is negative, zero or positive like strings.Compare.
+func compareValues(a interface{}, b interface{}) (int, bool) {
+	if fa, ok := toFloat(a); ok {
+		fb, ok := toFloat(b)
+		switch {
+		case !ok:
+			return 0, false
+		case fa < fb:
+			return -1, true
+		case fa > fb:
+			return 1, true
+		}
+		return 0, true
+	}
+
+	sa, ok := a.(string)
+	if !ok {
+		return 0, false
+	}
+	sb, ok := b.(string)
+	if !ok {
+		return 0, false
+	}
+	return strings.Compare(sa, sb), true
+}
+
+// Split a text in lower case words, like the full text index of JanusGraph does.
+func words(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
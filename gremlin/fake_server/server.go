@@ -0,0 +1,316 @@
+// Package fake_server contains a fake Gremlin Server, to test code that uses the gremlin package without a running
+// JanusGraph. The server speaks both the Gremlin HTTP and WebSocket protocol, and answers queries in one of three ways:
+//
+//   - NewServer evaluates the queries against an in-memory graph. Only the subset of Gremlin that is emitted by the
+//     gremlin DSL is supported, including its JanusGraph management scripts. Like the Gremlin Server, a query without
+//     a session is a transaction by itself, which is rolled back when the query fails. Queries in a session share one
+//     transaction, until it is committed or rolled back with g.tx().commit() or g.tx().rollback(), or until the
+//     session is closed. Transactions are not isolated; a rollback restores the whole graph. Use InjectFailure to make
+//     queries fail halfway.
+//   - NewRecordingServer forwards the queries to a real Gremlin Server, and records the request/response pairs, which
+//     can be saved as fixtures with SaveFixtures.
+//   - NewReplayServer answers the queries with the responses of fixtures, which can be loaded with LoadFixtures.
+package fake_server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+// Server is a fake Gremlin Server. Point a client at its URL, and close it when done.
+type Server struct {
+	*httptest.Server
+
+	mutex  sync.Mutex
+	answer func(request gremlinRequest, body []byte) (int, []byte)
+
+	graph    *graph
+	failure  func(step string) error
+	fixtures []Fixture
+	replay   map[string][]Fixture
+
+	// The graph as it was at the start of the open transaction of every session
+	transactions map[string]*graph
+}
+
+// Fixture is a recorded request/response pair.
+type Fixture struct {
+	Query      string                 `json:"query"`
+	Bindings   map[string]interface{} `json:"bindings,omitempty"`
+	StatusCode int                    `json:"statusCode"`
+	Response   json.RawMessage        `json:"response"`
+}
+
+type gremlinRequest struct {
+	Gremlin  string                 `json:"gremlin"`
+	Bindings map[string]interface{} `json:"bindings"`
+
+	// The ID of the session of the request, if any
+	session string
+
+	// The mime type that the response is requested in
+	mimeType string
+}
+
+// The key by which fixtures are looked up; the query with its bindings.
+func (r gremlinRequest) key() string {
+	if len(r.Bindings) == 0 {
+		return r.Gremlin
+	}
+
+	bindings, _ := json.Marshal(r.Bindings)
+	return r.Gremlin + " " + string(bindings)
+}
+
+// NewServer starts a server that evaluates the queries against an empty in-memory graph.
+func NewServer() *Server {
+	s := &Server{graph: newGraph(), transactions: map[string]*graph{}}
+	s.answer = s.evaluate
+	return s.start()
+}
+
+// NewRecordingServer starts a server that forwards all queries to the Gremlin Server at the upstream URL, and records
+// the responses. Use Fixtures to get the recordings.
+func NewRecordingServer(upstream string) *Server {
+	s := &Server{}
+	client := &http.Client{}
+
+	s.answer = func(request gremlinRequest, body []byte) (int, []byte) {
+		upstreamRequest, err := http.NewRequest(http.MethodPost, upstream, bytes.NewReader(body))
+		if err != nil {
+			return errorResponse(err)
+		}
+		upstreamRequest.Header.Set("Content-Type", "application/json")
+		upstreamRequest.Header.Set("Accept", request.mimeType)
+
+		response, err := client.Do(upstreamRequest)
+		if err != nil {
+			return errorResponse(fmt.Errorf("could not forward the query to '%s': %v", upstream, err))
+		}
+		defer response.Body.Close()
+
+		responseBody, err := ioutil.ReadAll(response.Body)
+		if err != nil {
+			return errorResponse(fmt.Errorf("could not read the response of '%s': %v", upstream, err))
+		}
+
+		s.fixtures = append(s.fixtures, Fixture{
+			Query:      request.Gremlin,
+			Bindings:   request.Bindings,
+			StatusCode: response.StatusCode,
+			Response:   json.RawMessage(responseBody),
+		})
+
+		return response.StatusCode, responseBody
+	}
+
+	return s.start()
+}
+
+// NewReplayServer starts a server that answers queries with the response of the fixture of the same query and
+// bindings. When a
+// query is recorded more than once, the responses are replayed in the recorded order. Queries without a fixture give
+// a server error.
+func NewReplayServer(fixtures []Fixture) *Server {
+	s := &Server{replay: map[string][]Fixture{}}
+	for _, fixture := range fixtures {
+		key := gremlinRequest{Gremlin: fixture.Query, Bindings: fixture.Bindings}.key()
+		s.replay[key] = append(s.replay[key], fixture)
+	}
+
+	s.answer = func(request gremlinRequest, body []byte) (int, []byte) {
+		key := request.key()
+		recorded := s.replay[key]
+		if len(recorded) == 0 {
+			return errorResponse(fmt.Errorf("no fixture is recorded for the query '%s' with the bindings %v", request.Gremlin, request.Bindings))
+		}
+
+		fixture := recorded[0]
+		if len(recorded) > 1 {
+			s.replay[key] = recorded[1:]
+		}
+
+		return fixture.StatusCode, fixture.Response
+	}
+
+	return s.start()
+}
+
+// Fixtures returns the request/response pairs that are recorded by a recording server.
+func (s *Server) Fixtures() []Fixture {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return append([]Fixture{}, s.fixtures...)
+}
+
+// LoadFixtures reads fixtures from a JSON file, as written by SaveFixtures.
+func LoadFixtures(path string) ([]Fixture, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read fixtures from '%s': %v", path, err)
+	}
+
+	var fixtures []Fixture
+	if err := json.Unmarshal(data, &fixtures); err != nil {
+		return nil, fmt.Errorf("could not parse fixtures in '%s': %v", path, err)
+	}
+
+	return fixtures, nil
+}
+
+// SaveFixtures writes fixtures to a JSON file.
+func SaveFixtures(path string, fixtures []Fixture) error {
+	data, err := json.MarshalIndent(fixtures, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode fixtures: %v", err)
+	}
+
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("could not write fixtures to '%s': %v", path, err)
+	}
+
+	return nil
+}
+
+// InjectFailure makes the in-memory graph call the function before it evaluates each step of a query. When the
+// function returns an error, the query fails at that step. Pass nil to stop injecting failures.
+func (s *Server) InjectFailure(failure func(step string) error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.failure = failure
+}
+
+func (s *Server) start() *Server {
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if websocket.IsWebSocketUpgrade(r) {
+		s.serveWebSocket(w, r)
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var request gremlinRequest
+	if err := decodeJSON(body, &request); err != nil || request.Gremlin == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	request.mimeType = r.Header.Get("Accept")
+
+	s.mutex.Lock()
+	statusCode, response := s.answer(request, body)
+	s.mutex.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	w.Write(response)
+}
+
+// Decode a request, keeping the exact value of numbers as json.Number.
+func decodeJSON(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+// Evaluate a query against the in-memory graph.
+func (s *Server) evaluate(request gremlinRequest, body []byte) (int, []byte) {
+	var parsed interface{}
+	var err error
+	if !isManagement(request.Gremlin) {
+		if parsed, err = parse(request.Gremlin, request.Bindings); err != nil {
+			return errorResponse(err)
+		}
+	}
+
+	var objects []interface{}
+	if t, ok := parsed.(*traversal); ok && isTransaction(t) {
+		if t.steps[1].name == "rollback" {
+			s.rollback(request.session)
+		}
+		delete(s.transactions, request.session)
+	} else {
+		// Remember the graph as it was before the transaction started
+		snapshot, open := s.transactions[request.session]
+		if !open {
+			snapshot = s.graph.clone()
+			if request.session != "" {
+				s.transactions[request.session] = snapshot
+			}
+		}
+
+		s.graph.failure = s.failure
+		if parsed == nil {
+			objects, err = s.graph.manage(request.Gremlin, request.Bindings)
+		} else {
+			objects, err = s.graph.evaluate(parsed)
+		}
+		if err != nil {
+			// Without a session, the query is a transaction by itself. In a session, the client rolls back.
+			if request.session == "" {
+				s.graph = snapshot
+			}
+			return errorResponse(err)
+		}
+	}
+
+	version := graphSONVersion(request.mimeType)
+	data := make([]interface{}, 0, len(objects))
+	for _, object := range objects {
+		data = append(data, toGraphSON(object, version))
+	}
+
+	response, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{"message": "", "code": http.StatusOK, "attributes": map[string]interface{}{}},
+		"result": map[string]interface{}{"data": typedValue("g:List", data, version-1), "meta": map[string]interface{}{}},
+	})
+	if err != nil {
+		return errorResponse(err)
+	}
+
+	return http.StatusOK, response
+}
+
+// Whether the traversal is `g.tx().commit()` or `g.tx().rollback()`.
+func isTransaction(t *traversal) bool {
+	return t.source == "g" && len(t.steps) == 2 && t.steps[0].name == "tx" &&
+		(t.steps[1].name == "commit" || t.steps[1].name == "rollback")
+}
+
+// Undo the changes of the open transaction of a session.
+func (s *Server) rollback(session string) {
+	if snapshot, ok := s.transactions[session]; ok {
+		s.graph = snapshot
+		delete(s.transactions, session)
+	}
+}
+
+// A server error, in the form in which the Gremlin Server reports script errors.
+func errorResponse(err error) (int, []byte) {
+	response, _ := json.Marshal(map[string]interface{}{
+		"message":         err.Error(),
+		"Exception-Class": "org.apache.tinkerpop.gremlin.server.ScriptException",
+	})
+	return http.StatusInternalServerError, response
+}
@@ -0,0 +1,499 @@
+package fake_server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/creativesoftwarefdn/weaviate/gremlin"
+	"github.com/creativesoftwarefdn/weaviate/gremlin/http_client"
+)
+
+func TestPing(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	require.NoError(t, http_client.NewClient(server.URL).Ping(context.Background()))
+}
+
+func TestEvaluateVerticesAndEdges(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := http_client.NewClient(server.URL)
+
+	_, err := client.Execute(context.Background(), gremlin.G.AddV("person").
+		As("alice").
+		StringProperty("name", "Alice \"Al\" $x").
+		Int64Property("age", -42).
+		Float64Property("height", 1.7).
+		BoolProperty("active", true).
+		AddV("person").
+		As("bob").
+		StringProperty("name", "Bob").
+		AddE("knows").
+		FromRef("alice").
+		ToRef("bob").
+		StringProperty("since", "2018"))
+	require.NoError(t, err)
+
+	result, err := client.Execute(context.Background(), gremlin.G.V().HasLabel("person").HasString("name", "Alice \"Al\" $x"))
+	require.NoError(t, err)
+	vertices, err := result.Vertices()
+	require.NoError(t, err)
+	require.Len(t, vertices, 1)
+	require.Equal(t, int64(-42), vertices[0].AssertPropertyValue("age").AssertInt64())
+	require.Equal(t, 1.7, vertices[0].AssertPropertyValue("height").AssertFloat())
+	require.True(t, vertices[0].AssertPropertyValue("active").AssertBool())
+
+	result, err = client.Execute(context.Background(), gremlin.G.V().HasString("name", "Alice \"Al\" $x").OutE().As("e").InV().Path().FromRef("e"))
+	require.NoError(t, err)
+	p := result.AssertFirst().AssertPath()
+	require.Len(t, p.Segments, 2)
+	require.Equal(t, "2018", p.Segments[0].AssertEdge().AssertPropertyValue("since").AssertString())
+	require.Equal(t, "Bob", p.Segments[1].AssertVertex().AssertPropertyValue("name").AssertString())
+
+	result, err = client.Execute(context.Background(), gremlin.G.V().HasString("name", "Bob").In().Values([]string{"name"}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"Alice \"Al\" $x"}, result.AssertStringSlice())
+
+	result, err = client.Execute(context.Background(), gremlin.G.V().HasBool("active", true).Count())
+	require.NoError(t, err)
+	count, err := result.OneInt()
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	// Dropping a vertex drops its edges
+	_, err = client.Execute(context.Background(), gremlin.G.V().HasString("name", "Bob").Drop())
+	require.NoError(t, err)
+	result, err = client.Execute(context.Background(), gremlin.G.V().OutE().Count())
+	require.NoError(t, err)
+	count, err = result.OneInt()
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+}
+
+func TestEvaluateOptionalChooseAndSelect(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := http_client.NewClient(server.URL)
+
+	_, err := client.Execute(context.Background(), gremlin.G.AddV("city").As("a").StringProperty("name", "Amsterdam").
+		AddV("city").As("r").StringProperty("name", "Rotterdam").
+		AddE("near").FromRef("a").ToRef("r"))
+	require.NoError(t, err)
+
+	query := gremlin.G.V().HasLabel("city").As("city").
+		Optional(gremlin.Current().OutEWithLabel("near").As("ref")).
+		Choose(
+			gremlin.Current().Select([]string{"ref"}),
+			gremlin.Current().Select([]string{"city", "ref"}),
+			gremlin.Current().Select([]string{"city"}),
+		)
+	result, err := client.Execute(context.Background(), query)
+	require.NoError(t, err)
+	require.Len(t, result.Data, 2)
+
+	withRef := result.Data[0]
+	require.Equal(t, "Amsterdam", withRef.AssertKey("city").AssertVertex().AssertPropertyValue("name").AssertString())
+	require.Equal(t, "near", withRef.AssertKey("ref").AssertEdge().Label)
+
+	withoutRef := result.Data[1]
+	require.Equal(t, "Rotterdam", withoutRef.AssertVertex().AssertPropertyValue("name").AssertString())
+
+	// Range pages through the results
+	result, err = client.Execute(context.Background(), gremlin.G.V().HasLabel("city").Range(1, 5).Values([]string{"name"}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"Rotterdam"}, result.AssertStringSlice())
+}
+
+func TestEvaluatePredicatesAndAggregations(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := http_client.NewClient(server.URL)
+
+	for i, name := range []string{"Amsterdam", "Rotterdam", "Utrecht", "Den Haag"} {
+		_, err := client.Execute(context.Background(), gremlin.G.AddV("city").
+			StringProperty("name", name).
+			StringProperty("description", "The city of "+name).
+			Int64Property("rank", int64(i+1)))
+		require.NoError(t, err)
+	}
+
+	result, err := client.Execute(context.Background(), gremlin.G.V().Has("rank", gremlin.Between(2, 4)).
+		Order().ByWithOrder("name", gremlin.Descending).Values([]string{"name"}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"Utrecht", "Rotterdam"}, result.AssertStringSlice())
+
+	result, err = client.Execute(context.Background(), gremlin.G.V().Has("name", gremlin.Within("Utrecht", "Amsterdam")).
+		Order().By("rank").Limit(1).Values([]string{"name"}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"Amsterdam"}, result.AssertStringSlice())
+
+	result, err = client.Execute(context.Background(), gremlin.G.V().Has("description", gremlin.TextContains("HAAG")).Values([]string{"name"}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"Den Haag"}, result.AssertStringSlice())
+
+	result, err = client.Execute(context.Background(), gremlin.G.V().Values([]string{"rank"}).Is(gremlin.Gt(2)).Fold())
+	require.NoError(t, err)
+	require.Len(t, result.Data, 1)
+
+	result, err = client.Execute(context.Background(), gremlin.G.V().HasLabel("city").
+		Coalesce(gremlin.Current().Has("rank", gremlin.Lt(2)).Values([]string{"name"}), gremlin.Current().Values([]string{"rank"})).
+		Fold().Unfold().Count())
+	require.NoError(t, err)
+	count, err := result.OneInt()
+	require.NoError(t, err)
+	require.Equal(t, 4, count)
+
+	result, err = client.Execute(context.Background(), gremlin.G.V().Has("rank", gremlin.Eq(1)).
+		Project([]string{"name", "rank"}).By("name").By("rank"))
+	require.NoError(t, err)
+	require.Equal(t, "Amsterdam", result.AssertFirst().AssertKey("name").Datum)
+
+	result, err = client.Execute(context.Background(), gremlin.G.V().GroupCount().By("description"))
+	require.NoError(t, err)
+	require.Len(t, result.AssertFirst().Datum, 4)
+
+	result, err = client.Execute(context.Background(), gremlin.G.V().
+		Union(gremlin.Current().Has("rank", gremlin.Lte(1)), gremlin.Current().Has("rank", gremlin.Gte(4))).
+		SideEffect(gremlin.Current().Drop()).Count())
+	require.NoError(t, err)
+	count, err = result.OneInt()
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	result, err = client.Execute(context.Background(), gremlin.G.V().Count())
+	require.NoError(t, err)
+	count, err = result.OneInt()
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	_, err = client.Execute(context.Background(), gremlin.G.AddV("city"))
+	require.NoError(t, err)
+	result, err = client.Execute(context.Background(), gremlin.G.V().HasKey("name").Count())
+	require.NoError(t, err)
+	count, err = result.OneInt()
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	_, err = client.Execute(context.Background(), gremlin.G.V().HasString("name", "Utrecht").Properties([]string{"name"}).Drop())
+	require.NoError(t, err)
+	result, err = client.Execute(context.Background(), gremlin.G.V().HasKey("name").Count())
+	require.NoError(t, err)
+	count, err = result.OneInt()
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+}
+
+func TestListProperties(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := http_client.NewClient(server.URL)
+
+	_, err := client.Execute(context.Background(), gremlin.OpenManagement().
+		MakePropertyKeyWithCardinality("tags", gremlin.DataTypeString, gremlin.CardinalityList).
+		MakePropertyKey("name", gremlin.DataTypeString).
+		Commit())
+	require.NoError(t, err)
+
+	_, err = client.Execute(context.Background(), gremlin.G.AddV("city").
+		StringProperty("name", "Amsterdam").
+		StringListProperty("tags", "capital").
+		StringListProperty("tags", "port"))
+	require.NoError(t, err)
+
+	// A list property can not be set on a key with a single value
+	_, err = client.Execute(context.Background(), gremlin.G.V().StringListProperty("name", "Rotterdam"))
+	require.Error(t, err)
+
+	result, err := client.Execute(context.Background(), gremlin.G.V().HasString("tags", "port").Values([]string{"tags"}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"capital", "port"}, result.AssertStringSlice())
+
+	result, err = client.Execute(context.Background(), gremlin.G.V().HasLabel("city"))
+	require.NoError(t, err)
+	vertices, err := result.Vertices()
+	require.NoError(t, err)
+	require.Len(t, vertices, 1)
+	require.Len(t, vertices[0].PropertyValues("tags"), 2)
+
+	// Dropping the property drops all of its values
+	_, err = client.Execute(context.Background(), gremlin.G.V().Properties([]string{"tags"}).Drop())
+	require.NoError(t, err)
+	result, err = client.Execute(context.Background(), gremlin.G.V().Values([]string{"tags"}))
+	require.NoError(t, err)
+	require.Empty(t, result.AssertStringSlice())
+}
+
+func TestGeoshapes(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := http_client.NewClient(server.URL)
+
+	_, err := client.Execute(context.Background(), gremlin.OpenManagement().
+		MakePropertyKey("location", gremlin.DataTypeGeoshape).
+		Commit())
+	require.NoError(t, err)
+
+	_, err = client.Execute(context.Background(), gremlin.G.
+		AddV("city").StringProperty("name", "Amsterdam").GeoPointProperty("location", 52.3702, 4.8952).
+		AddV("city").StringProperty("name", "Utrecht").GeoPointProperty("location", 52.0907, 5.1214).
+		AddV("city").StringProperty("name", "Berlin").GeoPointProperty("location", 52.52, 13.405))
+	require.NoError(t, err)
+
+	// A geoshape property only accepts points
+	_, err = client.Execute(context.Background(), gremlin.G.V().StringProperty("location", "Amsterdam"))
+	require.Error(t, err)
+
+	result, err := client.Execute(context.Background(), gremlin.G.V().Has("location", gremlin.GeoWithinDistance(52.3702, 4.8952, 50)).Values([]string{"name"}))
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"Amsterdam", "Utrecht"}, result.AssertStringSlice())
+
+	result, err = client.Execute(context.Background(), gremlin.G.V().
+		Has("location", gremlin.GeoWithinBoundingBox(gremlin.GeoPoint{Latitude: 50, Longitude: 10}, gremlin.GeoPoint{Latitude: 55, Longitude: 15})).
+		Values([]string{"name"}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"Berlin"}, result.AssertStringSlice())
+
+	for _, mimeType := range []string{gremlin.MimeTypeGraphSONv1, gremlin.MimeTypeGraphSONv2, gremlin.MimeTypeGraphSONv3} {
+		datums := executeAs(t, server.URL, mimeType, gremlin.G.V().HasString("name", "Berlin"))
+		require.Len(t, datums, 1, mimeType)
+		point := datums[0].AssertVertex().AssertPropertyValue("location").AssertGeoPoint()
+		require.Equal(t, gremlin.GeoPoint{Latitude: 52.52, Longitude: 13.405}, point, mimeType)
+	}
+}
+
+func TestFailedQueryIsRolledBack(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := http_client.NewClient(server.URL)
+
+	_, err := client.Execute(context.Background(), gremlin.G.AddV("city").StringProperty("name", "Amsterdam"))
+	require.NoError(t, err)
+
+	server.InjectFailure(func(step string) error {
+		if step == "addE" {
+			return errors.New("injected failure")
+		}
+		return nil
+	})
+
+	// The property is changed and the vertex is added before the query fails
+	_, err = client.Execute(context.Background(), gremlin.G.V().HasLabel("city").StringProperty("name", "Rotterdam").
+		AddV("city").As("new").AddE("near").ToRef("new"))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "injected failure")
+
+	server.InjectFailure(nil)
+	result, err := client.Execute(context.Background(), gremlin.G.V().HasLabel("city").Values([]string{"name"}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"Amsterdam"}, result.AssertStringSlice())
+}
+
+func TestUnsupportedQuery(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	_, err := http_client.NewClient(server.URL).Execute(context.Background(), gremlin.RawQuery(`g.V().repeat(out()).times(2)`))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "repeat")
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	upstream := NewServer()
+	defer upstream.Close()
+
+	recorder := NewRecordingServer(upstream.URL)
+	client := http_client.NewClient(recorder.URL)
+	_, err := client.Execute(context.Background(), gremlin.G.AddV("person").StringProperty("name", "Alice"))
+	require.NoError(t, err)
+	require.NoError(t, client.Ping(context.Background()))
+	recorder.Close()
+
+	dir, err := ioutil.TempDir("", "fixtures")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "fixtures.json")
+	require.NoError(t, SaveFixtures(path, recorder.Fixtures()))
+	fixtures, err := LoadFixtures(path)
+	require.NoError(t, err)
+	require.Len(t, fixtures, 2)
+
+	// The upstream server is not needed to replay
+	upstream.Close()
+	replay := NewReplayServer(fixtures)
+	defer replay.Close()
+
+	client = http_client.NewClient(replay.URL)
+	require.NoError(t, client.Ping(context.Background()))
+
+	result, err := client.Execute(context.Background(), gremlin.G.AddV("person").StringProperty("name", "Alice"))
+	require.NoError(t, err)
+	require.Equal(t, "Alice", result.AssertFirst().AssertVertex().AssertPropertyValue("name").AssertString())
+
+	_, err = client.Execute(context.Background(), gremlin.G.V().Count())
+	require.Error(t, err)
+}
+
+func TestGraphSONVersions(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	_, err := http_client.NewClient(server.URL).Execute(context.Background(), gremlin.G.AddV("person").
+		As("alice").
+		StringProperty("name", "Alice").
+		Int64Property("id", 9007199254740993).
+		Float64Property("height", 2).
+		AddV("person").
+		As("bob").
+		AddE("knows").
+		FromRef("alice").
+		ToRef("bob").
+		Int64Property("since", 2018))
+	require.NoError(t, err)
+
+	for _, mimeType := range []string{"application/json", gremlin.MimeTypeGraphSONv2, gremlin.MimeTypeGraphSONv3} {
+		t.Run(mimeType, func(t *testing.T) {
+			data := executeAs(t, server.URL, mimeType, gremlin.G.V().HasString("name", "Alice").OutE().As("e").InV().Path().FromRef("e"))
+			require.Len(t, data, 1)
+			p := data[0].AssertPath()
+			require.Len(t, p.Segments, 2)
+			require.Equal(t, int64(2018), p.Segments[0].AssertEdge().AssertPropertyValue("since").AssertInt64())
+
+			data = executeAs(t, server.URL, mimeType, gremlin.G.V().HasString("name", "Alice").Project([]string{"id", "height"}).By("id").By("height"))
+			require.Len(t, data, 1)
+			require.Equal(t, int64(9007199254740993), data[0].AssertKey("id").Datum)
+			if mimeType != "application/json" {
+				// GraphSON 1.0 does not keep the type of a float without a fraction
+				require.Equal(t, 2.0, data[0].AssertKey("height").Datum)
+			}
+		})
+	}
+}
+
+func TestRetriesAndTimeouts(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := http_client.NewClient(server.URL)
+	client.SetRetryPolicy(gremlin.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond})
+
+	// Fail the first two queries
+	failures := 2
+	server.InjectFailure(func(step string) error {
+		if failures > 0 {
+			failures--
+			return errors.New("injected failure")
+		}
+		return nil
+	})
+
+	// Reads are retried
+	result, err := client.Execute(context.Background(), gremlin.G.V().Count())
+	require.NoError(t, err)
+	count, err := result.OneInt()
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+	require.Equal(t, 0, failures)
+
+	// Writes are not
+	failures = 2
+	_, err = client.Execute(context.Background(), gremlin.G.AddV("city"))
+	require.Error(t, err)
+	require.IsType(t, &gremlin.ServerError{}, err)
+	require.Equal(t, 1, failures)
+
+	// Queries that take too long time out
+	server.InjectFailure(func(step string) error {
+		time.Sleep(200 * time.Millisecond)
+		return nil
+	})
+	_, err = client.Execute(context.Background(), gremlin.G.V().Count().WithTimeout(20*time.Millisecond))
+	require.IsType(t, &gremlin.UnavailableError{}, err)
+	require.Contains(t, err.Error(), "did not answer the query within 20ms")
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	server := NewServer()
+	client := http_client.NewClient(server.URL)
+	client.SetRetryPolicy(gremlin.RetryPolicy{MaxAttempts: 1})
+	breaker := gremlin.NewCircuitBreaker(2, time.Minute)
+	client.SetCircuitBreaker(breaker)
+	require.NoError(t, client.Ping(context.Background()))
+
+	server.Close()
+	for i := 0; i < 2; i++ {
+		err := client.Ping(context.Background())
+		require.IsType(t, &gremlin.UnavailableError{}, err)
+	}
+	require.Equal(t, gremlin.CircuitOpen, breaker.Status().State)
+	require.Equal(t, gremlin.ErrCircuitOpen, client.Ping(context.Background()))
+}
+
+// Execute a query over HTTP, and decode the response in the GraphSON version of the mime type.
+func executeAs(t *testing.T, url string, mimeType string, query *gremlin.Query) []gremlin.Datum {
+	body, err := json.Marshal(map[string]interface{}{"gremlin": query.Query(), "bindings": query.Bindings()})
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	require.NoError(t, err)
+	request.Header.Set("Accept", mimeType)
+
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	var decoded struct {
+		Result struct {
+			Data json.RawMessage `json:"data"`
+		} `json:"result"`
+	}
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&decoded))
+
+	data, err := gremlin.DecodeData(decoded.Result.Data)
+	require.NoError(t, err)
+	return data
+}
+
+func TestPropertyKeysCanNotChange(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := http_client.NewClient(server.URL)
+
+	_, err := client.Execute(context.Background(), gremlin.OpenManagement().
+		MakePropertyKey("name", gremlin.DataTypeString).
+		MakePropertyKey("count", gremlin.DataTypeLong).
+		Commit())
+	require.NoError(t, err)
+
+	// The same keys are left alone
+	result, err := client.Execute(context.Background(), gremlin.OpenManagement().
+		MakePropertyKey("name", gremlin.DataTypeString).
+		Commit())
+	require.NoError(t, err)
+	require.Empty(t, result.AssertStringSlice())
+
+	// Keys with another data type or cardinality fail the whole script
+	_, err = client.Execute(context.Background(), gremlin.OpenManagement().
+		MakePropertyKey("tags", gremlin.DataTypeString).
+		MakePropertyKey("name", gremlin.DataTypeObject).
+		MakePropertyKeyWithCardinality("count", gremlin.DataTypeLong, gremlin.CardinalityList).
+		Commit())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "name (Object, SINGLE), count (Long, LIST)")
+
+	result, err = client.Execute(context.Background(), gremlin.OpenManagement().
+		MakePropertyKey("tags", gremlin.DataTypeString).
+		Commit())
+	require.NoError(t, err)
+	require.Equal(t, []string{"property key tags"}, result.AssertStringSlice())
+}
@@ -0,0 +1,181 @@
+package fake_server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+// The number of results in a single response, when the request does not set a batch size
+const defaultBatchSize = 64
+
+// Status codes of the Gremlin Server WebSocket protocol
+const (
+	statusSuccess         = 200
+	statusNoContent       = 204
+	statusPartialContent  = 206
+	statusMalformed       = 499
+	statusScriptException = 597
+)
+
+type websocketRequest struct {
+	RequestID string                 `json:"requestId"`
+	Op        string                 `json:"op"`
+	Processor string                 `json:"processor"`
+	Args      map[string]interface{} `json:"args"`
+}
+
+var upgrader = websocket.Upgrader{}
+
+// Serve the WebSocket protocol; the queries are answered in the same way as over HTTP, but large results are split
+// in batches. Requests are answered concurrently, so responses to different requests can be interleaved.
+func (s *Server) serveWebSocket(w http.ResponseWriter, r *http.Request) {
+	ws, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer ws.Close()
+
+	var writeMutex sync.Mutex
+	write := func(response interface{}) {
+		message, _ := json.Marshal(response)
+
+		writeMutex.Lock()
+		defer writeMutex.Unlock()
+		ws.WriteMessage(websocket.TextMessage, message)
+	}
+
+	// Like the Gremlin Server, roll back the open transactions of the sessions of the connection when it closes
+	sessions := map[string]bool{}
+	defer func() {
+		if s.transactions == nil {
+			return
+		}
+		s.mutex.Lock()
+		for session := range sessions {
+			s.rollback(session)
+		}
+		s.mutex.Unlock()
+	}()
+
+	for {
+		_, frame, err := ws.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		// Skip the mime type in front of the payload
+		if len(frame) == 0 || len(frame) < int(frame[0])+1 {
+			write(websocketResponse("", statusMalformed, "the request does not start with a mime type", nil))
+			continue
+		}
+		mimeType := string(frame[1 : int(frame[0])+1])
+		payload := frame[int(frame[0])+1:]
+
+		var request websocketRequest
+		if err := decodeJSON(payload, &request); err != nil {
+			write(websocketResponse("", statusMalformed, err.Error(), nil))
+			continue
+		}
+
+		if session, ok := request.Args["session"].(string); ok && request.Processor == "session" {
+			sessions[session] = true
+		}
+
+		go s.answerWebSocket(request, mimeType, write)
+	}
+}
+
+func (s *Server) answerWebSocket(request websocketRequest, mimeType string, write func(response interface{})) {
+	session, _ := request.Args["session"].(string)
+	if request.Processor != "session" {
+		session = ""
+	}
+
+	if request.Op == "close" {
+		// Like the Gremlin Server, roll back the open transaction of the session
+		if s.transactions != nil {
+			s.mutex.Lock()
+			s.rollback(session)
+			s.mutex.Unlock()
+		}
+		write(websocketResponse(request.RequestID, statusNoContent, "", nil))
+		return
+	}
+
+	query, _ := request.Args["gremlin"].(string)
+	if request.Op != "eval" || query == "" {
+		write(websocketResponse(request.RequestID, statusMalformed, "only 'eval' requests with a query are supported", nil))
+		return
+	}
+
+	// Answer the query as if it was sent over HTTP
+	bindings, _ := request.Args["bindings"].(map[string]interface{})
+	httpRequest := gremlinRequest{Gremlin: query, Bindings: bindings, session: session, mimeType: mimeType}
+	body, _ := json.Marshal(httpRequest)
+
+	s.mutex.Lock()
+	statusCode, httpResponse := s.answer(httpRequest, body)
+	s.mutex.Unlock()
+
+	if statusCode != http.StatusOK {
+		var failure struct {
+			Message string `json:"message"`
+		}
+		json.Unmarshal(httpResponse, &failure)
+		write(websocketResponse(request.RequestID, statusScriptException, failure.Message, nil))
+		return
+	}
+
+	var result struct {
+		Result struct {
+			Data interface{} `json:"data"`
+		} `json:"result"`
+	}
+	decoder := json.NewDecoder(bytes.NewReader(httpResponse))
+	decoder.UseNumber()
+	if err := decoder.Decode(&result); err != nil {
+		write(websocketResponse(request.RequestID, statusScriptException, err.Error(), nil))
+		return
+	}
+
+	// In GraphSON 3.0 the data is a typed list; each batch is typed as a list by itself
+	version := graphSONVersion(mimeType)
+	all := result.Result.Data
+	if list, ok := all.(map[string]interface{}); ok {
+		all = list["@value"]
+	}
+	data, _ := all.([]interface{})
+	batch := func(data []interface{}) interface{} {
+		return typedValue("g:List", data, version-1)
+	}
+
+	batchSize := defaultBatchSize
+	if size, ok := request.Args["batchSize"].(json.Number); ok {
+		if n, err := size.Int64(); err == nil && n > 0 {
+			batchSize = int(n)
+		}
+	}
+
+	if len(data) == 0 {
+		write(websocketResponse(request.RequestID, statusNoContent, "", nil))
+		return
+	}
+
+	for len(data) > batchSize {
+		write(websocketResponse(request.RequestID, statusPartialContent, "", batch(data[:batchSize])))
+		data = data[batchSize:]
+	}
+	write(websocketResponse(request.RequestID, statusSuccess, "", batch(data)))
+}
+
+func websocketResponse(requestID string, code int, message string, data interface{}) interface{} {
+	return map[string]interface{}{
+		"requestId": requestID,
+		"status":    map[string]interface{}{"message": message, "code": code, "attributes": map[string]interface{}{}},
+		"result":    map[string]interface{}{"data": data, "meta": map[string]interface{}{}},
+	}
+}
@@ -0,0 +1,423 @@
+package gremlin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// The GraphSON versions that the Gremlin server can serialize results with, and their mime types.
+const (
+	MimeTypeGraphSONv1 = "application/vnd.gremlin-v1.0+json"
+	MimeTypeGraphSONv2 = "application/vnd.gremlin-v2.0+json"
+	MimeTypeGraphSONv3 = "application/vnd.gremlin-v3.0+json"
+)
+
+// Decode the data of a response, in any version of GraphSON. In GraphSON 1.0 the data is a plain list, in 2.0 it is
+// a list of typed values, and in 3.0 it is a typed list itself.
+//
+// The values are decoded to Go values: integers to int64, floating point numbers to float64, dates to time.Time,
+// lists and sets to []interface{}, maps to map[string]interface{}, and vertices, edges and paths to *Vertex, *Edge
+// and *Path. The integers of GraphSON 1.0 keep their exact value.
+func DecodeData(data json.RawMessage) ([]Datum, error) {
+	if len(data) == 0 {
+		return []Datum{}, nil
+	}
+
+	decoded, err := DecodeGraphSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if decoded == nil {
+		return []Datum{}, nil
+	}
+
+	list, ok := decoded.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Expected the data of the response to be a list, but got %#v", decoded)
+	}
+
+	datums := make([]Datum, 0, len(list))
+	for _, value := range list {
+		datums = append(datums, Datum{Datum: value})
+	}
+	return datums, nil
+}
+
+// Decode a single GraphSON value of any version. See DecodeData for the Go types of the decoded values.
+func DecodeGraphSON(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("Could not decode GraphSON; %v", err)
+	}
+
+	return decodeValue(value)
+}
+
+func decodeValue(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case nil, string, bool:
+		return v, nil
+	case json.Number:
+		return decodeNumber(v)
+	case []interface{}:
+		return decodeList(v)
+	case map[string]interface{}:
+		if typeName, typedValue, ok := typed(v); ok {
+			return decodeTyped(typeName, typedValue)
+		}
+		return decodeMap(v)
+	}
+
+	return nil, fmt.Errorf("Unexpected GraphSON value %#v", value)
+}
+
+// Whether a map is a typed value of GraphSON 2.0 or 3.0, like {"@type": "g:Int64", "@value": 1}.
+func typed(m map[string]interface{}) (string, interface{}, bool) {
+	typeName, ok := m["@type"].(string)
+	if !ok || len(m) != 2 {
+		return "", nil, false
+	}
+
+	typedValue, ok := m["@value"]
+	return typeName, typedValue, ok
+}
+
+func decodeTyped(typeName string, value interface{}) (interface{}, error) {
+	switch typeName {
+	case "g:Int32", "g:Int64", "gx:Int16", "gx:Byte":
+		n, ok := value.(json.Number)
+		if !ok {
+			return nil, fmt.Errorf("Expected a number for %s, but got %#v", typeName, value)
+		}
+		return n.Int64()
+	case "g:Float", "g:Double", "gx:BigDecimal":
+		return decodeFloat(typeName, value)
+	case "g:Date", "g:Timestamp":
+		n, ok := value.(json.Number)
+		if !ok {
+			return nil, fmt.Errorf("Expected a number of milliseconds for %s, but got %#v", typeName, value)
+		}
+		millis, err := n.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("Expected a number of milliseconds for %s, but got %v", typeName, n)
+		}
+		return time.Unix(0, millis*int64(time.Millisecond)).UTC(), nil
+	case "g:UUID", "g:T", "g:Direction", "g:Class":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("Expected a string for %s, but got %#v", typeName, value)
+		}
+		return s, nil
+	case "g:List", "g:Set":
+		list, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Expected a list for %s, but got %#v", typeName, value)
+		}
+		return decodeList(list)
+	case "g:Map":
+		return decodeTypedMap(value)
+	case "g:BulkSet":
+		return decodeBulkSet(value)
+	case "g:Vertex":
+		return decodeVertex(value)
+	case "g:Edge":
+		return decodeEdge(value)
+	case "g:Path":
+		return decodePath(value)
+	case "g:VertexProperty", "g:Property":
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Expected an object for %s, but got %#v", typeName, value)
+		}
+		return decodeMap(m)
+	case "janusgraph:RelationIdentifier":
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Expected an object for %s, but got %#v", typeName, value)
+		}
+		id, ok := m["relationId"].(string)
+		if !ok {
+			return nil, fmt.Errorf("Expected a relationId in %s, but got %#v", typeName, value)
+		}
+		return id, nil
+	case "janusgraph:Geoshape":
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Expected an object for %s, but got %#v", typeName, value)
+		}
+		decoded, err := decodeMap(m)
+		if err != nil {
+			return nil, err
+		}
+		// Shapes other than points are kept as GeoJSON
+		if shape, ok := decoded.(map[string]interface{}); ok {
+			if point, ok := geoPointFromGeoJSON(shape); ok {
+				return point, nil
+			}
+		}
+		return decoded, nil
+	}
+
+	// Types that we don't know of are decoded by their value.
+	return decodeValue(value)
+}
+
+// A point in GeoJSON has the coordinates [longitude, latitude].
+func geoPointFromGeoJSON(m map[string]interface{}) (GeoPoint, bool) {
+	if shapeType, ok := m["type"].(string); !ok || shapeType != "Point" {
+		return GeoPoint{}, false
+	}
+
+	coordinates, ok := m["coordinates"].([]interface{})
+	if !ok || len(coordinates) != 2 {
+		return GeoPoint{}, false
+	}
+
+	longitude, longitudeOk := (&PropertyValue{Value: coordinates[0]}).Float()
+	latitude, latitudeOk := (&PropertyValue{Value: coordinates[1]}).Float()
+	if !longitudeOk || !latitudeOk {
+		return GeoPoint{}, false
+	}
+	return GeoPoint{Latitude: latitude, Longitude: longitude}, true
+}
+
+// Numbers of GraphSON 1.0 and untyped numbers of GraphSON 2.0 are integers, unless they have a fraction or exponent.
+func decodeNumber(n json.Number) (interface{}, error) {
+	if i, err := strconv.ParseInt(string(n), 10, 64); err == nil {
+		return i, nil
+	}
+
+	f, err := n.Float64()
+	if err != nil {
+		return nil, fmt.Errorf("Invalid number %v", n)
+	}
+	return f, nil
+}
+
+// Floating point numbers are numbers, or the strings "NaN", "Infinity" and "-Infinity".
+func decodeFloat(typeName string, value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case json.Number:
+		return v.Float64()
+	case string:
+		switch v {
+		case "NaN":
+			return math.NaN(), nil
+		case "Infinity":
+			return math.Inf(1), nil
+		case "-Infinity":
+			return math.Inf(-1), nil
+		}
+	}
+
+	return 0, fmt.Errorf("Expected a number for %s, but got %#v", typeName, value)
+}
+
+func decodeList(list []interface{}) ([]interface{}, error) {
+	decoded := make([]interface{}, 0, len(list))
+	for _, item := range list {
+		value, err := decodeValue(item)
+		if err != nil {
+			return nil, err
+		}
+		decoded = append(decoded, value)
+	}
+	return decoded, nil
+}
+
+// Decode an untyped object. In GraphSON 1.0, vertices, edges and paths are untyped objects too.
+func decodeMap(m map[string]interface{}) (interface{}, error) {
+	switch {
+	case m["type"] == "vertex" && hasKeys(m, "id", "label"):
+		return decodeVertex(m)
+	case m["type"] == "edge" && hasKeys(m, "id", "label", "inV", "outV"):
+		return decodeEdge(m)
+	case len(m) == 2 && hasKeys(m, "labels", "objects"):
+		return decodePath(m)
+	}
+
+	decoded := make(map[string]interface{}, len(m))
+	for key, item := range m {
+		value, err := decodeValue(item)
+		if err != nil {
+			return nil, err
+		}
+		decoded[key] = value
+	}
+	return decoded, nil
+}
+
+// A map of GraphSON 3.0 is a list of keys and values, because the keys don't have to be strings. Keys that are not
+// strings are formatted as a string.
+func decodeTypedMap(value interface{}) (map[string]interface{}, error) {
+	list, ok := value.([]interface{})
+	if !ok || len(list)%2 != 0 {
+		return nil, fmt.Errorf("Expected a list of keys and values for g:Map, but got %#v", value)
+	}
+
+	decoded := make(map[string]interface{}, len(list)/2)
+	for i := 0; i < len(list); i += 2 {
+		key, err := decodeValue(list[i])
+		if err != nil {
+			return nil, err
+		}
+		item, err := decodeValue(list[i+1])
+		if err != nil {
+			return nil, err
+		}
+
+		if s, ok := key.(string); ok {
+			decoded[s] = item
+		} else {
+			decoded[fmt.Sprint(key)] = item
+		}
+	}
+	return decoded, nil
+}
+
+// The most values that a bulk set is expanded to. A larger count is not a plausible result of a query; it would only
+// exhaust the memory of the server.
+const maxBulkSetSize = 1 << 20
+
+// A bulk set is a list of values with the number of times they occur.
+func decodeBulkSet(value interface{}) ([]interface{}, error) {
+	list, ok := value.([]interface{})
+	if !ok || len(list)%2 != 0 {
+		return nil, fmt.Errorf("Expected a list of values and counts for g:BulkSet, but got %#v", value)
+	}
+
+	var decoded []interface{}
+	for i := 0; i < len(list); i += 2 {
+		item, err := decodeValue(list[i])
+		if err != nil {
+			return nil, err
+		}
+		count, err := decodeValue(list[i+1])
+		if err != nil {
+			return nil, err
+		}
+		n, ok := count.(int64)
+		if !ok {
+			return nil, fmt.Errorf("Expected a count in g:BulkSet, but got %#v", count)
+		}
+		if n < 0 || n > int64(maxBulkSetSize-len(decoded)) {
+			return nil, fmt.Errorf("Expected at most %d values in g:BulkSet, but got a count of %d", maxBulkSetSize, n)
+		}
+		for j := int64(0); j < n; j++ {
+			decoded = append(decoded, item)
+		}
+	}
+	return decoded, nil
+}
+
+func decodeVertex(value interface{}) (*Vertex, error) {
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Expected an object for a vertex, but got %#v", value)
+	}
+
+	id, err := decodeValue(m["id"])
+	if err != nil {
+		return nil, err
+	}
+	vertexID, ok := id.(int64)
+	if !ok {
+		return nil, fmt.Errorf("Vertex element does not have a number as an id, but %#v", id)
+	}
+
+	label, ok := m["label"].(string)
+	if !ok {
+		return nil, fmt.Errorf("Vertex element does not have a string as a label")
+	}
+
+	properties := map[string]Property{}
+	propertyLists := map[string][]Property{}
+	if m["properties"] != nil {
+		propertiesMap, ok := m["properties"].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Vertex element does not have an object for properties")
+		}
+
+		propertyLists, err = extractVertexProperties(propertiesMap)
+		if err != nil {
+			return nil, err
+		}
+
+		for key, values := range propertyLists {
+			properties[key] = values[0]
+		}
+	}
+
+	return &Vertex{Id: vertexID, Label: label, Properties: properties, PropertyLists: propertyLists}, nil
+}
+
+func decodeEdge(value interface{}) (*Edge, error) {
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Expected an object for an edge, but got %#v", value)
+	}
+
+	id, err := decodeValue(m["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	label, ok := m["label"].(string)
+	if !ok {
+		return nil, fmt.Errorf("Edge element does not have a string as a label")
+	}
+
+	properties := map[string]PropertyValue{}
+	if m["properties"] != nil {
+		propertiesMap, ok := m["properties"].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Edge element does not have an object for properties")
+		}
+
+		properties, err = extractEdgeProperties(propertiesMap)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Edge{Id: fmt.Sprint(id), Label: label, Properties: properties}, nil
+}
+
+func decodePath(value interface{}) (*Path, error) {
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Expected an object for a path, but got %#v", value)
+	}
+
+	objects, err := decodeValue(m["objects"])
+	if err != nil {
+		return nil, err
+	}
+	list, ok := objects.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Expected a list of objects in a path, but got %#v", objects)
+	}
+
+	segments := make([]Datum, 0, len(list))
+	for _, object := range list {
+		segments = append(segments, Datum{Datum: object})
+	}
+	return &Path{Segments: segments}, nil
+}
+
+func hasKeys(m map[string]interface{}, keys ...string) bool {
+	for _, key := range keys {
+		if _, ok := m[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,238 @@
+package gremlin
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDecodeData(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected []interface{}
+	}{
+		{
+			name:     "GraphSON 1.0 numbers",
+			data:     `[9007199254740993, 1.5, "a", true, null]`,
+			expected: []interface{}{int64(9007199254740993), 1.5, "a", true, nil},
+		},
+		{
+			name: "GraphSON 2.0 typed values",
+			data: `[{"@type": "g:Int64", "@value": 9007199254740993}, {"@type": "g:Int32", "@value": 42},
+				{"@type": "g:Double", "@value": 2.0}, {"@type": "g:Date", "@value": 1530000000123},
+				{"@type": "g:UUID", "@value": "41ee2e7f-8f68-4d4d-9c2a-3b9d1c9c8a2d"}]`,
+			expected: []interface{}{int64(9007199254740993), int64(42), 2.0,
+				time.Date(2018, 6, 26, 8, 0, 0, 123000000, time.UTC), "41ee2e7f-8f68-4d4d-9c2a-3b9d1c9c8a2d"},
+		},
+		{
+			name: "GraphSON 3.0 lists, sets and maps",
+			data: `{"@type": "g:List", "@value": [
+				{"@type": "g:Map", "@value": ["a", {"@type": "g:Int64", "@value": 1}, {"@type": "g:Int32", "@value": 2}, "b"]},
+				{"@type": "g:Set", "@value": ["x", "y"]},
+				{"@type": "g:BulkSet", "@value": ["z", {"@type": "g:Int64", "@value": 2}]}]}`,
+			expected: []interface{}{
+				map[string]interface{}{"a": int64(1), "2": "b"},
+				[]interface{}{"x", "y"},
+				[]interface{}{"z", "z"},
+			},
+		},
+		{
+			name:     "null",
+			data:     `null`,
+			expected: []interface{}{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			datums, err := DecodeData([]byte(test.data))
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			values := make([]interface{}, 0, len(datums))
+			for _, datum := range datums {
+				values = append(values, datum.Datum)
+			}
+			if !reflect.DeepEqual(values, test.expected) {
+				t.Errorf("expected %#v, got %#v", test.expected, values)
+			}
+		})
+	}
+}
+
+func TestDecodeElements(t *testing.T) {
+	versions := map[string]string{
+		"GraphSON 1.0": `[{"labels": [["e"], []], "objects": [
+			{"id": "e1", "label": "knows", "type": "edge", "inV": 2, "outV": 1, "properties": {"since": 2018}},
+			{"id": 2, "label": "person", "type": "vertex", "properties": {
+				"name": [{"id": "p1", "value": "Bob"}],
+				"height": [{"id": "p2", "value": 1.8}]}}]}]`,
+		"GraphSON 2.0": `[{"@type": "g:Path", "@value": {"labels": [["e"], []], "objects": [
+			{"@type": "g:Edge", "@value": {
+				"id": {"@type": "janusgraph:RelationIdentifier", "@value": {"relationId": "e1"}},
+				"label": "knows", "inV": {"@type": "g:Int64", "@value": 2}, "outV": {"@type": "g:Int64", "@value": 1},
+				"properties": {"since": {"@type": "g:Property", "@value": {"key": "since", "value": {"@type": "g:Int32", "@value": 2018}}}}}},
+			{"@type": "g:Vertex", "@value": {"id": {"@type": "g:Int64", "@value": 2}, "label": "person", "properties": {
+				"name": [{"@type": "g:VertexProperty", "@value": {
+					"id": {"@type": "janusgraph:RelationIdentifier", "@value": {"relationId": "p1"}}, "value": "Bob", "label": "name"}}],
+				"height": [{"@type": "g:VertexProperty", "@value": {
+					"id": {"@type": "janusgraph:RelationIdentifier", "@value": {"relationId": "p2"}},
+					"value": {"@type": "g:Double", "@value": 1.8}, "label": "height"}}]}}}]}}]`,
+	}
+
+	for name, data := range versions {
+		t.Run(name, func(t *testing.T) {
+			datums, err := DecodeData([]byte(data))
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if len(datums) != 1 {
+				t.Fatalf("expected 1 datum, got %d", len(datums))
+			}
+
+			path, err := datums[0].Path()
+			if err != nil {
+				t.Fatalf("expected a path, got %v", err)
+			}
+			if len(path.Segments) != 2 {
+				t.Fatalf("expected 2 segments, got %d", len(path.Segments))
+			}
+
+			edge, err := path.Segments[0].Edge()
+			if err != nil {
+				t.Fatalf("expected an edge, got %v", err)
+			}
+			if edge.Id != "e1" || edge.Label != "knows" || edge.AssertPropertyValue("since").AssertInt64() != 2018 {
+				t.Errorf("unexpected edge %#v", edge)
+			}
+
+			vertex, err := path.Segments[1].Vertex()
+			if err != nil {
+				t.Fatalf("expected a vertex, got %v", err)
+			}
+			if vertex.Id != 2 || vertex.Properties["name"].Id != "p1" || vertex.AssertPropertyValue("name").AssertString() != "Bob" {
+				t.Errorf("unexpected vertex %#v", vertex)
+			}
+			if vertex.AssertPropertyValue("height").AssertFloat() != 1.8 {
+				t.Errorf("unexpected height %#v", vertex.PropertyValue("height"))
+			}
+		})
+	}
+}
+
+func TestDecodeListProperty(t *testing.T) {
+	data := `[{"id": 1, "label": "a", "type": "vertex", "properties": {
+		"tags": [{"id": "p1", "value": "a"}, {"id": "p2", "value": "b"}]}}]`
+
+	datums, err := DecodeData([]byte(data))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	vertex, err := datums[0].Vertex()
+	if err != nil {
+		t.Fatalf("expected a vertex, got %v", err)
+	}
+
+	values := vertex.PropertyValues("tags")
+	if len(values) != 2 || values[0].AssertString() != "a" || values[1].AssertString() != "b" {
+		t.Errorf("unexpected values %#v", values)
+	}
+	if vertex.AssertPropertyValue("tags").AssertString() != "a" {
+		t.Errorf("expected the first value, got %#v", vertex.PropertyValue("tags"))
+	}
+	if vertex.PropertyValues("other") != nil {
+		t.Errorf("expected no values for a missing property")
+	}
+}
+
+func TestDecodeGeoshape(t *testing.T) {
+	versions := map[string]string{
+		"GraphSON 1.0": `[{"id": 1, "label": "a", "type": "vertex", "properties": {
+			"location": [{"id": "p1", "value": {"type": "Point", "coordinates": [4.89, 52]}}]}}]`,
+		"GraphSON 2.0": `[{"@type": "g:Vertex", "@value": {"id": {"@type": "g:Int64", "@value": 1}, "label": "a", "properties": {
+			"location": [{"@type": "g:VertexProperty", "@value": {"id": "p1", "label": "location", "value": {"@type": "janusgraph:Geoshape",
+				"@value": {"type": "Point", "coordinates": [{"@type": "g:Double", "@value": 4.89}, {"@type": "g:Double", "@value": 52.0}]}}}}]}}}]`,
+	}
+
+	for name, data := range versions {
+		t.Run(name, func(t *testing.T) {
+			datums, err := DecodeData([]byte(data))
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			vertex, err := datums[0].Vertex()
+			if err != nil {
+				t.Fatalf("expected a vertex, got %v", err)
+			}
+
+			point := vertex.AssertPropertyValue("location").AssertGeoPoint()
+			if point.Latitude != 52 || point.Longitude != 4.89 {
+				t.Errorf("unexpected point %#v", point)
+			}
+		})
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	tests := map[string]string{
+		"invalid JSON":              `[`,
+		"not a list":                `{"a": 1}`,
+		"int that is not a number":  `[{"@type": "g:Int64", "@value": "1"}]`,
+		"date that is not a number": `[{"@type": "g:Date", "@value": 1.5}]`,
+		"map with an odd length":    `[{"@type": "g:Map", "@value": ["a"]}]`,
+		"vertex without a label":    `[{"@type": "g:Vertex", "@value": {"id": {"@type": "g:Int64", "@value": 1}}}]`,
+		"property without values":   `[{"id": 1, "label": "a", "type": "vertex", "properties": {"name": []}}]`,
+		"negative bulk set count":   `[{"@type": "g:BulkSet", "@value": ["z", {"@type": "g:Int64", "@value": -1}]}]`,
+		"huge bulk set count":       `[{"@type": "g:BulkSet", "@value": ["z", {"@type": "g:Int64", "@value": 9007199254740993}]}]`,
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := DecodeData([]byte(data)); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+func TestDatumAccessorsReturnErrors(t *testing.T) {
+	datum := Datum{Datum: int64(1)}
+
+	if _, err := datum.Vertex(); err == nil {
+		t.Errorf("expected an error for a vertex")
+	}
+	if _, err := datum.Edge(); err == nil {
+		t.Errorf("expected an error for an edge")
+	}
+	if _, err := datum.Path(); err == nil {
+		t.Errorf("expected an error for a path")
+	}
+	if _, err := datum.StringSlice(); err == nil {
+		t.Errorf("expected an error for a list of strings")
+	}
+
+	response := Response{Data: []Datum{{Datum: "a"}, datum}}
+	if _, err := response.StringSlice(); err == nil {
+		t.Errorf("expected an error for a list of strings")
+	}
+
+	vertex := Vertex{Id: 1, Properties: map[string]Property{"name": {Value: PropertyValue{Value: "a"}}}}
+	if _, err := vertex.StringValue("missing"); err == nil {
+		t.Errorf("expected an error for a missing property")
+	}
+	if _, err := vertex.Int64Value("name"); err == nil {
+		t.Errorf("expected an error for a string that is read as an int")
+	}
+	if name, err := vertex.StringValue("name"); err != nil || name != "a" {
+		t.Errorf("expected the name 'a', got '%s' (%v)", name, err)
+	}
+
+	edge := Edge{Id: "e1", Properties: map[string]PropertyValue{"since": {Value: int64(2018)}}}
+	if _, err := edge.StringValue("since"); err == nil {
+		t.Errorf("expected an error for an int that is read as a string")
+	}
+}
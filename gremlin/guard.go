@@ -0,0 +1,140 @@
+package gremlin
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// The defaults of a Guard.
+const (
+	DefaultTimeout          = 30 * time.Second
+	DefaultBreakerThreshold = 5
+	DefaultBreakerCooldown  = 10 * time.Second
+)
+
+// A RetryPolicy tells how often read only queries are sent when they fail with a transient error, and how long to
+// wait in between. The wait doubles after every attempt, up to the maximum.
+type RetryPolicy struct {
+	// The number of times a query is sent at most; 1 means that it is not retried.
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// The retry policy of a new Guard.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: 100 * time.Millisecond,
+	MaxBackoff:     2 * time.Second,
+}
+
+// How long to wait after the attempt, counting from 1.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < attempt && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	return backoff
+}
+
+// A Guard protects the queries of a client with a timeout, retries and a circuit breaker, so that a slow or
+// unavailable server can't make its callers hang. Both the HTTP and the WebSocket driver use it.
+type Guard struct {
+	// How long a query may take, unless the query sets its own timeout. Zero means no timeout.
+	Timeout time.Duration
+	Retry   RetryPolicy
+	// The circuit breaker; nil means that queries are always sent.
+	Breaker *CircuitBreaker
+}
+
+// NewGuard creates a guard with the default timeout, retry policy and circuit breaker.
+func NewGuard() *Guard {
+	return &Guard{
+		Timeout: DefaultTimeout,
+		Retry:   DefaultRetryPolicy,
+		Breaker: NewCircuitBreaker(DefaultBreakerThreshold, DefaultBreakerCooldown),
+	}
+}
+
+// Execute a query by calling send, which sends it to the server. Send must return an UnavailableError when the server
+// can't be reached, and a ServerError when it reports an error. The context that is passed to send is canceled when
+// the caller cancels ctx, or when the query times out.
+func (g *Guard) Execute(ctx context.Context, query *Query, send func(ctx context.Context) (*Response, error)) (*Response, error) {
+	attempts := 1
+	if query.IsReadOnly() && g.Retry.MaxAttempts > 1 {
+		attempts = g.Retry.MaxAttempts
+	}
+
+	var response *Response
+	err := g.run(ctx, query, attempts, func(ctx context.Context) error {
+		var err error
+		response, err = send(ctx)
+		return err
+	})
+	return response, err
+}
+
+// Stream the results of a query by calling send, like Execute. The query is never retried, because send may have
+// handled some of its results already.
+func (g *Guard) Stream(ctx context.Context, query *Query, send func(ctx context.Context) error) error {
+	return g.run(ctx, query, 1, send)
+}
+
+func (g *Guard) run(ctx context.Context, query *Query, attempts int, send func(ctx context.Context) error) error {
+	timeout := query.Timeout()
+	if timeout == 0 {
+		timeout = g.Timeout
+	}
+
+	for attempt := 1; ; attempt++ {
+		if g.Breaker != nil {
+			if err := g.Breaker.Allow(); err != nil {
+				return err
+			}
+		}
+
+		err := g.attempt(ctx, timeout, send)
+		if g.Breaker != nil {
+			g.Breaker.Record(err)
+		}
+
+		if err == nil || attempt >= attempts || !IsTransient(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(g.Retry.backoff(attempt)):
+		}
+	}
+}
+
+func (g *Guard) attempt(ctx context.Context, timeout time.Duration, send func(ctx context.Context) error) error {
+	attemptCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		attemptCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	err := send(attemptCtx)
+	if err == nil {
+		return nil
+	}
+
+	// The caller canceled the query, or its own deadline passed
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if attemptCtx.Err() == context.DeadlineExceeded {
+		return &UnavailableError{Err: fmt.Errorf("The Gremlin server did not answer the query within %v", timeout)}
+	}
+
+	return err
+}
@@ -0,0 +1,110 @@
+package gremlin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func testGuard() *Guard {
+	return &Guard{
+		Timeout: time.Second,
+		Retry:   RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 4 * time.Millisecond},
+		Breaker: NewCircuitBreaker(10, time.Minute),
+	}
+}
+
+// A send function that fails with the errors, in turn, and then succeeds.
+func failing(attempts *int, errs ...error) func(ctx context.Context) (*Response, error) {
+	return func(ctx context.Context) (*Response, error) {
+		*attempts++
+		if *attempts <= len(errs) {
+			return nil, errs[*attempts-1]
+		}
+		return &Response{}, nil
+	}
+}
+
+func TestGuardRetriesReads(t *testing.T) {
+	attempts := 0
+	unavailable := &ServerError{StatusCode: 503, Message: "Service Unavailable"}
+	_, err := testGuard().Execute(context.Background(), G.V().Count(), failing(&attempts, unavailable, unavailable))
+	if err != nil || attempts != 3 {
+		t.Errorf("Expected the read to succeed at the third attempt, but got %v after %d attempts", err, attempts)
+	}
+
+	attempts = 0
+	_, err = testGuard().Execute(context.Background(), G.V().Count(), failing(&attempts, unavailable, unavailable, unavailable))
+	if err != unavailable || attempts != 3 {
+		t.Errorf("Expected the read to fail after 3 attempts, but got %v after %d attempts", err, attempts)
+	}
+}
+
+func TestGuardDoesNotRetry(t *testing.T) {
+	tests := []struct {
+		name  string
+		query *Query
+		err   error
+	}{
+		{"writes", G.AddV("thing").StringProperty("uuid", "a"), &ServerError{StatusCode: 503}},
+		{"raw queries", RawQuery("g.V().count()"), &UnavailableError{Err: errors.New("connection reset")}},
+		{"client errors", G.V().Count(), &ServerError{StatusCode: 499, Message: "Invalid request"}},
+		{"other errors", G.V().Count(), errors.New("could not decode the response")},
+	}
+
+	for _, test := range tests {
+		attempts := 0
+		_, err := testGuard().Execute(context.Background(), test.query, failing(&attempts, test.err))
+		if err != test.err || attempts != 1 {
+			t.Errorf("%s: expected a single attempt that fails, but got %v after %d attempts", test.name, err, attempts)
+		}
+	}
+}
+
+func TestGuardTimeout(t *testing.T) {
+	guard := testGuard()
+	guard.Retry.MaxAttempts = 1
+
+	slow := func(ctx context.Context) (*Response, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	_, err := guard.Execute(context.Background(), G.V().Count().WithTimeout(10*time.Millisecond), slow)
+	if _, ok := err.(*UnavailableError); !ok {
+		t.Errorf("Expected a query that times out to fail with an UnavailableError, but got %#v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	start := time.Now()
+	_, err = guard.Execute(ctx, G.V().Count(), slow)
+	if err != context.Canceled || time.Since(start) > guard.Timeout/2 {
+		t.Errorf("Expected a canceled query to return promptly, but got %v after %v", err, time.Since(start))
+	}
+	if status := guard.Breaker.Status(); status.ConsecutiveFailures != 1 {
+		t.Errorf("Expected only the timeout to count as a failure, but got %+v", status)
+	}
+}
+
+func TestGuardCircuitBreaker(t *testing.T) {
+	guard := testGuard()
+	guard.Breaker = NewCircuitBreaker(2, time.Minute)
+
+	attempts := 0
+	unavailable := &UnavailableError{Err: errors.New("connection refused")}
+	_, err := guard.Execute(context.Background(), G.V().Count(), failing(&attempts, unavailable, unavailable, unavailable))
+	if err != ErrCircuitOpen || attempts != 2 {
+		t.Errorf("Expected the breaker to stop the retries after 2 attempts, but got %v after %d attempts", err, attempts)
+	}
+
+	_, err = guard.Execute(context.Background(), G.V().Count(), failing(&attempts))
+	if err != ErrCircuitOpen || attempts != 2 {
+		t.Errorf("Expected an open breaker to fail fast, but got %v after %d attempts", err, attempts)
+	}
+}
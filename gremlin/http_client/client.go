@@ -1,10 +1,12 @@
 package http_client
 
 import (
+	"context"
 	"fmt"
 	"github.com/creativesoftwarefdn/weaviate/gremlin"
 	"github.com/sirupsen/logrus"
 	"net/http"
+	"time"
 
 	"io/ioutil"
 )
@@ -13,6 +15,7 @@ type Client struct {
 	endpoint string
 	client   http.Client
 	logger   *logrus.Logger
+	guard    *gremlin.Guard
 }
 
 func NewClient(endpoint string) *Client {
@@ -23,6 +26,7 @@ func NewClient(endpoint string) *Client {
 		endpoint: endpoint,
 		client:   http.Client{},
 		logger:   logger,
+		guard:    gremlin.NewGuard(),
 	}
 
 	return &c
@@ -32,9 +36,24 @@ func (c *Client) SetLogger(logger *logrus.Logger) {
 	c.logger = logger
 }
 
-func (c *Client) Ping() error {
+// Set how long a query may take, unless the query sets its own timeout. Zero means no timeout.
+func (c *Client) SetTimeout(timeout time.Duration) {
+	c.guard.Timeout = timeout
+}
+
+// Set how read only queries are retried when they fail with a transient error.
+func (c *Client) SetRetryPolicy(policy gremlin.RetryPolicy) {
+	c.guard.Retry = policy
+}
+
+// Set the circuit breaker that protects the server; nil disables it.
+func (c *Client) SetCircuitBreaker(breaker *gremlin.CircuitBreaker) {
+	c.guard.Breaker = breaker
+}
+
+func (c *Client) Ping(ctx context.Context) error {
 	q := gremlin.RawQuery("1+41")
-	response, err := c.Execute(q)
+	response, err := c.Execute(ctx, q)
 	if err != nil {
 		return err
 	}
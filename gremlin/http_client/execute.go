@@ -2,6 +2,7 @@ package http_client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -11,7 +12,8 @@ import (
 )
 
 type gremlin_http_query struct {
-	Gremlin string `json:"gremlin"`
+	Gremlin  string                 `json:"gremlin"`
+	Bindings map[string]interface{} `json:"bindings"`
 }
 
 type gremlinResponseStatus struct {
@@ -20,8 +22,13 @@ type gremlinResponseStatus struct {
 }
 
 type gremlinResponseResult struct {
-	Data []interface{} `json:"data"`
-	Meta interface{}   `json:"meta"`
+	Data json.RawMessage `json:"data"`
+	Meta interface{}     `json:"meta"`
+}
+
+// The body of a response to a query that failed.
+type gremlinErrorResponse struct {
+	Message string `json:"message"`
 }
 
 type gremlinResponse struct {
@@ -29,12 +36,21 @@ type gremlinResponse struct {
 	Result gremlinResponseResult `json:"result"`
 }
 
-func (c *Client) Execute(query *gremlin.Query) (*gremlin.Response, error) {
-	log := c.logger.WithField("query", query.Query())
+// Execute a query. Read only queries are retried when they fail with a transient error.
+func (c *Client) Execute(ctx context.Context, query *gremlin.Query) (*gremlin.Response, error) {
+	return c.guard.Execute(ctx, query, func(ctx context.Context) (*gremlin.Response, error) {
+		return c.execute(ctx, query)
+	})
+}
+
+// Send a query once.
+func (c *Client) execute(ctx context.Context, query *gremlin.Query) (*gremlin.Response, error) {
+	log := c.logger.WithField("query", query.Query()).WithField("bindings", query.Bindings())
 	log.Debugf("Sending query")
 
 	q := gremlin_http_query{
-		Gremlin: query.Query(),
+		Gremlin:  query.Query(),
+		Bindings: query.Bindings(),
 	}
 
 	json_bytes, err := json.Marshal(&q)
@@ -50,39 +66,42 @@ func (c *Client) Execute(query *gremlin.Query) (*gremlin.Response, error) {
 		return nil, fmt.Errorf("Could not create HTTP request to resolve a Gremlin query; %v", err)
 	}
 
+	req = req.WithContext(ctx)
 	req.Header.Add("Content-Type", "application/json")
-	req.Header.Add("Accept", "application/json")
+	// Request GraphSON 2.0, which keeps the types of the values
+	req.Header.Add("Accept", gremlin.MimeTypeGraphSONv2)
 
 	http_response, err := c.client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("Could not peform HTTP request to JanusGraph, because %v", err)
+		return nil, &gremlin.UnavailableError{Err: fmt.Errorf("Could not peform HTTP request to JanusGraph, because %v", err)}
 	}
 
 	defer http_response.Body.Close()
 
 	buf, err := ioutil.ReadAll(http_response.Body)
-	var response_data gremlinResponse
-	json.Unmarshal(buf, &response_data)
+	if err != nil {
+		return nil, &gremlin.UnavailableError{Err: fmt.Errorf("Could not read the response of JanusGraph, because %v", err)}
+	}
 
 	log.WithField("status_code", http_response.StatusCode).Debugf("Received reply: %s", string(buf))
-	switch http_response.StatusCode {
-	case 200:
-		data := make([]gremlin.Datum, 0)
-
-		for _, d := range response_data.Result.Data {
-			data = append(data, gremlin.Datum{Datum: d})
+	if http_response.StatusCode != http.StatusOK {
+		var failure gremlinErrorResponse
+		if err := json.Unmarshal(buf, &failure); err != nil || failure.Message == "" {
+			failure.Message = string(buf)
 		}
+		return nil, &gremlin.ServerError{StatusCode: http_response.StatusCode, Message: failure.Message}
+	}
+
+	var response_data gremlinResponse
+	if err := json.Unmarshal(buf, &response_data); err != nil {
+		return nil, fmt.Errorf("Could not parse the response of JanusGraph; %v", err)
+	}
 
-		client_response := gremlin.Response{Data: data}
-		return &client_response, nil
-	case 500:
-		// TODO return status message
-		return nil, fmt.Errorf("Server error: %s", string(buf))
-	default:
-		return nil, fmt.Errorf("Unexpected status code %v", http_response.StatusCode)
+	data, err := gremlin.DecodeData(response_data.Result.Data)
+	if err != nil {
+		return nil, fmt.Errorf("Could not decode the results of the Gremlin query; %v", err)
 	}
 
-	// should not reach this; default case in switch should handle everything.
-	panic("unreachable")
-	return nil, nil
+	client_response := gremlin.Response{Data: data}
+	return &client_response, nil
 }
@@ -15,7 +15,7 @@ func (r *Response) OneInt() (int, error) {
 		return 0, fmt.Errorf("Query resulted in %v results, whilst we expected just 1", len(r.Data))
 	}
 
-	i, ok := r.Data[0].Datum.(float64)
+	i, ok := r.Data[0].Datum.(int64)
 	if !ok {
 		return 0, fmt.Errorf("Expected to get see an int, but got %#v instead", r.Data[0])
 	}
@@ -61,16 +61,20 @@ func (r *Response) AssertEdgeSlice(from, to int) []*Edge {
 	return nil
 }
 
-func (r *Response) AssertStringSlice() []string {
-	var stringSlice []string
+// Check if the output of the query are all strings.
+func (r *Response) StringSlice() ([]string, error) {
+	slice := make([]interface{}, 0, len(r.Data))
+	for _, datum := range r.Data {
+		slice = append(slice, datum.Datum)
+	}
 
-	for _, mightBeStr := range r.Data {
-		str, ok := mightBeStr.Datum.(string)
-		if !ok {
-			panic("epxected this to be a string of slices")
-		}
+	return stringSlice(slice)
+}
 
-		stringSlice = append(stringSlice, str)
+func (r *Response) AssertStringSlice() []string {
+	stringSlice, err := r.StringSlice()
+	if err != nil {
+		panic(err)
 	}
 
 	return stringSlice
@@ -1,12 +1,13 @@
 package gremlin
 
 import (
-	"errors"
 	"fmt"
 	"reflect"
+	"time"
 )
 
-// A single piece of data returned by a Gremlin query.
+// A single piece of data returned by a Gremlin query. The data is decoded from GraphSON, see DecodeData for the Go
+// types of the data.
 type Datum struct {
 	Datum interface{}
 }
@@ -15,7 +16,7 @@ type Datum struct {
 func (d *Datum) Key(key string) (*Datum, error) {
 	x, ok := d.Datum.(map[string]interface{})
 	if !ok {
-		return nil, fmt.Errorf("Expected a map, but got something else as the result")
+		return nil, fmt.Errorf("Expected a map, but got %s as the result", d.Type())
 	}
 
 	element, ok := x[key]
@@ -36,31 +37,12 @@ func (d *Datum) AssertKey(key string) *Datum {
 }
 
 func (d *Datum) Path() (*Path, error) {
-	m, ok := d.Datum.(map[string]interface{})
+	path, ok := d.Datum.(*Path)
 	if !ok {
-		return nil, fmt.Errorf("Expected a Path, but got something else as the result")
+		return nil, fmt.Errorf("Expected a Path, but got %s as the result", d.Type())
 	}
 
-	// Paths have a a 'labels' and 'objects' key.
-	_, label_ok := m["labels"]
-	objects_interface, object_ok := m["objects"]
-
-	if !label_ok || !object_ok {
-		return nil, fmt.Errorf("Expected a Path, but got something else as the result")
-	}
-
-	objects_list, ok := objects_interface.([]interface{})
-	if !ok {
-		return nil, fmt.Errorf("Expected a Path, but got something else as the result")
-	}
-
-	segments := make([]Datum, 0)
-	for _, datum := range objects_list {
-		segment := Datum{Datum: datum}
-		segments = append(segments, segment)
-	}
-
-	return &Path{Segments: segments}, nil
+	return path, nil
 }
 
 func (d *Datum) AssertPath() *Path {
@@ -75,8 +57,28 @@ func (d *Datum) AssertPath() *Path {
 // don't use it in a critical path.
 func (d *Datum) Type() string {
 	switch d.Datum.(type) {
-	case int:
+	case nil:
+		return "null"
+	case int64:
 		return "int"
+	case float64:
+		return "float"
+	case string:
+		return "string"
+	case bool:
+		return "bool"
+	case time.Time:
+		return "date"
+	case []interface{}:
+		return "list"
+	case map[string]interface{}:
+		return "map"
+	case *Vertex:
+		return "vertex"
+	case *Edge:
+		return "edge"
+	case *Path:
+		return "path"
 	default:
 		return fmt.Sprintf("Unknown type for '%s'", reflect.TypeOf(d.Datum).Name())
 	}
@@ -84,56 +86,12 @@ func (d *Datum) Type() string {
 
 // Attempt to extract a Vertex from this datum.
 func (d *Datum) Vertex() (*Vertex, error) {
-	v, ok := d.Datum.(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("Expected a vertex, but got something else as the result")
-	}
-
-	type_, ok := v["type"]
-	if !ok || type_ != "vertex" {
-		return nil, errors.New("Vertex element in result does not have type 'vertex'")
-	}
-
-	label_interface, ok := v["label"]
-	if !ok {
-		return nil, errors.New("Vertex element does not have a label")
-	}
-	label, ok := label_interface.(string)
-	if !ok {
-		return nil, errors.New("Vertex element does not have a string as a label")
-	}
-
-	id_interface, ok := v["id"]
-	if !ok {
-		return nil, errors.New("Vertex element does not have a id")
-	}
-	id_f, ok := id_interface.(float64)
-	if !ok {
-		return nil, errors.New("Vertex element does not have a string as a id")
-	}
-	id := int(id_f)
-
-	properties_interface, ok := v["properties"]
-	if !ok {
-		return nil, errors.New("Vertex element does not have a properties key")
-	}
-	properties_map, ok := properties_interface.(map[string]interface{})
+	vertex, ok := d.Datum.(*Vertex)
 	if !ok {
-		return nil, errors.New("Vertex element does not have an object for properties ")
-	}
-
-	properties, err := extractVertexProperties(properties_map)
-	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("Expected a vertex, but got %s as the result", d.Type())
 	}
 
-	vertex := Vertex{
-		Id:         id,
-		Label:      label,
-		Properties: properties,
-	}
-
-	return &vertex, nil
+	return vertex, nil
 }
 
 func (d *Datum) AssertVertex() *Vertex {
@@ -148,57 +106,12 @@ func (d *Datum) AssertVertex() *Vertex {
 
 // Attempt to extract a edge from this datum.
 func (d *Datum) Edge() (*Edge, error) {
-	v, ok := d.Datum.(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("Expected a edge, but got something else as the result")
-	}
-
-	type_, ok := v["type"]
-	if !ok || type_ != "edge" {
-		return nil, errors.New("edge element in result does not have type 'edge'")
-	}
-
-	label_interface, ok := v["label"]
-	if !ok {
-		return nil, errors.New("edge element does not have a label")
-	}
-	label, ok := label_interface.(string)
-	if !ok {
-		return nil, errors.New("edge element does not have a string as a label")
-	}
-
-	id_interface, ok := v["id"]
+	edge, ok := d.Datum.(*Edge)
 	if !ok {
-		return nil, errors.New("edge element does not have a id")
-	}
-	id, ok := id_interface.(string)
-	if !ok {
-		return nil, errors.New("edge element does not have a string as a id")
-	}
-
-	var properties map[string]PropertyValue
-
-	properties_interface, ok := v["properties"]
-	if ok {
-		properties_map, ok := properties_interface.(map[string]interface{})
-		if !ok {
-			return nil, errors.New("edge element does not have an object for properties ")
-		}
-
-		var err error
-		properties, err = extractEdgeProperties(properties_map)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	edge := Edge{
-		Id:         id,
-		Label:      label,
-		Properties: properties,
+		return nil, fmt.Errorf("Expected a edge, but got %s as the result", d.Type())
 	}
 
-	return &edge, nil
+	return edge, nil
 }
 
 func (d *Datum) AssertEdge() *Edge {
@@ -211,22 +124,35 @@ func (d *Datum) AssertEdge() *Edge {
 	return v
 }
 
-func (d *Datum) AssertStringSlice() []string {
-	var stringSlice []string
+// Attempt to extract a list of strings from this datum.
+func (d *Datum) StringSlice() ([]string, error) {
 	slice, ok := d.Datum.([]interface{})
-
 	if !ok {
-		panic("Expected this datum to be a slice")
+		return nil, fmt.Errorf("Expected a list, but got %s as the result", d.Type())
+	}
+
+	return stringSlice(slice)
+}
+
+func (d *Datum) AssertStringSlice() []string {
+	stringSlice, err := d.StringSlice()
+	if err != nil {
+		panic(err)
 	}
 
+	return stringSlice
+}
+
+func stringSlice(slice []interface{}) ([]string, error) {
+	stringSlice := make([]string, 0, len(slice))
 	for _, mightBeStr := range slice {
 		str, ok := mightBeStr.(string)
 		if !ok {
-			panic("epxected this to be a string of slices")
+			return nil, fmt.Errorf("Expected a list of strings, but it contains %#v", mightBeStr)
 		}
 
 		stringSlice = append(stringSlice, str)
 	}
 
-	return stringSlice
+	return stringSlice, nil
 }
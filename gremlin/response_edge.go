@@ -28,3 +28,13 @@ func (e *Edge) PropertyValue(name string) *PropertyValue {
 		return &val
 	}
 }
+
+// The string value of a property, or an error if the edge does not have the property or it is not a string.
+func (e *Edge) StringValue(name string) (string, error) {
+	prop := e.PropertyValue(name)
+	if prop == nil {
+		return "", fmt.Errorf("Expected to find a property '%v' on edge '%v', but no such property exists", name, e.Id)
+	}
+
+	return prop.stringValue(name)
+}
@@ -2,6 +2,7 @@ package gremlin
 
 import (
 	"fmt"
+	"time"
 )
 
 type Property struct {
@@ -13,11 +14,27 @@ type PropertyValue struct {
 	Value interface{}
 }
 
+// A geographic point, as stored in a property with the data type Geoshape.
+type GeoPoint struct {
+	Latitude  float64
+	Longitude float64
+}
+
 func (p *PropertyValue) String() (string, bool) {
 	val, ok := p.Value.(string)
 	return val, ok
 }
 
+// The string value of the property with the name, or an error if it is not a string.
+func (p *PropertyValue) stringValue(name string) (string, error) {
+	val, ok := p.String()
+	if !ok {
+		return "", fmt.Errorf("Expected property '%v' to be a string, but got %#v", name, p.Value)
+	}
+
+	return val, nil
+}
+
 func (p *PropertyValue) AssertString() string {
 	val, ok := p.String()
 	if ok {
@@ -27,9 +44,15 @@ func (p *PropertyValue) AssertString() string {
 	}
 }
 
+// Integers are floats too, because GraphSON 1.0 does not keep the type of floats without a fraction.
 func (p *PropertyValue) Float() (float64, bool) {
-	val, ok := p.Value.(float64)
-	return val, ok
+	switch val := p.Value.(type) {
+	case float64:
+		return val, true
+	case int64:
+		return float64(val), true
+	}
+	return 0, false
 }
 
 func (p *PropertyValue) AssertFloat() float64 {
@@ -42,7 +65,7 @@ func (p *PropertyValue) AssertFloat() float64 {
 }
 
 func (p *PropertyValue) Int() (int, bool) {
-	val, ok := p.Value.(float64)
+	val, ok := p.Value.(int64)
 	return int(val), ok
 }
 
@@ -56,8 +79,8 @@ func (p *PropertyValue) AssertInt() int {
 }
 
 func (p *PropertyValue) Int64() (int64, bool) {
-	val, ok := p.Value.(float64)
-	return int64(val), ok
+	val, ok := p.Value.(int64)
+	return val, ok
 }
 
 func (p *PropertyValue) AssertInt64() int64 {
@@ -69,6 +92,40 @@ func (p *PropertyValue) AssertInt64() int64 {
 	}
 }
 
+func (p *PropertyValue) Time() (time.Time, bool) {
+	val, ok := p.Value.(time.Time)
+	return val, ok
+}
+
+func (p *PropertyValue) AssertTime() time.Time {
+	val, ok := p.Time()
+	if ok {
+		return val
+	} else {
+		panic(fmt.Sprintf("Expected a date, but got %#v", p.Value))
+	}
+}
+
+// The point of a Geoshape. GraphSON 1.0 does not type Geoshapes, so they are plain GeoJSON objects there.
+func (p *PropertyValue) GeoPoint() (GeoPoint, bool) {
+	switch val := p.Value.(type) {
+	case GeoPoint:
+		return val, true
+	case map[string]interface{}:
+		return geoPointFromGeoJSON(val)
+	}
+	return GeoPoint{}, false
+}
+
+func (p *PropertyValue) AssertGeoPoint() GeoPoint {
+	val, ok := p.GeoPoint()
+	if ok {
+		return val
+	} else {
+		panic(fmt.Sprintf("Expected a geographic point, but got %#v", p.Value))
+	}
+}
+
 func (p *PropertyValue) Bool() (bool, bool) {
 	val, ok := p.Value.(bool)
 	return val, ok
@@ -83,8 +140,10 @@ func (p *PropertyValue) AssertBool() bool {
 	}
 }
 
-func extractVertexProperties(props map[string]interface{}) (map[string]Property, error) {
-	properties := make(map[string]Property)
+// Extract the properties of a vertex, from the GraphSON object that maps the keys to a list of vertex properties. A key
+// with the cardinality LIST maps to all of its values.
+func extractVertexProperties(props map[string]interface{}) (map[string][]Property, error) {
+	properties := make(map[string][]Property)
 	for key, prop_val := range props {
 		prop_val_maps, ok := prop_val.([]interface{})
 
@@ -92,50 +151,69 @@ func extractVertexProperties(props map[string]interface{}) (map[string]Property,
 			return nil, fmt.Errorf("Property is not a list %#v", prop_val)
 		}
 
-		if len(prop_val_maps) != 1 {
-			//TODO fix; read up on where/how this is possible.
-			panic(fmt.Sprintf("should be exactly 1, but got %#v", prop_val_maps))
+		if len(prop_val_maps) == 0 {
+			return nil, fmt.Errorf("Property '%s' should have at least 1 value, but got none", key)
 		}
 
-		prop_val_map, ok := prop_val_maps[0].(map[string]interface{})
-		if !ok {
-			return nil, fmt.Errorf("Property value map is not an object %#v", prop_val)
-		}
-
-		prop_val, ok := prop_val_map["value"]
-		if !ok {
-			return nil, fmt.Errorf("no 'value' in property object")
+		values := make([]Property, 0, len(prop_val_maps))
+		for _, prop_val_map_interface := range prop_val_maps {
+			decoded, err := decodeValue(prop_val_map_interface)
+			if err != nil {
+				return nil, err
+			}
+
+			prop_val_map, ok := decoded.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("Property value map is not an object %#v", prop_val)
+			}
+
+			prop_val, ok := prop_val_map["value"]
+			if !ok {
+				return nil, fmt.Errorf("no 'value' in property object")
+			}
+
+			prop_id_interface, ok := prop_val_map["id"]
+			if !ok {
+				return nil, fmt.Errorf("no 'id' in property object")
+			}
+
+			prop_id, ok := prop_id_interface.(string)
+			if !ok {
+				return nil, fmt.Errorf("'id' in property object is not a string")
+			}
+
+			values = append(values, Property{
+				Id:    prop_id,
+				Value: PropertyValue{Value: prop_val},
+			})
 		}
 
-		prop_id_interface, ok := prop_val_map["id"]
-		if !ok {
-			return nil, fmt.Errorf("no 'id' in property object")
-		}
-
-		prop_id, ok := prop_id_interface.(string)
-		if !ok {
-			return nil, fmt.Errorf("'id' in property object is not a string")
-		}
-
-		property := Property{
-			Id:    prop_id,
-			Value: PropertyValue{Value: prop_val},
-		}
-
-		properties[key] = property
+		properties[key] = values
 	}
 
 	return properties, nil
 }
 
+// Extract the properties of an edge. In GraphSON 1.0 the keys map to the values, from 2.0 on they map to properties.
 func extractEdgeProperties(props map[string]interface{}) (map[string]PropertyValue, error) {
 	properties := make(map[string]PropertyValue)
 	for key, prop_val := range props {
-		property := PropertyValue{
-			Value: prop_val,
+		if m, ok := prop_val.(map[string]interface{}); ok {
+			if typeName, typedValue, ok := typed(m); ok && typeName == "g:Property" {
+				property, ok := typedValue.(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("Property '%s' is not an object %#v", key, typedValue)
+				}
+				prop_val = property["value"]
+			}
+		}
+
+		value, err := decodeValue(prop_val)
+		if err != nil {
+			return nil, err
 		}
 
-		properties[key] = property
+		properties[key] = PropertyValue{Value: value}
 	}
 
 	return properties, nil
@@ -5,9 +5,12 @@ import (
 )
 
 type Vertex struct {
-	Id         int
-	Label      string
+	Id    int64
+	Label string
+	// The first value of every property
 	Properties map[string]Property
+	// All values of every property; more than one for a property key with the cardinality LIST
+	PropertyLists map[string][]Property
 }
 
 func (v *Vertex) AssertPropertyValue(name string) *PropertyValue {
@@ -28,3 +31,67 @@ func (v *Vertex) PropertyValue(name string) *PropertyValue {
 		return &val.Value
 	}
 }
+
+// The value of a property that the vertex must have, or an error if it does not have it.
+func (v *Vertex) requiredPropertyValue(name string) (*PropertyValue, error) {
+	prop := v.PropertyValue(name)
+	if prop == nil {
+		return nil, fmt.Errorf("Expected to find a property '%v' on vertex '%v', but no such property exists", name, v.Id)
+	}
+
+	return prop, nil
+}
+
+// The string value of a property, or an error if the vertex does not have the property or it is not a string.
+func (v *Vertex) StringValue(name string) (string, error) {
+	prop, err := v.requiredPropertyValue(name)
+	if err != nil {
+		return "", err
+	}
+
+	return prop.stringValue(name)
+}
+
+// The int64 value of a property, or an error if the vertex does not have the property or it is not an int.
+func (v *Vertex) Int64Value(name string) (int64, error) {
+	prop, err := v.requiredPropertyValue(name)
+	if err != nil {
+		return 0, err
+	}
+
+	val, ok := prop.Int64()
+	if !ok {
+		return 0, fmt.Errorf("Expected property '%v' to be an int, but got %#v", name, prop.Value)
+	}
+
+	return val, nil
+}
+
+// The bool value of a property, or an error if the vertex does not have the property or it is not a bool.
+func (v *Vertex) BoolValue(name string) (bool, error) {
+	prop, err := v.requiredPropertyValue(name)
+	if err != nil {
+		return false, err
+	}
+
+	val, ok := prop.Bool()
+	if !ok {
+		return false, fmt.Errorf("Expected property '%v' to be a bool, but got %#v", name, prop.Value)
+	}
+
+	return val, nil
+}
+
+// All values of the property, or nil if the vertex does not have the property.
+func (v *Vertex) PropertyValues(name string) []PropertyValue {
+	props, ok := v.PropertyLists[name]
+	if !ok {
+		return nil
+	}
+
+	values := make([]PropertyValue, 0, len(props))
+	for _, prop := range props {
+		values = append(values, prop.Value)
+	}
+	return values
+}
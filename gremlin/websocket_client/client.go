@@ -0,0 +1,449 @@
+// Package websocket_client is a Gremlin driver that uses the native WebSocket protocol of the Gremlin Server. It keeps
+// a pool of connections, sends many queries over each connection at the same time, streams large results in batches,
+// and supports sessions.
+package websocket_client
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/creativesoftwarefdn/weaviate/gremlin"
+)
+
+// The number of results that the server sends in a single response, when the client does not set it
+const defaultBatchSize = 64
+
+// How long closing a session waits for the server, when the client has no timeout
+const defaultCloseTimeout = time.Minute
+
+// Client sends queries over a pool of WebSocket connections.
+type Client struct {
+	endpoint  string
+	logger    *logrus.Logger
+	batchSize int
+	guard     *gremlin.Guard
+
+	mutex       sync.Mutex
+	connections []*connection
+	next        int
+}
+
+// NewClient creates a client for the Gremlin Server at the endpoint, e.g. ws://localhost:8182/gremlin. At most
+// poolSize connections are opened; they are opened when they are first needed.
+func NewClient(endpoint string, poolSize int) *Client {
+	if poolSize < 1 {
+		poolSize = 1
+	}
+
+	logger := logrus.New()
+	logger.Out = ioutil.Discard
+
+	return &Client{
+		endpoint:    endpoint,
+		logger:      logger,
+		batchSize:   defaultBatchSize,
+		guard:       gremlin.NewGuard(),
+		connections: make([]*connection, poolSize),
+	}
+}
+
+func (c *Client) SetLogger(logger *logrus.Logger) {
+	c.logger = logger
+}
+
+// Set the number of results that the server sends in a single response.
+func (c *Client) SetBatchSize(batchSize int) {
+	c.batchSize = batchSize
+}
+
+// Set how long a query may take, unless the query sets its own timeout. Zero means no timeout.
+func (c *Client) SetTimeout(timeout time.Duration) {
+	c.guard.Timeout = timeout
+}
+
+// Set how read only queries are retried when they fail with a transient error.
+func (c *Client) SetRetryPolicy(policy gremlin.RetryPolicy) {
+	c.guard.Retry = policy
+}
+
+// Set the circuit breaker that protects the server; nil disables it.
+func (c *Client) SetCircuitBreaker(breaker *gremlin.CircuitBreaker) {
+	c.guard.Breaker = breaker
+}
+
+func (c *Client) Ping(ctx context.Context) error {
+	return ping(ctx, c)
+}
+
+// Execute a query, and return all of its results. Read only queries are retried when they fail with a transient
+// error.
+func (c *Client) Execute(ctx context.Context, query *gremlin.Query) (*gremlin.Response, error) {
+	return c.guard.Execute(ctx, query, func(ctx context.Context) (*gremlin.Response, error) {
+		return collect(ctx, c.streamOnce, query)
+	})
+}
+
+// Stream the results of a query. The handler is called for every batch of results, as soon as the batch arrives. When
+// the handler returns an error, the remaining results are ignored and the error is returned. Streamed queries are not
+// retried.
+func (c *Client) Stream(ctx context.Context, query *gremlin.Query, handler func(data []gremlin.Datum) error) error {
+	return c.guard.Stream(ctx, query, func(ctx context.Context) error {
+		return c.streamOnce(ctx, query, handler)
+	})
+}
+
+func (c *Client) streamOnce(ctx context.Context, query *gremlin.Query, handler func(data []gremlin.Datum) error) error {
+	conn, err := c.connection()
+	if err != nil {
+		return &gremlin.UnavailableError{Err: err}
+	}
+
+	return c.stream(ctx, conn, "", query, handler)
+}
+
+// Session opens a session on a connection of the pool, which it shares with other queries and sessions. The queries in
+// a session share their variables, and are executed in the same transaction until it is committed or rolled back.
+func (c *Client) Session() (*Session, error) {
+	conn, err := c.connection()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Session{
+		client: c,
+		id:     newRequestID(),
+		conn:   conn,
+	}, nil
+}
+
+// Close all connections of the pool.
+func (c *Client) Close() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for i, conn := range c.connections {
+		if conn != nil {
+			conn.close()
+			c.connections[i] = nil
+		}
+	}
+
+	return nil
+}
+
+// Get the next connection of the pool, and (re)open it if it is not open.
+func (c *Client) connection() (*connection, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	i := c.next
+	c.next = (c.next + 1) % len(c.connections)
+
+	conn := c.connections[i]
+	if conn != nil && conn.broken() == nil {
+		return conn, nil
+	}
+
+	if conn != nil {
+		c.logger.WithField("error", conn.broken()).Debugf("Reconnecting to Gremlin server")
+	}
+
+	conn, err := dial(c.endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	c.connections[i] = conn
+	return conn, nil
+}
+
+// Send a query over the connection, in the session with the given ID if it is not empty, and pass the results to the
+// handler. When the context is canceled, the results that are still to come are ignored.
+func (c *Client) stream(ctx context.Context, conn *connection, sessionID string, query *gremlin.Query, handler func(data []gremlin.Datum) error) error {
+	log := c.logger.WithField("query", query.Query()).WithField("bindings", query.Bindings())
+	log.Debugf("Sending query")
+
+	r := &request{
+		RequestID: newRequestID(),
+		Op:        "eval",
+		Args: map[string]interface{}{
+			"gremlin":   query.Query(),
+			"bindings":  query.Bindings(),
+			"language":  "gremlin-groovy",
+			"batchSize": c.batchSize,
+		},
+	}
+
+	if sessionID != "" {
+		r.Processor = "session"
+		r.Args["session"] = sessionID
+	}
+
+	// Let the server stop evaluating the script when the caller stops waiting for it
+	if deadline, ok := ctx.Deadline(); ok {
+		r.Args["scriptEvaluationTimeout"] = int64(time.Until(deadline) / time.Millisecond)
+	}
+
+	pending, err := conn.send(r)
+	if err != nil {
+		return &gremlin.UnavailableError{Err: err}
+	}
+	defer conn.forget(r, pending)
+
+	for {
+		reply, ok, err := pending.next(ctx)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+
+		log.WithField("status_code", reply.Status.Code).Debugf("Received results: %s", string(reply.Result.Data))
+
+		switch reply.Status.Code {
+		case statusSuccess, statusNoContent, statusPartialContent:
+			data, err := gremlin.DecodeData(reply.Result.Data)
+			if err != nil {
+				return fmt.Errorf("Could not decode the results of the Gremlin server; %v", err)
+			}
+
+			if err := handler(data); err != nil {
+				return err
+			}
+
+			if reply.Status.Code != statusPartialContent {
+				return nil
+			}
+		default:
+			return &gremlin.ServerError{StatusCode: reply.Status.Code, Message: reply.Status.Message}
+		}
+	}
+
+	// The responses stopped before the final one
+	if err := conn.broken(); err != nil {
+		return &gremlin.UnavailableError{Err: err}
+	}
+	return &gremlin.UnavailableError{Err: fmt.Errorf("Gremlin server did not send the final response")}
+}
+
+// Session is a Gremlin session on a connection of the pool. The queries of a session run in one transaction; commit
+// it to persist them. Close the session when done, which rolls back the open transaction.
+//
+// When the connection breaks, the server ends the session, and the next query opens a new session on a reopened
+// connection of the pool. When queries of the open transaction are lost with the connection, the queries and commits of the
+// session fail until the transaction is rolled back.
+type Session struct {
+	client *Client
+
+	mutex  sync.Mutex
+	id     string
+	conn   *connection
+	open   bool  // whether queries ran since the last commit or rollback
+	lost   error // why the open transaction is lost
+	closed bool
+}
+
+func (s *Session) Ping(ctx context.Context) error {
+	return ping(ctx, s)
+}
+
+// Execute a query in the session, and return all of its results, like Client.Execute.
+func (s *Session) Execute(ctx context.Context, query *gremlin.Query) (*gremlin.Response, error) {
+	return s.client.guard.Execute(ctx, query, func(ctx context.Context) (*gremlin.Response, error) {
+		return collect(ctx, s.streamOnce, query)
+	})
+}
+
+// Stream the results of a query in the session, like Client.Stream.
+func (s *Session) Stream(ctx context.Context, query *gremlin.Query, handler func(data []gremlin.Datum) error) error {
+	return s.client.guard.Stream(ctx, query, func(ctx context.Context) error {
+		return s.streamOnce(ctx, query, handler)
+	})
+}
+
+func (s *Session) streamOnce(ctx context.Context, query *gremlin.Query, handler func(data []gremlin.Datum) error) error {
+	conn, id, err := s.connection()
+	if err != nil {
+		return err
+	}
+
+	err = s.client.stream(ctx, conn, id, query, handler)
+
+	// Queries that change the graph open a transaction, which is lost when the connection breaks
+	if !query.IsReadOnly() {
+		s.mutex.Lock()
+		if id == s.id {
+			s.open = true
+		}
+		s.mutex.Unlock()
+	}
+
+	return err
+}
+
+// The connection and the ID of the session. A broken connection is replaced by a new one, with a new session, unless
+// the open transaction is lost with it.
+func (s *Session) connection() (*connection, string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.closed {
+		return nil, "", fmt.Errorf("The session is closed")
+	}
+
+	broken := s.conn.broken()
+	if broken != nil && s.open {
+		s.lost = broken
+		s.open = false
+	}
+
+	if s.lost != nil {
+		return nil, "", &gremlin.UnavailableError{Err: fmt.Errorf("The transaction of the session is lost, because its connection broke; %v", s.lost)}
+	}
+
+	if broken == nil {
+		return s.conn, s.id, nil
+	}
+
+	s.client.logger.WithField("error", broken).Debugf("Reconnecting the session to Gremlin server")
+	conn, err := s.client.connection()
+	if err != nil {
+		return nil, "", &gremlin.UnavailableError{Err: err}
+	}
+
+	s.conn = conn
+	s.id = newRequestID()
+	return s.conn, s.id, nil
+}
+
+// Commit the open transaction of the session. It is committed even when the caller of the queries gave up.
+func (s *Session) Commit() error {
+	_, err := s.Execute(context.Background(), gremlin.G.Commit())
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	s.open = false
+	s.mutex.Unlock()
+
+	return nil
+}
+
+// Roll back the open transaction of the session.
+func (s *Session) Rollback() error {
+	// The server rolls back the transaction of a session whose connection broke
+	s.mutex.Lock()
+	lost := s.lost != nil || (s.open && s.conn.broken() != nil)
+	if lost {
+		s.lost = nil
+		s.open = false
+	}
+	s.mutex.Unlock()
+
+	if lost {
+		return nil
+	}
+
+	_, err := s.Execute(context.Background(), gremlin.G.Rollback())
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	s.open = false
+	s.mutex.Unlock()
+
+	return nil
+}
+
+// Close the session on the server. The connection stays open for the other queries and sessions of the pool. Close
+// gives up when the server does not answer within the timeout of the client, or within a minute without one.
+func (s *Session) Close() error {
+	s.mutex.Lock()
+	s.closed = true
+	conn, id := s.conn, s.id
+	s.mutex.Unlock()
+
+	timeout := s.client.guard.Timeout
+	if timeout == 0 {
+		timeout = defaultCloseTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	r := &request{
+		RequestID: newRequestID(),
+		Op:        "close",
+		Processor: "session",
+		Args:      map[string]interface{}{"session": id},
+	}
+
+	pending, err := conn.send(r)
+	if err != nil {
+		return err
+	}
+	defer conn.forget(r, pending)
+
+	for {
+		response, ok, err := pending.next(ctx)
+		if err != nil {
+			return fmt.Errorf("Could not close session, because the Gremlin server did not answer; %v", err)
+		}
+		if !ok {
+			return nil
+		}
+
+		if response.Status.Code != statusSuccess && response.Status.Code != statusNoContent {
+			return fmt.Errorf("Could not close session: %s (status code %d)", response.Status.Message, response.Status.Code)
+		}
+	}
+}
+
+// Collect all results of a query that are streamed by the stream function of a client or a session.
+func collect(ctx context.Context, stream func(ctx context.Context, query *gremlin.Query, handler func(data []gremlin.Datum) error) error, query *gremlin.Query) (*gremlin.Response, error) {
+	data := make([]gremlin.Datum, 0)
+
+	err := stream(ctx, query, func(batch []gremlin.Datum) error {
+		data = append(data, batch...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &gremlin.Response{Data: data}, nil
+}
+
+func ping(ctx context.Context, client gremlin.Client) error {
+	response, err := client.Execute(ctx, gremlin.RawQuery("1+41"))
+	if err != nil {
+		return err
+	}
+
+	i, err := response.OneInt()
+	if err != nil {
+		return err
+	}
+
+	if i != 42 {
+		return fmt.Errorf("Could not connnected to Gremlin server. Expected the answer to a test query to be 42', but it was %v", i)
+	}
+
+	return nil
+}
+
+func newRequestID() string {
+	id, err := uuid.NewV4()
+	if err != nil {
+		panic("PANIC: Can't create UUID")
+	}
+	return id.String()
+}
@@ -0,0 +1,294 @@
+package websocket_client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/creativesoftwarefdn/weaviate/gremlin"
+	"github.com/creativesoftwarefdn/weaviate/gremlin/fake_server"
+)
+
+func newTestClient(t *testing.T, poolSize int) (*Client, func()) {
+	server := fake_server.NewServer()
+	client := NewClient("ws"+strings.TrimPrefix(server.URL, "http"), poolSize)
+
+	return client, func() {
+		client.Close()
+		server.Close()
+	}
+}
+
+func addPeople(t *testing.T, client gremlin.Client, n int) {
+	for i := 0; i < n; i++ {
+		_, err := client.Execute(context.Background(), gremlin.G.AddV("person").Int64Property("number", int64(i)))
+		require.NoError(t, err)
+	}
+}
+
+func TestPingAndExecute(t *testing.T) {
+	client, done := newTestClient(t, 2)
+	defer done()
+
+	require.NoError(t, client.Ping(context.Background()))
+
+	addPeople(t, client, 3)
+
+	result, err := client.Execute(context.Background(), gremlin.G.V().HasLabel("person").Count())
+	require.NoError(t, err)
+	count, err := result.OneInt()
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+
+	// Queries without results give an empty response
+	result, err = client.Execute(context.Background(), gremlin.G.V().HasLabel("animal"))
+	require.NoError(t, err)
+	require.Len(t, result.Data, 0)
+}
+
+func TestStreamInBatches(t *testing.T) {
+	client, done := newTestClient(t, 1)
+	defer done()
+
+	addPeople(t, client, 5)
+	client.SetBatchSize(2)
+
+	var batches []int
+	err := client.Stream(context.Background(), gremlin.G.V().HasLabel("person"), func(data []gremlin.Datum) error {
+		batches = append(batches, len(data))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 2, 1}, batches)
+
+	// Execute collects all batches
+	result, err := client.Execute(context.Background(), gremlin.G.V().HasLabel("person"))
+	require.NoError(t, err)
+	require.Len(t, result.Data, 5)
+
+	// Stopping early returns the error of the handler, and the connection keeps working
+	stop := errors.New("stop")
+	err = client.Stream(context.Background(), gremlin.G.V().HasLabel("person"), func(data []gremlin.Datum) error {
+		return stop
+	})
+	require.Equal(t, stop, err)
+	require.NoError(t, client.Ping(context.Background()))
+}
+
+func TestMultiplexing(t *testing.T) {
+	client, done := newTestClient(t, 2)
+	defer done()
+
+	addPeople(t, client, 10)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 50)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			result, err := client.Execute(context.Background(), gremlin.G.V().HasLabel("person").HasString("name", "nobody").Count())
+			if err != nil {
+				errs <- err
+				return
+			}
+			if count, err := result.OneInt(); err != nil || count != 0 {
+				errs <- fmt.Errorf("query %d: unexpected result %v, %v", i, count, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+}
+
+func TestSlowStreamDoesNotHoldUpConnection(t *testing.T) {
+	client, done := newTestClient(t, 1)
+	defer done()
+
+	addPeople(t, client, 6)
+	client.SetBatchSize(1)
+
+	// The stream takes its first batch, and waits while the other batches arrive on the connection
+	release := make(chan struct{})
+	streamed := make(chan error, 1)
+	first := true
+	go func() {
+		streamed <- client.Stream(context.Background(), gremlin.G.V().HasLabel("person"), func(data []gremlin.Datum) error {
+			if first {
+				first = false
+				<-release
+			}
+			return nil
+		})
+	}()
+
+	// Give the server the time to send all batches
+	time.Sleep(100 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, client.Ping(ctx))
+
+	close(release)
+	require.NoError(t, <-streamed)
+}
+
+func TestServerError(t *testing.T) {
+	client, done := newTestClient(t, 1)
+	defer done()
+
+	_, err := client.Execute(context.Background(), gremlin.RawQuery("g.V().repeat(out()).times(2)"))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "repeat")
+
+	// The connection keeps working after an error
+	require.NoError(t, client.Ping(context.Background()))
+}
+
+func TestCancel(t *testing.T) {
+	server := fake_server.NewServer()
+	defer server.Close()
+	client := NewClient("ws"+strings.TrimPrefix(server.URL, "http"), 1)
+	defer client.Close()
+
+	server.InjectFailure(func(step string) error {
+		time.Sleep(200 * time.Millisecond)
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := client.Execute(ctx, gremlin.G.V().Count())
+	require.Equal(t, context.DeadlineExceeded, err)
+	require.True(t, time.Since(start) < 200*time.Millisecond, "the query returned after %v", time.Since(start))
+
+	// The connection keeps working after a canceled query
+	server.InjectFailure(nil)
+	require.NoError(t, client.Ping(context.Background()))
+}
+
+func TestReconnect(t *testing.T) {
+	client, done := newTestClient(t, 1)
+	defer done()
+
+	require.NoError(t, client.Ping(context.Background()))
+	client.Close()
+	require.NoError(t, client.Ping(context.Background()))
+}
+
+func TestSession(t *testing.T) {
+	client, done := newTestClient(t, 1)
+	defer done()
+
+	session, err := client.Session()
+	require.NoError(t, err)
+
+	require.NoError(t, session.Ping(context.Background()))
+	addPeople(t, session, 2)
+
+	result, err := client.Execute(context.Background(), gremlin.G.V().HasLabel("person").Count())
+	require.NoError(t, err)
+	count, err := result.OneInt()
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	require.NoError(t, session.Close())
+	_, err = session.Execute(context.Background(), gremlin.G.V().Count())
+	require.Error(t, err)
+}
+
+func TestSessionsSharePool(t *testing.T) {
+	client, done := newTestClient(t, 1)
+	defer done()
+
+	first, err := client.Session()
+	require.NoError(t, err)
+	second, err := client.Session()
+	require.NoError(t, err)
+	require.True(t, first.conn == second.conn, "the sessions have connections of their own")
+
+	// Closing a session leaves the connection open for the other session and the client
+	addPeople(t, first, 1)
+	require.NoError(t, first.Close())
+	require.Nil(t, second.conn.broken())
+	addPeople(t, second, 1)
+	require.NoError(t, second.Commit())
+	require.NoError(t, second.Close())
+	require.NoError(t, client.Ping(context.Background()))
+
+	result, err := client.Execute(context.Background(), gremlin.G.V().HasLabel("person").Count())
+	require.NoError(t, err)
+	count, err := result.OneInt()
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+}
+
+func TestSessionCommitAndRollback(t *testing.T) {
+	client, done := newTestClient(t, 1)
+	defer done()
+
+	countPeople := func() int {
+		result, err := client.Execute(context.Background(), gremlin.G.V().HasLabel("person").Count())
+		require.NoError(t, err)
+		count, err := result.OneInt()
+		require.NoError(t, err)
+		return count
+	}
+
+	session, err := client.Session()
+	require.NoError(t, err)
+
+	addPeople(t, session, 2)
+	require.NoError(t, session.Commit())
+
+	addPeople(t, session, 3)
+	require.NoError(t, session.Rollback())
+	require.Equal(t, 2, countPeople())
+
+	// Closing the session rolls back the open transaction
+	addPeople(t, session, 1)
+	require.NoError(t, session.Close())
+	require.Equal(t, 2, countPeople())
+}
+
+func TestSessionReconnect(t *testing.T) {
+	client, done := newTestClient(t, 1)
+	defer done()
+
+	session, err := client.Session()
+	require.NoError(t, err)
+	defer session.Close()
+
+	// Without an open transaction, the session continues on a new connection
+	_, err = session.Execute(context.Background(), gremlin.G.V().Count())
+	require.NoError(t, err)
+	session.conn.close()
+	addPeople(t, session, 1)
+
+	// The queries of the open transaction are lost with the connection, so the session fails until it is rolled back
+	session.conn.close()
+	_, err = session.Execute(context.Background(), gremlin.G.AddV("person"))
+	require.Error(t, err)
+	require.Error(t, session.Commit())
+	require.NoError(t, session.Rollback())
+
+	addPeople(t, session, 1)
+	require.NoError(t, session.Commit())
+
+	result, err := client.Execute(context.Background(), gremlin.G.V().HasLabel("person").Count())
+	require.NoError(t, err)
+	count, err := result.OneInt()
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+}
@@ -0,0 +1,231 @@
+package websocket_client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/creativesoftwarefdn/weaviate/gremlin"
+)
+
+// The serializer to request from the Gremlin Server; GraphSON 2.0, which keeps the types of the values.
+const mimeType = gremlin.MimeTypeGraphSONv2
+
+// Status codes of the Gremlin Server protocol
+const (
+	statusSuccess        = 200
+	statusNoContent      = 204
+	statusPartialContent = 206
+)
+
+type request struct {
+	RequestID string                 `json:"requestId"`
+	Op        string                 `json:"op"`
+	Processor string                 `json:"processor"`
+	Args      map[string]interface{} `json:"args"`
+}
+
+type responseStatus struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+}
+
+type responseResult struct {
+	Data json.RawMessage `json:"data"`
+	Meta interface{}     `json:"meta"`
+}
+
+type response struct {
+	RequestID string         `json:"requestId"`
+	Status    responseStatus `json:"status"`
+	Result    responseResult `json:"result"`
+}
+
+// A request that waits for its responses. The reader of the connection queues the responses, until the final response
+// is queued or the connection breaks. The queue has no limit, so that a caller that is slow to take its responses
+// never holds up the responses to the other requests on the connection.
+type pendingRequest struct {
+	mutex sync.Mutex
+	queue []*response
+	ended bool          // no responses are queued after the ones in the queue
+	ready chan struct{} // signaled when the queue changes
+}
+
+func newPendingRequest() *pendingRequest {
+	return &pendingRequest{ready: make(chan struct{}, 1)}
+}
+
+// Queue a response, or only end the queue when the response is nil. It never blocks the reader.
+func (p *pendingRequest) add(r *response, final bool) {
+	p.mutex.Lock()
+	if r != nil {
+		p.queue = append(p.queue, r)
+	}
+	p.ended = p.ended || final
+	p.mutex.Unlock()
+
+	select {
+	case p.ready <- struct{}{}:
+	default:
+	}
+}
+
+// Take the next response. It returns false when the responses ended, and the error of the context when it is done
+// before the next response arrives.
+func (p *pendingRequest) next(ctx context.Context) (*response, bool, error) {
+	for {
+		p.mutex.Lock()
+		if len(p.queue) > 0 {
+			r := p.queue[0]
+			p.queue[0] = nil
+			p.queue = p.queue[1:]
+			p.mutex.Unlock()
+			return r, true, nil
+		}
+		ended := p.ended
+		p.mutex.Unlock()
+
+		if ended {
+			return nil, false, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+		case <-p.ready:
+		}
+	}
+}
+
+// A single WebSocket connection, on which many requests can be in flight at the same time. The responses are
+// dispatched to the requests by their request ID.
+type connection struct {
+	ws         *websocket.Conn
+	writeMutex sync.Mutex
+
+	mutex   sync.Mutex
+	pending map[string]*pendingRequest
+	err     error // the error that broke the connection
+}
+
+func dial(endpoint string) (*connection, error) {
+	ws, _, err := websocket.DefaultDialer.Dial(endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Could not connect to Gremlin server at '%s', because %v", endpoint, err)
+	}
+
+	c := &connection{
+		ws:      ws,
+		pending: map[string]*pendingRequest{},
+	}
+	go c.read()
+
+	return c, nil
+}
+
+// Send a request. Take its responses from the returned request, and forget it when done.
+func (c *connection) send(r *request) (*pendingRequest, error) {
+	message, err := json.Marshal(r)
+	if err != nil {
+		return nil, fmt.Errorf("Could not create query because %v", err)
+	}
+
+	// Binary frames start with the length and the name of the mime type of the payload
+	frame := append([]byte{byte(len(mimeType))}, []byte(mimeType)...)
+	frame = append(frame, message...)
+
+	pending := newPendingRequest()
+
+	c.mutex.Lock()
+	if c.err != nil {
+		c.mutex.Unlock()
+		return nil, c.err
+	}
+	c.pending[r.RequestID] = pending
+	c.mutex.Unlock()
+
+	c.writeMutex.Lock()
+	err = c.ws.WriteMessage(websocket.BinaryMessage, frame)
+	c.writeMutex.Unlock()
+
+	if err != nil {
+		c.fail(fmt.Errorf("Could not send query to the Gremlin server, because %v", err))
+		return nil, c.broken()
+	}
+
+	return pending, nil
+}
+
+// Read all responses, and queue them for the pending requests.
+func (c *connection) read() {
+	for {
+		_, message, err := c.ws.ReadMessage()
+		if err != nil {
+			c.fail(fmt.Errorf("Connection to the Gremlin server is lost, because %v", err))
+			break
+		}
+
+		var r response
+		if err := json.Unmarshal(message, &r); err != nil {
+			c.fail(fmt.Errorf("Could not parse response of the Gremlin server, because %v", err))
+			break
+		}
+
+		final := r.Status.Code != statusPartialContent
+
+		c.mutex.Lock()
+		pending, ok := c.pending[r.RequestID]
+		if ok && final {
+			delete(c.pending, r.RequestID)
+		}
+		c.mutex.Unlock()
+
+		// The caller may have stopped listening already
+		if ok {
+			pending.add(&r, final)
+		}
+	}
+
+	// The connection is broken; the requests that are still pending get the error.
+	c.mutex.Lock()
+	for id, pending := range c.pending {
+		pending.add(nil, true)
+		delete(c.pending, id)
+	}
+	c.mutex.Unlock()
+}
+
+// Break the connection. The reader stops, and all pending requests get the error.
+func (c *connection) fail(err error) {
+	c.mutex.Lock()
+	if c.err == nil {
+		c.err = err
+	}
+	c.mutex.Unlock()
+
+	c.ws.Close()
+}
+
+// Stop listening to a request.
+func (c *connection) forget(r *request, pending *pendingRequest) {
+	c.mutex.Lock()
+	if c.pending[r.RequestID] == pending {
+		delete(c.pending, r.RequestID)
+	}
+	c.mutex.Unlock()
+}
+
+// The error that broke the connection, or nil if it still works.
+func (c *connection) broken() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.err
+}
+
+func (c *connection) close() {
+	c.fail(errors.New("Connection to the Gremlin server is closed"))
+}
@@ -86,8 +86,17 @@ func (m *ErrorResponse) UnmarshalBinary(b []byte) error {
 // swagger:model ErrorResponseError
 type ErrorResponseError struct {
 
+	// The class of the thing or action, when the error is about one of its properties.
+	Class string `json:"class,omitempty"`
+
+	// The constraint of the property that is violated, e.g. 'maximum' or 'unique'.
+	Constraint string `json:"constraint,omitempty"`
+
 	// message
 	Message string `json:"message,omitempty"`
+
+	// The property that the error is about.
+	Property string `json:"property,omitempty"`
 }
 
 // Validate validates this error response error
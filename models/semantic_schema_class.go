@@ -36,6 +36,9 @@ type SemanticSchemaClass struct {
 	// Description of the class
 	Description string `json:"description,omitempty"`
 
+	// Name of the parent class, whose properties this class inherits. References to the parent class accept instances of this class as well.
+	Extends string `json:"extends,omitempty"`
+
 	// Describes the kind of class. For example Geolocation for the class City.
 	Keywords []*SemanticSchemaClassKeywordsItems0 `json:"keywords"`
 
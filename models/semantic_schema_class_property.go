@@ -33,6 +33,9 @@ type SemanticSchemaClassProperty struct {
 	// Can be a reference ($cref) to another type when starts with a capital (for example Person) otherwise "string" or "int".
 	AtDataType []string `json:"@dataType"`
 
+	// constraints
+	Constraints *SemanticSchemaClassPropertyConstraints `json:"constraints,omitempty"`
+
 	// Description of the property
 	Description string `json:"description,omitempty"`
 
@@ -47,6 +50,10 @@ type SemanticSchemaClassProperty struct {
 func (m *SemanticSchemaClassProperty) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateConstraints(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateKeywords(formats); err != nil {
 		res = append(res, err)
 	}
@@ -57,6 +64,24 @@ func (m *SemanticSchemaClassProperty) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *SemanticSchemaClassProperty) validateConstraints(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Constraints) { // not required
+		return nil
+	}
+
+	if m.Constraints != nil {
+		if err := m.Constraints.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("constraints")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *SemanticSchemaClassProperty) validateKeywords(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Keywords) { // not required
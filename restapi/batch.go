@@ -0,0 +1,405 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/creativesoftwarefdn/weaviate/auth"
+	dbconnector "github.com/creativesoftwarefdn/weaviate/connectors"
+	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/creativesoftwarefdn/weaviate/validation"
+)
+
+const (
+	// errorBatchEmpty message
+	errorBatchEmpty string = "no %s are given in the batch"
+	// errorBatchMissingObject message
+	errorBatchMissingObject string = "no %s is given in this item of the batch"
+	// errorBatchDuplicate message
+	errorBatchDuplicate string = "'%s' occurs more than once in the batch"
+	// errorBatchInvalidRef message
+	errorBatchInvalidRef string = "property '%s' refers to '%s', which is not an item of this batch"
+	// errorBatchFailedRef message
+	errorBatchFailedRef string = "refers to item %d of the batch, which failed"
+	// errorBatchNotAllowed message
+	errorBatchNotAllowed string = "not allowed to update %s '%s'"
+)
+
+// The statuses of the items of a batch, which are the same for things and actions
+const (
+	batchStatusCreated = models.ThingBatchResultStatusCreated
+	batchStatusUpdated = models.ThingBatchResultStatusUpdated
+	batchStatusFailed  = models.ThingBatchResultStatusFailed
+)
+
+// batchItem is an item of a batch of things or actions, as far as the batch handlers of both have it in common
+type batchItem struct {
+	// The UUID of the existing object to update, or empty to add a new object
+	ExistingUUID strfmt.UUID
+	// Whether the item holds no thing or action
+	Missing bool
+	// The class of the thing or action
+	Class string
+	// The schema of the thing or action, whose references to other items of the batch are resolved
+	Schema interface{}
+}
+
+// batchExisting is an existing thing or action, which is updated by the batch
+type batchExisting struct {
+	// The current values, a *models.Thing or *models.Action, which are moved to the history
+	Current          interface{}
+	Key              *models.SingleRef
+	CreationTimeUnix int64
+}
+
+// batchWrite is an item of a batch that is valid, and is written to the database
+type batchWrite struct {
+	// The index of the item in the batch
+	Index              int
+	UUID               strfmt.UUID
+	Update             bool
+	CreationTimeUnix   int64
+	LastUpdateTimeUnix int64
+	Key                *models.SingleRef
+	// The current values of an updated thing or action, see batchExisting
+	History interface{}
+}
+
+// batchResult is the result of an item of a batch
+type batchResult struct {
+	UUID   strfmt.UUID
+	Status string
+	Errors *models.ErrorResponse
+}
+
+// batchOperations are the parts of a batch that differ between things and actions
+type batchOperations struct {
+	refType connutils.RefType
+	// get fetches an existing object from the database
+	get func(ctx context.Context, UUID strfmt.UUID) (*batchExisting, error)
+	// validate validates item i of the batch. The connector regards the items of the batch to exist.
+	validate func(ctx context.Context, i int, UUID strfmt.UUID, connector dbconnector.DatabaseConnector) error
+	// write writes the valid items of the batch to the database at once
+	write func(ctx context.Context, writes []*batchWrite) error
+}
+
+// createBatch validates every item of a batch, and then writes the valid items in one go. Updated items are moved
+// to their history in the same write, so nothing is moved to the history for items that are not written.
+func createBatch(ctx context.Context, principal interface{}, databaseConnector dbconnector.DatabaseConnector, keyRef *models.SingleRef, items []batchItem, ops batchOperations) []*batchResult {
+	name := strings.ToLower(string(ops.refType))
+
+	// Determine the UUIDs of all items first, so the items in the batch can refer to each other
+	existingUUIDs := make([]strfmt.UUID, len(items))
+	for i, item := range items {
+		existingUUIDs[i] = item.ExistingUUID
+	}
+	UUIDs, duplicates := batchUUIDs(existingUUIDs)
+	classes := map[strfmt.UUID]string{}
+	for i, item := range items {
+		if !duplicates[i] {
+			classes[UUIDs[i]] = item.Class
+		}
+	}
+	validationConnector := newBatchConnector(databaseConnector, ops.refType, classes)
+
+	// Prepare the write of every item, and fail the items that can not be written before they are validated
+	results := make([]*batchResult, len(items))
+	writes := make([]*batchWrite, len(items))
+	failed := make([]bool, len(items))
+	refs := make([][]int, len(items))
+	for i, item := range items {
+		results[i] = &batchResult{UUID: UUIDs[i]}
+
+		fail := func(err error) {
+			failed[i] = true
+			results[i].Status = batchStatusFailed
+			results[i].Errors = createValidationErrorResponseObject(err)
+		}
+
+		if item.Missing {
+			fail(fmt.Errorf(errorBatchMissingObject, name))
+			continue
+		}
+
+		if duplicates[i] {
+			fail(fmt.Errorf(errorBatchDuplicate, UUIDs[i]))
+			continue
+		}
+
+		// Point references to other items in the batch to their UUIDs
+		var err error
+		refs[i], err = resolveBatchRefs(item.Schema, ops.refType, UUIDs)
+		if err != nil {
+			fail(err)
+			continue
+		}
+
+		write := &batchWrite{Index: i, UUID: UUIDs[i], Update: item.ExistingUUID != ""}
+		if write.Update {
+			existing, err := ops.get(ctx, item.ExistingUUID)
+			if err != nil {
+				fail(err)
+				continue
+			}
+
+			// This is a write function, validate if allowed to write this object?
+			if allowed, _ := auth.ActionsAllowed(ctx, []string{"write"}, principal, databaseConnector, existing.Key.NrDollarCref); !allowed {
+				fail(fmt.Errorf(errorBatchNotAllowed, name, item.ExistingUUID))
+				continue
+			}
+
+			write.CreationTimeUnix = existing.CreationTimeUnix
+			write.LastUpdateTimeUnix = connutils.NowUnix()
+			write.Key = existing.Key
+			write.History = existing.Current
+		} else {
+			write.CreationTimeUnix = connutils.NowUnix()
+			write.Key = keyRef
+		}
+
+		writes[i] = write
+	}
+
+	// Validate schema given in body with the weaviate schema. The unique values of a valid item can't be used by the
+	// items after it. Items referring to a failed item in the batch can't be stored either, so their values are
+	// released: the batch is validated again without them, until no more items fail that way.
+	// The items that failed before they could be validated, and the items that refer to a failed item
+	rejected := append([]bool{}, failed...)
+	causes := map[int]int{}
+	for {
+		validationConnector.claims = validation.NewBatchClaims()
+		for i := range items {
+			if _, ok := causes[i]; ok || rejected[i] {
+				continue
+			}
+
+			validationConnector.claims.Start(UUIDs[i])
+			if err := ops.validate(ctx, i, UUIDs[i], validationConnector); err != nil {
+				failed[i] = true
+				results[i].Status = batchStatusFailed
+				results[i].Errors = createValidationErrorResponseObject(err)
+				continue
+			}
+			validationConnector.claims.Commit()
+
+			failed[i] = false
+			results[i].Errors = nil
+			if writes[i].Update {
+				results[i].Status = batchStatusUpdated
+			} else {
+				results[i].Status = batchStatusCreated
+			}
+		}
+
+		propagated := propagateBatchFailures(failed, refs)
+		if len(propagated) == 0 {
+			break
+		}
+		for i, index := range propagated {
+			causes[i] = index
+			results[i].Status = batchStatusFailed
+			results[i].Errors = createErrorResponseObject(fmt.Sprintf(errorBatchFailedRef, index))
+		}
+
+		for i := range items {
+			if _, ok := causes[i]; !ok && !rejected[i] {
+				failed[i] = false
+			}
+		}
+	}
+
+	// Store all valid items at once. Items that the database can't store fail, and so do the items that refer to
+	// them; the other items are stored without them.
+	for {
+		valid := []*batchWrite{}
+		for i, write := range writes {
+			if !failed[i] {
+				valid = append(valid, write)
+			}
+		}
+
+		err := ops.write(ctx, valid)
+		itemErrors, ok := err.(*connutils.BatchItemErrors)
+		if !ok || len(itemErrors.Errors) == 0 {
+			if err != nil {
+				for i := range results {
+					if !failed[i] {
+						results[i].Status = batchStatusFailed
+						results[i].Errors = createErrorResponseObject(err.Error())
+					}
+				}
+			}
+			break
+		}
+
+		for j, itemErr := range itemErrors.Errors {
+			i := valid[j].Index
+			failed[i] = true
+			results[i].Status = batchStatusFailed
+			results[i].Errors = createErrorResponseObject(itemErr.Error())
+		}
+		for i, index := range propagateBatchFailures(failed, refs) {
+			results[i].Status = batchStatusFailed
+			results[i].Errors = createErrorResponseObject(fmt.Sprintf(errorBatchFailedRef, index))
+		}
+	}
+
+	return results
+}
+
+// batchConnector wraps the database connector while a batch is validated. The objects in the batch
+// are regarded to exist already, so that references between objects of the same batch are valid.
+type batchConnector struct {
+	dbconnector.DatabaseConnector
+	refType connutils.RefType
+	// The classes of the objects in the batch, by their UUIDs
+	classes map[strfmt.UUID]string
+	claims  *validation.BatchClaims
+}
+
+// newBatchConnector creates a batchConnector for a batch of the given type with objects of the given classes
+func newBatchConnector(databaseConnector dbconnector.DatabaseConnector, refType connutils.RefType, classes map[strfmt.UUID]string) *batchConnector {
+	return &batchConnector{
+		DatabaseConnector: databaseConnector,
+		refType:           refType,
+		classes:           classes,
+		claims:            validation.NewBatchClaims(),
+	}
+}
+
+// GetThing returns the class of things in the batch, other things are fetched from the database
+func (b *batchConnector) GetThing(ctx context.Context, UUID strfmt.UUID, thingResponse *models.ThingGetResponse) error {
+	if className, ok := b.classes[UUID]; ok && b.refType == connutils.RefTypeThing {
+		thingResponse.AtClass = className
+		return nil
+	}
+
+	return b.DatabaseConnector.GetThing(ctx, UUID, thingResponse)
+}
+
+// GetAction returns the class of actions in the batch, other actions are fetched from the database
+func (b *batchConnector) GetAction(ctx context.Context, UUID strfmt.UUID, actionResponse *models.ActionGetResponse) error {
+	if className, ok := b.classes[UUID]; ok && b.refType == connutils.RefTypeAction {
+		actionResponse.AtClass = className
+		return nil
+	}
+
+	return b.DatabaseConnector.GetAction(ctx, UUID, actionResponse)
+}
+
+// FindInstances finds the instances in the database, when the database connector can find them, and the valid items
+// of the batch that are validated before and have the value too.
+func (b *batchConnector) FindInstances(ctx context.Context, refType connutils.RefType, className string, propertyName string, value interface{}) ([]strfmt.UUID, error) {
+	claimed := b.claims.Find(refType, className, propertyName, value)
+
+	finder, ok := b.DatabaseConnector.(dbconnector.InstanceFinder)
+	if !ok {
+		return claimed, nil
+	}
+
+	UUIDs, err := finder.FindInstances(ctx, refType, className, propertyName, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(UUIDs, claimed...), nil
+}
+
+// batchUUIDs returns the UUID of every item in the batch. Existing items keep their UUID, new ones get a new UUID.
+// Items that have a UUID which is also used by an earlier item in the batch are returned as duplicates.
+func batchUUIDs(existingUUIDs []strfmt.UUID) (UUIDs []strfmt.UUID, duplicates map[int]bool) {
+	UUIDs = make([]strfmt.UUID, len(existingUUIDs))
+	duplicates = map[int]bool{}
+	seen := map[strfmt.UUID]bool{}
+
+	for i, UUID := range existingUUIDs {
+		if UUID == "" {
+			UUID = connutils.GenerateUUID()
+		} else if seen[UUID] {
+			duplicates[i] = true
+		}
+
+		seen[UUID] = true
+		UUIDs[i] = UUID
+	}
+
+	return UUIDs, duplicates
+}
+
+// resolveBatchRefs replaces the '$cref' of references to other items in the batch, given as '#/things/{index}'
+// or '#/actions/{index}', with the UUID of that item. It returns the indices of the items that are referred to.
+func resolveBatchRefs(schema interface{}, refType connutils.RefType, UUIDs []strfmt.UUID) ([]int, error) {
+	properties, ok := schema.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	prefix := fmt.Sprintf("#/%ss/", strings.ToLower(string(refType)))
+
+	var indices []int
+	for key, value := range properties {
+		cref, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		location, ok := cref["$cref"].(string)
+		if !ok || !strings.HasPrefix(location, prefix) {
+			continue
+		}
+
+		index, err := strconv.Atoi(strings.TrimPrefix(location, prefix))
+		if err != nil || index < 0 || index >= len(UUIDs) || cref["type"] != string(refType) {
+			return nil, fmt.Errorf(errorBatchInvalidRef, key, location)
+		}
+
+		cref["$cref"] = string(UUIDs[index])
+		indices = append(indices, index)
+	}
+
+	return indices, nil
+}
+
+// propagateBatchFailures marks every item that refers to a failed item of the same batch as failed as well.
+// It returns, for every newly failed item, the index of the failed item it refers to.
+func propagateBatchFailures(failed []bool, refs [][]int) map[int]int {
+	causes := map[int]int{}
+
+	for changed := true; changed; {
+		changed = false
+		for i, indices := range refs {
+			if failed[i] {
+				continue
+			}
+
+			for _, index := range indices {
+				if failed[index] {
+					failed[i] = true
+					causes[i] = index
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	return causes
+}
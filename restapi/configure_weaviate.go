@@ -41,6 +41,7 @@ import (
 	"google.golang.org/grpc/grpclog"
 
 	"github.com/creativesoftwarefdn/weaviate/auth"
+	libbackup "github.com/creativesoftwarefdn/weaviate/backup"
 	"github.com/creativesoftwarefdn/weaviate/broker"
 	"github.com/creativesoftwarefdn/weaviate/config"
 	dbconnector "github.com/creativesoftwarefdn/weaviate/connectors"
@@ -51,9 +52,12 @@ import (
 	"github.com/creativesoftwarefdn/weaviate/models"
 	"github.com/creativesoftwarefdn/weaviate/restapi/operations"
 	"github.com/creativesoftwarefdn/weaviate/restapi/operations/actions"
+	"github.com/creativesoftwarefdn/weaviate/restapi/operations/backup"
+	"github.com/creativesoftwarefdn/weaviate/restapi/operations/contextionary_api"
 	"github.com/creativesoftwarefdn/weaviate/restapi/operations/keys"
+	"github.com/creativesoftwarefdn/weaviate/restapi/operations/schema"
 	"github.com/creativesoftwarefdn/weaviate/restapi/operations/things"
-	"github.com/creativesoftwarefdn/weaviate/schema"
+	libschema "github.com/creativesoftwarefdn/weaviate/schema"
 	"github.com/creativesoftwarefdn/weaviate/validation"
 
 	libcontextionary "github.com/creativesoftwarefdn/weaviate/contextionary"
@@ -63,9 +67,13 @@ import (
 
 const pageOverride int = 1
 
+// healthCheckTimeout is how long the health endpoint waits for the database to answer
+const healthCheckTimeout = 5 * time.Second
+
 var connectorOptionGroup *swag.CommandLineOptionsGroup
-var databaseSchema schema.WeaviateSchema
+var databaseSchema libschema.WeaviateSchema
 var contextionary *libcontextionary.Contextionary
+var fileContextionary *libcontextionary.Contextionary
 var network libnetwork.Network
 var serverConfig *config.WeaviateConfig
 var dbConnector dbconnector.DatabaseConnector
@@ -207,6 +215,20 @@ func createErrorResponseObject(message string) *models.ErrorResponse {
 	return er
 }
 
+// createValidationErrorResponseObject is createErrorResponseObject for validation errors, which points at the property
+// whose value violates a constraint.
+func createValidationErrorResponseObject(err error) *models.ErrorResponse {
+	er := createErrorResponseObject(err.Error())
+
+	if propertyError, ok := err.(*validation.PropertyError); ok {
+		er.Error.Class = propertyError.Class
+		er.Error.Property = propertyError.Property
+		er.Error.Constraint = propertyError.Constraint
+	}
+
+	return er
+}
+
 func headerAPIKeyHandling(ctx context.Context, keyToken string) (*models.KeyTokenGetResponse, error) {
 	// Convert JSON string to struct
 	kth := keyTokenHeader{}
@@ -396,21 +418,22 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		json.Unmarshal([]byte(updatedJSON), &action)
 
 		// Validate schema made after patching with the weaviate schema
-		validatedErr := validation.ValidateActionBody(params.HTTPRequest.Context(), &action.ActionCreate, databaseSchema, dbConnector, serverConfig, principal.(*models.KeyTokenGetResponse))
+		validatedErr := validation.ValidateActionBody(params.HTTPRequest.Context(), &action.ActionCreate, UUID, currentSchema(), dbConnector, serverConfig, principal.(*models.KeyTokenGetResponse))
 		if validatedErr != nil {
-			return actions.NewWeaviateActionsPatchUnprocessableEntity().WithPayload(createErrorResponseObject(validatedErr.Error()))
+			return actions.NewWeaviateActionsPatchUnprocessableEntity().WithPayload(createValidationErrorResponseObject(validatedErr))
 		}
 
-		// Move the current properties to the history
-		go dbConnector.MoveToHistoryAction(ctx, &oldAction.Action, params.ActionID, false)
-
-		// Update the database
-		go dbConnector.UpdateAction(ctx, action, UUID)
+		// Move the current properties to the history and update the action, in one transaction
+		if err := dbConnector.MoveToHistoryAndUpdateAction(ctx, &oldAction.Action, action, UUID); err != nil {
+			if _, ok := err.(*connutils.InvalidValueError); ok {
+				return actions.NewWeaviateActionsPatchUnprocessableEntity().WithPayload(createErrorResponseObject(err.Error()))
+			}
+			return actions.NewWeaviateActionsPatchInternalServerError().WithPayload(createErrorResponseObject(err.Error()))
+		}
 
 		// Create return Object
 		actionGetResponse.Action = *action
 
-		// Returns accepted so a Go routine can process in the background
 		return actions.NewWeaviateActionsPatchAccepted().WithPayload(&actionGetResponse)
 	})
 	api.ActionsWeaviateActionUpdateHandler = actions.WeaviateActionUpdateHandlerFunc(func(params actions.WeaviateActionUpdateParams, principal interface{}) middleware.Responder {
@@ -440,19 +463,21 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		}
 
 		// Validate schema given in body with the weaviate schema
-		validatedErr := validation.ValidateActionBody(params.HTTPRequest.Context(), &params.Body.ActionCreate, databaseSchema, dbConnector, serverConfig, principal.(*models.KeyTokenGetResponse))
+		validatedErr := validation.ValidateActionBody(params.HTTPRequest.Context(), &params.Body.ActionCreate, UUID, currentSchema(), dbConnector, serverConfig, principal.(*models.KeyTokenGetResponse))
 		if validatedErr != nil {
-			return actions.NewWeaviateActionUpdateUnprocessableEntity().WithPayload(createErrorResponseObject(validatedErr.Error()))
+			return actions.NewWeaviateActionUpdateUnprocessableEntity().WithPayload(createValidationErrorResponseObject(validatedErr))
 		}
 
-		// Move the current properties to the history
-		go dbConnector.MoveToHistoryAction(ctx, &oldAction.Action, params.ActionID, false)
-
-		// Update the database
+		// Move the current properties to the history and update the action, in one transaction
 		params.Body.LastUpdateTimeUnix = connutils.NowUnix()
 		params.Body.CreationTimeUnix = actionGetResponse.CreationTimeUnix
 		params.Body.Key = actionGetResponse.Key
-		go dbConnector.UpdateAction(ctx, &params.Body.Action, UUID)
+		if err := dbConnector.MoveToHistoryAndUpdateAction(ctx, &oldAction.Action, &params.Body.Action, UUID); err != nil {
+			if _, ok := err.(*connutils.InvalidValueError); ok {
+				return actions.NewWeaviateActionUpdateUnprocessableEntity().WithPayload(createErrorResponseObject(err.Error()))
+			}
+			return actions.NewWeaviateActionUpdateInternalServerError().WithPayload(createErrorResponseObject(err.Error()))
+		}
 
 		// Create object to return
 		responseObject := &models.ActionGetResponse{}
@@ -463,7 +488,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		mqttJson, _ := json.Marshal(responseObject)
 		weaviateBroker.Publish("/actions/"+string(responseObject.ActionID), string(mqttJson[:]))
 
-		// Return SUCCESS (NOTE: this is ACCEPTED, so the dbConnector.Add should have a go routine)
+		// Return SUCCESS
 		return actions.NewWeaviateActionUpdateAccepted().WithPayload(responseObject)
 	})
 	api.ActionsWeaviateActionsValidateHandler = actions.WeaviateActionsValidateHandlerFunc(func(params actions.WeaviateActionsValidateParams, principal interface{}) middleware.Responder {
@@ -471,13 +496,95 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		ctx := params.HTTPRequest.Context()
 
 		// Validate schema given in body with the weaviate schema
-		validatedErr := validation.ValidateActionBody(ctx, &params.Body.ActionCreate, databaseSchema, dbConnector, serverConfig, principal.(*models.KeyTokenGetResponse))
+		validatedErr := validation.ValidateActionBody(ctx, &params.Body.ActionCreate, "", currentSchema(), dbConnector, serverConfig, principal.(*models.KeyTokenGetResponse))
 		if validatedErr != nil {
-			return actions.NewWeaviateActionsValidateUnprocessableEntity().WithPayload(createErrorResponseObject(validatedErr.Error()))
+			return actions.NewWeaviateActionsValidateUnprocessableEntity().WithPayload(createValidationErrorResponseObject(validatedErr))
 		}
 
 		return actions.NewWeaviateActionsValidateOK()
 	})
+	api.ActionsWeaviateActionsBatchCreateHandler = actions.WeaviateActionsBatchCreateHandlerFunc(func(params actions.WeaviateActionsBatchCreateParams, principal interface{}) middleware.Responder {
+		// Get context from request
+		ctx := params.HTTPRequest.Context()
+
+		// This is a write function, validate if allowed to write?
+		if allowed, _ := auth.ActionsAllowed(ctx, []string{"write"}, principal, dbConnector, nil); !allowed {
+			return actions.NewWeaviateActionsBatchCreateForbidden()
+		}
+
+		// The batch should contain at least one action
+		items := params.Body.Actions
+		if len(items) == 0 {
+			return actions.NewWeaviateActionsBatchCreateUnprocessableEntity().WithPayload(createErrorResponseObject(fmt.Sprintf(errorBatchEmpty, "actions")))
+		}
+
+		// Convert principal to object
+		keyToken := principal.(*models.KeyTokenGetResponse)
+
+		// Create Key-ref-Object
+		url := serverConfig.GetHostAddress()
+		keyRef := &models.SingleRef{
+			LocationURL:  &url,
+			NrDollarCref: keyToken.KeyID,
+			Type:         string(connutils.RefTypeKey),
+		}
+
+		batchItems := make([]batchItem, len(items))
+		for i, item := range items {
+			batchItems[i] = batchItem{ExistingUUID: item.ActionID, Missing: item.Action == nil}
+			if item.Action != nil {
+				batchItems[i].Class = item.Action.AtClass
+				batchItems[i].Schema = item.Action.Schema
+			}
+		}
+
+		results := createBatch(ctx, principal, dbConnector, keyRef, batchItems, batchOperations{
+			refType: connutils.RefTypeAction,
+			get: func(ctx context.Context, UUID strfmt.UUID) (*batchExisting, error) {
+				actionGetResponse := models.ActionGetResponse{}
+				actionGetResponse.Schema = map[string]models.JSONObject{}
+				if err := dbConnector.GetAction(ctx, UUID, &actionGetResponse); err != nil {
+					return nil, err
+				}
+
+				return &batchExisting{
+					Current:          &actionGetResponse.Action,
+					Key:              actionGetResponse.Key,
+					CreationTimeUnix: actionGetResponse.CreationTimeUnix,
+				}, nil
+			},
+			validate: func(ctx context.Context, i int, UUID strfmt.UUID, connector dbconnector.DatabaseConnector) error {
+				return validation.ValidateActionBody(ctx, items[i].Action, UUID, currentSchema(), connector, serverConfig, keyToken)
+			},
+			write: func(ctx context.Context, writes []*batchWrite) error {
+				batch := make([]*connutils.BatchAction, len(writes))
+				for i, w := range writes {
+					batch[i] = &connutils.BatchAction{
+						Action: &models.Action{
+							ActionCreate:       *items[w.Index].Action,
+							CreationTimeUnix:   w.CreationTimeUnix,
+							LastUpdateTimeUnix: w.LastUpdateTimeUnix,
+							Key:                w.Key,
+						},
+						UUID:   w.UUID,
+						Update: w.Update,
+					}
+					if w.History != nil {
+						batch[i].History = w.History.(*models.Action)
+					}
+				}
+
+				return dbConnector.BatchActions(ctx, batch)
+			},
+		})
+
+		payload := &models.ActionsBatchResponse{Actions: make([]*models.ActionBatchResult, len(results))}
+		for i, result := range results {
+			payload.Actions[i] = &models.ActionBatchResult{ActionID: result.UUID, Status: result.Status, Errors: result.Errors}
+		}
+
+		return actions.NewWeaviateActionsBatchCreateOK().WithPayload(payload)
+	})
 	api.ActionsWeaviateActionsCreateHandler = actions.WeaviateActionsCreateHandlerFunc(func(params actions.WeaviateActionsCreateParams, principal interface{}) middleware.Responder {
 		// Get context from request
 		ctx := params.HTTPRequest.Context()
@@ -491,9 +598,9 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		UUID := connutils.GenerateUUID()
 
 		// Validate schema given in body with the weaviate schema
-		validatedErr := validation.ValidateActionBody(params.HTTPRequest.Context(), params.Body.Action, databaseSchema, dbConnector, serverConfig, principal.(*models.KeyTokenGetResponse))
+		validatedErr := validation.ValidateActionBody(params.HTTPRequest.Context(), params.Body.Action, "", currentSchema(), dbConnector, serverConfig, principal.(*models.KeyTokenGetResponse))
 		if validatedErr != nil {
-			return actions.NewWeaviateActionsCreateUnprocessableEntity().WithPayload(createErrorResponseObject(validatedErr.Error()))
+			return actions.NewWeaviateActionsCreateUnprocessableEntity().WithPayload(createValidationErrorResponseObject(validatedErr))
 		}
 
 		// Create Key-ref-Object
@@ -518,10 +625,20 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		responseObject.ActionID = UUID
 
 		if params.Body.Async {
-			go dbConnector.AddAction(ctx, action, UUID)
+			// The action is added after the response, so an error can only be logged
+			go func(ctx context.Context) {
+				if err := dbConnector.AddAction(ctx, action, UUID); err != nil {
+					messaging.ErrorMessage(fmt.Sprintf("could not add action '%s': %v", UUID, err))
+				}
+			}(detachContext(ctx))
 			return actions.NewWeaviateActionsCreateAccepted().WithPayload(responseObject)
 		} else {
-			dbConnector.AddAction(ctx, action, UUID)
+			if err := dbConnector.AddAction(ctx, action, UUID); err != nil {
+				if _, ok := err.(*connutils.InvalidValueError); ok {
+					return actions.NewWeaviateActionsCreateUnprocessableEntity().WithPayload(createErrorResponseObject(err.Error()))
+				}
+				return actions.NewWeaviateActionsCreateInternalServerError().WithPayload(createErrorResponseObject(err.Error()))
+			}
 			return actions.NewWeaviateActionsCreateOK().WithPayload(responseObject)
 		}
 	})
@@ -536,9 +653,6 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		// Get item from database
 		errGet := dbConnector.GetAction(ctx, params.ActionID, &actionGetResponse)
 
-		// Save the old-aciton in a variable
-		oldAction := actionGetResponse
-
 		// Not found
 		if errGet != nil {
 			return actions.NewWeaviateActionsDeleteNotFound()
@@ -549,13 +663,10 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 			return things.NewWeaviateThingsDeleteForbidden()
 		}
 
-		actionGetResponse.LastUpdateTimeUnix = connutils.NowUnix()
-
-		// Move the current properties to the history
-		go dbConnector.MoveToHistoryAction(ctx, &oldAction.Action, params.ActionID, false)
-
-		// Add new row as GO-routine
-		go dbConnector.DeleteAction(ctx, &actionGetResponse.Action, params.ActionID)
+		// Move the current properties to the history and delete the action, in one transaction
+		if err := dbConnector.MoveToHistoryAndDeleteAction(ctx, &actionGetResponse.Action, params.ActionID); err != nil {
+			return actions.NewWeaviateActionsDeleteInternalServerError().WithPayload(createErrorResponseObject(err.Error()))
+		}
 
 		// Return 'No Content'
 		return actions.NewWeaviateActionsDeleteNoContent()
@@ -774,6 +885,88 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	/*
 	 * HANDLE THINGS
 	 */
+	api.ThingsWeaviateThingsBatchCreateHandler = things.WeaviateThingsBatchCreateHandlerFunc(func(params things.WeaviateThingsBatchCreateParams, principal interface{}) middleware.Responder {
+		// Get context from request
+		ctx := params.HTTPRequest.Context()
+
+		// This is a write function, validate if allowed to write?
+		if allowed, _ := auth.ActionsAllowed(ctx, []string{"write"}, principal, dbConnector, nil); !allowed {
+			return things.NewWeaviateThingsBatchCreateForbidden()
+		}
+
+		// The batch should contain at least one thing
+		items := params.Body.Things
+		if len(items) == 0 {
+			return things.NewWeaviateThingsBatchCreateUnprocessableEntity().WithPayload(createErrorResponseObject(fmt.Sprintf(errorBatchEmpty, "things")))
+		}
+
+		// Convert principal to object
+		keyToken := principal.(*models.KeyTokenGetResponse)
+
+		// Create Key-ref-Object
+		url := serverConfig.GetHostAddress()
+		keyRef := &models.SingleRef{
+			LocationURL:  &url,
+			NrDollarCref: keyToken.KeyID,
+			Type:         string(connutils.RefTypeKey),
+		}
+
+		batchItems := make([]batchItem, len(items))
+		for i, item := range items {
+			batchItems[i] = batchItem{ExistingUUID: item.ThingID, Missing: item.Thing == nil}
+			if item.Thing != nil {
+				batchItems[i].Class = item.Thing.AtClass
+				batchItems[i].Schema = item.Thing.Schema
+			}
+		}
+
+		results := createBatch(ctx, principal, dbConnector, keyRef, batchItems, batchOperations{
+			refType: connutils.RefTypeThing,
+			get: func(ctx context.Context, UUID strfmt.UUID) (*batchExisting, error) {
+				thingGetResponse := models.ThingGetResponse{}
+				thingGetResponse.Schema = map[string]models.JSONObject{}
+				if err := dbConnector.GetThing(ctx, UUID, &thingGetResponse); err != nil {
+					return nil, err
+				}
+
+				return &batchExisting{
+					Current:          &thingGetResponse.Thing,
+					Key:              thingGetResponse.Key,
+					CreationTimeUnix: thingGetResponse.CreationTimeUnix,
+				}, nil
+			},
+			validate: func(ctx context.Context, i int, UUID strfmt.UUID, connector dbconnector.DatabaseConnector) error {
+				return validation.ValidateThingBody(ctx, items[i].Thing, UUID, currentSchema(), connector, serverConfig, keyToken)
+			},
+			write: func(ctx context.Context, writes []*batchWrite) error {
+				batch := make([]*connutils.BatchThing, len(writes))
+				for i, w := range writes {
+					batch[i] = &connutils.BatchThing{
+						Thing: &models.Thing{
+							ThingCreate:        *items[w.Index].Thing,
+							CreationTimeUnix:   w.CreationTimeUnix,
+							LastUpdateTimeUnix: w.LastUpdateTimeUnix,
+							Key:                w.Key,
+						},
+						UUID:   w.UUID,
+						Update: w.Update,
+					}
+					if w.History != nil {
+						batch[i].History = w.History.(*models.Thing)
+					}
+				}
+
+				return dbConnector.BatchThings(ctx, batch)
+			},
+		})
+
+		payload := &models.ThingsBatchResponse{Things: make([]*models.ThingBatchResult, len(results))}
+		for i, result := range results {
+			payload.Things[i] = &models.ThingBatchResult{ThingID: result.UUID, Status: result.Status, Errors: result.Errors}
+		}
+
+		return things.NewWeaviateThingsBatchCreateOK().WithPayload(payload)
+	})
 	api.ThingsWeaviateThingsCreateHandler = things.WeaviateThingsCreateHandlerFunc(func(params things.WeaviateThingsCreateParams, principal interface{}) middleware.Responder {
 		// Get context from request
 		ctx := params.HTTPRequest.Context()
@@ -790,9 +983,9 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		keyToken := principal.(*models.KeyTokenGetResponse)
 
 		// Validate schema given in body with the weaviate schema
-		validatedErr := validation.ValidateThingBody(params.HTTPRequest.Context(), params.Body.Thing, databaseSchema, dbConnector, serverConfig, keyToken)
+		validatedErr := validation.ValidateThingBody(params.HTTPRequest.Context(), params.Body.Thing, "", currentSchema(), dbConnector, serverConfig, keyToken)
 		if validatedErr != nil {
-			return things.NewWeaviateThingsCreateUnprocessableEntity().WithPayload(createErrorResponseObject(validatedErr.Error()))
+			return things.NewWeaviateThingsCreateUnprocessableEntity().WithPayload(createValidationErrorResponseObject(validatedErr))
 		}
 
 		// Create Key-ref-Object
@@ -817,10 +1010,20 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		responseObject.ThingID = UUID
 
 		if params.Body.Async {
-			go dbConnector.AddThing(ctx, thing, UUID)
+			// The thing is added after the response, so an error can only be logged
+			go func(ctx context.Context) {
+				if err := dbConnector.AddThing(ctx, thing, UUID); err != nil {
+					messaging.ErrorMessage(fmt.Sprintf("could not add thing '%s': %v", UUID, err))
+				}
+			}(detachContext(ctx))
 			return things.NewWeaviateThingsCreateAccepted().WithPayload(responseObject)
 		} else {
-			dbConnector.AddThing(ctx, thing, UUID)
+			if err := dbConnector.AddThing(ctx, thing, UUID); err != nil {
+				if _, ok := err.(*connutils.InvalidValueError); ok {
+					return things.NewWeaviateThingsCreateUnprocessableEntity().WithPayload(createErrorResponseObject(err.Error()))
+				}
+				return things.NewWeaviateThingsCreateInternalServerError().WithPayload(createErrorResponseObject(err.Error()))
+			}
 			return things.NewWeaviateThingsCreateOK().WithPayload(responseObject)
 		}
 	})
@@ -835,9 +1038,6 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		// Get item from database
 		errGet := dbConnector.GetThing(params.HTTPRequest.Context(), params.ThingID, &thingGetResponse)
 
-		// Save the old-thing in a variable
-		oldThing := thingGetResponse
-
 		// Not found
 		if errGet != nil {
 			return things.NewWeaviateThingsDeleteNotFound()
@@ -856,7 +1056,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 			actions.Actions = []*models.ActionGetResponse{}
 			dbConnector.ListActions(ctx, params.ThingID, 50, 0, []*connutils.WhereQuery{}, &actions)
 			for _, v := range actions.Actions {
-				go dbConnector.DeleteAction(ctx, &v.Action, v.ActionID)
+				go dbConnector.DeleteAction(detachContext(ctx), &v.Action, v.ActionID)
 			}
 
 			// Exit if total results are 0 or the total results are not lowering, then there is some kind of error
@@ -864,13 +1064,10 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 			lastActionsCount = actions.TotalResults
 		}
 
-		thingGetResponse.LastUpdateTimeUnix = connutils.NowUnix()
-
-		// Move the current properties to the history
-		go dbConnector.MoveToHistoryThing(ctx, &oldThing.Thing, params.ThingID, true)
-
-		// Add new row as GO-routine
-		go dbConnector.DeleteThing(ctx, &thingGetResponse.Thing, params.ThingID)
+		// Move the current properties to the history and delete the thing, in one transaction
+		if err := dbConnector.MoveToHistoryAndDeleteThing(ctx, &thingGetResponse.Thing, params.ThingID); err != nil {
+			return things.NewWeaviateThingsDeleteInternalServerError().WithPayload(createErrorResponseObject(err.Error()))
+		}
 
 		// Return 'No Content'
 		return things.NewWeaviateThingsDeleteNoContent()
@@ -963,12 +1160,22 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 			return things.NewWeaviateThingsListForbidden()
 		}
 
+		// Compile the where filters against the schema of the listed class
+		var className string
+		if params.Class != nil {
+			className = *params.Class
+		}
+		wheres, err := parseWheres(currentSchema().ThingSchema.Schema, className, params.Where)
+		if err != nil {
+			return things.NewWeaviateThingsListUnprocessableEntity().WithPayload(createErrorResponseObject(err.Error()))
+		}
+
 		// Initialize response
 		thingsResponse := models.ThingsListResponse{}
 		thingsResponse.Things = []*models.ThingGetResponse{}
 
 		// List all results
-		err := dbConnector.ListThings(ctx, limit, (page-1)*limit, keyID, []*connutils.WhereQuery{}, &thingsResponse)
+		err = dbConnector.ListThings(ctx, limit, (page-1)*limit, keyID, className, wheres, &thingsResponse)
 
 		if err != nil {
 			messaging.ErrorMessage(err)
@@ -1033,21 +1240,22 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		keyToken := principal.(*models.KeyTokenGetResponse)
 
 		// Validate schema made after patching with the weaviate schema
-		validatedErr := validation.ValidateThingBody(params.HTTPRequest.Context(), &thing.ThingCreate, databaseSchema, dbConnector, serverConfig, keyToken)
+		validatedErr := validation.ValidateThingBody(params.HTTPRequest.Context(), &thing.ThingCreate, UUID, currentSchema(), dbConnector, serverConfig, keyToken)
 		if validatedErr != nil {
-			return things.NewWeaviateThingsPatchUnprocessableEntity().WithPayload(createErrorResponseObject(validatedErr.Error()))
+			return things.NewWeaviateThingsPatchUnprocessableEntity().WithPayload(createValidationErrorResponseObject(validatedErr))
 		}
 
-		// Move the current properties to the history
-		go dbConnector.MoveToHistoryThing(ctx, &oldThing.Thing, UUID, false)
-
-		// Update the database
-		go dbConnector.UpdateThing(ctx, thing, UUID)
+		// Move the current properties to the history and update the thing, in one transaction
+		if err := dbConnector.MoveToHistoryAndUpdateThing(ctx, &oldThing.Thing, thing, UUID); err != nil {
+			if _, ok := err.(*connutils.InvalidValueError); ok {
+				return things.NewWeaviateThingsPatchUnprocessableEntity().WithPayload(createErrorResponseObject(err.Error()))
+			}
+			return things.NewWeaviateThingsPatchInternalServerError().WithPayload(createErrorResponseObject(err.Error()))
+		}
 
 		// Create return Object
 		thingGetResponse.Thing = *thing
 
-		// Returns accepted so a Go routine can process in the background
 		return things.NewWeaviateThingsPatchAccepted().WithPayload(&thingGetResponse)
 	})
 	api.ThingsWeaviateThingsUpdateHandler = things.WeaviateThingsUpdateHandlerFunc(func(params things.WeaviateThingsUpdateParams, principal interface{}) middleware.Responder {
@@ -1080,19 +1288,21 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		keyToken := principal.(*models.KeyTokenGetResponse)
 
 		// Validate schema given in body with the weaviate schema
-		validatedErr := validation.ValidateThingBody(params.HTTPRequest.Context(), &params.Body.ThingCreate, databaseSchema, dbConnector, serverConfig, keyToken)
+		validatedErr := validation.ValidateThingBody(params.HTTPRequest.Context(), &params.Body.ThingCreate, UUID, currentSchema(), dbConnector, serverConfig, keyToken)
 		if validatedErr != nil {
-			return things.NewWeaviateThingsUpdateUnprocessableEntity().WithPayload(createErrorResponseObject(validatedErr.Error()))
+			return things.NewWeaviateThingsUpdateUnprocessableEntity().WithPayload(createValidationErrorResponseObject(validatedErr))
 		}
 
-		// Move the current properties to the history
-		go dbConnector.MoveToHistoryThing(ctx, &oldThing.Thing, UUID, false)
-
-		// Update the database
+		// Move the current properties to the history and update the thing, in one transaction
 		params.Body.LastUpdateTimeUnix = connutils.NowUnix()
 		params.Body.CreationTimeUnix = thingGetResponse.CreationTimeUnix
 		params.Body.Key = thingGetResponse.Key
-		go dbConnector.UpdateThing(ctx, &params.Body.Thing, UUID)
+		if err := dbConnector.MoveToHistoryAndUpdateThing(ctx, &oldThing.Thing, &params.Body.Thing, UUID); err != nil {
+			if _, ok := err.(*connutils.InvalidValueError); ok {
+				return things.NewWeaviateThingsUpdateUnprocessableEntity().WithPayload(createErrorResponseObject(err.Error()))
+			}
+			return things.NewWeaviateThingsUpdateInternalServerError().WithPayload(createErrorResponseObject(err.Error()))
+		}
 
 		// Create object to return
 		responseObject := &models.ThingGetResponse{}
@@ -1103,7 +1313,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		mqttJson, _ := json.Marshal(responseObject)
 		weaviateBroker.Publish("/things/"+string(responseObject.ThingID), string(mqttJson[:]))
 
-		// Return SUCCESS (NOTE: this is ACCEPTED, so the dbConnector.Add should have a go routine)
+		// Return SUCCESS
 		return things.NewWeaviateThingsUpdateAccepted().WithPayload(responseObject)
 	})
 	api.ThingsWeaviateThingsValidateHandler = things.WeaviateThingsValidateHandlerFunc(func(params things.WeaviateThingsValidateParams, principal interface{}) middleware.Responder {
@@ -1111,9 +1321,9 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		keyToken := principal.(*models.KeyTokenGetResponse)
 
 		// Validate schema given in body with the weaviate schema
-		validatedErr := validation.ValidateThingBody(params.HTTPRequest.Context(), params.Body, databaseSchema, dbConnector, serverConfig, keyToken)
+		validatedErr := validation.ValidateThingBody(params.HTTPRequest.Context(), params.Body, "", currentSchema(), dbConnector, serverConfig, keyToken)
 		if validatedErr != nil {
-			return things.NewWeaviateThingsValidateUnprocessableEntity().WithPayload(createErrorResponseObject(validatedErr.Error()))
+			return things.NewWeaviateThingsValidateUnprocessableEntity().WithPayload(createValidationErrorResponseObject(validatedErr))
 		}
 
 		return things.NewWeaviateThingsValidateOK()
@@ -1124,12 +1334,43 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 
 		// Set the response object's values
 		metaResponse.Hostname = serverConfig.GetHostAddress()
-		metaResponse.ActionsSchema = databaseSchema.ActionSchema.Schema
-		metaResponse.ThingsSchema = databaseSchema.ThingSchema.Schema
+		weaviateSchema := currentSchema()
+		metaResponse.ActionsSchema = weaviateSchema.ActionSchema.Schema
+		metaResponse.ThingsSchema = weaviateSchema.ThingSchema.Schema
 
 		return meta.NewWeaviateMetaGetOK().WithPayload(metaResponse)
 	})
 
+	api.MetaWeaviateHealthHandler = meta.WeaviateHealthHandlerFunc(func(params meta.WeaviateHealthParams) middleware.Responder {
+		healthResponse := &models.HealthResponse{
+			Database: dbConnector.GetName(),
+			Status:   models.HealthResponseStatusHealthy,
+		}
+
+		// Connectors that can't check their database are assumed to be healthy
+		checker, ok := dbConnector.(dbconnector.HealthChecker)
+		if !ok {
+			return meta.NewWeaviateHealthOK().WithPayload(healthResponse)
+		}
+
+		ctx, cancel := context.WithTimeout(params.HTTPRequest.Context(), healthCheckTimeout)
+		defer cancel()
+
+		err := checker.Health(ctx, healthResponse)
+		if err != nil {
+			healthResponse.Status = models.HealthResponseStatusUnhealthy
+			healthResponse.Message = err.Error()
+			return meta.NewWeaviateHealthServiceUnavailable().WithPayload(healthResponse)
+		}
+
+		// The database answers again, after it was unavailable
+		if healthResponse.CircuitBreaker != nil && healthResponse.CircuitBreaker.State != models.CircuitBreakerStatusStateClosed {
+			healthResponse.Status = models.HealthResponseStatusDegraded
+		}
+
+		return meta.NewWeaviateHealthOK().WithPayload(healthResponse)
+	})
+
 	api.P2PWeaviateP2pGenesisUpdateHandler = p2_p.WeaviateP2pGenesisUpdateHandlerFunc(func(params p2_p.WeaviateP2pGenesisUpdateParams) middleware.Responder {
 		new_peers := make([]libnetwork.Peer, 0)
 
@@ -1197,6 +1438,507 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 
 		return things.NewWeaviateThingsActionsListOK().WithPayload(&actionsResponse)
 	})
+
+	api.BackupWeaviateExportHandler = backup.WeaviateExportHandlerFunc(func(params backup.WeaviateExportParams, principal interface{}) middleware.Responder {
+		// Get key out of principal
+		keyToken := principal.(*models.KeyTokenGetResponse)
+
+		// Get context from request
+		ctx := params.HTTPRequest.Context()
+
+		// The export holds the whole knowledge graph, so only the root key is allowed to read it
+		if allowed, _ := auth.ActionsAllowed(ctx, []string{"read"}, principal, dbConnector, nil); !allowed || keyToken.IsRoot == nil || !*keyToken.IsRoot {
+			return backup.NewWeaviateExportForbidden()
+		}
+
+		includeKeys := params.IncludeKeys != nil && *params.IncludeKeys
+
+		// Stream the records, one JSON object per line
+		return middleware.ResponderFunc(func(rw http.ResponseWriter, producer runtime.Producer) {
+			rw.Header().Set("Content-Type", "application/x-ndjson")
+			rw.WriteHeader(backup.WeaviateExportOKCode)
+
+			encoder := json.NewEncoder(rw)
+			err := libbackup.Export(ctx, dbConnector, keyToken.KeyID, serverConfig.GetHostAddress(), includeKeys, func(record *models.ExportRecord) error {
+				return encoder.Encode(record)
+			})
+
+			// The status is sent already, so the error can only be logged
+			if err != nil {
+				messaging.ErrorMessage(err)
+			}
+		})
+	})
+	api.BackupWeaviateImportHandler = backup.WeaviateImportHandlerFunc(func(params backup.WeaviateImportParams, principal interface{}) middleware.Responder {
+		// Get key out of principal
+		keyToken := principal.(*models.KeyTokenGetResponse)
+
+		// Get context from request
+		ctx := params.HTTPRequest.Context()
+
+		// The import may add keys anywhere in the tree, so only the root key is allowed to write it
+		if allowed, _ := auth.ActionsAllowed(ctx, []string{"write"}, principal, dbConnector, nil); !allowed || keyToken.IsRoot == nil || !*keyToken.IsRoot {
+			return backup.NewWeaviateImportForbidden()
+		}
+
+		// Skip the records that are imported already
+		checkpoint := int64(0)
+		if params.Checkpoint != nil {
+			checkpoint = *params.Checkpoint
+		}
+
+		importer := libbackup.NewImporter(dbConnector, currentSchema(), checkpoint)
+
+		// Return the checkpoint to resume from when a record fails
+		if err := importer.ImportStream(ctx, params.HTTPRequest.Body); err != nil {
+			importer.Result.Errors = createErrorResponseObject(err.Error())
+			return backup.NewWeaviateImportUnprocessableEntity().WithPayload(&importer.Result)
+		}
+
+		return backup.NewWeaviateImportOK().WithPayload(&importer.Result)
+	})
+	api.SchemaWeaviateSchemaThingsClassesCreateHandler = schema.WeaviateSchemaThingsClassesCreateHandlerFunc(func(params schema.WeaviateSchemaThingsClassesCreateParams, principal interface{}) middleware.Responder {
+		// Get key out of principal
+		keyToken := principal.(*models.KeyTokenGetResponse)
+
+		// Get context from request
+		ctx := params.HTTPRequest.Context()
+
+		if !allowedToChangeSchema(ctx, principal) {
+			return schema.NewWeaviateSchemaThingsClassesCreateForbidden()
+		}
+
+		_, err := changeSchema(ctx, keyToken.KeyID, libschema.AddClass(connutils.RefTypeThing, params.Body))
+		switch err.(type) {
+		case nil:
+			return schema.NewWeaviateSchemaThingsClassesCreateOK().WithPayload(params.Body)
+		case *libschema.NotFoundError:
+			return schema.NewWeaviateSchemaThingsClassesCreateNotFound().WithPayload(createErrorResponseObject(err.Error()))
+		case *libschema.ConflictError:
+			return schema.NewWeaviateSchemaThingsClassesCreateConflict().WithPayload(createErrorResponseObject(err.Error()))
+		case *schemaChangeError:
+			messaging.ErrorMessage(err)
+			return schema.NewWeaviateSchemaThingsClassesCreateInternalServerError().WithPayload(createErrorResponseObject(err.Error()))
+		default:
+			return schema.NewWeaviateSchemaThingsClassesCreateUnprocessableEntity().WithPayload(createErrorResponseObject(err.Error()))
+		}
+	})
+	api.SchemaWeaviateSchemaThingsClassesUpdateHandler = schema.WeaviateSchemaThingsClassesUpdateHandlerFunc(func(params schema.WeaviateSchemaThingsClassesUpdateParams, principal interface{}) middleware.Responder {
+		// Get key out of principal
+		keyToken := principal.(*models.KeyTokenGetResponse)
+
+		// Get context from request
+		ctx := params.HTTPRequest.Context()
+
+		if !allowedToChangeSchema(ctx, principal) {
+			return schema.NewWeaviateSchemaThingsClassesUpdateForbidden()
+		}
+
+		_, err := changeSchema(ctx, keyToken.KeyID, libschema.UpdateClass(connutils.RefTypeThing, params.ClassName, params.Body))
+		switch err.(type) {
+		case nil:
+			return schema.NewWeaviateSchemaThingsClassesUpdateOK().WithPayload(params.Body)
+		case *libschema.NotFoundError:
+			return schema.NewWeaviateSchemaThingsClassesUpdateNotFound().WithPayload(createErrorResponseObject(err.Error()))
+		case *libschema.ConflictError:
+			return schema.NewWeaviateSchemaThingsClassesUpdateConflict().WithPayload(createErrorResponseObject(err.Error()))
+		case *schemaChangeError:
+			messaging.ErrorMessage(err)
+			return schema.NewWeaviateSchemaThingsClassesUpdateInternalServerError().WithPayload(createErrorResponseObject(err.Error()))
+		default:
+			return schema.NewWeaviateSchemaThingsClassesUpdateUnprocessableEntity().WithPayload(createErrorResponseObject(err.Error()))
+		}
+	})
+	api.SchemaWeaviateSchemaThingsClassesDeleteHandler = schema.WeaviateSchemaThingsClassesDeleteHandlerFunc(func(params schema.WeaviateSchemaThingsClassesDeleteParams, principal interface{}) middleware.Responder {
+		// Get key out of principal
+		keyToken := principal.(*models.KeyTokenGetResponse)
+
+		// Get context from request
+		ctx := params.HTTPRequest.Context()
+
+		if !allowedToChangeSchema(ctx, principal) {
+			return schema.NewWeaviateSchemaThingsClassesDeleteForbidden()
+		}
+
+		_, err := changeSchema(ctx, keyToken.KeyID, libschema.DeleteClass(connutils.RefTypeThing, params.ClassName))
+		switch err.(type) {
+		case nil:
+			return schema.NewWeaviateSchemaThingsClassesDeleteNoContent()
+		case *libschema.NotFoundError:
+			return schema.NewWeaviateSchemaThingsClassesDeleteNotFound().WithPayload(createErrorResponseObject(err.Error()))
+		case *libschema.ConflictError:
+			return schema.NewWeaviateSchemaThingsClassesDeleteConflict().WithPayload(createErrorResponseObject(err.Error()))
+		case *schemaChangeError:
+			messaging.ErrorMessage(err)
+			return schema.NewWeaviateSchemaThingsClassesDeleteInternalServerError().WithPayload(createErrorResponseObject(err.Error()))
+		default:
+			return schema.NewWeaviateSchemaThingsClassesDeleteUnprocessableEntity().WithPayload(createErrorResponseObject(err.Error()))
+		}
+	})
+	api.SchemaWeaviateSchemaThingsPropertiesCreateHandler = schema.WeaviateSchemaThingsPropertiesCreateHandlerFunc(func(params schema.WeaviateSchemaThingsPropertiesCreateParams, principal interface{}) middleware.Responder {
+		// Get key out of principal
+		keyToken := principal.(*models.KeyTokenGetResponse)
+
+		// Get context from request
+		ctx := params.HTTPRequest.Context()
+
+		if !allowedToChangeSchema(ctx, principal) {
+			return schema.NewWeaviateSchemaThingsPropertiesCreateForbidden()
+		}
+
+		_, err := changeSchema(ctx, keyToken.KeyID, libschema.AddProperty(connutils.RefTypeThing, params.ClassName, params.Body))
+		switch err.(type) {
+		case nil:
+			return schema.NewWeaviateSchemaThingsPropertiesCreateOK().WithPayload(params.Body)
+		case *libschema.NotFoundError:
+			return schema.NewWeaviateSchemaThingsPropertiesCreateNotFound().WithPayload(createErrorResponseObject(err.Error()))
+		case *libschema.ConflictError:
+			return schema.NewWeaviateSchemaThingsPropertiesCreateConflict().WithPayload(createErrorResponseObject(err.Error()))
+		case *schemaChangeError:
+			messaging.ErrorMessage(err)
+			return schema.NewWeaviateSchemaThingsPropertiesCreateInternalServerError().WithPayload(createErrorResponseObject(err.Error()))
+		default:
+			return schema.NewWeaviateSchemaThingsPropertiesCreateUnprocessableEntity().WithPayload(createErrorResponseObject(err.Error()))
+		}
+	})
+	api.SchemaWeaviateSchemaThingsPropertiesUpdateHandler = schema.WeaviateSchemaThingsPropertiesUpdateHandlerFunc(func(params schema.WeaviateSchemaThingsPropertiesUpdateParams, principal interface{}) middleware.Responder {
+		// Get key out of principal
+		keyToken := principal.(*models.KeyTokenGetResponse)
+
+		// Get context from request
+		ctx := params.HTTPRequest.Context()
+
+		if !allowedToChangeSchema(ctx, principal) {
+			return schema.NewWeaviateSchemaThingsPropertiesUpdateForbidden()
+		}
+
+		_, err := changeSchema(ctx, keyToken.KeyID, libschema.UpdateProperty(connutils.RefTypeThing, params.ClassName, params.PropertyName, params.Body))
+		switch err.(type) {
+		case nil:
+			return schema.NewWeaviateSchemaThingsPropertiesUpdateOK().WithPayload(params.Body)
+		case *libschema.NotFoundError:
+			return schema.NewWeaviateSchemaThingsPropertiesUpdateNotFound().WithPayload(createErrorResponseObject(err.Error()))
+		case *libschema.ConflictError:
+			return schema.NewWeaviateSchemaThingsPropertiesUpdateConflict().WithPayload(createErrorResponseObject(err.Error()))
+		case *schemaChangeError:
+			messaging.ErrorMessage(err)
+			return schema.NewWeaviateSchemaThingsPropertiesUpdateInternalServerError().WithPayload(createErrorResponseObject(err.Error()))
+		default:
+			return schema.NewWeaviateSchemaThingsPropertiesUpdateUnprocessableEntity().WithPayload(createErrorResponseObject(err.Error()))
+		}
+	})
+	api.SchemaWeaviateSchemaThingsPropertiesDeleteHandler = schema.WeaviateSchemaThingsPropertiesDeleteHandlerFunc(func(params schema.WeaviateSchemaThingsPropertiesDeleteParams, principal interface{}) middleware.Responder {
+		// Get key out of principal
+		keyToken := principal.(*models.KeyTokenGetResponse)
+
+		// Get context from request
+		ctx := params.HTTPRequest.Context()
+
+		if !allowedToChangeSchema(ctx, principal) {
+			return schema.NewWeaviateSchemaThingsPropertiesDeleteForbidden()
+		}
+
+		_, err := changeSchema(ctx, keyToken.KeyID, libschema.DeleteProperty(connutils.RefTypeThing, params.ClassName, params.PropertyName))
+		switch err.(type) {
+		case nil:
+			return schema.NewWeaviateSchemaThingsPropertiesDeleteNoContent()
+		case *libschema.NotFoundError:
+			return schema.NewWeaviateSchemaThingsPropertiesDeleteNotFound().WithPayload(createErrorResponseObject(err.Error()))
+		case *libschema.ConflictError:
+			return schema.NewWeaviateSchemaThingsPropertiesDeleteConflict().WithPayload(createErrorResponseObject(err.Error()))
+		case *schemaChangeError:
+			messaging.ErrorMessage(err)
+			return schema.NewWeaviateSchemaThingsPropertiesDeleteInternalServerError().WithPayload(createErrorResponseObject(err.Error()))
+		default:
+			return schema.NewWeaviateSchemaThingsPropertiesDeleteUnprocessableEntity().WithPayload(createErrorResponseObject(err.Error()))
+		}
+	})
+	api.SchemaWeaviateSchemaActionsClassesCreateHandler = schema.WeaviateSchemaActionsClassesCreateHandlerFunc(func(params schema.WeaviateSchemaActionsClassesCreateParams, principal interface{}) middleware.Responder {
+		// Get key out of principal
+		keyToken := principal.(*models.KeyTokenGetResponse)
+
+		// Get context from request
+		ctx := params.HTTPRequest.Context()
+
+		if !allowedToChangeSchema(ctx, principal) {
+			return schema.NewWeaviateSchemaActionsClassesCreateForbidden()
+		}
+
+		_, err := changeSchema(ctx, keyToken.KeyID, libschema.AddClass(connutils.RefTypeAction, params.Body))
+		switch err.(type) {
+		case nil:
+			return schema.NewWeaviateSchemaActionsClassesCreateOK().WithPayload(params.Body)
+		case *libschema.NotFoundError:
+			return schema.NewWeaviateSchemaActionsClassesCreateNotFound().WithPayload(createErrorResponseObject(err.Error()))
+		case *libschema.ConflictError:
+			return schema.NewWeaviateSchemaActionsClassesCreateConflict().WithPayload(createErrorResponseObject(err.Error()))
+		case *schemaChangeError:
+			messaging.ErrorMessage(err)
+			return schema.NewWeaviateSchemaActionsClassesCreateInternalServerError().WithPayload(createErrorResponseObject(err.Error()))
+		default:
+			return schema.NewWeaviateSchemaActionsClassesCreateUnprocessableEntity().WithPayload(createErrorResponseObject(err.Error()))
+		}
+	})
+	api.SchemaWeaviateSchemaActionsClassesUpdateHandler = schema.WeaviateSchemaActionsClassesUpdateHandlerFunc(func(params schema.WeaviateSchemaActionsClassesUpdateParams, principal interface{}) middleware.Responder {
+		// Get key out of principal
+		keyToken := principal.(*models.KeyTokenGetResponse)
+
+		// Get context from request
+		ctx := params.HTTPRequest.Context()
+
+		if !allowedToChangeSchema(ctx, principal) {
+			return schema.NewWeaviateSchemaActionsClassesUpdateForbidden()
+		}
+
+		_, err := changeSchema(ctx, keyToken.KeyID, libschema.UpdateClass(connutils.RefTypeAction, params.ClassName, params.Body))
+		switch err.(type) {
+		case nil:
+			return schema.NewWeaviateSchemaActionsClassesUpdateOK().WithPayload(params.Body)
+		case *libschema.NotFoundError:
+			return schema.NewWeaviateSchemaActionsClassesUpdateNotFound().WithPayload(createErrorResponseObject(err.Error()))
+		case *libschema.ConflictError:
+			return schema.NewWeaviateSchemaActionsClassesUpdateConflict().WithPayload(createErrorResponseObject(err.Error()))
+		case *schemaChangeError:
+			messaging.ErrorMessage(err)
+			return schema.NewWeaviateSchemaActionsClassesUpdateInternalServerError().WithPayload(createErrorResponseObject(err.Error()))
+		default:
+			return schema.NewWeaviateSchemaActionsClassesUpdateUnprocessableEntity().WithPayload(createErrorResponseObject(err.Error()))
+		}
+	})
+	api.SchemaWeaviateSchemaActionsClassesDeleteHandler = schema.WeaviateSchemaActionsClassesDeleteHandlerFunc(func(params schema.WeaviateSchemaActionsClassesDeleteParams, principal interface{}) middleware.Responder {
+		// Get key out of principal
+		keyToken := principal.(*models.KeyTokenGetResponse)
+
+		// Get context from request
+		ctx := params.HTTPRequest.Context()
+
+		if !allowedToChangeSchema(ctx, principal) {
+			return schema.NewWeaviateSchemaActionsClassesDeleteForbidden()
+		}
+
+		_, err := changeSchema(ctx, keyToken.KeyID, libschema.DeleteClass(connutils.RefTypeAction, params.ClassName))
+		switch err.(type) {
+		case nil:
+			return schema.NewWeaviateSchemaActionsClassesDeleteNoContent()
+		case *libschema.NotFoundError:
+			return schema.NewWeaviateSchemaActionsClassesDeleteNotFound().WithPayload(createErrorResponseObject(err.Error()))
+		case *libschema.ConflictError:
+			return schema.NewWeaviateSchemaActionsClassesDeleteConflict().WithPayload(createErrorResponseObject(err.Error()))
+		case *schemaChangeError:
+			messaging.ErrorMessage(err)
+			return schema.NewWeaviateSchemaActionsClassesDeleteInternalServerError().WithPayload(createErrorResponseObject(err.Error()))
+		default:
+			return schema.NewWeaviateSchemaActionsClassesDeleteUnprocessableEntity().WithPayload(createErrorResponseObject(err.Error()))
+		}
+	})
+	api.SchemaWeaviateSchemaActionsPropertiesCreateHandler = schema.WeaviateSchemaActionsPropertiesCreateHandlerFunc(func(params schema.WeaviateSchemaActionsPropertiesCreateParams, principal interface{}) middleware.Responder {
+		// Get key out of principal
+		keyToken := principal.(*models.KeyTokenGetResponse)
+
+		// Get context from request
+		ctx := params.HTTPRequest.Context()
+
+		if !allowedToChangeSchema(ctx, principal) {
+			return schema.NewWeaviateSchemaActionsPropertiesCreateForbidden()
+		}
+
+		_, err := changeSchema(ctx, keyToken.KeyID, libschema.AddProperty(connutils.RefTypeAction, params.ClassName, params.Body))
+		switch err.(type) {
+		case nil:
+			return schema.NewWeaviateSchemaActionsPropertiesCreateOK().WithPayload(params.Body)
+		case *libschema.NotFoundError:
+			return schema.NewWeaviateSchemaActionsPropertiesCreateNotFound().WithPayload(createErrorResponseObject(err.Error()))
+		case *libschema.ConflictError:
+			return schema.NewWeaviateSchemaActionsPropertiesCreateConflict().WithPayload(createErrorResponseObject(err.Error()))
+		case *schemaChangeError:
+			messaging.ErrorMessage(err)
+			return schema.NewWeaviateSchemaActionsPropertiesCreateInternalServerError().WithPayload(createErrorResponseObject(err.Error()))
+		default:
+			return schema.NewWeaviateSchemaActionsPropertiesCreateUnprocessableEntity().WithPayload(createErrorResponseObject(err.Error()))
+		}
+	})
+	api.SchemaWeaviateSchemaActionsPropertiesUpdateHandler = schema.WeaviateSchemaActionsPropertiesUpdateHandlerFunc(func(params schema.WeaviateSchemaActionsPropertiesUpdateParams, principal interface{}) middleware.Responder {
+		// Get key out of principal
+		keyToken := principal.(*models.KeyTokenGetResponse)
+
+		// Get context from request
+		ctx := params.HTTPRequest.Context()
+
+		if !allowedToChangeSchema(ctx, principal) {
+			return schema.NewWeaviateSchemaActionsPropertiesUpdateForbidden()
+		}
+
+		_, err := changeSchema(ctx, keyToken.KeyID, libschema.UpdateProperty(connutils.RefTypeAction, params.ClassName, params.PropertyName, params.Body))
+		switch err.(type) {
+		case nil:
+			return schema.NewWeaviateSchemaActionsPropertiesUpdateOK().WithPayload(params.Body)
+		case *libschema.NotFoundError:
+			return schema.NewWeaviateSchemaActionsPropertiesUpdateNotFound().WithPayload(createErrorResponseObject(err.Error()))
+		case *libschema.ConflictError:
+			return schema.NewWeaviateSchemaActionsPropertiesUpdateConflict().WithPayload(createErrorResponseObject(err.Error()))
+		case *schemaChangeError:
+			messaging.ErrorMessage(err)
+			return schema.NewWeaviateSchemaActionsPropertiesUpdateInternalServerError().WithPayload(createErrorResponseObject(err.Error()))
+		default:
+			return schema.NewWeaviateSchemaActionsPropertiesUpdateUnprocessableEntity().WithPayload(createErrorResponseObject(err.Error()))
+		}
+	})
+	api.SchemaWeaviateSchemaActionsPropertiesDeleteHandler = schema.WeaviateSchemaActionsPropertiesDeleteHandlerFunc(func(params schema.WeaviateSchemaActionsPropertiesDeleteParams, principal interface{}) middleware.Responder {
+		// Get key out of principal
+		keyToken := principal.(*models.KeyTokenGetResponse)
+
+		// Get context from request
+		ctx := params.HTTPRequest.Context()
+
+		if !allowedToChangeSchema(ctx, principal) {
+			return schema.NewWeaviateSchemaActionsPropertiesDeleteForbidden()
+		}
+
+		_, err := changeSchema(ctx, keyToken.KeyID, libschema.DeleteProperty(connutils.RefTypeAction, params.ClassName, params.PropertyName))
+		switch err.(type) {
+		case nil:
+			return schema.NewWeaviateSchemaActionsPropertiesDeleteNoContent()
+		case *libschema.NotFoundError:
+			return schema.NewWeaviateSchemaActionsPropertiesDeleteNotFound().WithPayload(createErrorResponseObject(err.Error()))
+		case *libschema.ConflictError:
+			return schema.NewWeaviateSchemaActionsPropertiesDeleteConflict().WithPayload(createErrorResponseObject(err.Error()))
+		case *schemaChangeError:
+			messaging.ErrorMessage(err)
+			return schema.NewWeaviateSchemaActionsPropertiesDeleteInternalServerError().WithPayload(createErrorResponseObject(err.Error()))
+		default:
+			return schema.NewWeaviateSchemaActionsPropertiesDeleteUnprocessableEntity().WithPayload(createErrorResponseObject(err.Error()))
+		}
+	})
+	api.SchemaWeaviateSchemaHistoryHandler = schema.WeaviateSchemaHistoryHandlerFunc(func(params schema.WeaviateSchemaHistoryParams, principal interface{}) middleware.Responder {
+		// Get context from request
+		ctx := params.HTTPRequest.Context()
+
+		if allowed, _ := auth.ActionsAllowed(ctx, []string{"read"}, principal, dbConnector, nil); !allowed {
+			return schema.NewWeaviateSchemaHistoryForbidden()
+		}
+
+		weaviateSchema := currentSchema()
+		return schema.NewWeaviateSchemaHistoryOK().WithPayload(weaviateSchema.History())
+	})
+	api.SchemaWeaviateSchemaMigrateHandler = schema.WeaviateSchemaMigrateHandlerFunc(func(params schema.WeaviateSchemaMigrateParams, principal interface{}) middleware.Responder {
+		// Get key out of principal
+		keyToken := principal.(*models.KeyTokenGetResponse)
+
+		// Get context from request
+		ctx := params.HTTPRequest.Context()
+
+		if !allowedToChangeSchema(ctx, principal) {
+			return schema.NewWeaviateSchemaMigrateForbidden()
+		}
+
+		migration, err := libbackup.NewSchemaMigration(params.Body)
+		if err != nil {
+			return schema.NewWeaviateSchemaMigrateUnprocessableEntity().WithPayload(createErrorResponseObject(err.Error()))
+		}
+
+		// A dry run only reports the differences with the current schema. When the schema was changed by an earlier
+		// run of the migration, which failed to migrate all data, only the rest of the data is migrated.
+		response := &models.SchemaMigrationResponse{DryRun: params.Body.DryRun}
+		weaviateSchema := currentSchema()
+		if migration.Applied(weaviateSchema) {
+			response.Version = weaviateSchema.Version()
+		} else if response.DryRun {
+			response.Diff, err = weaviateSchema.PreviewChange(migration.Change())
+			response.Version = weaviateSchema.Version()
+		} else {
+			var recorded *models.SchemaChange
+			recorded, err = changeSchema(ctx, keyToken.KeyID, migration.Change())
+			if recorded != nil {
+				response.Diff = recorded.Diff
+				response.Version = recorded.Version
+			}
+		}
+
+		switch err.(type) {
+		case nil:
+		case *libschema.NotFoundError:
+			return schema.NewWeaviateSchemaMigrateNotFound().WithPayload(createErrorResponseObject(err.Error()))
+		case *libschema.ConflictError:
+			return schema.NewWeaviateSchemaMigrateConflict().WithPayload(createErrorResponseObject(err.Error()))
+		case *schemaChangeError:
+			messaging.ErrorMessage(err)
+			return schema.NewWeaviateSchemaMigrateInternalServerError().WithPayload(createErrorResponseObject(err.Error()))
+		default:
+			return schema.NewWeaviateSchemaMigrateUnprocessableEntity().WithPayload(createErrorResponseObject(err.Error()))
+		}
+
+		// Migrate the data to the changed schema, or count what would be migrated
+		response.Things, response.Actions, err = migration.Migrate(ctx, dbConnector, currentSchema(), keyToken.KeyID, serverConfig.GetHostAddress(), response.DryRun)
+		if err != nil {
+			messaging.ErrorMessage(err)
+			message := fmt.Sprintf(errorMigrationData, response.Things, response.Actions, err)
+			return schema.NewWeaviateSchemaMigrateInternalServerError().WithPayload(createErrorResponseObject(message))
+		}
+
+		return schema.NewWeaviateSchemaMigrateOK().WithPayload(response)
+	})
+	api.SchemaWeaviateSchemaExportHandler = schema.WeaviateSchemaExportHandlerFunc(func(params schema.WeaviateSchemaExportParams, principal interface{}) middleware.Responder {
+		// Get context from request
+		ctx := params.HTTPRequest.Context()
+
+		if allowed, _ := auth.ActionsAllowed(ctx, []string{"read"}, principal, dbConnector, nil); !allowed {
+			return schema.NewWeaviateSchemaExportForbidden()
+		}
+
+		weaviateSchema := currentSchema()
+		export := &models.SchemaExport{
+			Format:  params.Format,
+			Version: weaviateSchema.Version(),
+		}
+		switch params.Format {
+		case models.SchemaExportFormatGraphql:
+			current := currentGraphQL()
+			export.Graphql = current.SDL()
+		case models.SchemaExportFormatJsonschema:
+			export.JSONSchema = weaviateSchema.ExportJSONSchema()
+		case models.SchemaExportFormatOpenapi:
+			export.Openapi = map[string]interface{}{"definitions": weaviateSchema.ExportOpenAPIDefinitions()}
+		}
+
+		return schema.NewWeaviateSchemaExportOK().WithPayload(export)
+	})
+	api.SchemaWeaviateSchemaValidateHandler = schema.WeaviateSchemaValidateHandlerFunc(func(params schema.WeaviateSchemaValidateParams, principal interface{}) middleware.Responder {
+		// Get context from request
+		ctx := params.HTTPRequest.Context()
+
+		if allowed, _ := auth.ActionsAllowed(ctx, []string{"read"}, principal, dbConnector, nil); !allowed {
+			return schema.NewWeaviateSchemaValidateForbidden()
+		}
+
+		if params.Body == nil {
+			return schema.NewWeaviateSchemaValidateOK().WithPayload(validateSchema(nil, nil))
+		}
+		return schema.NewWeaviateSchemaValidateOK().WithPayload(validateSchema(params.Body.Things, params.Body.Actions))
+	})
+	api.SchemaWeaviateSchemaVocabularyConvertHandler = schema.WeaviateSchemaVocabularyConvertHandlerFunc(func(params schema.WeaviateSchemaVocabularyConvertParams, principal interface{}) middleware.Responder {
+		// Get context from request
+		ctx := params.HTTPRequest.Context()
+
+		if allowed, _ := auth.ActionsAllowed(ctx, []string{"read"}, principal, dbConnector, nil); !allowed {
+			return schema.NewWeaviateSchemaVocabularyConvertForbidden()
+		}
+
+		// The names are checked against the words of the contextionary on disk
+		converted, err := libschema.ConvertVocabulary(params.Body.Vocabulary, libschema.VocabularyOptions{
+			Classes:       params.Body.Classes,
+			ActionClasses: params.Body.ActionClasses,
+			Contextionary: *fileContextionary,
+		})
+		if err != nil {
+			return schema.NewWeaviateSchemaVocabularyConvertUnprocessableEntity().WithPayload(createErrorResponseObject(err.Error()))
+		}
+
+		return schema.NewWeaviateSchemaVocabularyConvertOK().WithPayload(&models.VocabularyConversionResponse{
+			Things:   converted.Things,
+			Actions:  converted.Actions,
+			Warnings: converted.Warnings,
+		})
+	})
 	api.GraphqlWeaviateGraphqlPostHandler = graphql.WeaviateGraphqlPostHandlerFunc(func(params graphql.WeaviateGraphqlPostParams, principal interface{}) middleware.Responder {
 		defer messaging.TimeTrack(time.Now())
 		messaging.DebugMessage("Starting GraphQL resolving")
@@ -1221,9 +1963,13 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		// Add security principal to context that we pass on to the GraphQL resolver.
 		graphql_context := context.WithValue(params.HTTPRequest.Context(), "principal", (principal.(*models.KeyTokenGetResponse)))
 
+		// The GraphQL schema is replaced when the schema changes
+		current := currentGraphQL()
+		graphQLSchema := *current.Schema()
+
 		// Do the request
 		result := gographql.Do(gographql.Params{
-			Schema:         *graphQL.Schema(),
+			Schema:         graphQLSchema,
 			RequestString:  query,
 			OperationName:  operationName,
 			VariableValues: variables,
@@ -1250,6 +1996,84 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		// Return the response
 		return graphql.NewWeaviateGraphqlPostOK().WithPayload(graphQLResponse)
 	})
+	api.ContextionaryAPIWeaviateC11yWordsGetHandler = contextionary_api.WeaviateC11yWordsGetHandlerFunc(func(params contextionary_api.WeaviateC11yWordsGetParams, principal interface{}) middleware.Responder {
+		// Get context from request
+		ctx := params.HTTPRequest.Context()
+
+		if allowed, _ := auth.ActionsAllowed(ctx, []string{"read"}, principal, dbConnector, nil); !allowed {
+			return contextionary_api.NewWeaviateC11yWordsGetForbidden()
+		}
+
+		c := currentContextionary()
+		word := &models.C11yWord{Word: params.Word}
+
+		if idx := libcontextionary.LookupWord(c, params.Word); idx.IsPresent() {
+			if vector, err := c.GetVectorForItemIndex(idx); err == nil {
+				word.Present = true
+				word.Vector = vector.ToArray()
+			}
+		}
+
+		return contextionary_api.NewWeaviateC11yWordsGetOK().WithPayload(word)
+	})
+	api.ContextionaryAPIWeaviateC11yWordsNeighboursHandler = contextionary_api.WeaviateC11yWordsNeighboursHandlerFunc(func(params contextionary_api.WeaviateC11yWordsNeighboursParams, principal interface{}) middleware.Responder {
+		// Get context from request
+		ctx := params.HTTPRequest.Context()
+
+		if allowed, _ := auth.ActionsAllowed(ctx, []string{"read"}, principal, dbConnector, nil); !allowed {
+			return contextionary_api.NewWeaviateC11yWordsNeighboursForbidden()
+		}
+
+		c := currentContextionary()
+		idx := libcontextionary.LookupWord(c, params.Word)
+		if !idx.IsPresent() {
+			return contextionary_api.NewWeaviateC11yWordsNeighboursNotFound()
+		}
+
+		neighbours, err := nearestNeighbours(c, params.Word, idx, *params.N)
+		if err != nil {
+			return contextionary_api.NewWeaviateC11yWordsNeighboursNotFound()
+		}
+
+		return contextionary_api.NewWeaviateC11yWordsNeighboursOK().WithPayload(neighbours)
+	})
+	api.ContextionaryAPIWeaviateC11yClassesNeighboursHandler = contextionary_api.WeaviateC11yClassesNeighboursHandlerFunc(func(params contextionary_api.WeaviateC11yClassesNeighboursParams, principal interface{}) middleware.Responder {
+		// Get context from request
+		ctx := params.HTTPRequest.Context()
+
+		if allowed, _ := auth.ActionsAllowed(ctx, []string{"read"}, principal, dbConnector, nil); !allowed {
+			return contextionary_api.NewWeaviateC11yClassesNeighboursForbidden()
+		}
+
+		// The centroids of the classes are in the contextionary that is built from the schema
+		c := currentContextionary()
+		word, idx := classCentroid(c, params.ClassName)
+		if !idx.IsPresent() {
+			return contextionary_api.NewWeaviateC11yClassesNeighboursNotFound()
+		}
+
+		neighbours, err := nearestNeighbours(c, word, idx, *params.N)
+		if err != nil {
+			return contextionary_api.NewWeaviateC11yClassesNeighboursNotFound()
+		}
+
+		return contextionary_api.NewWeaviateC11yClassesNeighboursOK().WithPayload(neighbours)
+	})
+	api.ContextionaryAPIWeaviateC11yDistanceHandler = contextionary_api.WeaviateC11yDistanceHandlerFunc(func(params contextionary_api.WeaviateC11yDistanceParams, principal interface{}) middleware.Responder {
+		// Get context from request
+		ctx := params.HTTPRequest.Context()
+
+		if allowed, _ := auth.ActionsAllowed(ctx, []string{"read"}, principal, dbConnector, nil); !allowed {
+			return contextionary_api.NewWeaviateC11yDistanceForbidden()
+		}
+
+		distance, err := phraseDistance(currentContextionary(), *params.Body.A, *params.Body.B)
+		if err != nil {
+			return contextionary_api.NewWeaviateC11yDistanceUnprocessableEntity().WithPayload(createErrorResponseObject(err.Error()))
+		}
+
+		return contextionary_api.NewWeaviateC11yDistanceOK().WithPayload(distance)
+	})
 
 	api.ServerShutdown = func() {}
 
@@ -1283,7 +2107,7 @@ func configureServer(s *http.Server, scheme, addr string) {
 	}
 
 	// Load the schema using the config
-	databaseSchema = schema.WeaviateSchema{}
+	databaseSchema = libschema.WeaviateSchema{}
 	err = databaseSchema.LoadSchema(&serverConfig.Environment, messaging)
 
 	// Fatal error loading schema file
@@ -1313,7 +2137,9 @@ func configureServer(s *http.Server, scheme, addr string) {
 		messaging.ExitError(78, err.Error())
 	}
 
-	err = dbConnector.SetSchema(&databaseSchema)
+	// The connector gets a copy, because the schema is changed in place at runtime
+	connectorSchema := databaseSchema
+	err = dbConnector.SetSchema(&connectorSchema)
 	// Fatal error loading schema file
 	if err != nil {
 		messaging.ExitError(78, err.Error())
@@ -1340,7 +2166,9 @@ func configureServer(s *http.Server, scheme, addr string) {
 		messaging.ExitError(1, "database with the name '"+serverConfig.Environment.Database.Name+"' gave an error when initializing: "+errInit.Error())
 	}
 
-	graphQL, err = graphqlapi.CreateSchema(&dbConnector, serverConfig, &databaseSchema, messaging)
+	// The GraphQL schema gets its own copy of the schema, which is replaced rather than changed when the schema changes
+	initialSchema := databaseSchema
+	graphQL, err = graphqlapi.CreateSchema(&dbConnector, serverConfig, &initialSchema, messaging)
 
 	if err != nil {
 		messaging.ExitError(1, "GraphQL schema initialization gave an error when initializing: "+err.Error())
@@ -1404,35 +2232,43 @@ func loadContextionary() {
 		messaging.ExitError(78, "Contextionary IDX file not specified")
 	}
 
-	mmaped_contextionary, err := libcontextionary.LoadVectorFromDisk(serverConfig.Environment.Contextionary.KNNFile, serverConfig.Environment.Contextionary.IDXFile)
+	// Without a metric in the config, the metric that the KNN file is built for is used
+	var metric libcontextionary.DistanceMetric
+	if serverConfig.Environment.Contextionary.DistanceMetric != "" {
+		parsed, err := libcontextionary.ParseDistanceMetric(serverConfig.Environment.Contextionary.DistanceMetric)
+		if err != nil {
+			messaging.ExitError(78, fmt.Sprintf("Could not load Contextionary; %+v", err))
+		}
+		metric = parsed
+	}
+
+	mmaped_contextionary, err := libcontextionary.LoadVectorFromDiskWithDistanceMetric(serverConfig.Environment.Contextionary.KNNFile, serverConfig.Environment.Contextionary.IDXFile, metric)
 
 	if err != nil {
 		messaging.ExitError(78, fmt.Sprintf("Could not load Contextionary; %+v", err))
 	}
 
 	messaging.InfoMessage("Contextionary loaded from disk")
+	fileContextionary = mmaped_contextionary
 
-	// Now create the in-memory contextionary based on the classes / properties.
-	in_memory_contextionary, err := databaseSchema.BuildInMemoryContextionaryFromSchema(mmaped_contextionary)
-	if err != nil {
-		messaging.ExitError(78, fmt.Sprintf("Could not build in-memory contextionary from schema; %+v", err))
+	// In lint mode, only the problems of the schema are reported
+	if connectorOptionGroup.Options.(*config.Flags).SchemaLint {
+		if reportLintProblems() {
+			messaging.ExitError(78, "The schema has errors")
+		}
+		messaging.InfoMessage("The schema has no errors")
+		os.Exit(0)
 	}
 
-	// Combine contextionaries
-	contextionaries := []libcontextionary.Contextionary{*in_memory_contextionary, *mmaped_contextionary}
-	combined, err := libcontextionary.CombineVectorIndices(contextionaries)
-
+	// Now create the in-memory contextionary based on the classes / properties, and combine them.
+	contextionary, err = buildContextionary(&databaseSchema)
 	if err != nil {
-		messaging.ExitError(78, fmt.Sprintf("Could not combine the contextionary database with the in-memory generated contextionary; %+v", err))
+		// Report all problems, rather than only the first one
+		reportLintProblems()
+		messaging.ExitError(78, err.Error())
 	}
 
 	messaging.InfoMessage("Contextionary extended with names in the schema")
-
-	// urgh, go.
-	x := libcontextionary.Contextionary(combined)
-	contextionary = &x
-
-	// whoop!
 }
 
 func connectToNetwork() {
@@ -0,0 +1,69 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+package restapi
+
+import (
+	"fmt"
+
+	libcontextionary "github.com/creativesoftwarefdn/weaviate/contextionary"
+	"github.com/creativesoftwarefdn/weaviate/models"
+)
+
+// classCentroid looks up the centroid of a thing or action class in the contextionary, and returns its name, like
+// $THING[City]
+func classCentroid(c libcontextionary.Contextionary, className string) (string, libcontextionary.ItemIndex) {
+	for _, kind := range []string{"THING", "ACTION"} {
+		word := fmt.Sprintf("$%s[%s]", kind, className)
+		if idx := c.WordToItemIndex(word); idx.IsPresent() {
+			return word, idx
+		}
+	}
+
+	return "", -1
+}
+
+// nearestNeighbours returns the n nearest words to the word of an item in the contextionary
+func nearestNeighbours(c libcontextionary.Contextionary, word string, idx libcontextionary.ItemIndex, n int64) (*models.C11yNeighbours, error) {
+	neighbours, err := libcontextionary.NearestNeighbours(c, idx, int(n))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &models.C11yNeighbours{Word: word, Neighbours: make([]*models.C11yNeighbour, 0, len(neighbours))}
+	for _, neighbour := range neighbours {
+		response.Neighbours = append(response.Neighbours, &models.C11yNeighbour{Word: neighbour.Word, Distance: neighbour.Distance})
+	}
+
+	return response, nil
+}
+
+// phraseDistance computes the distance between the centroids of the words of two phrases in the contextionary
+func phraseDistance(c libcontextionary.Contextionary, a string, b string) (*models.C11yDistance, error) {
+	vectorA, err := libcontextionary.ComputePhraseVector(c, a)
+	if err != nil {
+		return nil, err
+	}
+
+	vectorB, err := libcontextionary.ComputePhraseVector(c, b)
+	if err != nil {
+		return nil, err
+	}
+
+	distance, err := c.GetDistanceMetric().Distance(vectorA, vectorB)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.C11yDistance{A: a, B: b, Distance: distance}, nil
+}
@@ -98,6 +98,54 @@ func init() {
           "403": {
             "description": "The used API-key has insufficient permissions."
           },
+          "422": {
+            "description": "Request body contains well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The action could not be written to the database.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/actions/batch": {
+      "post": {
+        "description": "Registers or updates multiple actions at once. Every action is validated separately, the results are returned per action.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Create or update a batch of actions related to this key.",
+        "operationId": "weaviate.actions.batch.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ActionsBatchRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Batch handled, see the results per action.",
+            "schema": {
+              "$ref": "#/definitions/ActionsBatchResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
           "422": {
             "description": "Request body contains well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
@@ -232,6 +280,12 @@ func init() {
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "500": {
+            "description": "The action could not be written to the database.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "x-available-in-mqtt": false,
@@ -266,6 +320,12 @@ func init() {
           },
           "404": {
             "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "The action could not be written to the database.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "x-available-in-mqtt": true,
@@ -324,6 +384,12 @@ func init() {
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "500": {
+            "description": "The action could not be written to the database.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "x-available-in-mqtt": false,
@@ -372,6 +438,210 @@ func init() {
         "x-available-in-websocket": false
       }
     },
+    "/c11y/classes/{className}/neighbours": {
+      "get": {
+        "description": "Returns the nearest words to the centroid of a thing or action class, which is computed from its keywords or the words of its name.",
+        "tags": [
+          "contextionary-API"
+        ],
+        "summary": "Get the nearest neighbours of a class.",
+        "operationId": "weaviate.c11y.classes.neighbours",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the thing or action class.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 10,
+            "description": "The number of neighbours.",
+            "name": "n",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The nearest neighbours of the class.",
+            "schema": {
+              "$ref": "#/definitions/C11yNeighbours"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "404": {
+            "description": "The class is not in the schema."
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/c11y/distance": {
+      "post": {
+        "description": "Returns the distance between two words or phrases in the contextionary.",
+        "tags": [
+          "contextionary-API"
+        ],
+        "summary": "Get the distance between two words or phrases.",
+        "operationId": "weaviate.c11y.distance",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/C11yDistanceQuery"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The distance between the words or phrases.",
+            "schema": {
+              "$ref": "#/definitions/C11yDistance"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "422": {
+            "description": "A word of the phrases is not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/c11y/words/{word}": {
+      "get": {
+        "description": "Returns whether a word is in the contextionary, and its vector.",
+        "tags": [
+          "contextionary-API"
+        ],
+        "summary": "Look up a word in the contextionary.",
+        "operationId": "weaviate.c11y.words.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The word to look up. Words are looked up in lower case, unless they are the centroid of a class or property like ` + "`" + `$THING[City]` + "`" + `.",
+            "name": "word",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The word, and its vector if it is present.",
+            "schema": {
+              "$ref": "#/definitions/C11yWord"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/c11y/words/{word}/neighbours": {
+      "get": {
+        "description": "Returns the nearest words to a word in the contextionary.",
+        "tags": [
+          "contextionary-API"
+        ],
+        "summary": "Get the nearest neighbours of a word.",
+        "operationId": "weaviate.c11y.words.neighbours",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The word to look up. Words are looked up in lower case, unless they are the centroid of a class or property like ` + "`" + `$THING[City]` + "`" + `.",
+            "name": "word",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 10,
+            "description": "The number of neighbours.",
+            "name": "n",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The nearest neighbours of the word.",
+            "schema": {
+              "$ref": "#/definitions/C11yNeighbours"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "404": {
+            "description": "The word is not in the contextionary."
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/export": {
+      "get": {
+        "description": "Streams all keys, things and actions as newline-delimited JSON, one ExportRecord per line: first the keys, then the things, then the actions. References to records later in the stream are added at the end of an import. Only available for the root key.",
+        "tags": [
+          "backup"
+        ],
+        "summary": "Export the whole knowledge graph.",
+        "operationId": "weaviate.export",
+        "parameters": [
+          {
+            "type": "boolean",
+            "description": "Whether to include the keys and their hashed tokens in the export.",
+            "name": "includeKeys",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response, the body is a stream of newline-delimited ExportRecords."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
     "/graphql": {
       "post": {
         "description": "Get an object based on GraphQL",
@@ -415,6 +685,77 @@ func init() {
         "x-available-in-websocket": false
       }
     },
+    "/health": {
+      "get": {
+        "security": [],
+        "description": "Checks whether the database can be reached and answers queries, and gives the status of the circuit breaker that protects it. Does not need an API key, so that load balancers can use it.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "meta"
+        ],
+        "summary": "Check the health of this Weaviate instance.",
+        "operationId": "weaviate.health",
+        "responses": {
+          "200": {
+            "description": "The instance is healthy or degraded.",
+            "schema": {
+              "$ref": "#/definitions/HealthResponse"
+            }
+          },
+          "503": {
+            "description": "The database is unavailable.",
+            "schema": {
+              "$ref": "#/definitions/HealthResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/import": {
+      "post": {
+        "description": "Imports a stream of newline-delimited ExportRecords, as produced by the export, into the database. The stream is sent as the body, with the application/json content type. UUIDs, creation times, key ownership and cross-references are preserved. Only available for the root key.",
+        "tags": [
+          "backup"
+        ],
+        "summary": "Import a knowledge graph.",
+        "operationId": "weaviate.import",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The number of records at the start of the stream that are already imported and should be skipped.",
+            "name": "checkpoint",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "All records are imported.",
+            "schema": {
+              "$ref": "#/definitions/ImportResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "422": {
+            "description": "A record could not be imported, the checkpoint tells where to resume.",
+            "schema": {
+              "$ref": "#/definitions/ImportResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
     "/keys": {
       "post": {
         "description": "Creates a new key. Input expiration date is validated on being in the future and not longer than parent expiration date.",
@@ -743,27 +1084,29 @@ func init() {
         "x-available-in-websocket": false
       }
     },
-    "/things": {
-      "get": {
-        "description": "Lists all things in reverse order of creation, owned by the user that belongs to the used token.",
+    "/schema/actions/classes": {
+      "post": {
+        "description": "Adds a action class with its properties to the schema. The names of the class and its properties must be in the contextionary, or the class must have keywords. Only available for the root key.",
         "tags": [
-          "things"
+          "schema"
         ],
-        "summary": "Get a list of things related to this key.",
-        "operationId": "weaviate.things.list",
+        "summary": "Add a action class to the schema.",
+        "operationId": "weaviate.schema.actions.classes.create",
         "parameters": [
           {
-            "$ref": "#/parameters/CommonMaxResultsParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonPageParameterQuery"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SemanticSchemaClass"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "The schema is changed.",
             "schema": {
-              "$ref": "#/definitions/ThingsListResponse"
+              "$ref": "#/definitions/SemanticSchemaClass"
             }
           },
           "401": {
@@ -773,49 +1116,64 @@ func init() {
             "description": "The used API-key has insufficient permissions."
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "The class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The change conflicts with the schema, or would make existing data invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The changed schema is not valid, or its names are not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The changed schema could not be saved or applied.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false
-      },
-      "post": {
-        "description": "Registers a new thing. Given meta-data and schema values are validated.",
+      }
+    },
+    "/schema/actions/classes/{className}": {
+      "put": {
+        "description": "Replaces a action class in the schema. When the class is renamed, the references to it are renamed as well. Renaming the class, deleting its properties or changing their data type is refused when there are actions that would become invalid. Only available for the root key.",
         "tags": [
-          "things"
+          "schema"
         ],
-        "summary": "Create a new thing based on a thing template related to this key.",
-        "operationId": "weaviate.things.create",
+        "summary": "Update a action class in the schema.",
+        "operationId": "weaviate.schema.actions.classes.update",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the class.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "properties": {
-                "async": {
-                  "description": "If ` + "`" + `async` + "`" + ` is true, return a 202 with the new ID of the Thing. You will receive this response before the data is persisted. If ` + "`" + `async` + "`" + ` is false, you will receive confirmation after the value is persisted. The value of ` + "`" + `async` + "`" + ` defaults to false.",
-                  "type": "boolean"
-                },
-                "thing": {
-                  "$ref": "#/definitions/ThingCreate"
-                }
-              }
+              "$ref": "#/definitions/SemanticSchemaClass"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Thing created.",
-            "schema": {
-              "$ref": "#/definitions/ThingGetResponse"
-            }
-          },
-          "202": {
-            "description": "Successfully received.",
+            "description": "The schema is changed.",
             "schema": {
-              "$ref": "#/definitions/ThingGetResponse"
+              "$ref": "#/definitions/SemanticSchemaClass"
             }
           },
           "401": {
@@ -824,8 +1182,26 @@ func init() {
           "403": {
             "description": "The used API-key has insufficient permissions."
           },
+          "404": {
+            "description": "The class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The change conflicts with the schema, or would make existing data invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body contains well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "The changed schema is not valid, or its names are not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The changed schema could not be saved or applied.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -833,29 +1209,26 @@ func init() {
         },
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false
-      }
-    },
-    "/things/validate": {
-      "post": {
-        "description": "Validate a thing's schema and meta-data. It has to be based on a schema, which is related to the given Thing to be accepted by this validation.",
+      },
+      "delete": {
+        "description": "Deletes a action class from the schema. A class that has actions, or that other classes refer to, can't be deleted. Only available for the root key.",
         "tags": [
-          "things"
+          "schema"
         ],
-        "summary": "Validate Things schema.",
-        "operationId": "weaviate.things.validate",
+        "summary": "Delete a action class from the schema.",
+        "operationId": "weaviate.schema.actions.classes.delete",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ThingCreate"
-            }
+            "type": "string",
+            "description": "The name of the class.",
+            "name": "className",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successful validated."
+          "204": {
+            "description": "The schema is changed."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -863,8 +1236,26 @@ func init() {
           "403": {
             "description": "The used API-key has insufficient permissions."
           },
+          "404": {
+            "description": "The class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The change conflicts with the schema, or would make existing data invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body contains well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "The changed schema is not valid, or its names are not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The changed schema could not be saved or applied.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -874,29 +1265,36 @@ func init() {
         "x-available-in-websocket": false
       }
     },
-    "/things/{thingId}": {
-      "get": {
-        "description": "Returns a particular thing data.",
+    "/schema/actions/classes/{className}/properties": {
+      "post": {
+        "description": "Adds a property to a action class in the schema. Only available for the root key.",
         "tags": [
-          "things"
+          "schema"
         ],
-        "summary": "Get a thing based on its uuid related to this key.",
-        "operationId": "weaviate.things.get",
+        "summary": "Add a property to a action class.",
+        "operationId": "weaviate.schema.actions.properties.create",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the thing.",
-            "name": "thingId",
+            "description": "The name of the class.",
+            "name": "className",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SemanticSchemaClassProperty"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "The schema is changed.",
             "schema": {
-              "$ref": "#/definitions/ThingGetResponse"
+              "$ref": "#/definitions/SemanticSchemaClassProperty"
             }
           },
           "401": {
@@ -906,25 +1304,54 @@ func init() {
             "description": "The used API-key has insufficient permissions."
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "The class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The change conflicts with the schema, or would make existing data invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The changed schema is not valid, or its names are not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The changed schema could not be saved or applied.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false
-      },
+      }
+    },
+    "/schema/actions/classes/{className}/properties/{propertyName}": {
       "put": {
-        "description": "Updates a thing data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "description": "Replaces a property of a action class in the schema. Renaming the property or changing its data type is refused when there are actions with a value for the property. Only available for the root key.",
         "tags": [
-          "things"
+          "schema"
         ],
-        "summary": "Update a thing based on its uuid related to this key.",
-        "operationId": "weaviate.things.update",
+        "summary": "Update a property of a action class.",
+        "operationId": "weaviate.schema.actions.properties.update",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the thing.",
-            "name": "thingId",
+            "description": "The name of the class.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the property.",
+            "name": "propertyName",
             "in": "path",
             "required": true
           },
@@ -933,15 +1360,15 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ThingUpdate"
+              "$ref": "#/definitions/SemanticSchemaClassProperty"
             }
           }
         ],
         "responses": {
-          "202": {
-            "description": "Successfully received.",
+          "200": {
+            "description": "The schema is changed.",
             "schema": {
-              "$ref": "#/definitions/ThingGetResponse"
+              "$ref": "#/definitions/SemanticSchemaClassProperty"
             }
           },
           "401": {
@@ -951,10 +1378,25 @@ func init() {
             "description": "The used API-key has insufficient permissions."
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "The class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The change conflicts with the schema, or would make existing data invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Request body contains well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "The changed schema is not valid, or its names are not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The changed schema could not be saved or applied.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -964,25 +1406,31 @@ func init() {
         "x-available-in-websocket": false
       },
       "delete": {
-        "description": "Deletes a thing from the system. All actions pointing to this thing, where the thing is the object of the action, are also being deleted.",
+        "description": "Deletes a property from a action class in the schema. This is refused when there are actions with a value for the property. Only available for the root key.",
         "tags": [
-          "things"
+          "schema"
         ],
-        "summary": "Delete a thing based on its uuid related to this key.",
-        "operationId": "weaviate.things.delete",
+        "summary": "Delete a property from a action class.",
+        "operationId": "weaviate.schema.actions.properties.delete",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the thing.",
-            "name": "thingId",
+            "description": "The name of the class.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the property.",
+            "name": "propertyName",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "204": {
-            "description": "Successful deleted."
+            "description": "The schema is changed."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -991,62 +1439,151 @@ func init() {
             "description": "The used API-key has insufficient permissions."
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "The class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The change conflicts with the schema, or would make existing data invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The changed schema is not valid, or its names are not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The changed schema could not be saved or applied.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true
-      },
-      "patch": {
-        "description": "Updates a thing data. This method supports patch semantics. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/schema/export": {
+      "get": {
+        "description": "Exports the schema of the things and actions, to generate typed models from: as a GraphQL SDL with the types of the GraphQL endpoint, as JSON Schema documents for the schema of every class, or as OpenAPI definitions.",
         "tags": [
-          "things"
+          "schema"
         ],
-        "summary": "Update a thing based on its uuid (using patch semantics) related to this key.",
-        "operationId": "weaviate.things.patch",
+        "summary": "Export the schema.",
+        "operationId": "weaviate.schema.export",
         "parameters": [
           {
+            "enum": [
+              "graphql",
+              "jsonschema",
+              "openapi"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the thing.",
-            "name": "thingId",
-            "in": "path",
+            "description": "The format of the export.",
+            "name": "format",
+            "in": "query",
             "required": true
-          },
-          {
-            "description": "JSONPatch document as defined by RFC 6902.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/PatchDocument"
-              }
-            }
           }
         ],
         "responses": {
-          "202": {
-            "description": "Successfully received.",
+          "200": {
+            "description": "The exported schema.",
             "schema": {
-              "$ref": "#/definitions/ThingGetResponse"
+              "$ref": "#/definitions/SchemaExport"
             }
           },
-          "400": {
-            "description": "The patch-JSON is malformed."
-          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
           "403": {
             "description": "The used API-key has insufficient permissions."
-          },
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/schema/history": {
+      "get": {
+        "description": "Lists the changes of the schema that were made at runtime, with their version, the key that made them and their differences.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the history of the schema.",
+        "operationId": "weaviate.schema.history",
+        "responses": {
+          "200": {
+            "description": "The changes of the schema.",
+            "schema": {
+              "$ref": "#/definitions/SchemaHistory"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/schema/migrations": {
+      "post": {
+        "description": "Renames classes and properties, or splits properties, in the schema and in the existing things and actions. With dryRun, only the changes are reported. Only available for the root key.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Migrate the schema and the existing data.",
+        "operationId": "weaviate.schema.migrate",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SchemaMigration"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The migration is applied, or the report of the dry run.",
+            "schema": {
+              "$ref": "#/definitions/SchemaMigrationResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "A class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The migration conflicts with the schema.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "The migration is not valid, or the changed schema is not valid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The migration could not be applied.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1056,35 +1593,29 @@ func init() {
         "x-available-in-websocket": false
       }
     },
-    "/things/{thingId}/actions": {
-      "get": {
-        "description": "Lists all actions in reverse order of creation, related to the thing that belongs to the used thingId.",
+    "/schema/things/classes": {
+      "post": {
+        "description": "Adds a thing class with its properties to the schema. The names of the class and its properties must be in the contextionary, or the class must have keywords. Only available for the root key.",
         "tags": [
-          "things"
+          "schema"
         ],
-        "summary": "Get a thing based on its uuid related to this thing. Also available as Websocket.",
-        "operationId": "weaviate.things.actions.list",
+        "summary": "Add a thing class to the schema.",
+        "operationId": "weaviate.schema.things.classes.create",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the thing.",
-            "name": "thingId",
-            "in": "path",
-            "required": true
-          },
-          {
-            "$ref": "#/parameters/CommonMaxResultsParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonPageParameterQuery"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SemanticSchemaClass"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "The schema is changed.",
             "schema": {
-              "$ref": "#/definitions/ActionsListResponse"
+              "$ref": "#/definitions/SemanticSchemaClass"
             }
           },
           "401": {
@@ -1094,36 +1625,64 @@ func init() {
             "description": "The used API-key has insufficient permissions."
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "The class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The change conflicts with the schema, or would make existing data invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The changed schema is not valid, or its names are not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The changed schema could not be saved or applied.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false
       }
     },
-    "/things/{thingId}/history": {
-      "get": {
-        "description": "Returns a particular thing history.",
+    "/schema/things/classes/{className}": {
+      "put": {
+        "description": "Replaces a thing class in the schema. When the class is renamed, the references to it are renamed as well. Renaming the class, deleting its properties or changing their data type is refused when there are things that would become invalid. Only available for the root key.",
         "tags": [
-          "things"
+          "schema"
         ],
-        "summary": "Get a thing's history based on its uuid related to this key.",
-        "operationId": "weaviate.thing.history.get",
+        "summary": "Update a thing class in the schema.",
+        "operationId": "weaviate.schema.things.classes.update",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the thing.",
-            "name": "thingId",
+            "description": "The name of the class.",
+            "name": "className",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SemanticSchemaClass"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "The schema is changed.",
             "schema": {
-              "$ref": "#/definitions/ThingGetHistoryResponse"
+              "$ref": "#/definitions/SemanticSchemaClass"
             }
           },
           "401": {
@@ -1133,868 +1692,3484 @@ func init() {
             "description": "The used API-key has insufficient permissions."
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "The class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
-          "501": {
-            "description": "Not (yet) implemented."
+          "409": {
+            "description": "The change conflicts with the schema, or would make existing data invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The changed schema is not valid, or its names are not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The changed schema could not be saved or applied.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false
-      }
-    }
-  },
-  "definitions": {
-    "Action": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/ActionCreate"
-        },
-        {
-          "type": "object",
-          "properties": {
-            "creationTimeUnix": {
-              "description": "Timestamp of creation of this action in milliseconds since epoch UTC.",
-              "type": "integer",
-              "format": "int64"
-            },
-            "key": {
-              "$ref": "#/definitions/SingleRef"
-            },
-            "lastUpdateTimeUnix": {
-              "description": "Timestamp since epoch of last update made to the action.",
-              "type": "integer",
-              "format": "int64"
+      },
+      "delete": {
+        "description": "Deletes a thing class from the schema. A class that has things, or that other classes refer to, can't be deleted. Only available for the root key.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Delete a thing class from the schema.",
+        "operationId": "weaviate.schema.things.classes.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the class.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The schema is changed."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "404": {
+            "description": "The class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The change conflicts with the schema, or would make existing data invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The changed schema is not valid, or its names are not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The changed schema could not be saved or applied.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      ]
-    },
-    "ActionCreate": {
-      "type": "object",
-      "properties": {
-        "@class": {
-          "description": "Type of the Action, defined in the schema.",
-          "type": "string"
-        },
-        "@context": {
-          "description": "Available context schema.",
-          "type": "string"
         },
-        "schema": {
-          "$ref": "#/definitions/Schema"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
       }
     },
-    "ActionGetHistoryResponse": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/ActionHistory"
-        },
-        {
-          "type": "object",
-          "properties": {
-            "actionId": {
-              "type": "string",
-              "format": "uuid"
+    "/schema/things/classes/{className}/properties": {
+      "post": {
+        "description": "Adds a property to a thing class in the schema. Only available for the root key.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Add a property to a thing class.",
+        "operationId": "weaviate.schema.things.properties.create",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the class.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SemanticSchemaClassProperty"
             }
           }
-        }
-      ]
-    },
-    "ActionGetResponse": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/Action"
-        },
-        {
-          "properties": {
-            "actionId": {
-              "description": "ID of the action.",
-              "type": "string",
-              "format": "uuid"
+        ],
+        "responses": {
+          "200": {
+            "description": "The schema is changed.",
+            "schema": {
+              "$ref": "#/definitions/SemanticSchemaClassProperty"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "404": {
+            "description": "The class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The change conflicts with the schema, or would make existing data invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The changed schema is not valid, or its names are not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The changed schema could not be saved or applied.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      ]
-    },
-    "ActionHistory": {
-      "type": "object",
-      "properties": {
-        "deleted": {
-          "description": "Indication whether the action is deleted",
-          "type": "boolean"
-        },
-        "key": {
-          "$ref": "#/definitions/SingleRef"
         },
-        "propertyHistory": {
-          "description": "An array with the history of the action.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ActionHistoryObject"
-          }
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
       }
     },
-    "ActionHistoryObject": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/ActionCreate"
-        },
-        {
-          "type": "object",
-          "properties": {
-            "creationTimeUnix": {
-              "description": "Timestamp of creation of this action history in milliseconds since epoch UTC.",
-              "type": "integer",
-              "format": "int64"
+    "/schema/things/classes/{className}/properties/{propertyName}": {
+      "put": {
+        "description": "Replaces a property of a thing class in the schema. Renaming the property or changing its data type is refused when there are things with a value for the property. Only available for the root key.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Update a property of a thing class.",
+        "operationId": "weaviate.schema.things.properties.update",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the class.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the property.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SemanticSchemaClassProperty"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The schema is changed.",
+            "schema": {
+              "$ref": "#/definitions/SemanticSchemaClassProperty"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "404": {
+            "description": "The class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The change conflicts with the schema, or would make existing data invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The changed schema is not valid, or its names are not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The changed schema could not be saved or applied.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      ]
-    },
-    "ActionUpdate": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/Action"
         },
-        {
-          "type": "object"
-        }
-      ]
-    },
-    "ActionValidate": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/ActionCreate"
-        }
-      ]
-    },
-    "ActionsListResponse": {
-      "description": "List of actions for specific Thing.",
-      "type": "object",
-      "properties": {
-        "actions": {
-          "description": "The actual list of actions.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ActionGetResponse"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      },
+      "delete": {
+        "description": "Deletes a property from a thing class in the schema. This is refused when there are things with a value for the property. Only available for the root key.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Delete a property from a thing class.",
+        "operationId": "weaviate.schema.things.properties.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the class.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the property.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The schema is changed."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "404": {
+            "description": "The class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The change conflicts with the schema, or would make existing data invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The changed schema is not valid, or its names are not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The changed schema could not be saved or applied.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "totalResults": {
-          "description": "The total number of actions for the query. The number of items in a response may be smaller due to paging.",
-          "type": "integer",
-          "format": "int64"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
       }
     },
-    "ErrorResponse": {
-      "description": "An error response given by Weaviate end-points.",
-      "type": "object",
-      "properties": {
-        "error": {
-          "type": "object",
-          "properties": {
-            "message": {
-              "type": "string"
+    "/schema/validate": {
+      "post": {
+        "description": "Checks the names and keywords of all classes and properties against the contextionary, and reports all problems at once. Words that are not in the contextionary come with suggestions of known words that are spelled alike or near the other words, and classes that are nearly the same in the contextionary are warned about. Without a body the current schema is validated. The schema is not changed.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Validate the schema against the contextionary.",
+        "operationId": "weaviate.schema.validate",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/SchemaValidation"
             }
           }
-        }
-      }
-    },
-    "GraphQLError": {
-      "description": "Error messages responded only if error exists.",
-      "properties": {
-        "locations": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "column": {
-                "type": "integer",
-                "format": "int64"
-              },
-              "line": {
-                "type": "integer",
-                "format": "int64"
-              }
+        ],
+        "responses": {
+          "200": {
+            "description": "The problems of the schema.",
+            "schema": {
+              "$ref": "#/definitions/SchemaValidationResponse"
             }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
           }
         },
-        "message": {
-          "type": "string"
-        },
-        "path": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
       }
     },
-    "GraphQLQuery": {
-      "description": "GraphQL query based on: http://facebook.github.io/graphql/",
-      "type": "object",
-      "properties": {
-        "operationName": {
-          "description": "Name of the operation if multiple exist in query.",
-          "type": "string"
-        },
-        "query": {
-          "description": "Query based on GraphQL syntax",
-          "type": "string"
+    "/schema/vocabulary": {
+      "post": {
+        "description": "Converts the classes and properties of a vocabulary in JSON-LD, like schema.org or an RDFS or OWL ontology, into a thing and action schema. Classes extend their parent classes, the ranges of properties become their data types and the labels become keywords. The names and keywords are checked against the contextionary. The schema is not changed.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Convert a vocabulary into a schema.",
+        "operationId": "weaviate.schema.vocabulary.convert",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VocabularyConversion"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The converted schema.",
+            "schema": {
+              "$ref": "#/definitions/VocabularyConversionResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "422": {
+            "description": "The vocabulary can not be converted.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "variables": {
-          "description": "Additional variables for the query.",
-          "type": "object"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
       }
     },
-    "GraphQLResponse": {
-      "description": "GraphQL based repsonse: http://facebook.github.io/graphql/",
-      "properties": {
-        "data": {
-          "description": "GraphQL data object",
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/JsonObject"
+    "/things": {
+      "get": {
+        "description": "Lists all things in reverse order of creation, owned by the user that belongs to the used token.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Get a list of things related to this key.",
+        "operationId": "weaviate.things.list",
+        "parameters": [
+          {
+            "$ref": "#/parameters/CommonMaxResultsParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonPageParameterQuery"
+          },
+          {
+            "type": "string",
+            "description": "Only list the things of this class. The values of the where filters are converted to the data types of the properties of this class.",
+            "name": "class",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Only list the things whose schema matches all of the filters. A filter is a property, an operator and a value, e.g. 'founded\u003e=2018-01-01T00:00:00Z'. The operators are ':' or '=' for equal, '!=' for not equal, '\u003e', '\u003e=', '\u003c' and '\u003c='. Dates are given in RFC 3339.",
+            "name": "where",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ThingsListResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "A where filter is invalid, e.g. because its property does not exist or its value does not fit the data type of the property.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "errors": {
-          "description": "Array with errors",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/GraphQLError"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      },
+      "post": {
+        "description": "Registers a new thing. Given meta-data and schema values are validated.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Create a new thing based on a thing template related to this key.",
+        "operationId": "weaviate.things.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "async": {
+                  "description": "If ` + "`" + `async` + "`" + ` is true, return a 202 with the new ID of the Thing. You will receive this response before the data is persisted. If ` + "`" + `async` + "`" + ` is false, you will receive confirmation after the value is persisted. The value of ` + "`" + `async` + "`" + ` defaults to false.",
+                  "type": "boolean"
+                },
+                "thing": {
+                  "$ref": "#/definitions/ThingCreate"
+                }
+              }
+            }
           }
-        }
+        ],
+        "responses": {
+          "200": {
+            "description": "Thing created.",
+            "schema": {
+              "$ref": "#/definitions/ThingGetResponse"
+            }
+          },
+          "202": {
+            "description": "Successfully received.",
+            "schema": {
+              "$ref": "#/definitions/ThingGetResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "422": {
+            "description": "Request body contains well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The thing could not be written to the database.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
       }
     },
-    "JsonObject": {
-      "description": "JSON object value.",
-      "type": "object"
+    "/things/batch": {
+      "post": {
+        "description": "Registers or updates multiple things at once. Every thing is validated separately, the results are returned per thing.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Create or update a batch of things related to this key.",
+        "operationId": "weaviate.things.batch.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ThingsBatchRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Batch handled, see the results per thing.",
+            "schema": {
+              "$ref": "#/definitions/ThingsBatchResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "422": {
+            "description": "Request body contains well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
     },
-    "Key": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/KeyCreate"
+    "/things/validate": {
+      "post": {
+        "description": "Validate a thing's schema and meta-data. It has to be based on a schema, which is related to the given Thing to be accepted by this validation.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Validate Things schema.",
+        "operationId": "weaviate.things.validate",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ThingCreate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful validated."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "422": {
+            "description": "Request body contains well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        {
-          "properties": {
-            "parent": {
-              "$ref": "#/definitions/SingleRef"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/things/{thingId}": {
+      "get": {
+        "description": "Returns a particular thing data.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Get a thing based on its uuid related to this key.",
+        "operationId": "weaviate.things.get",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the thing.",
+            "name": "thingId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ThingGetResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      },
+      "put": {
+        "description": "Updates a thing data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Update a thing based on its uuid related to this key.",
+        "operationId": "weaviate.things.update",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the thing.",
+            "name": "thingId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ThingUpdate"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Successfully received.",
+            "schema": {
+              "$ref": "#/definitions/ThingGetResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request body contains well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The thing could not be written to the database.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      },
+      "delete": {
+        "description": "Deletes a thing from the system. All actions pointing to this thing, where the thing is the object of the action, are also being deleted.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Delete a thing based on its uuid related to this key.",
+        "operationId": "weaviate.things.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the thing.",
+            "name": "thingId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successful deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "The thing could not be written to the database.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true
+      },
+      "patch": {
+        "description": "Updates a thing data. This method supports patch semantics. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Update a thing based on its uuid (using patch semantics) related to this key.",
+        "operationId": "weaviate.things.patch",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the thing.",
+            "name": "thingId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "JSONPatch document as defined by RFC 6902.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/PatchDocument"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Successfully received.",
+            "schema": {
+              "$ref": "#/definitions/ThingGetResponse"
+            }
+          },
+          "400": {
+            "description": "The patch-JSON is malformed."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "The patch-JSON is valid but unprocessable.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The thing could not be written to the database.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/things/{thingId}/actions": {
+      "get": {
+        "description": "Lists all actions in reverse order of creation, related to the thing that belongs to the used thingId.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Get a thing based on its uuid related to this thing. Also available as Websocket.",
+        "operationId": "weaviate.things.actions.list",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the thing.",
+            "name": "thingId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonMaxResultsParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonPageParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ActionsListResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/things/{thingId}/history": {
+      "get": {
+        "description": "Returns a particular thing history.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Get a thing's history based on its uuid related to this key.",
+        "operationId": "weaviate.thing.history.get",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the thing.",
+            "name": "thingId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ThingGetHistoryResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "501": {
+            "description": "Not (yet) implemented."
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    }
+  },
+  "definitions": {
+    "Action": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/ActionCreate"
+        },
+        {
+          "type": "object",
+          "properties": {
+            "creationTimeUnix": {
+              "description": "Timestamp of creation of this action in milliseconds since epoch UTC.",
+              "type": "integer",
+              "format": "int64"
+            },
+            "key": {
+              "$ref": "#/definitions/SingleRef"
+            },
+            "lastUpdateTimeUnix": {
+              "description": "Timestamp since epoch of last update made to the action.",
+              "type": "integer",
+              "format": "int64"
+            }
+          }
+        }
+      ]
+    },
+    "ActionBatchItem": {
+      "description": "A single action in a batch. If ` + "`" + `actionId` + "`" + ` is given, the existing action is updated, otherwise a new action is created.",
+      "type": "object",
+      "properties": {
+        "action": {
+          "$ref": "#/definitions/ActionCreate"
+        },
+        "actionId": {
+          "description": "Unique ID of an existing action to update.",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "ActionBatchResult": {
+      "description": "The result of a single action in a batch.",
+      "type": "object",
+      "properties": {
+        "actionId": {
+          "description": "Unique ID of the action.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "errors": {
+          "$ref": "#/definitions/ErrorResponse"
+        },
+        "status": {
+          "description": "Whether the action was created, updated or failed.",
+          "type": "string",
+          "enum": [
+            "created",
+            "updated",
+            "failed"
+          ]
+        }
+      }
+    },
+    "ActionCreate": {
+      "type": "object",
+      "properties": {
+        "@class": {
+          "description": "Type of the Action, defined in the schema.",
+          "type": "string"
+        },
+        "@context": {
+          "description": "Available context schema.",
+          "type": "string"
+        },
+        "schema": {
+          "$ref": "#/definitions/Schema"
+        }
+      }
+    },
+    "ActionGetHistoryResponse": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/ActionHistory"
+        },
+        {
+          "type": "object",
+          "properties": {
+            "actionId": {
+              "type": "string",
+              "format": "uuid"
+            }
+          }
+        }
+      ]
+    },
+    "ActionGetResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/Action"
+        },
+        {
+          "properties": {
+            "actionId": {
+              "description": "ID of the action.",
+              "type": "string",
+              "format": "uuid"
+            }
+          }
+        }
+      ]
+    },
+    "ActionHistory": {
+      "type": "object",
+      "properties": {
+        "deleted": {
+          "description": "Indication whether the action is deleted",
+          "type": "boolean"
+        },
+        "key": {
+          "$ref": "#/definitions/SingleRef"
+        },
+        "propertyHistory": {
+          "description": "An array with the history of the action.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ActionHistoryObject"
+          }
+        }
+      }
+    },
+    "ActionHistoryObject": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/ActionCreate"
+        },
+        {
+          "type": "object",
+          "properties": {
+            "creationTimeUnix": {
+              "description": "Timestamp of creation of this action history in milliseconds since epoch UTC.",
+              "type": "integer",
+              "format": "int64"
+            }
+          }
+        }
+      ]
+    },
+    "ActionUpdate": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/Action"
+        },
+        {
+          "type": "object"
+        }
+      ]
+    },
+    "ActionValidate": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/ActionCreate"
+        }
+      ]
+    },
+    "ActionsBatchRequest": {
+      "description": "A batch of actions to create or update. A cref to another action in the same batch is made by setting its '$cref' to '#/actions/{index}'.",
+      "type": "object",
+      "properties": {
+        "actions": {
+          "description": "The actions in this batch.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ActionBatchItem"
+          }
+        }
+      }
+    },
+    "ActionsBatchResponse": {
+      "description": "Results of a batch of actions, in the same order as the request.",
+      "type": "object",
+      "properties": {
+        "actions": {
+          "description": "The result of each action in the batch.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ActionBatchResult"
+          }
+        }
+      }
+    },
+    "ActionsListResponse": {
+      "description": "List of actions for specific Thing.",
+      "type": "object",
+      "properties": {
+        "actions": {
+          "description": "The actual list of actions.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ActionGetResponse"
+          }
+        },
+        "totalResults": {
+          "description": "The total number of actions for the query. The number of items in a response may be smaller due to paging.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "C11yDistance": {
+      "description": "The distance between two words or phrases in the contextionary.",
+      "type": "object",
+      "properties": {
+        "a": {
+          "description": "The first word or phrase.",
+          "type": "string"
+        },
+        "b": {
+          "description": "The second word or phrase.",
+          "type": "string"
+        },
+        "distance": {
+          "description": "The distance between the vectors of the words or phrases.",
+          "type": "number",
+          "format": "float",
+          "x-omitempty": false
+        }
+      }
+    },
+    "C11yDistanceQuery": {
+      "description": "Two words or phrases to measure the distance between. The vector of a phrase is the centroid of its words.",
+      "type": "object",
+      "required": [
+        "a",
+        "b"
+      ],
+      "properties": {
+        "a": {
+          "description": "The first word or phrase.",
+          "type": "string"
+        },
+        "b": {
+          "description": "The second word or phrase.",
+          "type": "string"
+        }
+      }
+    },
+    "C11yNeighbour": {
+      "description": "A word near another word in the contextionary.",
+      "type": "object",
+      "properties": {
+        "distance": {
+          "description": "The distance between the vectors of the words.",
+          "type": "number",
+          "format": "float",
+          "x-omitempty": false
+        },
+        "word": {
+          "description": "The nearby word, or centroid of a class or property.",
+          "type": "string"
+        }
+      }
+    },
+    "C11yNeighbours": {
+      "description": "The nearest neighbours of a word, nearest first.",
+      "type": "object",
+      "properties": {
+        "neighbours": {
+          "description": "The nearest words, without the word itself.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/C11yNeighbour"
+          }
+        },
+        "word": {
+          "description": "The word, or centroid of a class, whose neighbours these are.",
+          "type": "string"
+        }
+      }
+    },
+    "C11yWord": {
+      "description": "A word of the contextionary, or the centroid of a class or property like ` + "`" + `$THING[City]` + "`" + `.",
+      "type": "object",
+      "properties": {
+        "present": {
+          "description": "Whether the word is in the contextionary.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "vector": {
+          "description": "The vector of the word, if it is present.",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          }
+        },
+        "word": {
+          "description": "The word that is looked up.",
+          "type": "string"
+        }
+      }
+    },
+    "CircuitBreakerStatus": {
+      "description": "The status of the circuit breaker that protects the database. It opens when the database is unavailable for a number of queries in a row; while it is open, queries fail immediately.",
+      "type": "object",
+      "properties": {
+        "consecutiveFailures": {
+          "description": "The number of queries in a row that failed because the database was unavailable.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "lastError": {
+          "description": "The last error that showed that the database was unavailable.",
+          "type": "string"
+        },
+        "openedAtUnix": {
+          "description": "Timestamp of when the circuit breaker last opened, in milliseconds since epoch UTC. Not given if it never opened.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "state": {
+          "description": "Closed when queries are sent to the database, open when they fail immediately, and halfOpen when a single query is sent to find out if the database is available again.",
+          "type": "string",
+          "enum": [
+            "closed",
+            "open",
+            "halfOpen"
+          ]
+        }
+      }
+    },
+    "ErrorResponse": {
+      "description": "An error response given by Weaviate end-points.",
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "The class of the thing or action, when the error is about one of its properties.",
+              "type": "string"
+            },
+            "constraint": {
+              "description": "The constraint of the property that is violated, e.g. 'maximum' or 'unique'.",
+              "type": "string"
+            },
+            "message": {
+              "type": "string"
+            },
+            "property": {
+              "description": "The property that the error is about.",
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "ExportRecord": {
+      "description": "A single line of an export stream. Keys come before the things and actions they own, things come before the actions that refer to them.",
+      "type": "object",
+      "properties": {
+        "action": {
+          "$ref": "#/definitions/Action"
+        },
+        "key": {
+          "$ref": "#/definitions/Key"
+        },
+        "thing": {
+          "$ref": "#/definitions/Thing"
+        },
+        "tokenHash": {
+          "description": "The hashed token of the key, only given for keys.",
+          "type": "string"
+        },
+        "type": {
+          "description": "Whether this record holds a key, a thing or an action.",
+          "type": "string",
+          "enum": [
+            "key",
+            "thing",
+            "action"
+          ]
+        },
+        "uuid": {
+          "description": "Unique ID of the key, thing or action.",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "GeoCoordinates": {
+      "description": "A location on earth, as the value of a geoCoordinates property.",
+      "type": "object",
+      "properties": {
+        "latitude": {
+          "description": "The latitude of the location, from -90 to 90 degrees.",
+          "type": "number",
+          "x-omitempty": false
+        },
+        "longitude": {
+          "description": "The longitude of the location, from -180 to 180 degrees.",
+          "type": "number",
+          "x-omitempty": false
+        }
+      }
+    },
+    "GraphQLError": {
+      "description": "Error messages responded only if error exists.",
+      "properties": {
+        "locations": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "column": {
+                "type": "integer",
+                "format": "int64"
+              },
+              "line": {
+                "type": "integer",
+                "format": "int64"
+              }
+            }
+          }
+        },
+        "message": {
+          "type": "string"
+        },
+        "path": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "GraphQLQuery": {
+      "description": "GraphQL query based on: http://facebook.github.io/graphql/",
+      "type": "object",
+      "properties": {
+        "operationName": {
+          "description": "Name of the operation if multiple exist in query.",
+          "type": "string"
+        },
+        "query": {
+          "description": "Query based on GraphQL syntax",
+          "type": "string"
+        },
+        "variables": {
+          "description": "Additional variables for the query.",
+          "type": "object"
+        }
+      }
+    },
+    "GraphQLResponse": {
+      "description": "GraphQL based repsonse: http://facebook.github.io/graphql/",
+      "properties": {
+        "data": {
+          "description": "GraphQL data object",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/JsonObject"
+          }
+        },
+        "errors": {
+          "description": "Array with errors",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GraphQLError"
+          }
+        }
+      }
+    },
+    "HealthResponse": {
+      "description": "The health of this Weaviate instance and its database.",
+      "type": "object",
+      "properties": {
+        "circuitBreaker": {
+          "$ref": "#/definitions/CircuitBreakerStatus"
+        },
+        "database": {
+          "description": "The name of the database connector.",
+          "type": "string"
+        },
+        "message": {
+          "description": "Why the instance is not healthy.",
+          "type": "string"
+        },
+        "status": {
+          "description": "Healthy when the database answers queries, degraded when it is being checked after it was unavailable, and unhealthy when it is unavailable.",
+          "type": "string",
+          "enum": [
+            "healthy",
+            "degraded",
+            "unhealthy"
+          ]
+        }
+      }
+    },
+    "ImportResponse": {
+      "description": "The result of an import.",
+      "type": "object",
+      "properties": {
+        "actions": {
+          "description": "The number of imported actions.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "checkpoint": {
+          "description": "The number of records from the start of the stream that are imported. Resume a failed import by sending the same stream with this checkpoint.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "errors": {
+          "$ref": "#/definitions/ErrorResponse"
+        },
+        "keys": {
+          "description": "The number of imported keys.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "things": {
+          "description": "The number of imported things.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "JsonObject": {
+      "description": "JSON object value.",
+      "type": "object"
+    },
+    "Key": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/KeyCreate"
+        },
+        {
+          "properties": {
+            "parent": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          }
+        }
+      ]
+    },
+    "KeyChildrenGetResponse": {
+      "properties": {
+        "children": {
+          "$ref": "#/definitions/MultipleRef"
+        }
+      }
+    },
+    "KeyCreate": {
+      "properties": {
+        "delete": {
+          "description": "Is user allowed to delete.",
+          "type": "boolean"
+        },
+        "email": {
+          "description": "Email associated with this account.",
+          "type": "string"
+        },
+        "execute": {
+          "description": "Is user allowed to execute.",
+          "type": "boolean"
+        },
+        "ipOrigin": {
+          "description": "Origin of the IP using CIDR notation.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "isRoot": {
+          "description": "Shows if key is root key",
+          "type": "boolean",
+          "default": false
+        },
+        "keyExpiresUnix": {
+          "description": "Time as Unix timestamp that the key expires. Set to 0 for never.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "read": {
+          "description": "Is user allowed to read.",
+          "type": "boolean"
+        },
+        "write": {
+          "description": "Is user allowed to write.",
+          "type": "boolean"
+        }
+      }
+    },
+    "KeyGetResponse": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/Key"
+        },
+        {
+          "properties": {
+            "keyId": {
+              "description": "Id of the key.",
+              "type": "string",
+              "format": "uuid"
+            }
+          }
+        }
+      ]
+    },
+    "KeyTokenGetResponse": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/KeyGetResponse"
+        },
+        {
+          "properties": {
+            "token": {
+              "description": "Key for user to use.",
+              "type": "string",
+              "format": "uuid"
+            }
+          }
+        }
+      ]
+    },
+    "Meta": {
+      "description": "Contains meta information of the current Weaviate instance.",
+      "type": "object",
+      "properties": {
+        "actionsSchema": {
+          "$ref": "#/definitions/SemanticSchema"
+        },
+        "hostname": {
+          "description": "The url of the host",
+          "type": "string",
+          "format": "url"
+        },
+        "thingsSchema": {
+          "$ref": "#/definitions/SemanticSchema"
+        }
+      }
+    },
+    "MultipleRef": {
+      "description": "Multiple instances of references to other objects.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/SingleRef"
+      }
+    },
+    "PatchDocument": {
+      "description": "A JSONPatch document as defined by RFC 6902.",
+      "required": [
+        "op",
+        "path"
+      ],
+      "properties": {
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
+        },
+        "op": {
+          "description": "The operation to be performed.",
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
+        },
+        "path": {
+          "description": "A JSON-Pointer.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
+        }
+      }
+    },
+    "PeerUpdate": {
+      "description": "A single peer in the network",
+      "properties": {
+        "id": {
+          "description": "The session ID of the peer",
+          "type": "string",
+          "format": "uuid"
+        },
+        "name": {
+          "description": "Human readable name",
+          "type": "string"
+        },
+        "uri": {
+          "description": "The location where the peer is exposed to the internet",
+          "type": "string",
+          "format": "uri"
+        }
+      }
+    },
+    "PeerUpdateList": {
+      "description": "Lisf of known peers",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/PeerUpdate"
+      }
+    },
+    "Schema": {
+      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition",
+      "type": "object"
+    },
+    "SchemaChange": {
+      "description": "A change of the schema that was made at runtime.",
+      "type": "object",
+      "properties": {
+        "creationTimeUnix": {
+          "description": "Timestamp of the change in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "diff": {
+          "description": "The differences with the previous version of the schema.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SchemaDiff"
+          }
+        },
+        "keyId": {
+          "description": "The key that made the change.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "version": {
+          "description": "The version of the schema after the change.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "SchemaDiff": {
+      "description": "A class or property that differs between two versions of the schema.",
+      "type": "object",
+      "properties": {
+        "new": {
+          "description": "The class or property after the change; a class without its properties, unless it is added.",
+          "type": "object"
+        },
+        "old": {
+          "description": "The class or property before the change; a class without its properties, unless it is removed.",
+          "type": "object"
+        },
+        "op": {
+          "description": "Whether the class or property is added, removed or replaced.",
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace"
+          ]
+        },
+        "path": {
+          "description": "The class or property, e.g. '/things/City' or '/things/City/population'.",
+          "type": "string"
+        }
+      }
+    },
+    "SchemaExport": {
+      "description": "The schema of the things and actions, in the requested format.",
+      "type": "object",
+      "properties": {
+        "format": {
+          "description": "The format of the export.",
+          "type": "string",
+          "enum": [
+            "graphql",
+            "jsonschema",
+            "openapi"
+          ]
+        },
+        "graphql": {
+          "description": "The GraphQL schema, in the schema definition language, with the types that the GraphQL endpoint generates for the classes.",
+          "type": "string"
+        },
+        "jsonSchema": {
+          "description": "A JSON Schema document for the schema of the things and actions of every class, as an object with ` + "`" + `things` + "`" + ` and ` + "`" + `actions` + "`" + ` that have the documents by class name.",
+          "$ref": "#/definitions/JsonObject"
+        },
+        "openapi": {
+          "description": "The OpenAPI definitions of the schema of the things and actions of every class, named ` + "`" + `\u003cClass\u003eSchema` + "`" + `, with the definitions that they refer to.",
+          "$ref": "#/definitions/JsonObject"
+        },
+        "version": {
+          "description": "The version of the schema that is exported.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "SchemaHistory": {
+      "description": "The changes of the schema that were made at runtime, oldest first.",
+      "type": "object",
+      "properties": {
+        "changes": {
+          "description": "The changes, oldest first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SchemaChange"
+          }
+        },
+        "version": {
+          "description": "The current version of the schema; the schema files that weaviate started with are version 0.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "SchemaMigration": {
+      "description": "Declarative changes of the schema, which are applied to the existing things and actions as well.",
+      "type": "object",
+      "required": [
+        "operations"
+      ],
+      "properties": {
+        "dryRun": {
+          "description": "Only report what the migration would change, without changing anything.",
+          "type": "boolean"
+        },
+        "operations": {
+          "description": "The operations, in the order in which they are applied.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SchemaMigrationOperation"
+          }
+        }
+      }
+    },
+    "SchemaMigrationOperation": {
+      "description": "A single operation of a migration. renameClass renames the class to newName. renameProperty renames the property to newName. splitProperty splits the string values of the property at the separator into the properties of into, in order; the last one gets the rest of the value.",
+      "type": "object",
+      "required": [
+        "op",
+        "kind",
+        "className"
+      ],
+      "properties": {
+        "className": {
+          "description": "The name of the class.",
+          "type": "string"
+        },
+        "into": {
+          "description": "The properties to split the property into, for splitProperty. Their data type must be string.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SemanticSchemaClassProperty"
+          }
+        },
+        "kind": {
+          "description": "Whether the class is a thing or an action class.",
+          "type": "string",
+          "enum": [
+            "thing",
+            "action"
+          ]
+        },
+        "newName": {
+          "description": "The new name of the class or property, for renameClass and renameProperty.",
+          "type": "string"
+        },
+        "op": {
+          "description": "The kind of operation.",
+          "type": "string",
+          "enum": [
+            "renameClass",
+            "renameProperty",
+            "splitProperty"
+          ]
+        },
+        "propertyName": {
+          "description": "The name of the property, for renameProperty and splitProperty.",
+          "type": "string"
+        },
+        "separator": {
+          "description": "Where to split the values, for splitProperty.",
+          "type": "string",
+          "default": " "
+        }
+      }
+    },
+    "SchemaMigrationResponse": {
+      "description": "What a migration changed, or would change for a dry run.",
+      "type": "object",
+      "properties": {
+        "actions": {
+          "description": "The number of actions that are changed.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "diff": {
+          "description": "The differences with the previous version of the schema.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SchemaDiff"
+          }
+        },
+        "dryRun": {
+          "description": "Whether this was a dry run, so nothing is changed.",
+          "type": "boolean"
+        },
+        "things": {
+          "description": "The number of things that are changed.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "version": {
+          "description": "The version of the schema after the migration; the current version for a dry run.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "SchemaProblem": {
+      "description": "A problem with the names or keywords of a class or property, or with the schema itself.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The class with the problem.",
+          "type": "string"
+        },
+        "kind": {
+          "description": "Whether the class is a thing or action class.",
+          "type": "string",
+          "enum": [
+            "thing",
+            "action"
+          ]
+        },
+        "message": {
+          "description": "What the problem is.",
+          "type": "string"
+        },
+        "property": {
+          "description": "The property with the problem, if the problem is not with the class itself.",
+          "type": "string"
+        },
+        "severity": {
+          "description": "An error keeps the schema from being used, a warning doesn't.",
+          "type": "string",
+          "enum": [
+            "error",
+            "warning"
+          ]
+        },
+        "suggestions": {
+          "description": "Known words to use instead of the word, or as keywords.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "word": {
+          "description": "The word that is not in the contextionary, or the class that is a near duplicate.",
+          "type": "string"
+        }
+      }
+    },
+    "SchemaValidation": {
+      "description": "The thing and action schema to validate. The current schema is validated when they are not given.",
+      "type": "object",
+      "properties": {
+        "actions": {
+          "$ref": "#/definitions/SemanticSchema"
+        },
+        "things": {
+          "$ref": "#/definitions/SemanticSchema"
+        }
+      }
+    },
+    "SchemaValidationResponse": {
+      "description": "All problems of the schema.",
+      "type": "object",
+      "properties": {
+        "problems": {
+          "description": "The problems, errors and warnings.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SchemaProblem"
+          }
+        },
+        "valid": {
+          "description": "Whether the schema has no errors.",
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
+    },
+    "SemanticSchema": {
+      "description": "Definitions of semantic schemas (also see: https://github.com/creativesoftwarefdn/weaviate-semantic-schemas)",
+      "type": "object",
+      "properties": {
+        "@context": {
+          "description": "URL of the context",
+          "type": "string",
+          "format": "uri"
+        },
+        "classes": {
+          "description": "Semantic classes that are available.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SemanticSchemaClass"
+          }
+        },
+        "maintainer": {
+          "description": "Email of the maintainer.",
+          "type": "string",
+          "format": "email"
+        },
+        "name": {
+          "description": "Name of the schema",
+          "type": "string"
+        },
+        "type": {
+          "description": "Type of schema, should be \"thing\" or \"action\".",
+          "type": "string",
+          "enum": [
+            "thing",
+            "action"
+          ]
+        },
+        "version": {
+          "description": "Version number of the schema in semver format.",
+          "type": "string"
+        }
+      }
+    },
+    "SemanticSchemaClass": {
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Name of the class as URI relative to the schema URL.",
+          "type": "string"
+        },
+        "description": {
+          "description": "Description of the class",
+          "type": "string"
+        },
+        "extends": {
+          "description": "Name of the parent class, whose properties this class inherits. References to the parent class accept instances of this class as well.",
+          "type": "string"
+        },
+        "keywords": {
+          "description": "Describes the kind of class. For example Geolocation for the class City.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "kind": {
+                "type": "string"
+              },
+              "weight": {
+                "type": "number",
+                "format": "float"
+              }
+            }
+          }
+        },
+        "properties": {
+          "description": "The properties of the class.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SemanticSchemaClassProperty"
+          }
+        }
+      }
+    },
+    "SemanticSchemaClassProperty": {
+      "type": "object",
+      "properties": {
+        "@dataType": {
+          "description": "Can be a reference ($cref) to another type when starts with a capital (for example Person) otherwise \"string\" or \"int\".",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "constraints": {
+          "$ref": "#/definitions/SemanticSchemaClassPropertyConstraints"
+        },
+        "description": {
+          "description": "Description of the property",
+          "type": "string"
+        },
+        "keywords": {
+          "description": "Describes the kind of class. For example Geolocation for the class City.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "kind": {
+                "type": "string"
+              },
+              "weight": {
+                "type": "number",
+                "format": "float"
+              }
+            }
+          }
+        },
+        "name": {
+          "description": "Name of the property as URI relative to the schema URL.",
+          "type": "string"
+        }
+      }
+    },
+    "SemanticSchemaClassPropertyConstraints": {
+      "description": "Constraints on the values of a property. They are checked when a thing or action is created, updated or patched.",
+      "type": "object",
+      "properties": {
+        "cardinality": {
+          "description": "How many things or actions of the class may refer to the same thing, action or key. Only for cross-references. 'manyToOne' is the default, 'oneToOne' allows a single reference to every thing, action or key.",
+          "type": "string",
+          "enum": [
+            "manyToOne",
+            "oneToOne"
+          ]
+        },
+        "enum": {
+          "description": "The values that the property may have. Only for string, int and number properties.",
+          "type": "array",
+          "items": {}
+        },
+        "maxLength": {
+          "description": "The maximum length of the value. Only for string properties.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "maximum": {
+          "description": "The maximum value, inclusive. Only for int and number properties.",
+          "type": "number",
+          "x-nullable": true
+        },
+        "minLength": {
+          "description": "The minimum length of the value. Only for string properties.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "minimum": {
+          "description": "The minimum value, inclusive. Only for int and number properties.",
+          "type": "number",
+          "x-nullable": true
+        },
+        "pattern": {
+          "description": "A regular expression that the value must match, in the syntax of https://golang.org/s/re2syntax. Only for string properties.",
+          "type": "string"
+        },
+        "required": {
+          "description": "Whether every thing or action of the class must have a value for the property.",
+          "type": "boolean"
+        },
+        "unique": {
+          "description": "Whether the value must be different for every thing or action of the class. Only for string, int, number and date properties.",
+          "type": "boolean"
+        }
+      }
+    },
+    "SingleRef": {
+      "properties": {
+        "$cref": {
+          "description": "Location of the cross reference.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "locationUrl": {
+          "description": "url of location. http://localhost means this database. This option can be used to refer to other databases.",
+          "type": "string",
+          "format": "url",
+          "default": "http://localhost/"
+        },
+        "type": {
+          "description": "Type should be Thing, Action or Key",
+          "type": "string",
+          "enum": [
+            "Thing",
+            "Action",
+            "Key"
+          ]
+        }
+      }
+    },
+    "Thing": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/ThingCreate"
+        },
+        {
+          "type": "object",
+          "properties": {
+            "creationTimeUnix": {
+              "description": "Timestamp of creation of this thing in milliseconds since epoch UTC.",
+              "type": "integer",
+              "format": "int64"
+            },
+            "key": {
+              "$ref": "#/definitions/SingleRef"
+            },
+            "lastUpdateTimeUnix": {
+              "description": "Timestamp of the last thing update in milliseconds since epoch UTC.",
+              "type": "integer",
+              "format": "int64"
+            }
+          }
+        }
+      ]
+    },
+    "ThingBatchItem": {
+      "description": "A single thing in a batch. If ` + "`" + `thingId` + "`" + ` is given, the existing thing is updated, otherwise a new thing is created.",
+      "type": "object",
+      "properties": {
+        "thing": {
+          "$ref": "#/definitions/ThingCreate"
+        },
+        "thingId": {
+          "description": "Unique ID of an existing thing to update.",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "ThingBatchResult": {
+      "description": "The result of a single thing in a batch.",
+      "type": "object",
+      "properties": {
+        "errors": {
+          "$ref": "#/definitions/ErrorResponse"
+        },
+        "status": {
+          "description": "Whether the thing was created, updated or failed.",
+          "type": "string",
+          "enum": [
+            "created",
+            "updated",
+            "failed"
+          ]
+        },
+        "thingId": {
+          "description": "Unique ID of the thing.",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "ThingCreate": {
+      "type": "object",
+      "properties": {
+        "@class": {
+          "description": "Class of the Thing, defined in the schema.",
+          "type": "string"
+        },
+        "@context": {
+          "description": "Available context schema.",
+          "type": "string"
+        },
+        "schema": {
+          "$ref": "#/definitions/Schema"
+        }
+      }
+    },
+    "ThingGetHistoryResponse": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/ThingHistory"
+        },
+        {
+          "type": "object",
+          "properties": {
+            "thingId": {
+              "type": "string",
+              "format": "uuid"
+            }
+          }
+        }
+      ]
+    },
+    "ThingGetResponse": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/Thing"
+        },
+        {
+          "type": "object",
+          "properties": {
+            "thingId": {
+              "type": "string",
+              "format": "uuid"
+            }
+          }
+        }
+      ]
+    },
+    "ThingHistory": {
+      "type": "object",
+      "properties": {
+        "deleted": {
+          "description": "Indication whether the action is deleted",
+          "type": "boolean"
+        },
+        "key": {
+          "$ref": "#/definitions/SingleRef"
+        },
+        "propertyHistory": {
+          "description": "An array with the history of the things.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ThingHistoryObject"
+          }
+        }
+      }
+    },
+    "ThingHistoryObject": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/ThingCreate"
+        },
+        {
+          "type": "object",
+          "properties": {
+            "creationTimeUnix": {
+              "description": "Timestamp of creation of this thing history in milliseconds since epoch UTC.",
+              "type": "integer",
+              "format": "int64"
+            }
+          }
+        }
+      ]
+    },
+    "ThingUpdate": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/Thing"
+        },
+        {
+          "type": "object"
+        }
+      ]
+    },
+    "ThingsBatchRequest": {
+      "description": "A batch of things to create or update. A cref to another thing in the same batch is made by setting its '$cref' to '#/things/{index}'.",
+      "type": "object",
+      "properties": {
+        "things": {
+          "description": "The things in this batch.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ThingBatchItem"
+          }
+        }
+      }
+    },
+    "ThingsBatchResponse": {
+      "description": "Results of a batch of things, in the same order as the request.",
+      "type": "object",
+      "properties": {
+        "things": {
+          "description": "The result of each thing in the batch.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ThingBatchResult"
+          }
+        }
+      }
+    },
+    "ThingsListResponse": {
+      "description": "List of things.",
+      "type": "object",
+      "properties": {
+        "things": {
+          "description": "The actual list of things.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ThingGetResponse"
+          }
+        },
+        "totalResults": {
+          "description": "The total number of things for the query. The number of items in a response may be smaller due to paging.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "VectorBasedQuestion": {
+      "description": "receive question based on array of classes, properties and values.",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "classProps": {
+            "description": "Vectorized properties.",
+            "type": "array",
+            "maxItems": 300,
+            "minItems": 300,
+            "items": {
+              "type": "object",
+              "properties": {
+                "propsVectors": {
+                  "type": "array",
+                  "items": {
+                    "type": "number",
+                    "format": "float"
+                  }
+                },
+                "value": {
+                  "description": "String with valuename.",
+                  "type": "string"
+                }
+              }
+            }
+          },
+          "classVectors": {
+            "description": "Vectorized classname.",
+            "type": "array",
+            "maxItems": 300,
+            "minItems": 300,
+            "items": {
+              "type": "number",
+              "format": "float"
+            }
+          }
+        }
+      }
+    },
+    "VocabularyConversion": {
+      "description": "A vocabulary in JSON-LD, like schema.org or an RDFS or OWL ontology, to convert into a thing and action schema.",
+      "type": "object",
+      "required": [
+        "vocabulary"
+      ],
+      "properties": {
+        "actionClasses": {
+          "description": "The names of the classes that are actions, with the classes that extend them. Defaults to the Action of schema.org.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "classes": {
+          "description": "The names of the classes to convert, which are converted with the classes they extend. All classes are converted when there are none.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "vocabulary": {
+          "description": "The JSON-LD document of the vocabulary, with its classes and properties.",
+          "$ref": "#/definitions/JsonObject"
+        }
+      }
+    },
+    "VocabularyConversionResponse": {
+      "description": "The thing and action schema that is converted from a vocabulary.",
+      "type": "object",
+      "properties": {
+        "actions": {
+          "$ref": "#/definitions/SemanticSchema"
+        },
+        "things": {
+          "$ref": "#/definitions/SemanticSchema"
+        },
+        "warnings": {
+          "description": "What is converted differently than the vocabulary describes it, or is not converted at all, e.g. names that are not in the contextionary.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    }
+  },
+  "parameters": {
+    "CommonMaxResultsParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
+      "name": "maxResults",
+      "in": "query"
+    },
+    "CommonPageParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "description": "The page number of the items to be returned.",
+      "name": "page",
+      "in": "query"
+    }
+  },
+  "securityDefinitions": {
+    "apiKey": {
+      "type": "apiKey",
+      "name": "X-API-KEY",
+      "in": "header"
+    },
+    "apiToken": {
+      "type": "apiKey",
+      "name": "X-API-TOKEN",
+      "in": "header"
+    }
+  },
+  "security": [
+    {
+      "apiKey": [],
+      "apiToken": []
+    }
+  ],
+  "tags": [
+    {
+      "name": "actions"
+    },
+    {
+      "name": "backup"
+    },
+    {
+      "name": "contextionary-API"
+    },
+    {
+      "name": "graphql"
+    },
+    {
+      "name": "keys"
+    },
+    {
+      "name": "meta"
+    },
+    {
+      "name": "P2P"
+    },
+    {
+      "name": "schema"
+    },
+    {
+      "name": "things"
+    }
+  ],
+  "externalDocs": {
+    "url": "https://github.com/creativesoftwarefdn/weaviate"
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Decentralised Knowledge Graph",
+    "title": "Weaviate - Decentralised Knowledge Graph",
+    "contact": {
+      "name": "Weaviate",
+      "url": "https://github.com/creativesoftwarefdn",
+      "email": "hello@creativesoftwarefdn.org"
+    },
+    "version": "0.9.4"
+  },
+  "basePath": "/weaviate/v1",
+  "paths": {
+    "/actions": {
+      "post": {
+        "description": "Registers a new action. Given meta-data and schema values are validated.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Create actions between two things (object and subject).",
+        "operationId": "weaviate.actions.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "action": {
+                  "$ref": "#/definitions/ActionCreate"
+                },
+                "async": {
+                  "description": "If ` + "`" + `async` + "`" + ` is true, return a 202 with the new ID of the Action. You will receive this response before the data is persisted. If ` + "`" + `async` + "`" + ` is false, you will receive confirmation after the value is persisted. The value of ` + "`" + `async` + "`" + ` defaults to false.",
+                  "type": "boolean"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Action created",
+            "schema": {
+              "$ref": "#/definitions/ActionGetResponse"
+            }
+          },
+          "202": {
+            "description": "Successfully received. No guarantees are made that the Action is persisted.",
+            "schema": {
+              "$ref": "#/definitions/ActionGetResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "422": {
+            "description": "Request body contains well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The action could not be written to the database.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/actions/batch": {
+      "post": {
+        "description": "Registers or updates multiple actions at once. Every action is validated separately, the results are returned per action.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Create or update a batch of actions related to this key.",
+        "operationId": "weaviate.actions.batch.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ActionsBatchRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Batch handled, see the results per action.",
+            "schema": {
+              "$ref": "#/definitions/ActionsBatchResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "422": {
+            "description": "Request body contains well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/actions/validate": {
+      "post": {
+        "description": "Validate an action's schema and meta-data. It has to be based on a schema, which is related to the given action to be accepted by this validation.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Validate an action based on a schema.",
+        "operationId": "weaviate.actions.validate",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ActionValidate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful validated."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "422": {
+            "description": "Request body contains well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/actions/{actionId}": {
+      "get": {
+        "description": "Lists actions.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Get a specific action based on its uuid and a thing uuid related to this key. Also available as Websocket bus.",
+        "operationId": "weaviate.actions.get",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the action.",
+            "name": "actionId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ActionGetResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      },
+      "put": {
+        "description": "Updates an action's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Update an action based on its uuid related to this key.",
+        "operationId": "weaviate.action.update",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the action.",
+            "name": "actionId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ActionUpdate"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Successfully received.",
+            "schema": {
+              "$ref": "#/definitions/ActionGetResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request body contains well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The action could not be written to the database.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      },
+      "delete": {
+        "description": "Deletes an action from the system.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Delete an action based on its uuid related to this key.",
+        "operationId": "weaviate.actions.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the thing.",
+            "name": "actionId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successful deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "The action could not be written to the database.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true
+      },
+      "patch": {
+        "description": "Updates an action. This method supports patch semantics. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Update an action based on its uuid (using patch semantics) related to this key.",
+        "operationId": "weaviate.actions.patch",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the action.",
+            "name": "actionId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "JSONPatch document as defined by RFC 6902.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/PatchDocument"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Successfully received.",
+            "schema": {
+              "$ref": "#/definitions/ActionGetResponse"
+            }
+          },
+          "400": {
+            "description": "The patch-JSON is malformed."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "The patch-JSON is valid but unprocessable.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The action could not be written to the database.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/actions/{actionId}/history": {
+      "get": {
+        "description": "Returns a particular action history.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Get a action's history based on its uuid related to this key.",
+        "operationId": "weaviate.action.history.get",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the action.",
+            "name": "actionId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ActionGetHistoryResponse"
             }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "501": {
+            "description": "Not (yet) implemented."
           }
-        }
-      ]
-    },
-    "KeyChildrenGetResponse": {
-      "properties": {
-        "children": {
-          "$ref": "#/definitions/MultipleRef"
-        }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
       }
     },
-    "KeyCreate": {
-      "properties": {
-        "delete": {
-          "description": "Is user allowed to delete.",
-          "type": "boolean"
-        },
-        "email": {
-          "description": "Email associated with this account.",
-          "type": "string"
-        },
-        "execute": {
-          "description": "Is user allowed to execute.",
-          "type": "boolean"
-        },
-        "ipOrigin": {
-          "description": "Origin of the IP using CIDR notation.",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/c11y/classes/{className}/neighbours": {
+      "get": {
+        "description": "Returns the nearest words to the centroid of a thing or action class, which is computed from its keywords or the words of its name.",
+        "tags": [
+          "contextionary-API"
+        ],
+        "summary": "Get the nearest neighbours of a class.",
+        "operationId": "weaviate.c11y.classes.neighbours",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the thing or action class.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 10,
+            "description": "The number of neighbours.",
+            "name": "n",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The nearest neighbours of the class.",
+            "schema": {
+              "$ref": "#/definitions/C11yNeighbours"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "404": {
+            "description": "The class is not in the schema."
           }
         },
-        "isRoot": {
-          "description": "Shows if key is root key",
-          "type": "boolean",
-          "default": false
-        },
-        "keyExpiresUnix": {
-          "description": "Time as Unix timestamp that the key expires. Set to 0 for never.",
-          "type": "integer",
-          "format": "int64"
-        },
-        "read": {
-          "description": "Is user allowed to read.",
-          "type": "boolean"
-        },
-        "write": {
-          "description": "Is user allowed to write.",
-          "type": "boolean"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
       }
     },
-    "KeyGetResponse": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/Key"
-        },
-        {
-          "properties": {
-            "keyId": {
-              "description": "Id of the key.",
-              "type": "string",
-              "format": "uuid"
+    "/c11y/distance": {
+      "post": {
+        "description": "Returns the distance between two words or phrases in the contextionary.",
+        "tags": [
+          "contextionary-API"
+        ],
+        "summary": "Get the distance between two words or phrases.",
+        "operationId": "weaviate.c11y.distance",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/C11yDistanceQuery"
             }
           }
-        }
-      ]
-    },
-    "KeyTokenGetResponse": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/KeyGetResponse"
-        },
-        {
-          "properties": {
-            "token": {
-              "description": "Key for user to use.",
-              "type": "string",
-              "format": "uuid"
+        ],
+        "responses": {
+          "200": {
+            "description": "The distance between the words or phrases.",
+            "schema": {
+              "$ref": "#/definitions/C11yDistance"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "422": {
+            "description": "A word of the phrases is not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      ]
-    },
-    "Meta": {
-      "description": "Contains meta information of the current Weaviate instance.",
-      "type": "object",
-      "properties": {
-        "actionsSchema": {
-          "$ref": "#/definitions/SemanticSchema"
-        },
-        "hostname": {
-          "description": "The url of the host",
-          "type": "string",
-          "format": "url"
         },
-        "thingsSchema": {
-          "$ref": "#/definitions/SemanticSchema"
-        }
-      }
-    },
-    "MultipleRef": {
-      "description": "Multiple instances of references to other objects.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/SingleRef"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
       }
     },
-    "PatchDocument": {
-      "description": "A JSONPatch document as defined by RFC 6902.",
-      "required": [
-        "op",
-        "path"
-      ],
-      "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
-          "type": "string"
-        },
-        "op": {
-          "description": "The operation to be performed.",
-          "type": "string",
-          "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
-          ]
-        },
-        "path": {
-          "description": "A JSON-Pointer.",
-          "type": "string"
+    "/c11y/words/{word}": {
+      "get": {
+        "description": "Returns whether a word is in the contextionary, and its vector.",
+        "tags": [
+          "contextionary-API"
+        ],
+        "summary": "Look up a word in the contextionary.",
+        "operationId": "weaviate.c11y.words.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The word to look up. Words are looked up in lower case, unless they are the centroid of a class or property like ` + "`" + `$THING[City]` + "`" + `.",
+            "name": "word",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The word, and its vector if it is present.",
+            "schema": {
+              "$ref": "#/definitions/C11yWord"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          }
         },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
       }
     },
-    "PeerUpdate": {
-      "description": "A single peer in the network",
-      "properties": {
-        "id": {
-          "description": "The session ID of the peer",
-          "type": "string",
-          "format": "uuid"
-        },
-        "name": {
-          "description": "Human readable name",
-          "type": "string"
+    "/c11y/words/{word}/neighbours": {
+      "get": {
+        "description": "Returns the nearest words to a word in the contextionary.",
+        "tags": [
+          "contextionary-API"
+        ],
+        "summary": "Get the nearest neighbours of a word.",
+        "operationId": "weaviate.c11y.words.neighbours",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The word to look up. Words are looked up in lower case, unless they are the centroid of a class or property like ` + "`" + `$THING[City]` + "`" + `.",
+            "name": "word",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 10,
+            "description": "The number of neighbours.",
+            "name": "n",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The nearest neighbours of the word.",
+            "schema": {
+              "$ref": "#/definitions/C11yNeighbours"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "404": {
+            "description": "The word is not in the contextionary."
+          }
         },
-        "uri": {
-          "description": "The location where the peer is exposed to the internet",
-          "type": "string",
-          "format": "uri"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
       }
     },
-    "PeerUpdateList": {
-      "description": "Lisf of known peers",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/PeerUpdate"
+    "/export": {
+      "get": {
+        "description": "Streams all keys, things and actions as newline-delimited JSON, one ExportRecord per line: first the keys, then the things, then the actions. References to records later in the stream are added at the end of an import. Only available for the root key.",
+        "tags": [
+          "backup"
+        ],
+        "summary": "Export the whole knowledge graph.",
+        "operationId": "weaviate.export",
+        "parameters": [
+          {
+            "type": "boolean",
+            "description": "Whether to include the keys and their hashed tokens in the export.",
+            "name": "includeKeys",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response, the body is a stream of newline-delimited ExportRecords."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
       }
     },
-    "Schema": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition",
-      "type": "object"
-    },
-    "SchemaHistory": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition",
-      "type": "object"
-    },
-    "SemanticSchema": {
-      "description": "Definitions of semantic schemas (also see: https://github.com/creativesoftwarefdn/weaviate-semantic-schemas)",
-      "type": "object",
-      "properties": {
-        "@context": {
-          "description": "URL of the context",
-          "type": "string",
-          "format": "uri"
-        },
-        "classes": {
-          "description": "Semantic classes that are available.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/SemanticSchemaClass"
+    "/graphql": {
+      "post": {
+        "description": "Get an object based on GraphQL",
+        "tags": [
+          "graphql"
+        ],
+        "summary": "Get a response based on GraphQL",
+        "operationId": "weaviate.graphql.post",
+        "parameters": [
+          {
+            "description": "The GraphQL query request parameters.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLQuery"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Succesful query (with select).",
+            "schema": {
+              "$ref": "#/definitions/GraphQLResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "422": {
+            "description": "Request body contains well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "maintainer": {
-          "description": "Email of the maintainer.",
-          "type": "string",
-          "format": "email"
-        },
-        "name": {
-          "description": "Name of the schema",
-          "type": "string"
-        },
-        "type": {
-          "description": "Type of schema, should be \"thing\" or \"action\".",
-          "type": "string",
-          "enum": [
-            "thing",
-            "action"
-          ]
-        },
-        "version": {
-          "description": "Version number of the schema in semver format.",
-          "type": "string"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
       }
     },
-    "SemanticSchemaClass": {
-      "type": "object",
-      "properties": {
-        "class": {
-          "description": "Name of the class as URI relative to the schema URL.",
-          "type": "string"
-        },
-        "description": {
-          "description": "Description of the class",
-          "type": "string"
-        },
-        "keywords": {
-          "description": "Describes the kind of class. For example Geolocation for the class City.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "kind": {
-                "type": "string"
-              },
-              "weight": {
-                "type": "number",
-                "format": "float"
-              }
+    "/health": {
+      "get": {
+        "security": [],
+        "description": "Checks whether the database can be reached and answers queries, and gives the status of the circuit breaker that protects it. Does not need an API key, so that load balancers can use it.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "meta"
+        ],
+        "summary": "Check the health of this Weaviate instance.",
+        "operationId": "weaviate.health",
+        "responses": {
+          "200": {
+            "description": "The instance is healthy or degraded.",
+            "schema": {
+              "$ref": "#/definitions/HealthResponse"
+            }
+          },
+          "503": {
+            "description": "The database is unavailable.",
+            "schema": {
+              "$ref": "#/definitions/HealthResponse"
             }
           }
         },
-        "properties": {
-          "description": "The properties of the class.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/SemanticSchemaClassProperty"
-          }
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
       }
     },
-    "SemanticSchemaClassProperty": {
-      "type": "object",
-      "properties": {
-        "@dataType": {
-          "description": "Can be a reference ($cref) to another type when starts with a capital (for example Person) otherwise \"string\" or \"int\".",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/import": {
+      "post": {
+        "description": "Imports a stream of newline-delimited ExportRecords, as produced by the export, into the database. The stream is sent as the body, with the application/json content type. UUIDs, creation times, key ownership and cross-references are preserved. Only available for the root key.",
+        "tags": [
+          "backup"
+        ],
+        "summary": "Import a knowledge graph.",
+        "operationId": "weaviate.import",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The number of records at the start of the stream that are already imported and should be skipped.",
+            "name": "checkpoint",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "All records are imported.",
+            "schema": {
+              "$ref": "#/definitions/ImportResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "422": {
+            "description": "A record could not be imported, the checkpoint tells where to resume.",
+            "schema": {
+              "$ref": "#/definitions/ImportResponse"
+            }
           }
         },
-        "description": {
-          "description": "Description of the property",
-          "type": "string"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/keys": {
+      "post": {
+        "description": "Creates a new key. Input expiration date is validated on being in the future and not longer than parent expiration date.",
+        "tags": [
+          "keys"
+        ],
+        "summary": "Create a new key related to this key.",
+        "operationId": "weaviate.key.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/KeyCreate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully created.",
+            "schema": {
+              "$ref": "#/definitions/KeyTokenGetResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "422": {
+            "description": "Request body contains well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "keywords": {
-          "description": "Describes the kind of class. For example Geolocation for the class City.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "kind": {
-                "type": "string"
-              },
-              "weight": {
-                "type": "number",
-                "format": "float"
-              }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/keys/me": {
+      "get": {
+        "description": "Get the key-information of the key used.",
+        "tags": [
+          "keys"
+        ],
+        "summary": "Get a key based on the key used to do the request.",
+        "operationId": "weaviate.keys.me.get",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/KeyGetResponse"
             }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
           }
         },
-        "name": {
-          "description": "Name of the property as URI relative to the schema URL.",
-          "type": "string"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
       }
     },
-    "SingleRef": {
-      "properties": {
-        "$cref": {
-          "description": "Location of the cross reference.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "locationUrl": {
-          "description": "url of location. http://localhost means this database. This option can be used to refer to other databases.",
-          "type": "string",
-          "format": "url",
-          "default": "http://localhost/"
+    "/keys/me/children": {
+      "get": {
+        "description": "Get children of used key, only one step deep. A child can have children of its own.",
+        "tags": [
+          "keys"
+        ],
+        "summary": "Get an object of this keys' children related to the key used for request.",
+        "operationId": "weaviate.keys.me.children.get",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/KeyChildrenGetResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          }
         },
-        "type": {
-          "description": "Type should be Thing, Action or Key",
-          "type": "string",
-          "enum": [
-            "Thing",
-            "Action",
-            "Key"
-          ]
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
       }
     },
-    "Thing": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/ThingCreate"
-        },
-        {
-          "type": "object",
-          "properties": {
-            "creationTimeUnix": {
-              "description": "Timestamp of creation of this thing in milliseconds since epoch UTC.",
-              "type": "integer",
-              "format": "int64"
-            },
-            "key": {
-              "$ref": "#/definitions/SingleRef"
-            },
-            "lastUpdateTimeUnix": {
-              "description": "Timestamp of the last thing update in milliseconds since epoch UTC.",
-              "type": "integer",
-              "format": "int64"
+    "/keys/{keyId}": {
+      "get": {
+        "description": "Get a key.",
+        "tags": [
+          "keys"
+        ],
+        "summary": "Get a key based on its uuid related to this key.",
+        "operationId": "weaviate.keys.get",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the key.",
+            "name": "keyId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/KeyGetResponse"
             }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
           }
-        }
-      ]
-    },
-    "ThingCreate": {
-      "type": "object",
-      "properties": {
-        "@class": {
-          "description": "Class of the Thing, defined in the schema.",
-          "type": "string"
         },
-        "@context": {
-          "description": "Available context schema.",
-          "type": "string"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      },
+      "delete": {
+        "description": "Deletes a key. Only parent or self is allowed to delete key. When you delete a key, all its children will be deleted as well.",
+        "tags": [
+          "keys"
+        ],
+        "summary": "Delete a key based on its uuid related to this key.",
+        "operationId": "weaviate.keys.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the key.",
+            "name": "keyId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successful deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          }
         },
-        "schema": {
-          "$ref": "#/definitions/Schema"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
       }
     },
-    "ThingGetHistoryResponse": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/ThingHistory"
-        },
-        {
-          "type": "object",
-          "properties": {
-            "thingId": {
-              "type": "string",
-              "format": "uuid"
+    "/keys/{keyId}/children": {
+      "get": {
+        "description": "Get children of a key, only one step deep. A child can have children of its own.",
+        "tags": [
+          "keys"
+        ],
+        "summary": "Get an object of this keys' children related to this key.",
+        "operationId": "weaviate.keys.children.get",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the key.",
+            "name": "keyId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/KeyChildrenGetResponse"
             }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
           }
-        }
-      ]
-    },
-    "ThingGetResponse": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/Thing"
         },
-        {
-          "type": "object",
-          "properties": {
-            "thingId": {
-              "type": "string",
-              "format": "uuid"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/keys/{keyId}/renew-token": {
+      "put": {
+        "description": "Renews the related key. Validates being lower in tree than given key. Can not renew itself, unless being parent.",
+        "tags": [
+          "keys"
+        ],
+        "summary": "Renews a key based on the key given in the query string.",
+        "operationId": "weaviate.keys.renew.token",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the key.",
+            "name": "keyId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/KeyTokenGetResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request body contains well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      ]
-    },
-    "ThingHistory": {
-      "type": "object",
-      "properties": {
-        "deleted": {
-          "description": "Indication whether the action is deleted",
-          "type": "boolean"
-        },
-        "key": {
-          "$ref": "#/definitions/SingleRef"
         },
-        "propertyHistory": {
-          "description": "An array with the history of the things.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ThingHistoryObject"
-          }
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
       }
     },
-    "ThingHistoryObject": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/ThingCreate"
-        },
-        {
-          "type": "object",
-          "properties": {
-            "creationTimeUnix": {
-              "description": "Timestamp of creation of this thing history in milliseconds since epoch UTC.",
-              "type": "integer",
-              "format": "int64"
+    "/meta": {
+      "get": {
+        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "meta"
+        ],
+        "summary": "Returns meta information of the current Weaviate instance.",
+        "operationId": "weaviate.meta.get",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Meta"
             }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
           }
-        }
-      ]
-    },
-    "ThingUpdate": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/Thing"
         },
-        {
-          "type": "object"
-        }
-      ]
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
     },
-    "ThingsListResponse": {
-      "description": "List of things.",
-      "type": "object",
-      "properties": {
-        "things": {
-          "description": "The actual list of things.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ThingGetResponse"
+    "/p2p/genesis": {
+      "put": {
+        "security": [],
+        "description": "Receive an update from the Genesis server.",
+        "tags": [
+          "P2P"
+        ],
+        "operationId": "weaviate.p2p.genesis_update",
+        "parameters": [
+          {
+            "name": "peers",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PeerUpdateList"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Alive and kicking!"
+          },
+          "401": {
+            "description": "Unauthorized update"
+          },
+          "500": {
+            "description": "Internal error"
           }
         },
-        "totalResults": {
-          "description": "The total number of things for the query. The number of items in a response may be smaller due to paging.",
-          "type": "integer",
-          "format": "int64"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
       }
     },
-    "VectorBasedQuestion": {
-      "description": "receive question based on array of classes, properties and values.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "classProps": {
-            "description": "Vectorized properties.",
-            "type": "array",
-            "maxItems": 300,
-            "minItems": 300,
-            "items": {
-              "type": "object",
-              "properties": {
-                "propsVectors": {
-                  "type": "array",
-                  "items": {
-                    "type": "number",
-                    "format": "float"
-                  }
-                },
-                "value": {
-                  "description": "String with valuename.",
-                  "type": "string"
-                }
-              }
-            }
+    "/p2p/health": {
+      "get": {
+        "security": [],
+        "description": "Check if a peer is alive and healthy",
+        "tags": [
+          "P2P"
+        ],
+        "summary": "Check if a peer is alive.",
+        "operationId": "weaviate.p2p.health",
+        "responses": {
+          "200": {
+            "description": "Alive and kicking!"
           },
-          "classVectors": {
-            "description": "Vectorized classname.",
-            "type": "array",
-            "maxItems": 300,
-            "minItems": 300,
-            "items": {
-              "type": "number",
-              "format": "float"
-            }
+          "500": {
+            "description": "Not healthy (yet)"
           }
-        }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
       }
-    }
-  },
-  "parameters": {
-    "CommonMaxResultsParameterQuery": {
-      "type": "integer",
-      "format": "int64",
-      "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
-      "name": "maxResults",
-      "in": "query"
-    },
-    "CommonPageParameterQuery": {
-      "type": "integer",
-      "format": "int64",
-      "description": "The page number of the items to be returned.",
-      "name": "page",
-      "in": "query"
-    }
-  },
-  "securityDefinitions": {
-    "apiKey": {
-      "type": "apiKey",
-      "name": "X-API-KEY",
-      "in": "header"
-    },
-    "apiToken": {
-      "type": "apiKey",
-      "name": "X-API-TOKEN",
-      "in": "header"
-    }
-  },
-  "security": [
-    {
-      "apiKey": [],
-      "apiToken": []
-    }
-  ],
-  "tags": [
-    {
-      "name": "actions"
-    },
-    {
-      "name": "graphql"
-    },
-    {
-      "name": "keys"
-    },
-    {
-      "name": "meta"
-    },
-    {
-      "name": "P2P"
     },
-    {
-      "name": "things"
-    }
-  ],
-  "externalDocs": {
-    "url": "https://github.com/creativesoftwarefdn/weaviate"
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Decentralised Knowledge Graph",
-    "title": "Weaviate - Decentralised Knowledge Graph",
-    "contact": {
-      "name": "Weaviate",
-      "url": "https://github.com/creativesoftwarefdn",
-      "email": "hello@creativesoftwarefdn.org"
-    },
-    "version": "0.9.4"
-  },
-  "basePath": "/weaviate/v1",
-  "paths": {
-    "/actions": {
+    "/schema/actions/classes": {
       "post": {
-        "description": "Registers a new action. Given meta-data and schema values are validated.",
+        "description": "Adds a action class with its properties to the schema. The names of the class and its properties must be in the contextionary, or the class must have keywords. Only available for the root key.",
         "tags": [
-          "actions"
+          "schema"
         ],
-        "summary": "Create actions between two things (object and subject).",
-        "operationId": "weaviate.actions.create",
+        "summary": "Add a action class to the schema.",
+        "operationId": "weaviate.schema.actions.classes.create",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "properties": {
-                "action": {
-                  "$ref": "#/definitions/ActionCreate"
-                },
-                "async": {
-                  "description": "If ` + "`" + `async` + "`" + ` is true, return a 202 with the new ID of the Action. You will receive this response before the data is persisted. If ` + "`" + `async` + "`" + ` is false, you will receive confirmation after the value is persisted. The value of ` + "`" + `async` + "`" + ` defaults to false.",
-                  "type": "boolean"
-                }
-              }
+              "$ref": "#/definitions/SemanticSchemaClass"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Action created",
-            "schema": {
-              "$ref": "#/definitions/ActionGetResponse"
-            }
-          },
-          "202": {
-            "description": "Successfully received. No guarantees are made that the Action is persisted.",
+            "description": "The schema is changed.",
             "schema": {
-              "$ref": "#/definitions/ActionGetResponse"
+              "$ref": "#/definitions/SemanticSchemaClass"
             }
           },
           "401": {
@@ -2003,8 +5178,26 @@ func init() {
           "403": {
             "description": "The used API-key has insufficient permissions."
           },
+          "404": {
+            "description": "The class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The change conflicts with the schema, or would make existing data invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body contains well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "The changed schema is not valid, or its names are not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The changed schema could not be saved or applied.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2014,27 +5207,37 @@ func init() {
         "x-available-in-websocket": false
       }
     },
-    "/actions/validate": {
-      "post": {
-        "description": "Validate an action's schema and meta-data. It has to be based on a schema, which is related to the given action to be accepted by this validation.",
+    "/schema/actions/classes/{className}": {
+      "put": {
+        "description": "Replaces a action class in the schema. When the class is renamed, the references to it are renamed as well. Renaming the class, deleting its properties or changing their data type is refused when there are actions that would become invalid. Only available for the root key.",
         "tags": [
-          "actions"
+          "schema"
         ],
-        "summary": "Validate an action based on a schema.",
-        "operationId": "weaviate.actions.validate",
+        "summary": "Update a action class in the schema.",
+        "operationId": "weaviate.schema.actions.classes.update",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the class.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ActionValidate"
+              "$ref": "#/definitions/SemanticSchemaClass"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful validated."
+            "description": "The schema is changed.",
+            "schema": {
+              "$ref": "#/definitions/SemanticSchemaClass"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2042,8 +5245,26 @@ func init() {
           "403": {
             "description": "The used API-key has insufficient permissions."
           },
+          "404": {
+            "description": "The class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The change conflicts with the schema, or would make existing data invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body contains well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "The changed schema is not valid, or its names are not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The changed schema could not be saved or applied.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2051,32 +5272,26 @@ func init() {
         },
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false
-      }
-    },
-    "/actions/{actionId}": {
-      "get": {
-        "description": "Lists actions.",
+      },
+      "delete": {
+        "description": "Deletes a action class from the schema. A class that has actions, or that other classes refer to, can't be deleted. Only available for the root key.",
         "tags": [
-          "actions"
+          "schema"
         ],
-        "summary": "Get a specific action based on its uuid and a thing uuid related to this key. Also available as Websocket bus.",
-        "operationId": "weaviate.actions.get",
+        "summary": "Delete a action class from the schema.",
+        "operationId": "weaviate.schema.actions.classes.delete",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the action.",
-            "name": "actionId",
+            "description": "The name of the class.",
+            "name": "className",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/ActionGetResponse"
-            }
+          "204": {
+            "description": "The schema is changed."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2085,25 +5300,47 @@ func init() {
             "description": "The used API-key has insufficient permissions."
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "The class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The change conflicts with the schema, or would make existing data invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The changed schema is not valid, or its names are not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The changed schema could not be saved or applied.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false
-      },
-      "put": {
-        "description": "Updates an action's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      }
+    },
+    "/schema/actions/classes/{className}/properties": {
+      "post": {
+        "description": "Adds a property to a action class in the schema. Only available for the root key.",
         "tags": [
-          "actions"
+          "schema"
         ],
-        "summary": "Update an action based on its uuid related to this key.",
-        "operationId": "weaviate.action.update",
+        "summary": "Add a property to a action class.",
+        "operationId": "weaviate.schema.actions.properties.create",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the action.",
-            "name": "actionId",
+            "description": "The name of the class.",
+            "name": "className",
             "in": "path",
             "required": true
           },
@@ -2112,15 +5349,15 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ActionUpdate"
+              "$ref": "#/definitions/SemanticSchemaClassProperty"
             }
           }
         ],
         "responses": {
-          "202": {
-            "description": "Successfully received.",
+          "200": {
+            "description": "The schema is changed.",
             "schema": {
-              "$ref": "#/definitions/ActionGetResponse"
+              "$ref": "#/definitions/SemanticSchemaClassProperty"
             }
           },
           "401": {
@@ -2130,10 +5367,25 @@ func init() {
             "description": "The used API-key has insufficient permissions."
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "The class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The change conflicts with the schema, or would make existing data invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Request body contains well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "The changed schema is not valid, or its names are not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The changed schema could not be saved or applied.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2141,27 +5393,46 @@ func init() {
         },
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false
-      },
-      "delete": {
-        "description": "Deletes an action from the system.",
+      }
+    },
+    "/schema/actions/classes/{className}/properties/{propertyName}": {
+      "put": {
+        "description": "Replaces a property of a action class in the schema. Renaming the property or changing its data type is refused when there are actions with a value for the property. Only available for the root key.",
         "tags": [
-          "actions"
+          "schema"
         ],
-        "summary": "Delete an action based on its uuid related to this key.",
-        "operationId": "weaviate.actions.delete",
+        "summary": "Update a property of a action class.",
+        "operationId": "weaviate.schema.actions.properties.update",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the thing.",
-            "name": "actionId",
+            "description": "The name of the class.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the property.",
+            "name": "propertyName",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SemanticSchemaClassProperty"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successful deleted."
+          "200": {
+            "description": "The schema is changed.",
+            "schema": {
+              "$ref": "#/definitions/SemanticSchemaClassProperty"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2170,50 +5441,59 @@ func init() {
             "description": "The used API-key has insufficient permissions."
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "The class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The change conflicts with the schema, or would make existing data invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The changed schema is not valid, or its names are not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The changed schema could not be saved or applied.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
       },
-      "patch": {
-        "description": "Updates an action. This method supports patch semantics. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      "delete": {
+        "description": "Deletes a property from a action class in the schema. This is refused when there are actions with a value for the property. Only available for the root key.",
         "tags": [
-          "actions"
+          "schema"
         ],
-        "summary": "Update an action based on its uuid (using patch semantics) related to this key.",
-        "operationId": "weaviate.actions.patch",
+        "summary": "Delete a property from a action class.",
+        "operationId": "weaviate.schema.actions.properties.delete",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the action.",
-            "name": "actionId",
+            "description": "The name of the class.",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "description": "JSONPatch document as defined by RFC 6902.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/PatchDocument"
-              }
-            }
+            "type": "string",
+            "description": "The name of the property.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "202": {
-            "description": "Successfully received.",
-            "schema": {
-              "$ref": "#/definitions/ActionGetResponse"
-            }
-          },
-          "400": {
-            "description": "The patch-JSON is malformed."
+          "204": {
+            "description": "The schema is changed."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2222,10 +5502,25 @@ func init() {
             "description": "The used API-key has insufficient permissions."
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "The class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The change conflicts with the schema, or would make existing data invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "The changed schema is not valid, or its names are not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The changed schema could not be saved or applied.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2235,29 +5530,59 @@ func init() {
         "x-available-in-websocket": false
       }
     },
-    "/actions/{actionId}/history": {
+    "/schema/export": {
       "get": {
-        "description": "Returns a particular action history.",
+        "description": "Exports the schema of the things and actions, to generate typed models from: as a GraphQL SDL with the types of the GraphQL endpoint, as JSON Schema documents for the schema of every class, or as OpenAPI definitions.",
         "tags": [
-          "actions"
+          "schema"
         ],
-        "summary": "Get a action's history based on its uuid related to this key.",
-        "operationId": "weaviate.action.history.get",
+        "summary": "Export the schema.",
+        "operationId": "weaviate.schema.export",
         "parameters": [
           {
+            "enum": [
+              "graphql",
+              "jsonschema",
+              "openapi"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the action.",
-            "name": "actionId",
-            "in": "path",
+            "description": "The format of the export.",
+            "name": "format",
+            "in": "query",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "The exported schema.",
+            "schema": {
+              "$ref": "#/definitions/SchemaExport"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/schema/history": {
+      "get": {
+        "description": "Lists the changes of the schema that were made at runtime, with their version, the key that made them and their differences.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the history of the schema.",
+        "operationId": "weaviate.schema.history",
+        "responses": {
+          "200": {
+            "description": "The changes of the schema.",
             "schema": {
-              "$ref": "#/definitions/ActionGetHistoryResponse"
+              "$ref": "#/definitions/SchemaHistory"
             }
           },
           "401": {
@@ -2265,42 +5590,35 @@ func init() {
           },
           "403": {
             "description": "The used API-key has insufficient permissions."
-          },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
-          "501": {
-            "description": "Not (yet) implemented."
           }
         },
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false
       }
     },
-    "/graphql": {
+    "/schema/migrations": {
       "post": {
-        "description": "Get an object based on GraphQL",
+        "description": "Renames classes and properties, or splits properties, in the schema and in the existing things and actions. With dryRun, only the changes are reported. Only available for the root key.",
         "tags": [
-          "graphql"
+          "schema"
         ],
-        "summary": "Get a response based on GraphQL",
-        "operationId": "weaviate.graphql.post",
+        "summary": "Migrate the schema and the existing data.",
+        "operationId": "weaviate.schema.migrate",
         "parameters": [
           {
-            "description": "The GraphQL query request parameters.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/GraphQLQuery"
+              "$ref": "#/definitions/SchemaMigration"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Succesful query (with select).",
+            "description": "The migration is applied, or the report of the dry run.",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponse"
+              "$ref": "#/definitions/SchemaMigrationResponse"
             }
           },
           "401": {
@@ -2309,8 +5627,26 @@ func init() {
           "403": {
             "description": "The used API-key has insufficient permissions."
           },
+          "404": {
+            "description": "A class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The migration conflicts with the schema.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body contains well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "The migration is not valid, or the changed schema is not valid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The migration could not be applied.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2320,36 +5656,57 @@ func init() {
         "x-available-in-websocket": false
       }
     },
-    "/keys": {
+    "/schema/things/classes": {
       "post": {
-        "description": "Creates a new key. Input expiration date is validated on being in the future and not longer than parent expiration date.",
+        "description": "Adds a thing class with its properties to the schema. The names of the class and its properties must be in the contextionary, or the class must have keywords. Only available for the root key.",
         "tags": [
-          "keys"
+          "schema"
         ],
-        "summary": "Create a new key related to this key.",
-        "operationId": "weaviate.key.create",
+        "summary": "Add a thing class to the schema.",
+        "operationId": "weaviate.schema.things.classes.create",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/KeyCreate"
+              "$ref": "#/definitions/SemanticSchemaClass"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully created.",
+            "description": "The schema is changed.",
             "schema": {
-              "$ref": "#/definitions/KeyTokenGetResponse"
+              "$ref": "#/definitions/SemanticSchemaClass"
             }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
+          "404": {
+            "description": "The class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The change conflicts with the schema, or would make existing data invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body contains well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "The changed schema is not valid, or its names are not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The changed schema could not be saved or applied.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2359,82 +5716,91 @@ func init() {
         "x-available-in-websocket": false
       }
     },
-    "/keys/me": {
-      "get": {
-        "description": "Get the key-information of the key used.",
+    "/schema/things/classes/{className}": {
+      "put": {
+        "description": "Replaces a thing class in the schema. When the class is renamed, the references to it are renamed as well. Renaming the class, deleting its properties or changing their data type is refused when there are things that would become invalid. Only available for the root key.",
         "tags": [
-          "keys"
+          "schema"
         ],
-        "summary": "Get a key based on the key used to do the request.",
-        "operationId": "weaviate.keys.me.get",
-        "responses": {
-          "200": {
-            "description": "Successful response.",
+        "summary": "Update a thing class in the schema.",
+        "operationId": "weaviate.schema.things.classes.update",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the class.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/KeyGetResponse"
+              "$ref": "#/definitions/SemanticSchemaClass"
             }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "404": {
-            "description": "Successful query result but no resource was found."
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false
-      }
-    },
-    "/keys/me/children": {
-      "get": {
-        "description": "Get children of used key, only one step deep. A child can have children of its own.",
-        "tags": [
-          "keys"
         ],
-        "summary": "Get an object of this keys' children related to the key used for request.",
-        "operationId": "weaviate.keys.me.children.get",
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "The schema is changed.",
             "schema": {
-              "$ref": "#/definitions/KeyChildrenGetResponse"
+              "$ref": "#/definitions/SemanticSchemaClass"
             }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "The class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The change conflicts with the schema, or would make existing data invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The changed schema is not valid, or its names are not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The changed schema could not be saved or applied.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false
-      }
-    },
-    "/keys/{keyId}": {
-      "get": {
-        "description": "Get a key.",
+      },
+      "delete": {
+        "description": "Deletes a thing class from the schema. A class that has things, or that other classes refer to, can't be deleted. Only available for the root key.",
         "tags": [
-          "keys"
+          "schema"
         ],
-        "summary": "Get a key based on its uuid related to this key.",
-        "operationId": "weaviate.keys.get",
+        "summary": "Delete a thing class from the schema.",
+        "operationId": "weaviate.schema.things.classes.delete",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the key.",
-            "name": "keyId",
+            "description": "The name of the class.",
+            "name": "className",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/KeyGetResponse"
-            }
+          "204": {
+            "description": "The schema is changed."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2443,32 +5809,65 @@ func init() {
             "description": "The used API-key has insufficient permissions."
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "The class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The change conflicts with the schema, or would make existing data invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The changed schema is not valid, or its names are not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The changed schema could not be saved or applied.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false
-      },
-      "delete": {
-        "description": "Deletes a key. Only parent or self is allowed to delete key. When you delete a key, all its children will be deleted as well.",
+      }
+    },
+    "/schema/things/classes/{className}/properties": {
+      "post": {
+        "description": "Adds a property to a thing class in the schema. Only available for the root key.",
         "tags": [
-          "keys"
+          "schema"
         ],
-        "summary": "Delete a key based on its uuid related to this key.",
-        "operationId": "weaviate.keys.delete",
+        "summary": "Add a property to a thing class.",
+        "operationId": "weaviate.schema.things.properties.create",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the key.",
-            "name": "keyId",
+            "description": "The name of the class.",
+            "name": "className",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SemanticSchemaClassProperty"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successful deleted."
+          "200": {
+            "description": "The schema is changed.",
+            "schema": {
+              "$ref": "#/definitions/SemanticSchemaClassProperty"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2477,36 +5876,71 @@ func init() {
             "description": "The used API-key has insufficient permissions."
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "The class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The change conflicts with the schema, or would make existing data invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The changed schema is not valid, or its names are not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The changed schema could not be saved or applied.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false
       }
     },
-    "/keys/{keyId}/children": {
-      "get": {
-        "description": "Get children of a key, only one step deep. A child can have children of its own.",
+    "/schema/things/classes/{className}/properties/{propertyName}": {
+      "put": {
+        "description": "Replaces a property of a thing class in the schema. Renaming the property or changing its data type is refused when there are things with a value for the property. Only available for the root key.",
         "tags": [
-          "keys"
+          "schema"
         ],
-        "summary": "Get an object of this keys' children related to this key.",
-        "operationId": "weaviate.keys.children.get",
+        "summary": "Update a property of a thing class.",
+        "operationId": "weaviate.schema.things.properties.update",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the key.",
-            "name": "keyId",
+            "description": "The name of the class.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the property.",
+            "name": "propertyName",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SemanticSchemaClassProperty"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "The schema is changed.",
             "schema": {
-              "$ref": "#/definitions/KeyChildrenGetResponse"
+              "$ref": "#/definitions/SemanticSchemaClassProperty"
             }
           },
           "401": {
@@ -2516,37 +5950,59 @@ func init() {
             "description": "The used API-key has insufficient permissions."
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "The class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The change conflicts with the schema, or would make existing data invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The changed schema is not valid, or its names are not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The changed schema could not be saved or applied.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false
-      }
-    },
-    "/keys/{keyId}/renew-token": {
-      "put": {
-        "description": "Renews the related key. Validates being lower in tree than given key. Can not renew itself, unless being parent.",
+      },
+      "delete": {
+        "description": "Deletes a property from a thing class in the schema. This is refused when there are things with a value for the property. Only available for the root key.",
         "tags": [
-          "keys"
+          "schema"
         ],
-        "summary": "Renews a key based on the key given in the query string.",
-        "operationId": "weaviate.keys.renew.token",
+        "summary": "Delete a property from a thing class.",
+        "operationId": "weaviate.schema.things.properties.delete",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the key.",
-            "name": "keyId",
+            "description": "The name of the class.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the property.",
+            "name": "propertyName",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/KeyTokenGetResponse"
-            }
+          "204": {
+            "description": "The schema is changed."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2555,10 +6011,25 @@ func init() {
             "description": "The used API-key has insufficient permissions."
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "The class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The change conflicts with the schema, or would make existing data invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Request body contains well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "The changed schema is not valid, or its names are not in the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The changed schema could not be saved or applied.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2568,80 +6039,77 @@ func init() {
         "x-available-in-websocket": false
       }
     },
-    "/meta": {
-      "get": {
-        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
-        "produces": [
-          "application/json"
-        ],
+    "/schema/validate": {
+      "post": {
+        "description": "Checks the names and keywords of all classes and properties against the contextionary, and reports all problems at once. Words that are not in the contextionary come with suggestions of known words that are spelled alike or near the other words, and classes that are nearly the same in the contextionary are warned about. Without a body the current schema is validated. The schema is not changed.",
         "tags": [
-          "meta"
+          "schema"
+        ],
+        "summary": "Validate the schema against the contextionary.",
+        "operationId": "weaviate.schema.validate",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/SchemaValidation"
+            }
+          }
         ],
-        "summary": "Returns meta information of the current Weaviate instance.",
-        "operationId": "weaviate.meta.get",
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "The problems of the schema.",
             "schema": {
-              "$ref": "#/definitions/Meta"
+              "$ref": "#/definitions/SchemaValidationResponse"
             }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
           }
         },
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false
       }
     },
-    "/p2p/genesis": {
-      "put": {
-        "security": [],
-        "description": "Receive an update from the Genesis server.",
+    "/schema/vocabulary": {
+      "post": {
+        "description": "Converts the classes and properties of a vocabulary in JSON-LD, like schema.org or an RDFS or OWL ontology, into a thing and action schema. Classes extend their parent classes, the ranges of properties become their data types and the labels become keywords. The names and keywords are checked against the contextionary. The schema is not changed.",
         "tags": [
-          "P2P"
+          "schema"
         ],
-        "operationId": "weaviate.p2p.genesis_update",
+        "summary": "Convert a vocabulary into a schema.",
+        "operationId": "weaviate.schema.vocabulary.convert",
         "parameters": [
           {
-            "name": "peers",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/PeerUpdateList"
+              "$ref": "#/definitions/VocabularyConversion"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Alive and kicking!"
+            "description": "The converted schema.",
+            "schema": {
+              "$ref": "#/definitions/VocabularyConversionResponse"
+            }
           },
           "401": {
-            "description": "Unauthorized update"
+            "description": "Unauthorized or invalid credentials."
           },
-          "500": {
-            "description": "Internal error"
-          }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false
-      }
-    },
-    "/p2p/health": {
-      "get": {
-        "security": [],
-        "description": "Check if a peer is alive and healthy",
-        "tags": [
-          "P2P"
-        ],
-        "summary": "Check if a peer is alive.",
-        "operationId": "weaviate.p2p.health",
-        "responses": {
-          "200": {
-            "description": "Alive and kicking!"
+          "403": {
+            "description": "The used API-key has insufficient permissions."
           },
-          "500": {
-            "description": "Not healthy (yet)"
+          "422": {
+            "description": "The vocabulary can not be converted.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "x-available-in-mqtt": false,
@@ -2670,6 +6138,22 @@ func init() {
             "description": "The page number of the items to be returned.",
             "name": "page",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only list the things of this class. The values of the where filters are converted to the data types of the properties of this class.",
+            "name": "class",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Only list the things whose schema matches all of the filters. A filter is a property, an operator and a value, e.g. 'founded\u003e=2018-01-01T00:00:00Z'. The operators are ':' or '=' for equal, '!=' for not equal, '\u003e', '\u003e=', '\u003c' and '\u003c='. Dates are given in RFC 3339.",
+            "name": "where",
+            "in": "query"
           }
         ],
         "responses": {
@@ -2687,6 +6171,12 @@ func init() {
           },
           "404": {
             "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "A where filter is invalid, e.g. because its property does not exist or its value does not fit the data type of the property.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "x-available-in-mqtt": false,
@@ -2737,6 +6227,54 @@ func init() {
           "403": {
             "description": "The used API-key has insufficient permissions."
           },
+          "422": {
+            "description": "Request body contains well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "The thing could not be written to the database.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/things/batch": {
+      "post": {
+        "description": "Registers or updates multiple things at once. Every thing is validated separately, the results are returned per thing.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Create or update a batch of things related to this key.",
+        "operationId": "weaviate.things.batch.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ThingsBatchRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Batch handled, see the results per thing.",
+            "schema": {
+              "$ref": "#/definitions/ThingsBatchResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "The used API-key has insufficient permissions."
+          },
           "422": {
             "description": "Request body contains well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
@@ -2871,6 +6409,12 @@ func init() {
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "500": {
+            "description": "The thing could not be written to the database.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "x-available-in-mqtt": false,
@@ -2905,6 +6449,12 @@ func init() {
           },
           "404": {
             "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "The thing could not be written to the database.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "x-available-in-mqtt": true,
@@ -2963,6 +6513,12 @@ func init() {
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "500": {
+            "description": "The thing could not be written to the database.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "x-available-in-mqtt": false,
@@ -3092,6 +6648,43 @@ func init() {
         }
       ]
     },
+    "ActionBatchItem": {
+      "description": "A single action in a batch. If ` + "`" + `actionId` + "`" + ` is given, the existing action is updated, otherwise a new action is created.",
+      "type": "object",
+      "properties": {
+        "action": {
+          "$ref": "#/definitions/ActionCreate"
+        },
+        "actionId": {
+          "description": "Unique ID of an existing action to update.",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "ActionBatchResult": {
+      "description": "The result of a single action in a batch.",
+      "type": "object",
+      "properties": {
+        "actionId": {
+          "description": "Unique ID of the action.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "errors": {
+          "$ref": "#/definitions/ErrorResponse"
+        },
+        "status": {
+          "description": "Whether the action was created, updated or failed.",
+          "type": "string",
+          "enum": [
+            "created",
+            "updated",
+            "failed"
+          ]
+        }
+      }
+    },
     "ActionCreate": {
       "type": "object",
       "properties": {
@@ -3175,41 +6768,190 @@ func init() {
             }
           }
         }
-      ]
+      ]
+    },
+    "ActionUpdate": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/Action"
+        },
+        {
+          "type": "object"
+        }
+      ]
+    },
+    "ActionValidate": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/ActionCreate"
+        }
+      ]
+    },
+    "ActionsBatchRequest": {
+      "description": "A batch of actions to create or update. A cref to another action in the same batch is made by setting its '$cref' to '#/actions/{index}'.",
+      "type": "object",
+      "properties": {
+        "actions": {
+          "description": "The actions in this batch.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ActionBatchItem"
+          }
+        }
+      }
+    },
+    "ActionsBatchResponse": {
+      "description": "Results of a batch of actions, in the same order as the request.",
+      "type": "object",
+      "properties": {
+        "actions": {
+          "description": "The result of each action in the batch.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ActionBatchResult"
+          }
+        }
+      }
+    },
+    "ActionsListResponse": {
+      "description": "List of actions for specific Thing.",
+      "type": "object",
+      "properties": {
+        "actions": {
+          "description": "The actual list of actions.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ActionGetResponse"
+          }
+        },
+        "totalResults": {
+          "description": "The total number of actions for the query. The number of items in a response may be smaller due to paging.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "C11yDistance": {
+      "description": "The distance between two words or phrases in the contextionary.",
+      "type": "object",
+      "properties": {
+        "a": {
+          "description": "The first word or phrase.",
+          "type": "string"
+        },
+        "b": {
+          "description": "The second word or phrase.",
+          "type": "string"
+        },
+        "distance": {
+          "description": "The distance between the vectors of the words or phrases.",
+          "type": "number",
+          "format": "float",
+          "x-omitempty": false
+        }
+      }
+    },
+    "C11yDistanceQuery": {
+      "description": "Two words or phrases to measure the distance between. The vector of a phrase is the centroid of its words.",
+      "type": "object",
+      "required": [
+        "a",
+        "b"
+      ],
+      "properties": {
+        "a": {
+          "description": "The first word or phrase.",
+          "type": "string"
+        },
+        "b": {
+          "description": "The second word or phrase.",
+          "type": "string"
+        }
+      }
     },
-    "ActionUpdate": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/Action"
-        },
-        {
-          "type": "object"
+    "C11yNeighbour": {
+      "description": "A word near another word in the contextionary.",
+      "type": "object",
+      "properties": {
+        "distance": {
+          "description": "The distance between the vectors of the words.",
+          "type": "number",
+          "format": "float",
+          "x-omitempty": false
+        },
+        "word": {
+          "description": "The nearby word, or centroid of a class or property.",
+          "type": "string"
         }
-      ]
+      }
     },
-    "ActionValidate": {
+    "C11yNeighbours": {
+      "description": "The nearest neighbours of a word, nearest first.",
       "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/ActionCreate"
+      "properties": {
+        "neighbours": {
+          "description": "The nearest words, without the word itself.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/C11yNeighbour"
+          }
+        },
+        "word": {
+          "description": "The word, or centroid of a class, whose neighbours these are.",
+          "type": "string"
         }
-      ]
+      }
     },
-    "ActionsListResponse": {
-      "description": "List of actions for specific Thing.",
+    "C11yWord": {
+      "description": "A word of the contextionary, or the centroid of a class or property like ` + "`" + `$THING[City]` + "`" + `.",
       "type": "object",
       "properties": {
-        "actions": {
-          "description": "The actual list of actions.",
+        "present": {
+          "description": "Whether the word is in the contextionary.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "vector": {
+          "description": "The vector of the word, if it is present.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/ActionGetResponse"
+            "type": "number",
+            "format": "float"
           }
         },
-        "totalResults": {
-          "description": "The total number of actions for the query. The number of items in a response may be smaller due to paging.",
+        "word": {
+          "description": "The word that is looked up.",
+          "type": "string"
+        }
+      }
+    },
+    "CircuitBreakerStatus": {
+      "description": "The status of the circuit breaker that protects the database. It opens when the database is unavailable for a number of queries in a row; while it is open, queries fail immediately.",
+      "type": "object",
+      "properties": {
+        "consecutiveFailures": {
+          "description": "The number of queries in a row that failed because the database was unavailable.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "lastError": {
+          "description": "The last error that showed that the database was unavailable.",
+          "type": "string"
+        },
+        "openedAtUnix": {
+          "description": "Timestamp of when the circuit breaker last opened, in milliseconds since epoch UTC. Not given if it never opened.",
           "type": "integer",
           "format": "int64"
+        },
+        "state": {
+          "description": "Closed when queries are sent to the database, open when they fail immediately, and halfOpen when a single query is sent to find out if the database is available again.",
+          "type": "string",
+          "enum": [
+            "closed",
+            "open",
+            "halfOpen"
+          ]
         }
       }
     },
@@ -3220,13 +6962,74 @@ func init() {
         "error": {
           "type": "object",
           "properties": {
+            "class": {
+              "description": "The class of the thing or action, when the error is about one of its properties.",
+              "type": "string"
+            },
+            "constraint": {
+              "description": "The constraint of the property that is violated, e.g. 'maximum' or 'unique'.",
+              "type": "string"
+            },
             "message": {
               "type": "string"
+            },
+            "property": {
+              "description": "The property that the error is about.",
+              "type": "string"
             }
           }
         }
       }
     },
+    "ExportRecord": {
+      "description": "A single line of an export stream. Keys come before the things and actions they own, things come before the actions that refer to them.",
+      "type": "object",
+      "properties": {
+        "action": {
+          "$ref": "#/definitions/Action"
+        },
+        "key": {
+          "$ref": "#/definitions/Key"
+        },
+        "thing": {
+          "$ref": "#/definitions/Thing"
+        },
+        "tokenHash": {
+          "description": "The hashed token of the key, only given for keys.",
+          "type": "string"
+        },
+        "type": {
+          "description": "Whether this record holds a key, a thing or an action.",
+          "type": "string",
+          "enum": [
+            "key",
+            "thing",
+            "action"
+          ]
+        },
+        "uuid": {
+          "description": "Unique ID of the key, thing or action.",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "GeoCoordinates": {
+      "description": "A location on earth, as the value of a geoCoordinates property.",
+      "type": "object",
+      "properties": {
+        "latitude": {
+          "description": "The latitude of the location, from -90 to 90 degrees.",
+          "type": "number",
+          "x-omitempty": false
+        },
+        "longitude": {
+          "description": "The longitude of the location, from -180 to 180 degrees.",
+          "type": "number",
+          "x-omitempty": false
+        }
+      }
+    },
     "GraphQLError": {
       "description": "Error messages responded only if error exists.",
       "properties": {
@@ -3294,6 +7097,61 @@ func init() {
         }
       }
     },
+    "HealthResponse": {
+      "description": "The health of this Weaviate instance and its database.",
+      "type": "object",
+      "properties": {
+        "circuitBreaker": {
+          "$ref": "#/definitions/CircuitBreakerStatus"
+        },
+        "database": {
+          "description": "The name of the database connector.",
+          "type": "string"
+        },
+        "message": {
+          "description": "Why the instance is not healthy.",
+          "type": "string"
+        },
+        "status": {
+          "description": "Healthy when the database answers queries, degraded when it is being checked after it was unavailable, and unhealthy when it is unavailable.",
+          "type": "string",
+          "enum": [
+            "healthy",
+            "degraded",
+            "unhealthy"
+          ]
+        }
+      }
+    },
+    "ImportResponse": {
+      "description": "The result of an import.",
+      "type": "object",
+      "properties": {
+        "actions": {
+          "description": "The number of imported actions.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "checkpoint": {
+          "description": "The number of records from the start of the stream that are imported. Resume a failed import by sending the same stream with this checkpoint.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "errors": {
+          "$ref": "#/definitions/ErrorResponse"
+        },
+        "keys": {
+          "description": "The number of imported keys.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "things": {
+          "description": "The number of imported things.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "JsonObject": {
       "description": "JSON object value.",
       "type": "object"
@@ -3390,98 +7248,378 @@ func init() {
             }
           }
         }
-      ]
+      ]
+    },
+    "Meta": {
+      "description": "Contains meta information of the current Weaviate instance.",
+      "type": "object",
+      "properties": {
+        "actionsSchema": {
+          "$ref": "#/definitions/SemanticSchema"
+        },
+        "hostname": {
+          "description": "The url of the host",
+          "type": "string",
+          "format": "url"
+        },
+        "thingsSchema": {
+          "$ref": "#/definitions/SemanticSchema"
+        }
+      }
+    },
+    "MultipleRef": {
+      "description": "Multiple instances of references to other objects.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/SingleRef"
+      }
+    },
+    "PatchDocument": {
+      "description": "A JSONPatch document as defined by RFC 6902.",
+      "required": [
+        "op",
+        "path"
+      ],
+      "properties": {
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
+        },
+        "op": {
+          "description": "The operation to be performed.",
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
+        },
+        "path": {
+          "description": "A JSON-Pointer.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
+        }
+      }
+    },
+    "PeerUpdate": {
+      "description": "A single peer in the network",
+      "properties": {
+        "id": {
+          "description": "The session ID of the peer",
+          "type": "string",
+          "format": "uuid"
+        },
+        "name": {
+          "description": "Human readable name",
+          "type": "string"
+        },
+        "uri": {
+          "description": "The location where the peer is exposed to the internet",
+          "type": "string",
+          "format": "uri"
+        }
+      }
+    },
+    "PeerUpdateList": {
+      "description": "Lisf of known peers",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/PeerUpdate"
+      }
+    },
+    "Schema": {
+      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition",
+      "type": "object"
+    },
+    "SchemaChange": {
+      "description": "A change of the schema that was made at runtime.",
+      "type": "object",
+      "properties": {
+        "creationTimeUnix": {
+          "description": "Timestamp of the change in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "diff": {
+          "description": "The differences with the previous version of the schema.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SchemaDiff"
+          }
+        },
+        "keyId": {
+          "description": "The key that made the change.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "version": {
+          "description": "The version of the schema after the change.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "SchemaDiff": {
+      "description": "A class or property that differs between two versions of the schema.",
+      "type": "object",
+      "properties": {
+        "new": {
+          "description": "The class or property after the change; a class without its properties, unless it is added.",
+          "type": "object"
+        },
+        "old": {
+          "description": "The class or property before the change; a class without its properties, unless it is removed.",
+          "type": "object"
+        },
+        "op": {
+          "description": "Whether the class or property is added, removed or replaced.",
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace"
+          ]
+        },
+        "path": {
+          "description": "The class or property, e.g. '/things/City' or '/things/City/population'.",
+          "type": "string"
+        }
+      }
+    },
+    "SchemaExport": {
+      "description": "The schema of the things and actions, in the requested format.",
+      "type": "object",
+      "properties": {
+        "format": {
+          "description": "The format of the export.",
+          "type": "string",
+          "enum": [
+            "graphql",
+            "jsonschema",
+            "openapi"
+          ]
+        },
+        "graphql": {
+          "description": "The GraphQL schema, in the schema definition language, with the types that the GraphQL endpoint generates for the classes.",
+          "type": "string"
+        },
+        "jsonSchema": {
+          "description": "A JSON Schema document for the schema of the things and actions of every class, as an object with ` + "`" + `things` + "`" + ` and ` + "`" + `actions` + "`" + ` that have the documents by class name.",
+          "$ref": "#/definitions/JsonObject"
+        },
+        "openapi": {
+          "description": "The OpenAPI definitions of the schema of the things and actions of every class, named ` + "`" + `\u003cClass\u003eSchema` + "`" + `, with the definitions that they refer to.",
+          "$ref": "#/definitions/JsonObject"
+        },
+        "version": {
+          "description": "The version of the schema that is exported.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
     },
-    "Meta": {
-      "description": "Contains meta information of the current Weaviate instance.",
+    "SchemaHistory": {
+      "description": "The changes of the schema that were made at runtime, oldest first.",
       "type": "object",
       "properties": {
-        "actionsSchema": {
-          "$ref": "#/definitions/SemanticSchema"
-        },
-        "hostname": {
-          "description": "The url of the host",
-          "type": "string",
-          "format": "url"
+        "changes": {
+          "description": "The changes, oldest first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SchemaChange"
+          }
         },
-        "thingsSchema": {
-          "$ref": "#/definitions/SemanticSchema"
+        "version": {
+          "description": "The current version of the schema; the schema files that weaviate started with are version 0.",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "MultipleRef": {
-      "description": "Multiple instances of references to other objects.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/SingleRef"
+    "SchemaMigration": {
+      "description": "Declarative changes of the schema, which are applied to the existing things and actions as well.",
+      "type": "object",
+      "required": [
+        "operations"
+      ],
+      "properties": {
+        "dryRun": {
+          "description": "Only report what the migration would change, without changing anything.",
+          "type": "boolean"
+        },
+        "operations": {
+          "description": "The operations, in the order in which they are applied.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SchemaMigrationOperation"
+          }
+        }
       }
     },
-    "PatchDocument": {
-      "description": "A JSONPatch document as defined by RFC 6902.",
+    "SchemaMigrationOperation": {
+      "description": "A single operation of a migration. renameClass renames the class to newName. renameProperty renames the property to newName. splitProperty splits the string values of the property at the separator into the properties of into, in order; the last one gets the rest of the value.",
+      "type": "object",
       "required": [
         "op",
-        "path"
+        "kind",
+        "className"
       ],
       "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
+        "className": {
+          "description": "The name of the class.",
+          "type": "string"
+        },
+        "into": {
+          "description": "The properties to split the property into, for splitProperty. Their data type must be string.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SemanticSchemaClassProperty"
+          }
+        },
+        "kind": {
+          "description": "Whether the class is a thing or an action class.",
+          "type": "string",
+          "enum": [
+            "thing",
+            "action"
+          ]
+        },
+        "newName": {
+          "description": "The new name of the class or property, for renameClass and renameProperty.",
           "type": "string"
         },
         "op": {
-          "description": "The operation to be performed.",
+          "description": "The kind of operation.",
           "type": "string",
           "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
+            "renameClass",
+            "renameProperty",
+            "splitProperty"
           ]
         },
-        "path": {
-          "description": "A JSON-Pointer.",
+        "propertyName": {
+          "description": "The name of the property, for renameProperty and splitProperty.",
           "type": "string"
         },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
+        "separator": {
+          "description": "Where to split the values, for splitProperty.",
+          "type": "string",
+          "default": " "
         }
       }
     },
-    "PeerUpdate": {
-      "description": "A single peer in the network",
+    "SchemaMigrationResponse": {
+      "description": "What a migration changed, or would change for a dry run.",
+      "type": "object",
       "properties": {
-        "id": {
-          "description": "The session ID of the peer",
+        "actions": {
+          "description": "The number of actions that are changed.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "diff": {
+          "description": "The differences with the previous version of the schema.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SchemaDiff"
+          }
+        },
+        "dryRun": {
+          "description": "Whether this was a dry run, so nothing is changed.",
+          "type": "boolean"
+        },
+        "things": {
+          "description": "The number of things that are changed.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "version": {
+          "description": "The version of the schema after the migration; the current version for a dry run.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "SchemaProblem": {
+      "description": "A problem with the names or keywords of a class or property, or with the schema itself.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The class with the problem.",
+          "type": "string"
+        },
+        "kind": {
+          "description": "Whether the class is a thing or action class.",
           "type": "string",
-          "format": "uuid"
+          "enum": [
+            "thing",
+            "action"
+          ]
         },
-        "name": {
-          "description": "Human readable name",
+        "message": {
+          "description": "What the problem is.",
           "type": "string"
         },
-        "uri": {
-          "description": "The location where the peer is exposed to the internet",
+        "property": {
+          "description": "The property with the problem, if the problem is not with the class itself.",
+          "type": "string"
+        },
+        "severity": {
+          "description": "An error keeps the schema from being used, a warning doesn't.",
           "type": "string",
-          "format": "uri"
+          "enum": [
+            "error",
+            "warning"
+          ]
+        },
+        "suggestions": {
+          "description": "Known words to use instead of the word, or as keywords.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "word": {
+          "description": "The word that is not in the contextionary, or the class that is a near duplicate.",
+          "type": "string"
         }
       }
     },
-    "PeerUpdateList": {
-      "description": "Lisf of known peers",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/PeerUpdate"
+    "SchemaValidation": {
+      "description": "The thing and action schema to validate. The current schema is validated when they are not given.",
+      "type": "object",
+      "properties": {
+        "actions": {
+          "$ref": "#/definitions/SemanticSchema"
+        },
+        "things": {
+          "$ref": "#/definitions/SemanticSchema"
+        }
       }
     },
-    "Schema": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition",
-      "type": "object"
-    },
-    "SchemaHistory": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition",
-      "type": "object"
+    "SchemaValidationResponse": {
+      "description": "All problems of the schema.",
+      "type": "object",
+      "properties": {
+        "problems": {
+          "description": "The problems, errors and warnings.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SchemaProblem"
+          }
+        },
+        "valid": {
+          "description": "Whether the schema has no errors.",
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
     },
     "SemanticSchema": {
       "description": "Definitions of semantic schemas (also see: https://github.com/creativesoftwarefdn/weaviate-semantic-schemas)",
@@ -3533,6 +7671,10 @@ func init() {
           "description": "Description of the class",
           "type": "string"
         },
+        "extends": {
+          "description": "Name of the parent class, whose properties this class inherits. References to the parent class accept instances of this class as well.",
+          "type": "string"
+        },
         "keywords": {
           "description": "Describes the kind of class. For example Geolocation for the class City.",
           "type": "array",
@@ -3568,6 +7710,9 @@ func init() {
             "type": "string"
           }
         },
+        "constraints": {
+          "$ref": "#/definitions/SemanticSchemaClassPropertyConstraints"
+        },
         "description": {
           "description": "Description of the property",
           "type": "string"
@@ -3594,6 +7739,59 @@ func init() {
         }
       }
     },
+    "SemanticSchemaClassPropertyConstraints": {
+      "description": "Constraints on the values of a property. They are checked when a thing or action is created, updated or patched.",
+      "type": "object",
+      "properties": {
+        "cardinality": {
+          "description": "How many things or actions of the class may refer to the same thing, action or key. Only for cross-references. 'manyToOne' is the default, 'oneToOne' allows a single reference to every thing, action or key.",
+          "type": "string",
+          "enum": [
+            "manyToOne",
+            "oneToOne"
+          ]
+        },
+        "enum": {
+          "description": "The values that the property may have. Only for string, int and number properties.",
+          "type": "array",
+          "items": {}
+        },
+        "maxLength": {
+          "description": "The maximum length of the value. Only for string properties.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "maximum": {
+          "description": "The maximum value, inclusive. Only for int and number properties.",
+          "type": "number",
+          "x-nullable": true
+        },
+        "minLength": {
+          "description": "The minimum length of the value. Only for string properties.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "minimum": {
+          "description": "The minimum value, inclusive. Only for int and number properties.",
+          "type": "number",
+          "x-nullable": true
+        },
+        "pattern": {
+          "description": "A regular expression that the value must match, in the syntax of https://golang.org/s/re2syntax. Only for string properties.",
+          "type": "string"
+        },
+        "required": {
+          "description": "Whether every thing or action of the class must have a value for the property.",
+          "type": "boolean"
+        },
+        "unique": {
+          "description": "Whether the value must be different for every thing or action of the class. Only for string, int, number and date properties.",
+          "type": "boolean"
+        }
+      }
+    },
     "SingleRef": {
       "properties": {
         "$cref": {
@@ -3643,6 +7841,43 @@ func init() {
         }
       ]
     },
+    "ThingBatchItem": {
+      "description": "A single thing in a batch. If ` + "`" + `thingId` + "`" + ` is given, the existing thing is updated, otherwise a new thing is created.",
+      "type": "object",
+      "properties": {
+        "thing": {
+          "$ref": "#/definitions/ThingCreate"
+        },
+        "thingId": {
+          "description": "Unique ID of an existing thing to update.",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "ThingBatchResult": {
+      "description": "The result of a single thing in a batch.",
+      "type": "object",
+      "properties": {
+        "errors": {
+          "$ref": "#/definitions/ErrorResponse"
+        },
+        "status": {
+          "description": "Whether the thing was created, updated or failed.",
+          "type": "string",
+          "enum": [
+            "created",
+            "updated",
+            "failed"
+          ]
+        },
+        "thingId": {
+          "description": "Unique ID of the thing.",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
     "ThingCreate": {
       "type": "object",
       "properties": {
@@ -3737,6 +7972,32 @@ func init() {
         }
       ]
     },
+    "ThingsBatchRequest": {
+      "description": "A batch of things to create or update. A cref to another thing in the same batch is made by setting its '$cref' to '#/things/{index}'.",
+      "type": "object",
+      "properties": {
+        "things": {
+          "description": "The things in this batch.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ThingBatchItem"
+          }
+        }
+      }
+    },
+    "ThingsBatchResponse": {
+      "description": "Results of a batch of things, in the same order as the request.",
+      "type": "object",
+      "properties": {
+        "things": {
+          "description": "The result of each thing in the batch.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ThingBatchResult"
+          }
+        }
+      }
+    },
     "ThingsListResponse": {
       "description": "List of things.",
       "type": "object",
@@ -3795,6 +8056,52 @@ func init() {
           }
         }
       }
+    },
+    "VocabularyConversion": {
+      "description": "A vocabulary in JSON-LD, like schema.org or an RDFS or OWL ontology, to convert into a thing and action schema.",
+      "type": "object",
+      "required": [
+        "vocabulary"
+      ],
+      "properties": {
+        "actionClasses": {
+          "description": "The names of the classes that are actions, with the classes that extend them. Defaults to the Action of schema.org.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "classes": {
+          "description": "The names of the classes to convert, which are converted with the classes they extend. All classes are converted when there are none.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "vocabulary": {
+          "description": "The JSON-LD document of the vocabulary, with its classes and properties.",
+          "$ref": "#/definitions/JsonObject"
+        }
+      }
+    },
+    "VocabularyConversionResponse": {
+      "description": "The thing and action schema that is converted from a vocabulary.",
+      "type": "object",
+      "properties": {
+        "actions": {
+          "$ref": "#/definitions/SemanticSchema"
+        },
+        "things": {
+          "$ref": "#/definitions/SemanticSchema"
+        },
+        "warnings": {
+          "description": "What is converted differently than the vocabulary describes it, or is not converted at all, e.g. names that are not in the contextionary.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
     }
   },
   "parameters": {
@@ -3835,6 +8142,12 @@ func init() {
     {
       "name": "actions"
     },
+    {
+      "name": "backup"
+    },
+    {
+      "name": "contextionary-API"
+    },
     {
       "name": "graphql"
     },
@@ -3847,6 +8160,9 @@ func init() {
     {
       "name": "P2P"
     },
+    {
+      "name": "schema"
+    },
     {
       "name": "things"
     }
@@ -204,3 +204,47 @@ func (o *WeaviateActionsCreateUnprocessableEntity) WriteResponse(rw http.Respons
 		}
 	}
 }
+
+// WeaviateActionsCreateInternalServerErrorCode is the HTTP code returned for type WeaviateActionsCreateInternalServerError
+const WeaviateActionsCreateInternalServerErrorCode int = 500
+
+/*WeaviateActionsCreateInternalServerError The action could not be written to the database.
+
+swagger:response weaviateActionsCreateInternalServerError
+*/
+type WeaviateActionsCreateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewWeaviateActionsCreateInternalServerError creates WeaviateActionsCreateInternalServerError with default headers values
+func NewWeaviateActionsCreateInternalServerError() *WeaviateActionsCreateInternalServerError {
+
+	return &WeaviateActionsCreateInternalServerError{}
+}
+
+// WithPayload adds the payload to the weaviate actions create internal server error response
+func (o *WeaviateActionsCreateInternalServerError) WithPayload(payload *models.ErrorResponse) *WeaviateActionsCreateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the weaviate actions create internal server error response
+func (o *WeaviateActionsCreateInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *WeaviateActionsCreateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
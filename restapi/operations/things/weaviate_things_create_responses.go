@@ -204,3 +204,47 @@ func (o *WeaviateThingsCreateUnprocessableEntity) WriteResponse(rw http.Response
 		}
 	}
 }
+
+// WeaviateThingsCreateInternalServerErrorCode is the HTTP code returned for type WeaviateThingsCreateInternalServerError
+const WeaviateThingsCreateInternalServerErrorCode int = 500
+
+/*WeaviateThingsCreateInternalServerError The thing could not be written to the database.
+
+swagger:response weaviateThingsCreateInternalServerError
+*/
+type WeaviateThingsCreateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewWeaviateThingsCreateInternalServerError creates WeaviateThingsCreateInternalServerError with default headers values
+func NewWeaviateThingsCreateInternalServerError() *WeaviateThingsCreateInternalServerError {
+
+	return &WeaviateThingsCreateInternalServerError{}
+}
+
+// WithPayload adds the payload to the weaviate things create internal server error response
+func (o *WeaviateThingsCreateInternalServerError) WithPayload(payload *models.ErrorResponse) *WeaviateThingsCreateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the weaviate things create internal server error response
+func (o *WeaviateThingsCreateInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *WeaviateThingsCreateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
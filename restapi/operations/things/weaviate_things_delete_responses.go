@@ -21,6 +21,8 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+
+	models "github.com/creativesoftwarefdn/weaviate/models"
 )
 
 // WeaviateThingsDeleteNoContentCode is the HTTP code returned for type WeaviateThingsDeleteNoContent
@@ -118,3 +120,47 @@ func (o *WeaviateThingsDeleteNotFound) WriteResponse(rw http.ResponseWriter, pro
 
 	rw.WriteHeader(404)
 }
+
+// WeaviateThingsDeleteInternalServerErrorCode is the HTTP code returned for type WeaviateThingsDeleteInternalServerError
+const WeaviateThingsDeleteInternalServerErrorCode int = 500
+
+/*WeaviateThingsDeleteInternalServerError The thing could not be written to the database.
+
+swagger:response weaviateThingsDeleteInternalServerError
+*/
+type WeaviateThingsDeleteInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewWeaviateThingsDeleteInternalServerError creates WeaviateThingsDeleteInternalServerError with default headers values
+func NewWeaviateThingsDeleteInternalServerError() *WeaviateThingsDeleteInternalServerError {
+
+	return &WeaviateThingsDeleteInternalServerError{}
+}
+
+// WithPayload adds the payload to the weaviate things delete internal server error response
+func (o *WeaviateThingsDeleteInternalServerError) WithPayload(payload *models.ErrorResponse) *WeaviateThingsDeleteInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the weaviate things delete internal server error response
+func (o *WeaviateThingsDeleteInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *WeaviateThingsDeleteInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
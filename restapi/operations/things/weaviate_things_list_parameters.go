@@ -44,6 +44,10 @@ type WeaviateThingsListParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Only list the things of this class. The values of the where filters are converted to the data types of the properties of this class.
+	  In: query
+	*/
+	Class *string
 	/*The maximum number of items to be returned per page. Default value is set in Weaviate config.
 	  In: query
 	*/
@@ -52,6 +56,11 @@ type WeaviateThingsListParams struct {
 	  In: query
 	*/
 	Page *int64
+	/*Only list the things whose schema matches all of the filters. A filter is a property, an operator and a value, e.g. 'founded>=2018-01-01T00:00:00Z'. The operators are ':' or '=' for equal, '!=' for not equal, '>', '>=', '<' and '<='. Dates are given in RFC 3339.
+	  In: query
+	  Collection Format: multi
+	*/
+	Where []string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -65,6 +74,11 @@ func (o *WeaviateThingsListParams) BindRequest(r *http.Request, route *middlewar
 
 	qs := runtime.Values(r.URL.Query())
 
+	qClass, qhkClass, _ := qs.GetOK("class")
+	if err := o.bindClass(qClass, qhkClass, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qMaxResults, qhkMaxResults, _ := qs.GetOK("maxResults")
 	if err := o.bindMaxResults(qMaxResults, qhkMaxResults, route.Formats); err != nil {
 		res = append(res, err)
@@ -75,12 +89,35 @@ func (o *WeaviateThingsListParams) BindRequest(r *http.Request, route *middlewar
 		res = append(res, err)
 	}
 
+	qWhere, qhkWhere, _ := qs.GetOK("where")
+	if err := o.bindWhere(qWhere, qhkWhere, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindClass binds and validates parameter Class from query.
+func (o *WeaviateThingsListParams) bindClass(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Class = &raw
+
+	return nil
+}
+
 // bindMaxResults binds and validates parameter MaxResults from query.
 func (o *WeaviateThingsListParams) bindMaxResults(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -124,3 +161,27 @@ func (o *WeaviateThingsListParams) bindPage(rawData []string, hasKey bool, forma
 
 	return nil
 }
+
+// bindWhere binds and validates array parameter Where from query.
+//
+// Arrays are parsed according to CollectionFormat: "multi" (defaults to "csv" when empty).
+func (o *WeaviateThingsListParams) bindWhere(rawData []string, hasKey bool, formats strfmt.Registry) error {
+
+	// CollectionFormat: multi
+	whereIC := rawData
+
+	if len(whereIC) == 0 {
+		return nil
+	}
+
+	var whereIR []string
+	for _, whereIV := range whereIC {
+		whereI := whereIV
+
+		whereIR = append(whereIR, whereI)
+	}
+
+	o.Where = whereIR
+
+	return nil
+}
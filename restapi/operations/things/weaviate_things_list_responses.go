@@ -140,3 +140,47 @@ func (o *WeaviateThingsListNotFound) WriteResponse(rw http.ResponseWriter, produ
 
 	rw.WriteHeader(404)
 }
+
+// WeaviateThingsListUnprocessableEntityCode is the HTTP code returned for type WeaviateThingsListUnprocessableEntity
+const WeaviateThingsListUnprocessableEntityCode int = 422
+
+/*WeaviateThingsListUnprocessableEntity A where filter is invalid, e.g. because its property does not exist or its value does not fit the data type of the property.
+
+swagger:response weaviateThingsListUnprocessableEntity
+*/
+type WeaviateThingsListUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewWeaviateThingsListUnprocessableEntity creates WeaviateThingsListUnprocessableEntity with default headers values
+func NewWeaviateThingsListUnprocessableEntity() *WeaviateThingsListUnprocessableEntity {
+
+	return &WeaviateThingsListUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the weaviate things list unprocessable entity response
+func (o *WeaviateThingsListUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *WeaviateThingsListUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the weaviate things list unprocessable entity response
+func (o *WeaviateThingsListUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *WeaviateThingsListUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
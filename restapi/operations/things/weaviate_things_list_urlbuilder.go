@@ -27,8 +27,10 @@ import (
 
 // WeaviateThingsListURL generates an URL for the weaviate things list operation
 type WeaviateThingsListURL struct {
+	Class      *string
 	MaxResults *int64
 	Page       *int64
+	Where      []string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -64,6 +66,14 @@ func (o *WeaviateThingsListURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var class string
+	if o.Class != nil {
+		class = *o.Class
+	}
+	if class != "" {
+		qs.Set("class", class)
+	}
+
 	var maxResults string
 	if o.MaxResults != nil {
 		maxResults = swag.FormatInt64(*o.MaxResults)
@@ -80,6 +90,18 @@ func (o *WeaviateThingsListURL) Build() (*url.URL, error) {
 		qs.Set("page", page)
 	}
 
+	var whereIR []string
+	for _, whereI := range o.Where {
+		whereIS := whereI
+		if whereIS != "" {
+			whereIR = append(whereIR, whereIS)
+		}
+	}
+
+	for _, qsv := range whereIR {
+		qs.Add("where", qsv)
+	}
+
 	result.RawQuery = qs.Encode()
 
 	return &result, nil
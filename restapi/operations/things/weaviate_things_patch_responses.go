@@ -208,3 +208,47 @@ func (o *WeaviateThingsPatchUnprocessableEntity) WriteResponse(rw http.ResponseW
 		}
 	}
 }
+
+// WeaviateThingsPatchInternalServerErrorCode is the HTTP code returned for type WeaviateThingsPatchInternalServerError
+const WeaviateThingsPatchInternalServerErrorCode int = 500
+
+/*WeaviateThingsPatchInternalServerError The thing could not be written to the database.
+
+swagger:response weaviateThingsPatchInternalServerError
+*/
+type WeaviateThingsPatchInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewWeaviateThingsPatchInternalServerError creates WeaviateThingsPatchInternalServerError with default headers values
+func NewWeaviateThingsPatchInternalServerError() *WeaviateThingsPatchInternalServerError {
+
+	return &WeaviateThingsPatchInternalServerError{}
+}
+
+// WithPayload adds the payload to the weaviate things patch internal server error response
+func (o *WeaviateThingsPatchInternalServerError) WithPayload(payload *models.ErrorResponse) *WeaviateThingsPatchInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the weaviate things patch internal server error response
+func (o *WeaviateThingsPatchInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *WeaviateThingsPatchInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
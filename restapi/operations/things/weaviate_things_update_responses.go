@@ -184,3 +184,47 @@ func (o *WeaviateThingsUpdateUnprocessableEntity) WriteResponse(rw http.Response
 		}
 	}
 }
+
+// WeaviateThingsUpdateInternalServerErrorCode is the HTTP code returned for type WeaviateThingsUpdateInternalServerError
+const WeaviateThingsUpdateInternalServerErrorCode int = 500
+
+/*WeaviateThingsUpdateInternalServerError The thing could not be written to the database.
+
+swagger:response weaviateThingsUpdateInternalServerError
+*/
+type WeaviateThingsUpdateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewWeaviateThingsUpdateInternalServerError creates WeaviateThingsUpdateInternalServerError with default headers values
+func NewWeaviateThingsUpdateInternalServerError() *WeaviateThingsUpdateInternalServerError {
+
+	return &WeaviateThingsUpdateInternalServerError{}
+}
+
+// WithPayload adds the payload to the weaviate things update internal server error response
+func (o *WeaviateThingsUpdateInternalServerError) WithPayload(payload *models.ErrorResponse) *WeaviateThingsUpdateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the weaviate things update internal server error response
+func (o *WeaviateThingsUpdateInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *WeaviateThingsUpdateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -32,10 +32,13 @@ import (
 	"github.com/go-openapi/swag"
 
 	"github.com/creativesoftwarefdn/weaviate/restapi/operations/actions"
+	"github.com/creativesoftwarefdn/weaviate/restapi/operations/backup"
+	"github.com/creativesoftwarefdn/weaviate/restapi/operations/contextionary_api"
 	"github.com/creativesoftwarefdn/weaviate/restapi/operations/graphql"
 	"github.com/creativesoftwarefdn/weaviate/restapi/operations/keys"
 	"github.com/creativesoftwarefdn/weaviate/restapi/operations/meta"
 	"github.com/creativesoftwarefdn/weaviate/restapi/operations/p2_p"
+	"github.com/creativesoftwarefdn/weaviate/restapi/operations/schema"
 	"github.com/creativesoftwarefdn/weaviate/restapi/operations/things"
 )
 
@@ -62,6 +65,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ActionsWeaviateActionUpdateHandler: actions.WeaviateActionUpdateHandlerFunc(func(params actions.WeaviateActionUpdateParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation ActionsWeaviateActionUpdate has not yet been implemented")
 		}),
+		ActionsWeaviateActionsBatchCreateHandler: actions.WeaviateActionsBatchCreateHandlerFunc(func(params actions.WeaviateActionsBatchCreateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation ActionsWeaviateActionsBatchCreate has not yet been implemented")
+		}),
 		ActionsWeaviateActionsCreateHandler: actions.WeaviateActionsCreateHandlerFunc(func(params actions.WeaviateActionsCreateParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation ActionsWeaviateActionsCreate has not yet been implemented")
 		}),
@@ -77,6 +83,24 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ActionsWeaviateActionsValidateHandler: actions.WeaviateActionsValidateHandlerFunc(func(params actions.WeaviateActionsValidateParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation ActionsWeaviateActionsValidate has not yet been implemented")
 		}),
+		BackupWeaviateExportHandler: backup.WeaviateExportHandlerFunc(func(params backup.WeaviateExportParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation BackupWeaviateExport has not yet been implemented")
+		}),
+		BackupWeaviateImportHandler: backup.WeaviateImportHandlerFunc(func(params backup.WeaviateImportParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation BackupWeaviateImport has not yet been implemented")
+		}),
+		ContextionaryAPIWeaviateC11yClassesNeighboursHandler: contextionary_api.WeaviateC11yClassesNeighboursHandlerFunc(func(params contextionary_api.WeaviateC11yClassesNeighboursParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation ContextionaryAPIWeaviateC11yClassesNeighbours has not yet been implemented")
+		}),
+		ContextionaryAPIWeaviateC11yDistanceHandler: contextionary_api.WeaviateC11yDistanceHandlerFunc(func(params contextionary_api.WeaviateC11yDistanceParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation ContextionaryAPIWeaviateC11yDistance has not yet been implemented")
+		}),
+		ContextionaryAPIWeaviateC11yWordsGetHandler: contextionary_api.WeaviateC11yWordsGetHandlerFunc(func(params contextionary_api.WeaviateC11yWordsGetParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation ContextionaryAPIWeaviateC11yWordsGet has not yet been implemented")
+		}),
+		ContextionaryAPIWeaviateC11yWordsNeighboursHandler: contextionary_api.WeaviateC11yWordsNeighboursHandlerFunc(func(params contextionary_api.WeaviateC11yWordsNeighboursParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation ContextionaryAPIWeaviateC11yWordsNeighbours has not yet been implemented")
+		}),
 		GraphqlWeaviateGraphqlPostHandler: graphql.WeaviateGraphqlPostHandlerFunc(func(params graphql.WeaviateGraphqlPostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation GraphqlWeaviateGraphqlPost has not yet been implemented")
 		}),
@@ -101,6 +125,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		KeysWeaviateKeysRenewTokenHandler: keys.WeaviateKeysRenewTokenHandlerFunc(func(params keys.WeaviateKeysRenewTokenParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation KeysWeaviateKeysRenewToken has not yet been implemented")
 		}),
+		MetaWeaviateHealthHandler: meta.WeaviateHealthHandlerFunc(func(params meta.WeaviateHealthParams) middleware.Responder {
+			return middleware.NotImplemented("operation MetaWeaviateHealth has not yet been implemented")
+		}),
 		MetaWeaviateMetaGetHandler: meta.WeaviateMetaGetHandlerFunc(func(params meta.WeaviateMetaGetParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation MetaWeaviateMetaGet has not yet been implemented")
 		}),
@@ -110,12 +137,66 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		P2PWeaviateP2pHealthHandler: p2_p.WeaviateP2pHealthHandlerFunc(func(params p2_p.WeaviateP2pHealthParams) middleware.Responder {
 			return middleware.NotImplemented("operation P2PWeaviateP2pHealth has not yet been implemented")
 		}),
+		SchemaWeaviateSchemaActionsClassesCreateHandler: schema.WeaviateSchemaActionsClassesCreateHandlerFunc(func(params schema.WeaviateSchemaActionsClassesCreateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation SchemaWeaviateSchemaActionsClassesCreate has not yet been implemented")
+		}),
+		SchemaWeaviateSchemaActionsClassesDeleteHandler: schema.WeaviateSchemaActionsClassesDeleteHandlerFunc(func(params schema.WeaviateSchemaActionsClassesDeleteParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation SchemaWeaviateSchemaActionsClassesDelete has not yet been implemented")
+		}),
+		SchemaWeaviateSchemaActionsClassesUpdateHandler: schema.WeaviateSchemaActionsClassesUpdateHandlerFunc(func(params schema.WeaviateSchemaActionsClassesUpdateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation SchemaWeaviateSchemaActionsClassesUpdate has not yet been implemented")
+		}),
+		SchemaWeaviateSchemaActionsPropertiesCreateHandler: schema.WeaviateSchemaActionsPropertiesCreateHandlerFunc(func(params schema.WeaviateSchemaActionsPropertiesCreateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation SchemaWeaviateSchemaActionsPropertiesCreate has not yet been implemented")
+		}),
+		SchemaWeaviateSchemaActionsPropertiesDeleteHandler: schema.WeaviateSchemaActionsPropertiesDeleteHandlerFunc(func(params schema.WeaviateSchemaActionsPropertiesDeleteParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation SchemaWeaviateSchemaActionsPropertiesDelete has not yet been implemented")
+		}),
+		SchemaWeaviateSchemaActionsPropertiesUpdateHandler: schema.WeaviateSchemaActionsPropertiesUpdateHandlerFunc(func(params schema.WeaviateSchemaActionsPropertiesUpdateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation SchemaWeaviateSchemaActionsPropertiesUpdate has not yet been implemented")
+		}),
+		SchemaWeaviateSchemaExportHandler: schema.WeaviateSchemaExportHandlerFunc(func(params schema.WeaviateSchemaExportParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation SchemaWeaviateSchemaExport has not yet been implemented")
+		}),
+		SchemaWeaviateSchemaHistoryHandler: schema.WeaviateSchemaHistoryHandlerFunc(func(params schema.WeaviateSchemaHistoryParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation SchemaWeaviateSchemaHistory has not yet been implemented")
+		}),
+		SchemaWeaviateSchemaMigrateHandler: schema.WeaviateSchemaMigrateHandlerFunc(func(params schema.WeaviateSchemaMigrateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation SchemaWeaviateSchemaMigrate has not yet been implemented")
+		}),
+		SchemaWeaviateSchemaThingsClassesCreateHandler: schema.WeaviateSchemaThingsClassesCreateHandlerFunc(func(params schema.WeaviateSchemaThingsClassesCreateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation SchemaWeaviateSchemaThingsClassesCreate has not yet been implemented")
+		}),
+		SchemaWeaviateSchemaThingsClassesDeleteHandler: schema.WeaviateSchemaThingsClassesDeleteHandlerFunc(func(params schema.WeaviateSchemaThingsClassesDeleteParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation SchemaWeaviateSchemaThingsClassesDelete has not yet been implemented")
+		}),
+		SchemaWeaviateSchemaThingsClassesUpdateHandler: schema.WeaviateSchemaThingsClassesUpdateHandlerFunc(func(params schema.WeaviateSchemaThingsClassesUpdateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation SchemaWeaviateSchemaThingsClassesUpdate has not yet been implemented")
+		}),
+		SchemaWeaviateSchemaThingsPropertiesCreateHandler: schema.WeaviateSchemaThingsPropertiesCreateHandlerFunc(func(params schema.WeaviateSchemaThingsPropertiesCreateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation SchemaWeaviateSchemaThingsPropertiesCreate has not yet been implemented")
+		}),
+		SchemaWeaviateSchemaThingsPropertiesDeleteHandler: schema.WeaviateSchemaThingsPropertiesDeleteHandlerFunc(func(params schema.WeaviateSchemaThingsPropertiesDeleteParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation SchemaWeaviateSchemaThingsPropertiesDelete has not yet been implemented")
+		}),
+		SchemaWeaviateSchemaThingsPropertiesUpdateHandler: schema.WeaviateSchemaThingsPropertiesUpdateHandlerFunc(func(params schema.WeaviateSchemaThingsPropertiesUpdateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation SchemaWeaviateSchemaThingsPropertiesUpdate has not yet been implemented")
+		}),
+		SchemaWeaviateSchemaValidateHandler: schema.WeaviateSchemaValidateHandlerFunc(func(params schema.WeaviateSchemaValidateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation SchemaWeaviateSchemaValidate has not yet been implemented")
+		}),
+		SchemaWeaviateSchemaVocabularyConvertHandler: schema.WeaviateSchemaVocabularyConvertHandlerFunc(func(params schema.WeaviateSchemaVocabularyConvertParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation SchemaWeaviateSchemaVocabularyConvert has not yet been implemented")
+		}),
 		ThingsWeaviateThingHistoryGetHandler: things.WeaviateThingHistoryGetHandlerFunc(func(params things.WeaviateThingHistoryGetParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation ThingsWeaviateThingHistoryGet has not yet been implemented")
 		}),
 		ThingsWeaviateThingsActionsListHandler: things.WeaviateThingsActionsListHandlerFunc(func(params things.WeaviateThingsActionsListParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation ThingsWeaviateThingsActionsList has not yet been implemented")
 		}),
+		ThingsWeaviateThingsBatchCreateHandler: things.WeaviateThingsBatchCreateHandlerFunc(func(params things.WeaviateThingsBatchCreateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation ThingsWeaviateThingsBatchCreate has not yet been implemented")
+		}),
 		ThingsWeaviateThingsCreateHandler: things.WeaviateThingsCreateHandlerFunc(func(params things.WeaviateThingsCreateParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation ThingsWeaviateThingsCreate has not yet been implemented")
 		}),
@@ -195,6 +276,8 @@ type WeaviateAPI struct {
 	ActionsWeaviateActionHistoryGetHandler actions.WeaviateActionHistoryGetHandler
 	// ActionsWeaviateActionUpdateHandler sets the operation handler for the weaviate action update operation
 	ActionsWeaviateActionUpdateHandler actions.WeaviateActionUpdateHandler
+	// ActionsWeaviateActionsBatchCreateHandler sets the operation handler for the weaviate actions batch create operation
+	ActionsWeaviateActionsBatchCreateHandler actions.WeaviateActionsBatchCreateHandler
 	// ActionsWeaviateActionsCreateHandler sets the operation handler for the weaviate actions create operation
 	ActionsWeaviateActionsCreateHandler actions.WeaviateActionsCreateHandler
 	// ActionsWeaviateActionsDeleteHandler sets the operation handler for the weaviate actions delete operation
@@ -205,6 +288,18 @@ type WeaviateAPI struct {
 	ActionsWeaviateActionsPatchHandler actions.WeaviateActionsPatchHandler
 	// ActionsWeaviateActionsValidateHandler sets the operation handler for the weaviate actions validate operation
 	ActionsWeaviateActionsValidateHandler actions.WeaviateActionsValidateHandler
+	// BackupWeaviateExportHandler sets the operation handler for the weaviate export operation
+	BackupWeaviateExportHandler backup.WeaviateExportHandler
+	// BackupWeaviateImportHandler sets the operation handler for the weaviate import operation
+	BackupWeaviateImportHandler backup.WeaviateImportHandler
+	// ContextionaryAPIWeaviateC11yClassesNeighboursHandler sets the operation handler for the weaviate c11y classes neighbours operation
+	ContextionaryAPIWeaviateC11yClassesNeighboursHandler contextionary_api.WeaviateC11yClassesNeighboursHandler
+	// ContextionaryAPIWeaviateC11yDistanceHandler sets the operation handler for the weaviate c11y distance operation
+	ContextionaryAPIWeaviateC11yDistanceHandler contextionary_api.WeaviateC11yDistanceHandler
+	// ContextionaryAPIWeaviateC11yWordsGetHandler sets the operation handler for the weaviate c11y words get operation
+	ContextionaryAPIWeaviateC11yWordsGetHandler contextionary_api.WeaviateC11yWordsGetHandler
+	// ContextionaryAPIWeaviateC11yWordsNeighboursHandler sets the operation handler for the weaviate c11y words neighbours operation
+	ContextionaryAPIWeaviateC11yWordsNeighboursHandler contextionary_api.WeaviateC11yWordsNeighboursHandler
 	// GraphqlWeaviateGraphqlPostHandler sets the operation handler for the weaviate graphql post operation
 	GraphqlWeaviateGraphqlPostHandler graphql.WeaviateGraphqlPostHandler
 	// KeysWeaviateKeyCreateHandler sets the operation handler for the weaviate key create operation
@@ -221,16 +316,54 @@ type WeaviateAPI struct {
 	KeysWeaviateKeysMeGetHandler keys.WeaviateKeysMeGetHandler
 	// KeysWeaviateKeysRenewTokenHandler sets the operation handler for the weaviate keys renew token operation
 	KeysWeaviateKeysRenewTokenHandler keys.WeaviateKeysRenewTokenHandler
+	// MetaWeaviateHealthHandler sets the operation handler for the weaviate health operation
+	MetaWeaviateHealthHandler meta.WeaviateHealthHandler
 	// MetaWeaviateMetaGetHandler sets the operation handler for the weaviate meta get operation
 	MetaWeaviateMetaGetHandler meta.WeaviateMetaGetHandler
 	// P2PWeaviateP2pGenesisUpdateHandler sets the operation handler for the weaviate p2p genesis update operation
 	P2PWeaviateP2pGenesisUpdateHandler p2_p.WeaviateP2pGenesisUpdateHandler
 	// P2PWeaviateP2pHealthHandler sets the operation handler for the weaviate p2p health operation
 	P2PWeaviateP2pHealthHandler p2_p.WeaviateP2pHealthHandler
+	// SchemaWeaviateSchemaActionsClassesCreateHandler sets the operation handler for the weaviate schema actions classes create operation
+	SchemaWeaviateSchemaActionsClassesCreateHandler schema.WeaviateSchemaActionsClassesCreateHandler
+	// SchemaWeaviateSchemaActionsClassesDeleteHandler sets the operation handler for the weaviate schema actions classes delete operation
+	SchemaWeaviateSchemaActionsClassesDeleteHandler schema.WeaviateSchemaActionsClassesDeleteHandler
+	// SchemaWeaviateSchemaActionsClassesUpdateHandler sets the operation handler for the weaviate schema actions classes update operation
+	SchemaWeaviateSchemaActionsClassesUpdateHandler schema.WeaviateSchemaActionsClassesUpdateHandler
+	// SchemaWeaviateSchemaActionsPropertiesCreateHandler sets the operation handler for the weaviate schema actions properties create operation
+	SchemaWeaviateSchemaActionsPropertiesCreateHandler schema.WeaviateSchemaActionsPropertiesCreateHandler
+	// SchemaWeaviateSchemaActionsPropertiesDeleteHandler sets the operation handler for the weaviate schema actions properties delete operation
+	SchemaWeaviateSchemaActionsPropertiesDeleteHandler schema.WeaviateSchemaActionsPropertiesDeleteHandler
+	// SchemaWeaviateSchemaActionsPropertiesUpdateHandler sets the operation handler for the weaviate schema actions properties update operation
+	SchemaWeaviateSchemaActionsPropertiesUpdateHandler schema.WeaviateSchemaActionsPropertiesUpdateHandler
+	// SchemaWeaviateSchemaExportHandler sets the operation handler for the weaviate schema export operation
+	SchemaWeaviateSchemaExportHandler schema.WeaviateSchemaExportHandler
+	// SchemaWeaviateSchemaHistoryHandler sets the operation handler for the weaviate schema history operation
+	SchemaWeaviateSchemaHistoryHandler schema.WeaviateSchemaHistoryHandler
+	// SchemaWeaviateSchemaMigrateHandler sets the operation handler for the weaviate schema migrate operation
+	SchemaWeaviateSchemaMigrateHandler schema.WeaviateSchemaMigrateHandler
+	// SchemaWeaviateSchemaThingsClassesCreateHandler sets the operation handler for the weaviate schema things classes create operation
+	SchemaWeaviateSchemaThingsClassesCreateHandler schema.WeaviateSchemaThingsClassesCreateHandler
+	// SchemaWeaviateSchemaThingsClassesDeleteHandler sets the operation handler for the weaviate schema things classes delete operation
+	SchemaWeaviateSchemaThingsClassesDeleteHandler schema.WeaviateSchemaThingsClassesDeleteHandler
+	// SchemaWeaviateSchemaThingsClassesUpdateHandler sets the operation handler for the weaviate schema things classes update operation
+	SchemaWeaviateSchemaThingsClassesUpdateHandler schema.WeaviateSchemaThingsClassesUpdateHandler
+	// SchemaWeaviateSchemaThingsPropertiesCreateHandler sets the operation handler for the weaviate schema things properties create operation
+	SchemaWeaviateSchemaThingsPropertiesCreateHandler schema.WeaviateSchemaThingsPropertiesCreateHandler
+	// SchemaWeaviateSchemaThingsPropertiesDeleteHandler sets the operation handler for the weaviate schema things properties delete operation
+	SchemaWeaviateSchemaThingsPropertiesDeleteHandler schema.WeaviateSchemaThingsPropertiesDeleteHandler
+	// SchemaWeaviateSchemaThingsPropertiesUpdateHandler sets the operation handler for the weaviate schema things properties update operation
+	SchemaWeaviateSchemaThingsPropertiesUpdateHandler schema.WeaviateSchemaThingsPropertiesUpdateHandler
+	// SchemaWeaviateSchemaValidateHandler sets the operation handler for the weaviate schema validate operation
+	SchemaWeaviateSchemaValidateHandler schema.WeaviateSchemaValidateHandler
+	// SchemaWeaviateSchemaVocabularyConvertHandler sets the operation handler for the weaviate schema vocabulary convert operation
+	SchemaWeaviateSchemaVocabularyConvertHandler schema.WeaviateSchemaVocabularyConvertHandler
 	// ThingsWeaviateThingHistoryGetHandler sets the operation handler for the weaviate thing history get operation
 	ThingsWeaviateThingHistoryGetHandler things.WeaviateThingHistoryGetHandler
 	// ThingsWeaviateThingsActionsListHandler sets the operation handler for the weaviate things actions list operation
 	ThingsWeaviateThingsActionsListHandler things.WeaviateThingsActionsListHandler
+	// ThingsWeaviateThingsBatchCreateHandler sets the operation handler for the weaviate things batch create operation
+	ThingsWeaviateThingsBatchCreateHandler things.WeaviateThingsBatchCreateHandler
 	// ThingsWeaviateThingsCreateHandler sets the operation handler for the weaviate things create operation
 	ThingsWeaviateThingsCreateHandler things.WeaviateThingsCreateHandler
 	// ThingsWeaviateThingsDeleteHandler sets the operation handler for the weaviate things delete operation
@@ -324,6 +457,10 @@ func (o *WeaviateAPI) Validate() error {
 		unregistered = append(unregistered, "actions.WeaviateActionUpdateHandler")
 	}
 
+	if o.ActionsWeaviateActionsBatchCreateHandler == nil {
+		unregistered = append(unregistered, "actions.WeaviateActionsBatchCreateHandler")
+	}
+
 	if o.ActionsWeaviateActionsCreateHandler == nil {
 		unregistered = append(unregistered, "actions.WeaviateActionsCreateHandler")
 	}
@@ -344,6 +481,30 @@ func (o *WeaviateAPI) Validate() error {
 		unregistered = append(unregistered, "actions.WeaviateActionsValidateHandler")
 	}
 
+	if o.BackupWeaviateExportHandler == nil {
+		unregistered = append(unregistered, "backup.WeaviateExportHandler")
+	}
+
+	if o.BackupWeaviateImportHandler == nil {
+		unregistered = append(unregistered, "backup.WeaviateImportHandler")
+	}
+
+	if o.ContextionaryAPIWeaviateC11yClassesNeighboursHandler == nil {
+		unregistered = append(unregistered, "contextionary_api.WeaviateC11yClassesNeighboursHandler")
+	}
+
+	if o.ContextionaryAPIWeaviateC11yDistanceHandler == nil {
+		unregistered = append(unregistered, "contextionary_api.WeaviateC11yDistanceHandler")
+	}
+
+	if o.ContextionaryAPIWeaviateC11yWordsGetHandler == nil {
+		unregistered = append(unregistered, "contextionary_api.WeaviateC11yWordsGetHandler")
+	}
+
+	if o.ContextionaryAPIWeaviateC11yWordsNeighboursHandler == nil {
+		unregistered = append(unregistered, "contextionary_api.WeaviateC11yWordsNeighboursHandler")
+	}
+
 	if o.GraphqlWeaviateGraphqlPostHandler == nil {
 		unregistered = append(unregistered, "graphql.WeaviateGraphqlPostHandler")
 	}
@@ -376,6 +537,10 @@ func (o *WeaviateAPI) Validate() error {
 		unregistered = append(unregistered, "keys.WeaviateKeysRenewTokenHandler")
 	}
 
+	if o.MetaWeaviateHealthHandler == nil {
+		unregistered = append(unregistered, "meta.WeaviateHealthHandler")
+	}
+
 	if o.MetaWeaviateMetaGetHandler == nil {
 		unregistered = append(unregistered, "meta.WeaviateMetaGetHandler")
 	}
@@ -388,6 +553,74 @@ func (o *WeaviateAPI) Validate() error {
 		unregistered = append(unregistered, "p2_p.WeaviateP2pHealthHandler")
 	}
 
+	if o.SchemaWeaviateSchemaActionsClassesCreateHandler == nil {
+		unregistered = append(unregistered, "schema.WeaviateSchemaActionsClassesCreateHandler")
+	}
+
+	if o.SchemaWeaviateSchemaActionsClassesDeleteHandler == nil {
+		unregistered = append(unregistered, "schema.WeaviateSchemaActionsClassesDeleteHandler")
+	}
+
+	if o.SchemaWeaviateSchemaActionsClassesUpdateHandler == nil {
+		unregistered = append(unregistered, "schema.WeaviateSchemaActionsClassesUpdateHandler")
+	}
+
+	if o.SchemaWeaviateSchemaActionsPropertiesCreateHandler == nil {
+		unregistered = append(unregistered, "schema.WeaviateSchemaActionsPropertiesCreateHandler")
+	}
+
+	if o.SchemaWeaviateSchemaActionsPropertiesDeleteHandler == nil {
+		unregistered = append(unregistered, "schema.WeaviateSchemaActionsPropertiesDeleteHandler")
+	}
+
+	if o.SchemaWeaviateSchemaActionsPropertiesUpdateHandler == nil {
+		unregistered = append(unregistered, "schema.WeaviateSchemaActionsPropertiesUpdateHandler")
+	}
+
+	if o.SchemaWeaviateSchemaExportHandler == nil {
+		unregistered = append(unregistered, "schema.WeaviateSchemaExportHandler")
+	}
+
+	if o.SchemaWeaviateSchemaHistoryHandler == nil {
+		unregistered = append(unregistered, "schema.WeaviateSchemaHistoryHandler")
+	}
+
+	if o.SchemaWeaviateSchemaMigrateHandler == nil {
+		unregistered = append(unregistered, "schema.WeaviateSchemaMigrateHandler")
+	}
+
+	if o.SchemaWeaviateSchemaThingsClassesCreateHandler == nil {
+		unregistered = append(unregistered, "schema.WeaviateSchemaThingsClassesCreateHandler")
+	}
+
+	if o.SchemaWeaviateSchemaThingsClassesDeleteHandler == nil {
+		unregistered = append(unregistered, "schema.WeaviateSchemaThingsClassesDeleteHandler")
+	}
+
+	if o.SchemaWeaviateSchemaThingsClassesUpdateHandler == nil {
+		unregistered = append(unregistered, "schema.WeaviateSchemaThingsClassesUpdateHandler")
+	}
+
+	if o.SchemaWeaviateSchemaThingsPropertiesCreateHandler == nil {
+		unregistered = append(unregistered, "schema.WeaviateSchemaThingsPropertiesCreateHandler")
+	}
+
+	if o.SchemaWeaviateSchemaThingsPropertiesDeleteHandler == nil {
+		unregistered = append(unregistered, "schema.WeaviateSchemaThingsPropertiesDeleteHandler")
+	}
+
+	if o.SchemaWeaviateSchemaThingsPropertiesUpdateHandler == nil {
+		unregistered = append(unregistered, "schema.WeaviateSchemaThingsPropertiesUpdateHandler")
+	}
+
+	if o.SchemaWeaviateSchemaValidateHandler == nil {
+		unregistered = append(unregistered, "schema.WeaviateSchemaValidateHandler")
+	}
+
+	if o.SchemaWeaviateSchemaVocabularyConvertHandler == nil {
+		unregistered = append(unregistered, "schema.WeaviateSchemaVocabularyConvertHandler")
+	}
+
 	if o.ThingsWeaviateThingHistoryGetHandler == nil {
 		unregistered = append(unregistered, "things.WeaviateThingHistoryGetHandler")
 	}
@@ -396,6 +629,10 @@ func (o *WeaviateAPI) Validate() error {
 		unregistered = append(unregistered, "things.WeaviateThingsActionsListHandler")
 	}
 
+	if o.ThingsWeaviateThingsBatchCreateHandler == nil {
+		unregistered = append(unregistered, "things.WeaviateThingsBatchCreateHandler")
+	}
+
 	if o.ThingsWeaviateThingsCreateHandler == nil {
 		unregistered = append(unregistered, "things.WeaviateThingsCreateHandler")
 	}
@@ -546,6 +783,11 @@ func (o *WeaviateAPI) initHandlerCache() {
 	}
 	o.handlers["PUT"]["/actions/{actionId}"] = actions.NewWeaviateActionUpdate(o.context, o.ActionsWeaviateActionUpdateHandler)
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/actions/batch"] = actions.NewWeaviateActionsBatchCreate(o.context, o.ActionsWeaviateActionsBatchCreateHandler)
+
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -571,6 +813,36 @@ func (o *WeaviateAPI) initHandlerCache() {
 	}
 	o.handlers["POST"]["/actions/validate"] = actions.NewWeaviateActionsValidate(o.context, o.ActionsWeaviateActionsValidateHandler)
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/export"] = backup.NewWeaviateExport(o.context, o.BackupWeaviateExportHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/import"] = backup.NewWeaviateImport(o.context, o.BackupWeaviateImportHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/c11y/classes/{className}/neighbours"] = contextionary_api.NewWeaviateC11yClassesNeighbours(o.context, o.ContextionaryAPIWeaviateC11yClassesNeighboursHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/c11y/distance"] = contextionary_api.NewWeaviateC11yDistance(o.context, o.ContextionaryAPIWeaviateC11yDistanceHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/c11y/words/{word}"] = contextionary_api.NewWeaviateC11yWordsGet(o.context, o.ContextionaryAPIWeaviateC11yWordsGetHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/c11y/words/{word}/neighbours"] = contextionary_api.NewWeaviateC11yWordsNeighbours(o.context, o.ContextionaryAPIWeaviateC11yWordsNeighboursHandler)
+
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -611,6 +883,11 @@ func (o *WeaviateAPI) initHandlerCache() {
 	}
 	o.handlers["PUT"]["/keys/{keyId}/renew-token"] = keys.NewWeaviateKeysRenewToken(o.context, o.KeysWeaviateKeysRenewTokenHandler)
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/health"] = meta.NewWeaviateHealth(o.context, o.MetaWeaviateHealthHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -626,6 +903,91 @@ func (o *WeaviateAPI) initHandlerCache() {
 	}
 	o.handlers["GET"]["/p2p/health"] = p2_p.NewWeaviateP2pHealth(o.context, o.P2PWeaviateP2pHealthHandler)
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/actions/classes"] = schema.NewWeaviateSchemaActionsClassesCreate(o.context, o.SchemaWeaviateSchemaActionsClassesCreateHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/actions/classes/{className}"] = schema.NewWeaviateSchemaActionsClassesDelete(o.context, o.SchemaWeaviateSchemaActionsClassesDeleteHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/schema/actions/classes/{className}"] = schema.NewWeaviateSchemaActionsClassesUpdate(o.context, o.SchemaWeaviateSchemaActionsClassesUpdateHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/actions/classes/{className}/properties"] = schema.NewWeaviateSchemaActionsPropertiesCreate(o.context, o.SchemaWeaviateSchemaActionsPropertiesCreateHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/actions/classes/{className}/properties/{propertyName}"] = schema.NewWeaviateSchemaActionsPropertiesDelete(o.context, o.SchemaWeaviateSchemaActionsPropertiesDeleteHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/schema/actions/classes/{className}/properties/{propertyName}"] = schema.NewWeaviateSchemaActionsPropertiesUpdate(o.context, o.SchemaWeaviateSchemaActionsPropertiesUpdateHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/export"] = schema.NewWeaviateSchemaExport(o.context, o.SchemaWeaviateSchemaExportHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/history"] = schema.NewWeaviateSchemaHistory(o.context, o.SchemaWeaviateSchemaHistoryHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/migrations"] = schema.NewWeaviateSchemaMigrate(o.context, o.SchemaWeaviateSchemaMigrateHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/things/classes"] = schema.NewWeaviateSchemaThingsClassesCreate(o.context, o.SchemaWeaviateSchemaThingsClassesCreateHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/things/classes/{className}"] = schema.NewWeaviateSchemaThingsClassesDelete(o.context, o.SchemaWeaviateSchemaThingsClassesDeleteHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/schema/things/classes/{className}"] = schema.NewWeaviateSchemaThingsClassesUpdate(o.context, o.SchemaWeaviateSchemaThingsClassesUpdateHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/things/classes/{className}/properties"] = schema.NewWeaviateSchemaThingsPropertiesCreate(o.context, o.SchemaWeaviateSchemaThingsPropertiesCreateHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/things/classes/{className}/properties/{propertyName}"] = schema.NewWeaviateSchemaThingsPropertiesDelete(o.context, o.SchemaWeaviateSchemaThingsPropertiesDeleteHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/schema/things/classes/{className}/properties/{propertyName}"] = schema.NewWeaviateSchemaThingsPropertiesUpdate(o.context, o.SchemaWeaviateSchemaThingsPropertiesUpdateHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/validate"] = schema.NewWeaviateSchemaValidate(o.context, o.SchemaWeaviateSchemaValidateHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/vocabulary"] = schema.NewWeaviateSchemaVocabularyConvert(o.context, o.SchemaWeaviateSchemaVocabularyConvertHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -636,6 +998,11 @@ func (o *WeaviateAPI) initHandlerCache() {
 	}
 	o.handlers["GET"]["/things/{thingId}/actions"] = things.NewWeaviateThingsActionsList(o.context, o.ThingsWeaviateThingsActionsListHandler)
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/things/batch"] = things.NewWeaviateThingsBatchCreate(o.context, o.ThingsWeaviateThingsBatchCreateHandler)
+
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -0,0 +1,240 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/creativesoftwarefdn/weaviate/auth"
+	dbconnector "github.com/creativesoftwarefdn/weaviate/connectors"
+	libcontextionary "github.com/creativesoftwarefdn/weaviate/contextionary"
+	"github.com/creativesoftwarefdn/weaviate/graphqlapi"
+	"github.com/creativesoftwarefdn/weaviate/models"
+	libschema "github.com/creativesoftwarefdn/weaviate/schema"
+	"github.com/go-openapi/strfmt"
+)
+
+const (
+	// errorSchemaDataExists message, when a change of the schema would make existing data invalid
+	errorSchemaDataExists string = "the schema is not changed, because %s and there are %d %ss of class '%s'%s"
+	// errorSchemaDataNotChecked message, when the existing data could not be checked
+	errorSchemaDataNotChecked string = "could not check for existing data of class '%s': %v"
+	// errorMigrationData message, when the schema is migrated but the data is not
+	errorMigrationData string = "the schema is migrated, but the data is not: %d things and %d actions were migrated before %v; " +
+		"send the migration again to migrate the rest of the data"
+)
+
+// schemaLock guards the schema, the contextionary and the GraphQL schema, which are replaced together when the
+// schema changes. Requests take a snapshot of them with currentSchema, currentContextionary and currentGraphQL.
+var schemaLock sync.RWMutex
+
+// currentSchema returns the current schema. The classes of a schema are never changed in place; a change replaces
+// them, so the returned copy stays the same while the request uses it.
+func currentSchema() libschema.WeaviateSchema {
+	schemaLock.RLock()
+	defer schemaLock.RUnlock()
+
+	return databaseSchema
+}
+
+// currentContextionary returns the contextionary that is built for the current schema
+func currentContextionary() libcontextionary.Contextionary {
+	schemaLock.RLock()
+	defer schemaLock.RUnlock()
+
+	return *contextionary
+}
+
+// currentGraphQL returns the GraphQL schema that is built for the current schema
+func currentGraphQL() graphqlapi.GraphQL {
+	schemaLock.RLock()
+	defer schemaLock.RUnlock()
+
+	return graphQL
+}
+
+// schemaChangeError is a failure of the database or the server while the schema is changed, rather than a problem
+// of the change itself
+type schemaChangeError struct {
+	err error
+}
+
+func (e *schemaChangeError) Error() string {
+	return e.err.Error()
+}
+
+// allowedToChangeSchema tells whether the key may change the schema, which only the root key may
+func allowedToChangeSchema(ctx context.Context, principal interface{}) bool {
+	keyToken := principal.(*models.KeyTokenGetResponse)
+	allowed, _ := auth.ActionsAllowed(ctx, []string{"write"}, principal, dbConnector, nil)
+	return allowed && keyToken.IsRoot != nil && *keyToken.IsRoot
+}
+
+// changeSchema makes a change to the schema at runtime, on behalf of a key. The change is refused when it would make
+// existing data invalid, or when the contextionary or the GraphQL schema can't be built for the changed schema.
+// Otherwise the changed schema is saved as a new version, and the contextionary, the GraphQL schema and the database
+// are updated.
+func changeSchema(ctx context.Context, keyID strfmt.UUID, change libschema.Change) (*models.SchemaChange, error) {
+	var changedContextionary *libcontextionary.Contextionary
+	var changedGraphQL graphqlapi.GraphQL
+
+	// Changes are made one at a time by ChangeSchema, which only changes the schema in apply. The data is checked and
+	// the contextionary and GraphQL schema are built without the lock, so requests are not held up by them. Requests
+	// see either the old schema, contextionary and GraphQL schema, or the new ones.
+	recorded, err := databaseSchema.ChangeSchema(change, keyID, func(candidate *libschema.WeaviateSchema, affected []libschema.AffectedData) error {
+		for _, data := range affected {
+			count, err := dbConnector.CountInstances(ctx, data.Kind, data.ClassName, data.PropertyName)
+			if err != nil {
+				return &schemaChangeError{fmt.Errorf(errorSchemaDataNotChecked, data.ClassName, err)}
+			}
+
+			if count > 0 {
+				withProperty := ""
+				if data.PropertyName != "" {
+					withProperty = fmt.Sprintf(" with a value for '%s'", data.PropertyName)
+				}
+				return &libschema.ConflictError{Message: fmt.Sprintf(errorSchemaDataExists, data.Reason, count, data.Kind, data.ClassName, withProperty)}
+			}
+		}
+
+		// The names of new classes and properties must be in the contextionary
+		var err error
+		changedContextionary, err = buildContextionary(candidate)
+		if err != nil {
+			return err
+		}
+
+		changedGraphQL, err = graphqlapi.CreateSchema(&dbConnector, serverConfig, candidate, messaging)
+		if err != nil {
+			return &schemaChangeError{err}
+		}
+
+		return nil
+	}, func(replace func()) {
+		schemaLock.Lock()
+		defer schemaLock.Unlock()
+
+		replace()
+		contextionary = changedContextionary
+		graphQL = changedGraphQL
+
+		// The connector gets a copy, because the schema is changed in place by the next change
+		changed := databaseSchema
+		if err := dbConnector.SetSchema(&changed); err != nil {
+			messaging.ErrorMessage(fmt.Sprintf("Could not give the changed schema to the database connector: %v", err))
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Let the database create what it needs for new classes and properties
+	if handler, ok := dbConnector.(dbconnector.SchemaChangeHandler); ok {
+		if err := handler.SchemaChanged(ctx); err != nil {
+			return nil, &schemaChangeError{fmt.Errorf("the schema is changed, but the database could not be updated: %v", err)}
+		}
+	}
+
+	messaging.InfoMessage(fmt.Sprintf("The schema is changed to version %d", recorded.Version))
+	return recorded, nil
+}
+
+// buildContextionary extends the contextionary that is loaded from disk with the centroids of the classes and
+// properties in the schema.
+func buildContextionary(weaviateSchema *libschema.WeaviateSchema) (*libcontextionary.Contextionary, error) {
+	inMemoryContextionary, err := weaviateSchema.BuildInMemoryContextionaryFromSchema(fileContextionary)
+	if err != nil {
+		return nil, fmt.Errorf("Could not build in-memory contextionary from schema; %+v", err)
+	}
+
+	contextionaries := []libcontextionary.Contextionary{*inMemoryContextionary, *fileContextionary}
+	combined, err := libcontextionary.CombineVectorIndices(contextionaries)
+	if err != nil {
+		return nil, fmt.Errorf("Could not combine the contextionary database with the in-memory generated contextionary; %+v", err)
+	}
+
+	x := libcontextionary.Contextionary(combined)
+	return &x, nil
+}
+
+// validateSchema reports all problems of the thing and action schema against the contextionary. The current schema is
+// validated when neither is given.
+func validateSchema(things *models.SemanticSchema, actions *models.SemanticSchema) *models.SchemaValidationResponse {
+	response := &models.SchemaValidationResponse{Problems: []*models.SchemaProblem{}}
+
+	current := currentSchema()
+	weaviateSchema := &current
+	if things != nil || actions != nil {
+		proposed, err := current.ProposeSchema(things, actions)
+		if err != nil {
+			// The names of an invalid schema are checked as well, to report all problems at once
+			response.Problems = append(response.Problems, &models.SchemaProblem{
+				Severity:    libschema.LintError,
+				Message:     err.Error(),
+				Suggestions: []string{},
+			})
+
+			proposed = &libschema.WeaviateSchema{}
+			proposed.ThingSchema.Schema, proposed.ActionSchema.Schema = current.ThingSchema.Schema, current.ActionSchema.Schema
+			if things != nil {
+				proposed.ThingSchema.Schema = things
+			}
+			if actions != nil {
+				proposed.ActionSchema.Schema = actions
+			}
+		}
+		weaviateSchema = proposed
+	}
+
+	for _, problem := range weaviateSchema.Lint(*fileContextionary) {
+		response.Problems = append(response.Problems, &models.SchemaProblem{
+			Severity:    problem.Severity,
+			Kind:        problem.Kind,
+			Class:       problem.Class,
+			Property:    problem.Property,
+			Word:        problem.Word,
+			Message:     problem.Message,
+			Suggestions: problem.Suggestions,
+		})
+	}
+
+	response.Valid = true
+	for _, problem := range response.Problems {
+		response.Valid = response.Valid && problem.Severity != libschema.LintError
+	}
+
+	return response
+}
+
+// reportLintProblems logs all problems of the schema against the contextionary, and returns whether there are errors.
+func reportLintProblems() bool {
+	problems := databaseSchema.Lint(*fileContextionary)
+	for _, problem := range problems {
+		message := problem.Message
+		if len(problem.Suggestions) > 0 {
+			message += fmt.Sprintf("; did you mean: %s", strings.Join(problem.Suggestions, ", "))
+		}
+
+		if problem.Severity == libschema.LintError {
+			messaging.ErrorMessage(message)
+		} else {
+			messaging.InfoMessage("warning: " + message)
+		}
+	}
+
+	return libschema.LintErrors(problems)
+}
@@ -0,0 +1,113 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+package restapi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	connutils "github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/models"
+	libschema "github.com/creativesoftwarefdn/weaviate/schema"
+)
+
+// parseWheres compiles the where filters of a list request, e.g. 'founded>=2018-01-01T00:00:00Z', and converts their
+// values to the data type of their property. The property is looked up in the queried class, or, when no class is
+// given, in every class of the schema that has it. Arrays are filtered on one of their values.
+func parseWheres(semanticSchema *models.SemanticSchema, className string, wheres []string) ([]*connutils.WhereQuery, error) {
+	queries := []*connutils.WhereQuery{}
+
+	if className != "" {
+		class, err := libschema.GetClassByName(semanticSchema, className)
+		if err != nil {
+			return nil, err
+		}
+		semanticSchema = &models.SemanticSchema{Classes: []*models.SemanticSchemaClass{class}}
+	}
+
+	for _, where := range wheres {
+		query, err := connutils.WhereStringToStruct("schema", where)
+		if err != nil {
+			return nil, fmt.Errorf("invalid where '%s': %v", where, err)
+		}
+		query.Property = strings.TrimPrefix(query.Property, "schema.")
+
+		dataType, err := wherePropertyDataType(semanticSchema, query.Property)
+		if err != nil {
+			return nil, fmt.Errorf("invalid where '%s': %v", where, err)
+		}
+
+		query.Value.Value, err = whereValue(dataType, query.Value.Value.(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid where '%s': the value does not fit the data type '%s' of property '%s'", where, dataType, query.Property)
+		}
+
+		queries = append(queries, &query)
+	}
+
+	return queries, nil
+}
+
+// wherePropertyDataType is the data type of the values of a property with the given name in the classes of the schema.
+// The classes that have the property have to agree on its data type, otherwise the class has to be given.
+func wherePropertyDataType(semanticSchema *models.SemanticSchema, propertyName string) (libschema.DataType, error) {
+	var found libschema.DataType
+
+	if semanticSchema != nil {
+		for _, class := range semanticSchema.Classes {
+			if _, err := libschema.GetPropertyByName(class, propertyName); err != nil {
+				continue
+			}
+
+			dataType, err := libschema.GetPropertyDataType(class, propertyName)
+			if err != nil {
+				return "", err
+			}
+			if libschema.IsArrayDataType(*dataType) {
+				*dataType = libschema.ElementDataType(*dataType)
+			}
+
+			if found != "" && found != *dataType {
+				return "", fmt.Errorf("property '%s' has different data types in different classes; give the class to list", propertyName)
+			}
+			found = *dataType
+		}
+	}
+
+	if found == "" {
+		return "", fmt.Errorf("no class has a property '%s'", propertyName)
+	}
+
+	return found, nil
+}
+
+// whereValue converts the value of a where to the given data type
+func whereValue(dataType libschema.DataType, value string) (interface{}, error) {
+	switch dataType {
+	case libschema.DataTypeString:
+		return value, nil
+	case libschema.DataTypeInt:
+		return strconv.ParseInt(value, 10, 64)
+	case libschema.DataTypeNumber:
+		return strconv.ParseFloat(value, 64)
+	case libschema.DataTypeBoolean:
+		return strconv.ParseBool(value)
+	case libschema.DataTypeDate:
+		return time.Parse(time.RFC3339, value)
+	}
+
+	return nil, fmt.Errorf("values of data type '%s' can not be filtered on", dataType)
+}
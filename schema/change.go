@@ -0,0 +1,560 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/models"
+)
+
+// Serializes the changes of the schema at runtime. A change only replaces the classes and the history of the schema
+// in the apply function of ChangeSchema, so a copy that is made under the lock of the caller is never changed.
+var changeMutex sync.Mutex
+
+var (
+	validClassName    = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+	validPropertyName = regexp.MustCompile(`^[a-z][A-Za-z0-9]*$`)
+)
+
+// AffectedData is existing data that a change of the schema would make invalid.
+type AffectedData struct {
+	Kind      connutils.RefType
+	ClassName string
+	// The property whose values are affected, or empty when all instances of the class are affected
+	PropertyName string
+	// Why the data is affected, e.g. "the class is deleted"
+	Reason string
+}
+
+// A Change changes the thing or action schema at runtime. It is made on a copy of the schema, and returns the
+// existing data that the change would make invalid.
+type Change func(candidate *WeaviateSchema) ([]AffectedData, error)
+
+// NotFoundError is returned by a change of a class or property that does not exist.
+type NotFoundError struct {
+	Message string
+}
+
+func (e *NotFoundError) Error() string {
+	return e.Message
+}
+
+// ConflictError is returned by a change that conflicts with the current schema or data, e.g. when a class that
+// already exists is added.
+type ConflictError struct {
+	Message string
+}
+
+func (e *ConflictError) Error() string {
+	return e.Message
+}
+
+// ChangeSchema makes a change to the schema at runtime. The change is made on a copy of the schema, which is
+// validated. Then check can refuse it, e.g. when it would make existing data invalid. Only when check returns nil, the
+// changed schema files are saved and the change is recorded as the next version in the history; when either fails,
+// the schema files are restored. Then the changed schema replaces the current one: apply is called with the function
+// that replaces it, so that the caller can replace what it built from the candidate under the same lock. Changes are
+// made one at a time. A change that changes nothing is not recorded; the current version is returned for it.
+func (f *WeaviateSchema) ChangeSchema(change Change, keyID strfmt.UUID, check func(candidate *WeaviateSchema, affected []AffectedData) error, apply func(replace func())) (*models.SchemaChange, error) {
+	changeMutex.Lock()
+	defer changeMutex.Unlock()
+
+	candidate, affected, err := f.prepareChange(change)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := check(candidate, affected); err != nil {
+		return nil, err
+	}
+
+	history := f.history
+	if history == nil {
+		history = &schemaHistory{}
+	}
+
+	diff := diffSchemas(f, candidate)
+	if len(diff) == 0 {
+		return &models.SchemaChange{Version: history.Version, Diff: diff}, nil
+	}
+
+	// Only save the schemas that changed, so the other schema file is left as it is
+	var saved []*schemaProperties
+	for _, properties := range []struct{ current, candidate *schemaProperties }{
+		{&f.ThingSchema, &candidate.ThingSchema},
+		{&f.ActionSchema, &candidate.ActionSchema},
+	} {
+		if equalJSON(properties.current.Schema, properties.candidate.Schema) {
+			continue
+		}
+		if err := properties.candidate.save(); err != nil {
+			return nil, restoreSchemas(saved, err)
+		}
+		saved = append(saved, properties.current)
+	}
+
+	history, recorded, err := history.record(keyID, diff)
+	if err != nil {
+		return nil, restoreSchemas(saved, err)
+	}
+
+	replace := func() {
+		f.ThingSchema.Schema = candidate.ThingSchema.Schema
+		f.ActionSchema.Schema = candidate.ActionSchema.Schema
+		f.predicateDict = candidate.predicateDict
+		f.history = history
+	}
+	if apply == nil {
+		replace()
+	} else {
+		apply(replace)
+	}
+
+	return recorded, nil
+}
+
+// Save the current schemas again, after their changed versions were saved but the change could not be completed.
+func restoreSchemas(saved []*schemaProperties, err error) error {
+	for _, properties := range saved {
+		if restoreErr := properties.save(); restoreErr != nil {
+			return fmt.Errorf("%v, and the schema could not be restored; %v", err, restoreErr)
+		}
+	}
+
+	return err
+}
+
+// PreviewChange returns the differences that a change would make to the schema, without changing it.
+func (f *WeaviateSchema) PreviewChange(change Change) ([]*models.SchemaDiff, error) {
+	candidate, _, err := f.prepareChange(change)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffSchemas(f, candidate), nil
+}
+
+// Make a change on a copy of the schema, and validate it.
+func (f *WeaviateSchema) prepareChange(change Change) (*WeaviateSchema, []AffectedData, error) {
+	candidate, err := f.copy()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	affected, err := change(candidate)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, properties := range []*schemaProperties{&candidate.ThingSchema, &candidate.ActionSchema} {
+		if err := candidate.validateSchema(properties.Schema); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return candidate, affected, nil
+}
+
+// A copy of the schema, which can be changed without changing the original.
+func (f *WeaviateSchema) copy() (*WeaviateSchema, error) {
+	candidate := &WeaviateSchema{
+		ThingSchema:   f.ThingSchema,
+		ActionSchema:  f.ActionSchema,
+		predicateDict: map[string]DataType{},
+		messaging:     f.messaging,
+	}
+
+	for _, properties := range []*schemaProperties{&candidate.ThingSchema, &candidate.ActionSchema} {
+		if properties.Schema == nil {
+			properties.Schema = &models.SemanticSchema{}
+			continue
+		}
+
+		data, err := json.Marshal(properties.Schema)
+		if err != nil {
+			return nil, err
+		}
+
+		properties.Schema = &models.SemanticSchema{}
+		if err := json.Unmarshal(data, properties.Schema); err != nil {
+			return nil, err
+		}
+
+		// Changes are made to the declared classes, so that the changes of a parent class are inherited again
+		properties.Schema = declaredSchema(properties.Schema)
+	}
+
+	return candidate, nil
+}
+
+// The schema of things or actions.
+func (f *WeaviateSchema) semanticSchema(kind connutils.RefType) (*models.SemanticSchema, error) {
+	switch kind {
+	case connutils.RefTypeThing:
+		return f.ThingSchema.Schema, nil
+	case connutils.RefTypeAction:
+		return f.ActionSchema.Schema, nil
+	}
+	return nil, errors.New(ErrorInvalidRefType)
+}
+
+// Find a class of things or actions, by its name.
+func (f *WeaviateSchema) findClass(kind connutils.RefType, className string) (*models.SemanticSchema, int, error) {
+	semanticSchema, err := f.semanticSchema(kind)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for i, class := range semanticSchema.Classes {
+		if class.Class == className {
+			return semanticSchema, i, nil
+		}
+	}
+
+	return nil, 0, &NotFoundError{Message: fmt.Sprintf(ErrorNoSuchClass, className)}
+}
+
+// Whether a thing or action class with the name exists.
+func (f *WeaviateSchema) classExists(className string) bool {
+	for _, semanticSchema := range []*models.SemanticSchema{f.ThingSchema.Schema, f.ActionSchema.Schema} {
+		if _, err := GetClassByName(semanticSchema, className); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Check the names of a class and its properties, and that its references refer to existing classes.
+func (f *WeaviateSchema) checkClass(class *models.SemanticSchemaClass) error {
+	if !validClassName.MatchString(class.Class) {
+		return fmt.Errorf("the class name '%s' should start with a capital letter, and only contain letters and digits", class.Class)
+	}
+
+	names := map[string]bool{}
+	for _, property := range class.Properties {
+		if err := f.checkProperty(class, property); err != nil {
+			return err
+		}
+		if names[property.Name] {
+			return &ConflictError{Message: fmt.Sprintf("the class '%s' has more than one property with the name '%s'", class.Class, property.Name)}
+		}
+		names[property.Name] = true
+	}
+
+	return nil
+}
+
+// Check the name of a property of a class, and that its references refer to existing classes.
+func (f *WeaviateSchema) checkProperty(class *models.SemanticSchemaClass, property *models.SemanticSchemaClassProperty) error {
+	if property == nil {
+		return fmt.Errorf("the class '%s' has an empty property", class.Class)
+	}
+
+	if !validPropertyName.MatchString(property.Name) {
+		return fmt.Errorf("the property name '%s' in class '%s' should start with a lowercase letter, and only contain letters and digits", property.Name, class.Class)
+	}
+
+	for _, dataType := range property.AtDataType {
+		if dataType == "" {
+			return fmt.Errorf("the property '%s' in class '%s' has an empty data type", property.Name, class.Class)
+		}
+		if validClassName.MatchString(dataType) && dataType != class.Class && !f.classExists(dataType) {
+			return fmt.Errorf("the property '%s' in class '%s' refers to the class '%s', which does not exist", property.Name, class.Class, dataType)
+		}
+	}
+
+	return nil
+}
+
+// Whether values of the old data types may not be valid for the new data types.
+func dataTypeNarrowed(old []string, new []string) bool {
+	for _, dataType := range old {
+		found := false
+		for _, newDataType := range new {
+			found = found || newDataType == dataType
+		}
+		if !found {
+			return true
+		}
+	}
+	return false
+}
+
+// Whether the property refers to the class.
+func refersTo(property *models.SemanticSchemaClassProperty, className string) bool {
+	for _, dataType := range property.AtDataType {
+		if dataType == className {
+			return true
+		}
+	}
+	return false
+}
+
+// AddClass adds a thing or action class.
+func AddClass(kind connutils.RefType, class *models.SemanticSchemaClass) Change {
+	return func(candidate *WeaviateSchema) ([]AffectedData, error) {
+		semanticSchema, err := candidate.semanticSchema(kind)
+		if err != nil {
+			return nil, err
+		}
+
+		if candidate.classExists(class.Class) {
+			return nil, &ConflictError{Message: fmt.Sprintf("the class '%s' exists already", class.Class)}
+		}
+
+		if err := candidate.checkClass(class); err != nil {
+			return nil, err
+		}
+
+		semanticSchema.Classes = append(semanticSchema.Classes, class)
+		return nil, nil
+	}
+}
+
+// UpdateClass replaces a thing or action class. When the new class has another name, the references to the class
+// are renamed as well.
+func UpdateClass(kind connutils.RefType, className string, class *models.SemanticSchemaClass) Change {
+	return func(candidate *WeaviateSchema) ([]AffectedData, error) {
+		semanticSchema, i, err := candidate.findClass(kind, className)
+		if err != nil {
+			return nil, err
+		}
+		old := semanticSchema.Classes[i]
+
+		var affected []AffectedData
+		if class.Class != className {
+			if candidate.classExists(class.Class) {
+				return nil, &ConflictError{Message: fmt.Sprintf("the class '%s' exists already", class.Class)}
+			}
+			affected = append(affected, AffectedData{Kind: kind, ClassName: className, Reason: "the class is renamed"})
+			candidate.renameReferences(className, class.Class)
+		}
+
+		for _, oldProperty := range old.Properties {
+			property, err := GetPropertyByName(class, oldProperty.Name)
+			switch {
+			case err != nil:
+				affected = append(affected, AffectedData{Kind: kind, ClassName: className, PropertyName: oldProperty.Name, Reason: "the property is deleted"})
+			case dataTypeNarrowed(oldProperty.AtDataType, property.AtDataType):
+				affected = append(affected, AffectedData{Kind: kind, ClassName: className, PropertyName: oldProperty.Name, Reason: "the data type of the property is changed"})
+			}
+		}
+
+		for _, property := range class.Properties {
+			if property == nil {
+				continue
+			}
+			oldProperty, _ := GetPropertyByName(old, property.Name)
+			affected = append(affected, constraintsAffected(kind, className, oldProperty, property)...)
+		}
+
+		semanticSchema.Classes[i] = class
+		if err := candidate.checkClass(class); err != nil {
+			return nil, err
+		}
+
+		return affected, nil
+	}
+}
+
+// DeleteClass deletes a thing or action class. Classes that other classes refer to or extend can't be deleted.
+func DeleteClass(kind connutils.RefType, className string) Change {
+	return func(candidate *WeaviateSchema) ([]AffectedData, error) {
+		semanticSchema, i, err := candidate.findClass(kind, className)
+		if err != nil {
+			return nil, err
+		}
+
+		if subclasses := GetSubclasses(semanticSchema, className); len(subclasses) > 0 {
+			return nil, &ConflictError{Message: fmt.Sprintf("the class '%s' can't be deleted, because the class '%s' extends it", className, subclasses[0].Class)}
+		}
+
+		for _, otherSchema := range []*models.SemanticSchema{candidate.ThingSchema.Schema, candidate.ActionSchema.Schema} {
+			for _, class := range otherSchema.Classes {
+				for _, property := range class.Properties {
+					if class.Class != className && refersTo(property, className) {
+						return nil, &ConflictError{Message: fmt.Sprintf("the class '%s' can't be deleted, because the property '%s' of class '%s' refers to it", className, property.Name, class.Class)}
+					}
+				}
+			}
+		}
+
+		semanticSchema.Classes = append(semanticSchema.Classes[:i], semanticSchema.Classes[i+1:]...)
+		return []AffectedData{{Kind: kind, ClassName: className, Reason: "the class is deleted"}}, nil
+	}
+}
+
+// AddProperty adds a property to a thing or action class.
+func AddProperty(kind connutils.RefType, className string, property *models.SemanticSchemaClassProperty) Change {
+	return func(candidate *WeaviateSchema) ([]AffectedData, error) {
+		semanticSchema, i, err := candidate.findClass(kind, className)
+		if err != nil {
+			return nil, err
+		}
+		class := semanticSchema.Classes[i]
+
+		if property != nil {
+			if _, err := GetPropertyByName(class, property.Name); err == nil {
+				return nil, &ConflictError{Message: fmt.Sprintf("the class '%s' has a property '%s' already", className, property.Name)}
+			}
+		}
+
+		if err := candidate.checkProperty(class, property); err != nil {
+			return nil, err
+		}
+
+		class.Properties = append(class.Properties, property)
+		return constraintsAffected(kind, className, nil, property), nil
+	}
+}
+
+// UpdateProperty replaces a property of a thing or action class.
+func UpdateProperty(kind connutils.RefType, className string, propertyName string, property *models.SemanticSchemaClassProperty) Change {
+	return func(candidate *WeaviateSchema) ([]AffectedData, error) {
+		semanticSchema, i, err := candidate.findClass(kind, className)
+		if err != nil {
+			return nil, err
+		}
+		class := semanticSchema.Classes[i]
+
+		j, err := propertyIndex(class, propertyName)
+		if err != nil {
+			return nil, err
+		}
+		old := class.Properties[j]
+
+		if err := candidate.checkProperty(class, property); err != nil {
+			return nil, err
+		}
+
+		var affected []AffectedData
+		if property.Name != propertyName {
+			if _, err := GetPropertyByName(class, property.Name); err == nil {
+				return nil, &ConflictError{Message: fmt.Sprintf("the class '%s' has a property '%s' already", className, property.Name)}
+			}
+			affected = append(affected, AffectedData{Kind: kind, ClassName: className, PropertyName: propertyName, Reason: "the property is renamed"})
+		} else if dataTypeNarrowed(old.AtDataType, property.AtDataType) {
+			affected = append(affected, AffectedData{Kind: kind, ClassName: className, PropertyName: propertyName, Reason: "the data type of the property is changed"})
+		}
+		affected = append(affected, constraintsAffected(kind, className, old, property)...)
+
+		class.Properties[j] = property
+		return affected, nil
+	}
+}
+
+// DeleteProperty deletes a property of a thing or action class.
+func DeleteProperty(kind connutils.RefType, className string, propertyName string) Change {
+	return func(candidate *WeaviateSchema) ([]AffectedData, error) {
+		semanticSchema, i, err := candidate.findClass(kind, className)
+		if err != nil {
+			return nil, err
+		}
+		class := semanticSchema.Classes[i]
+
+		j, err := propertyIndex(class, propertyName)
+		if err != nil {
+			return nil, err
+		}
+
+		class.Properties = append(class.Properties[:j], class.Properties[j+1:]...)
+		return []AffectedData{{Kind: kind, ClassName: className, PropertyName: propertyName, Reason: "the property is deleted"}}, nil
+	}
+}
+
+// The index of a property of a class, by its name.
+func propertyIndex(class *models.SemanticSchemaClass, propertyName string) (int, error) {
+	for i, property := range class.Properties {
+		if property.Name == propertyName {
+			return i, nil
+		}
+	}
+	return 0, &NotFoundError{Message: fmt.Sprintf(ErrorNoSuchProperty, propertyName, class.Class)}
+}
+
+// Rename the references to a class in the data types of all properties, and in the classes that extend it.
+func (f *WeaviateSchema) renameReferences(oldName string, newName string) {
+	for _, semanticSchema := range []*models.SemanticSchema{f.ThingSchema.Schema, f.ActionSchema.Schema} {
+		for _, class := range semanticSchema.Classes {
+			if class.Extends == oldName {
+				class.Extends = newName
+			}
+			for _, property := range class.Properties {
+				for i, dataType := range property.AtDataType {
+					if dataType == oldName {
+						property.AtDataType[i] = newName
+					}
+				}
+			}
+		}
+	}
+}
+
+// Save the schema to the file it was loaded from, if any, without the inherited properties. A schema that was
+// downloaded is saved to its local copy, so its changes are lost on restart.
+func (p *schemaProperties) save() error {
+	if p.localFile == "" {
+		return nil
+	}
+
+	if err := writeJSONFile(p.localFile, declaredSchema(p.Schema)); err != nil {
+		return fmt.Errorf("could not save the schema to '%s'; %v", p.localFile, err)
+	}
+
+	return nil
+}
+
+// Write a value as indented JSON to a file. The value is written to a temporary file first, which replaces the file,
+// so that the file is never written halfway.
+func writeJSONFile(file string, value interface{}) error {
+	data, err := json.MarshalIndent(value, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	mode := os.FileMode(0644)
+	if info, err := os.Stat(file); err == nil {
+		mode = info.Mode()
+	}
+
+	temp, err := ioutil.TempFile(filepath.Dir(file), filepath.Base(file)+".")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name())
+
+	_, err = temp.Write(append(data, '\n'))
+	if closeErr := temp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(temp.Name(), mode)
+	}
+	if err == nil {
+		err = os.Rename(temp.Name(), file)
+	}
+
+	return err
+}
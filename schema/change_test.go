@@ -0,0 +1,234 @@
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/stretchr/testify/require"
+)
+
+// The key that changes the schema in the tests
+var testKeyID = strfmt.UUID("a6a3b9a8-2b82-4c5d-9fc5-0c4d0f8b6d43")
+
+// A schema with a city that is in a country, and a visit action.
+func testSchema() *WeaviateSchema {
+	weaviateSchema := &WeaviateSchema{}
+	weaviateSchema.ThingSchema.Schema = &models.SemanticSchema{
+		Classes: []*models.SemanticSchemaClass{
+			{
+				Class: "City",
+				Properties: []*models.SemanticSchemaClassProperty{
+					{Name: "name", AtDataType: []string{"string"}},
+					{Name: "population", AtDataType: []string{"int"}},
+					{Name: "inCountry", AtDataType: []string{"Country"}},
+				},
+			},
+			{
+				Class: "Country",
+				Properties: []*models.SemanticSchemaClassProperty{
+					{Name: "name", AtDataType: []string{"string"}},
+				},
+			},
+		},
+	}
+	weaviateSchema.ActionSchema.Schema = &models.SemanticSchema{
+		Classes: []*models.SemanticSchemaClass{
+			{
+				Class: "Visit",
+				Properties: []*models.SemanticSchemaClassProperty{
+					{Name: "toCity", AtDataType: []string{"City"}},
+				},
+			},
+		},
+	}
+	return weaviateSchema
+}
+
+// A check that accepts every change, and records the affected data.
+func acceptChange(affected *[]AffectedData) func(*WeaviateSchema, []AffectedData) error {
+	return func(candidate *WeaviateSchema, data []AffectedData) error {
+		*affected = data
+		return nil
+	}
+}
+
+func TestAddClass(t *testing.T) {
+	weaviateSchema := testSchema()
+	var affected []AffectedData
+
+	airport := &models.SemanticSchemaClass{
+		Class: "Airport",
+		Properties: []*models.SemanticSchemaClassProperty{
+			{Name: "inCity", AtDataType: []string{"City"}},
+		},
+	}
+	_, err := weaviateSchema.ChangeSchema(AddClass(connutils.RefTypeThing, airport), testKeyID, acceptChange(&affected), nil)
+	require.Nil(t, err)
+	require.Empty(t, affected)
+
+	_, err = GetClassByName(weaviateSchema.ThingSchema.Schema, "Airport")
+	require.Nil(t, err)
+
+	// Class names are unique for things and actions together
+	_, err = weaviateSchema.ChangeSchema(AddClass(connutils.RefTypeAction, &models.SemanticSchemaClass{Class: "City"}), testKeyID, acceptChange(&affected), nil)
+	require.IsType(t, &ConflictError{}, err)
+
+	_, err = weaviateSchema.ChangeSchema(AddClass(connutils.RefTypeThing, &models.SemanticSchemaClass{Class: "airport"}), testKeyID, acceptChange(&affected), nil)
+	require.NotNil(t, err)
+
+	dangling := &models.SemanticSchemaClass{
+		Class: "Harbour",
+		Properties: []*models.SemanticSchemaClassProperty{
+			{Name: "inCity", AtDataType: []string{"Town"}},
+		},
+	}
+	_, err = weaviateSchema.ChangeSchema(AddClass(connutils.RefTypeThing, dangling), testKeyID, acceptChange(&affected), nil)
+	require.NotNil(t, err)
+
+	invalid := &models.SemanticSchemaClass{
+		Class: "Harbour",
+		Properties: []*models.SemanticSchemaClassProperty{
+			{Name: "depth", AtDataType: []string{"meters"}},
+		},
+	}
+	_, err = weaviateSchema.ChangeSchema(AddClass(connutils.RefTypeThing, invalid), testKeyID, acceptChange(&affected), nil)
+	require.NotNil(t, err)
+
+	_, err = GetClassByName(weaviateSchema.ThingSchema.Schema, "Harbour")
+	require.NotNil(t, err)
+}
+
+func TestUpdateClass(t *testing.T) {
+	weaviateSchema := testSchema()
+	var affected []AffectedData
+
+	state := &models.SemanticSchemaClass{
+		Class: "State",
+		Properties: []*models.SemanticSchemaClassProperty{
+			{Name: "name", AtDataType: []string{"string"}},
+		},
+	}
+	_, err := weaviateSchema.ChangeSchema(UpdateClass(connutils.RefTypeThing, "Country", state), testKeyID, acceptChange(&affected), nil)
+	require.Nil(t, err)
+	require.Equal(t, []AffectedData{{Kind: connutils.RefTypeThing, ClassName: "Country", Reason: "the class is renamed"}}, affected)
+
+	// The references to the class are renamed too
+	city, err := GetClassByName(weaviateSchema.ThingSchema.Schema, "City")
+	require.Nil(t, err)
+	property, err := GetPropertyByName(city, "inCountry")
+	require.Nil(t, err)
+	require.Equal(t, []string{"State"}, property.AtDataType)
+
+	_, err = weaviateSchema.ChangeSchema(UpdateClass(connutils.RefTypeThing, "Country", state), testKeyID, acceptChange(&affected), nil)
+	require.IsType(t, &NotFoundError{}, err)
+}
+
+func TestDeleteClass(t *testing.T) {
+	weaviateSchema := testSchema()
+	var affected []AffectedData
+
+	_, err := weaviateSchema.ChangeSchema(DeleteClass(connutils.RefTypeThing, "Country"), testKeyID, acceptChange(&affected), nil)
+	require.IsType(t, &ConflictError{}, err)
+
+	_, err = weaviateSchema.ChangeSchema(DeleteClass(connutils.RefTypeAction, "Visit"), testKeyID, acceptChange(&affected), nil)
+	require.Nil(t, err)
+	require.Equal(t, []AffectedData{{Kind: connutils.RefTypeAction, ClassName: "Visit", Reason: "the class is deleted"}}, affected)
+	require.Empty(t, weaviateSchema.ActionSchema.Schema.Classes)
+}
+
+func TestChangeProperties(t *testing.T) {
+	weaviateSchema := testSchema()
+	var affected []AffectedData
+
+	area := &models.SemanticSchemaClassProperty{Name: "area", AtDataType: []string{"number"}}
+	_, err := weaviateSchema.ChangeSchema(AddProperty(connutils.RefTypeThing, "Country", area), testKeyID, acceptChange(&affected), nil)
+	require.Nil(t, err)
+	require.Empty(t, affected)
+
+	_, err = weaviateSchema.ChangeSchema(AddProperty(connutils.RefTypeThing, "Country", area), testKeyID, acceptChange(&affected), nil)
+	require.IsType(t, &ConflictError{}, err)
+
+	population := &models.SemanticSchemaClassProperty{Name: "population", AtDataType: []string{"number"}}
+	_, err = weaviateSchema.ChangeSchema(UpdateProperty(connutils.RefTypeThing, "City", "population", population), testKeyID, acceptChange(&affected), nil)
+	require.Nil(t, err)
+	require.Equal(t, []AffectedData{{Kind: connutils.RefTypeThing, ClassName: "City", PropertyName: "population", Reason: "the data type of the property is changed"}}, affected)
+
+	_, err = weaviateSchema.ChangeSchema(DeleteProperty(connutils.RefTypeThing, "City", "population"), testKeyID, acceptChange(&affected), nil)
+	require.Nil(t, err)
+	require.Equal(t, []AffectedData{{Kind: connutils.RefTypeThing, ClassName: "City", PropertyName: "population", Reason: "the property is deleted"}}, affected)
+
+	_, err = weaviateSchema.ChangeSchema(DeleteProperty(connutils.RefTypeThing, "City", "population"), testKeyID, acceptChange(&affected), nil)
+	require.IsType(t, &NotFoundError{}, err)
+}
+
+func TestRefusedChangeLeavesSchema(t *testing.T) {
+	weaviateSchema := testSchema()
+
+	refused := errors.New("there is data")
+	_, err := weaviateSchema.ChangeSchema(DeleteClass(connutils.RefTypeAction, "Visit"), testKeyID, func(candidate *WeaviateSchema, affected []AffectedData) error {
+		require.Empty(t, candidate.ActionSchema.Schema.Classes)
+		return refused
+	}, nil)
+	require.Equal(t, refused, err)
+	require.Len(t, weaviateSchema.ActionSchema.Schema.Classes, 1)
+}
+
+func TestChangeSavesSchema(t *testing.T) {
+	dir, err := ioutil.TempDir("", "schema")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	weaviateSchema := testSchema()
+	weaviateSchema.ThingSchema.localFile = filepath.Join(dir, "things.json")
+	weaviateSchema.ActionSchema.localFile = filepath.Join(dir, "actions.json")
+
+	_, err = weaviateSchema.ChangeSchema(AddClass(connutils.RefTypeThing, &models.SemanticSchemaClass{Class: "Airport"}), testKeyID, func(*WeaviateSchema, []AffectedData) error {
+		return nil
+	}, nil)
+	require.Nil(t, err)
+
+	data, err := ioutil.ReadFile(weaviateSchema.ThingSchema.localFile)
+	require.Nil(t, err)
+	saved := &models.SemanticSchema{}
+	require.Nil(t, json.Unmarshal(data, saved))
+	_, err = GetClassByName(saved, "Airport")
+	require.Nil(t, err)
+
+	// The action schema did not change, so it is not written
+	_, err = os.Stat(weaviateSchema.ActionSchema.localFile)
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestFailedHistoryRestoresSchema(t *testing.T) {
+	dir, err := ioutil.TempDir("", "schema")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	weaviateSchema := testSchema()
+	weaviateSchema.ThingSchema.localFile = filepath.Join(dir, "things.json")
+	weaviateSchema.history = &schemaHistory{file: filepath.Join(dir, "missing", "history.json")}
+
+	_, err = weaviateSchema.ChangeSchema(AddClass(connutils.RefTypeThing, &models.SemanticSchemaClass{Class: "Airport"}), testKeyID, func(*WeaviateSchema, []AffectedData) error {
+		return nil
+	}, func(replace func()) {
+		t.Fatal("the schema is replaced, but the history is not saved")
+	})
+	require.NotNil(t, err)
+
+	data, err := ioutil.ReadFile(weaviateSchema.ThingSchema.localFile)
+	require.Nil(t, err)
+	saved := &models.SemanticSchema{}
+	require.Nil(t, json.Unmarshal(data, saved))
+	_, err = GetClassByName(saved, "Airport")
+	require.NotNil(t, err)
+	_, err = GetClassByName(weaviateSchema.ThingSchema.Schema, "Airport")
+	require.NotNil(t, err)
+}
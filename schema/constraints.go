@@ -0,0 +1,155 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/models"
+)
+
+// Check that the constraints of a property fit its data type, and that they can be met. The constraints on values
+// of a list apply to each of its values.
+func checkConstraints(class *models.SemanticSchemaClass, property *models.SemanticSchemaClassProperty, dataType DataType) error {
+	constraints := property.Constraints
+	if constraints == nil {
+		return nil
+	}
+
+	if IsArrayDataType(dataType) && constraints.Unique {
+		return fmt.Errorf("the constraint 'unique' of property '%s' in class '%s' is not allowed for the data type '%s'", property.Name, class.Class, dataType)
+	}
+	elementDataType := ElementDataType(dataType)
+
+	// The constraints and the data types that they are allowed for
+	allowed := []struct {
+		name      string
+		set       bool
+		dataTypes []DataType
+	}{
+		{"minimum", constraints.Minimum != nil, []DataType{DataTypeInt, DataTypeNumber}},
+		{"maximum", constraints.Maximum != nil, []DataType{DataTypeInt, DataTypeNumber}},
+		{"minLength", constraints.MinLength != nil, []DataType{DataTypeString}},
+		{"maxLength", constraints.MaxLength != nil, []DataType{DataTypeString}},
+		{"pattern", constraints.Pattern != "", []DataType{DataTypeString}},
+		{"enum", constraints.Enum != nil, []DataType{DataTypeString, DataTypeInt, DataTypeNumber}},
+		{"unique", constraints.Unique, []DataType{DataTypeString, DataTypeInt, DataTypeNumber, DataTypeDate}},
+		{"cardinality", constraints.Cardinality != "", []DataType{DataTypeCRef}},
+	}
+	for _, constraint := range allowed {
+		if constraint.set && !containsDataType(constraint.dataTypes, elementDataType) {
+			return fmt.Errorf("the constraint '%s' of property '%s' in class '%s' is not allowed for the data type '%s'", constraint.name, property.Name, class.Class, dataType)
+		}
+	}
+
+	if constraints.Minimum != nil && constraints.Maximum != nil && *constraints.Minimum > *constraints.Maximum {
+		return fmt.Errorf("the property '%s' in class '%s' has a minimum that is greater than its maximum", property.Name, class.Class)
+	}
+
+	if (constraints.MinLength != nil && *constraints.MinLength < 0) || (constraints.MaxLength != nil && *constraints.MaxLength < 0) {
+		return fmt.Errorf("the property '%s' in class '%s' has a negative minLength or maxLength", property.Name, class.Class)
+	}
+
+	if constraints.MinLength != nil && constraints.MaxLength != nil && *constraints.MinLength > *constraints.MaxLength {
+		return fmt.Errorf("the property '%s' in class '%s' has a minLength that is greater than its maxLength", property.Name, class.Class)
+	}
+
+	if constraints.Pattern != "" {
+		if _, err := regexp.Compile(constraints.Pattern); err != nil {
+			return fmt.Errorf("the pattern of property '%s' in class '%s' is not a valid regular expression; %v", property.Name, class.Class, err)
+		}
+	}
+
+	if constraints.Enum != nil && len(constraints.Enum) == 0 {
+		return fmt.Errorf("the property '%s' in class '%s' has an empty enum, so no value is allowed", property.Name, class.Class)
+	}
+
+	for _, value := range constraints.Enum {
+		var ok bool
+		switch elementDataType {
+		case DataTypeString:
+			_, ok = value.(string)
+		case DataTypeInt:
+			var number float64
+			number, ok = NumberValue(value)
+			ok = ok && number == float64(int64(number))
+		case DataTypeNumber:
+			_, ok = NumberValue(value)
+		}
+		if !ok {
+			return fmt.Errorf("the enum of property '%s' in class '%s' has the value '%v', which is not of the data type '%s'", property.Name, class.Class, value, dataType)
+		}
+	}
+
+	return nil
+}
+
+// NumberValue returns the value of a number that is decoded from JSON, or that is converted by the validation.
+func NumberValue(value interface{}) (float64, bool) {
+	switch number := value.(type) {
+	case float64:
+		return number, true
+	case float32:
+		return float64(number), true
+	case int64:
+		return float64(number), true
+	case int:
+		return float64(number), true
+	case json.Number:
+		f, err := number.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func containsDataType(dataTypes []DataType, dataType DataType) bool {
+	for _, d := range dataTypes {
+		if d == dataType {
+			return true
+		}
+	}
+	return false
+}
+
+// The existing data that a change of the constraints of a property may make invalid. A property that becomes
+// required affects every instance of the class, other constraints only affect the instances with a value. Removing
+// constraints never affects any data. The old property is nil when the property is added.
+func constraintsAffected(kind connutils.RefType, className string, old *models.SemanticSchemaClassProperty, new *models.SemanticSchemaClassProperty) []AffectedData {
+	if new.Constraints == nil {
+		return nil
+	}
+
+	oldConstraints := &models.SemanticSchemaClassPropertyConstraints{}
+	if old != nil && old.Constraints != nil {
+		oldConstraints = old.Constraints
+	}
+
+	var affected []AffectedData
+	if new.Constraints.Required && !oldConstraints.Required {
+		affected = append(affected, AffectedData{Kind: kind, ClassName: className, Reason: fmt.Sprintf("the property '%s' is made required", new.Name)})
+	}
+
+	// Compare the constraints on the values, so without required
+	oldValues, newValues := *oldConstraints, *new.Constraints
+	oldValues.Required, newValues.Required = false, false
+	noValues := models.SemanticSchemaClassPropertyConstraints{}
+	if old != nil && !equalJSON(oldValues, newValues) && !equalJSON(newValues, noValues) {
+		affected = append(affected, AffectedData{Kind: kind, ClassName: className, PropertyName: new.Name, Reason: "the constraints of the property are changed"})
+	}
+
+	return affected
+}
@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/stretchr/testify/require"
+)
+
+func float64Pointer(f float64) *float64 {
+	return &f
+}
+
+func int64Pointer(i int64) *int64 {
+	return &i
+}
+
+func TestConstraintsFitDataType(t *testing.T) {
+	valid := []*models.SemanticSchemaClassProperty{
+		{Name: "area", AtDataType: []string{"number"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Minimum: float64Pointer(0), Maximum: float64Pointer(1000)}},
+		{Name: "code", AtDataType: []string{"string"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{MinLength: int64Pointer(2), MaxLength: int64Pointer(2), Pattern: "^[A-Z]+$", Unique: true}},
+		{Name: "rank", AtDataType: []string{"int"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Enum: []interface{}{float64(1), float64(2)}}},
+		{Name: "capital", AtDataType: []string{"City"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Required: true, Cardinality: "oneToOne"}},
+		{Name: "codes", AtDataType: []string{"string[]"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Required: true, MaxLength: int64Pointer(2), Pattern: "^[A-Z]+$"}},
+		{Name: "ranks", AtDataType: []string{"int[]"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Minimum: float64Pointer(1), Enum: []interface{}{float64(1), float64(2)}}},
+		{Name: "location", AtDataType: []string{"geoCoordinates"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Required: true}},
+	}
+	for _, property := range valid {
+		_, err := testSchema().ChangeSchema(AddProperty(connutils.RefTypeThing, "Country", property), testKeyID, func(*WeaviateSchema, []AffectedData) error {
+			return nil
+		}, nil)
+		require.Nil(t, err, property.Name)
+	}
+
+	invalid := []*models.SemanticSchemaClassProperty{
+		{Name: "area", AtDataType: []string{"number"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Minimum: float64Pointer(10), Maximum: float64Pointer(1)}},
+		{Name: "area", AtDataType: []string{"number"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Pattern: "^[0-9]+$"}},
+		{Name: "code", AtDataType: []string{"string"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Pattern: "[A-Z"}},
+		{Name: "code", AtDataType: []string{"string"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{MaxLength: int64Pointer(-1)}},
+		{Name: "code", AtDataType: []string{"string"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Enum: []interface{}{}}},
+		{Name: "rank", AtDataType: []string{"int"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Enum: []interface{}{float64(1.5)}}},
+		{Name: "member", AtDataType: []string{"boolean"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Unique: true}},
+		{Name: "capital", AtDataType: []string{"City"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Unique: true}},
+		{Name: "name2", AtDataType: []string{"string"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Cardinality: "oneToOne"}},
+		{Name: "codes", AtDataType: []string{"string[]"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Unique: true}},
+		{Name: "ranks", AtDataType: []string{"int[]"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{MinLength: int64Pointer(1)}},
+		{Name: "location", AtDataType: []string{"geoCoordinates"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Unique: true}},
+		{Name: "location", AtDataType: []string{"geoCoordinates"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Minimum: float64Pointer(0)}},
+	}
+	for _, property := range invalid {
+		_, err := testSchema().ChangeSchema(AddProperty(connutils.RefTypeThing, "Country", property), testKeyID, func(*WeaviateSchema, []AffectedData) error {
+			return nil
+		}, nil)
+		require.NotNil(t, err, property.Name)
+	}
+}
+
+func TestChangedConstraintsAffectData(t *testing.T) {
+	weaviateSchema := testSchema()
+	var affected []AffectedData
+
+	// A new required property affects every city
+	area := &models.SemanticSchemaClassProperty{Name: "area", AtDataType: []string{"number"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Required: true}}
+	_, err := weaviateSchema.ChangeSchema(AddProperty(connutils.RefTypeThing, "City", area), testKeyID, acceptChange(&affected), nil)
+	require.Nil(t, err)
+	require.Equal(t, []AffectedData{{Kind: connutils.RefTypeThing, ClassName: "City", Reason: "the property 'area' is made required"}}, affected)
+
+	// A new maximum only affects the cities with a population
+	population := &models.SemanticSchemaClassProperty{Name: "population", AtDataType: []string{"int"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Maximum: float64Pointer(1e7)}}
+	_, err = weaviateSchema.ChangeSchema(UpdateProperty(connutils.RefTypeThing, "City", "population", population), testKeyID, acceptChange(&affected), nil)
+	require.Nil(t, err)
+	require.Equal(t, []AffectedData{{Kind: connutils.RefTypeThing, ClassName: "City", PropertyName: "population", Reason: "the constraints of the property are changed"}}, affected)
+
+	// Removing the constraints affects no data
+	population = &models.SemanticSchemaClassProperty{Name: "population", AtDataType: []string{"int"}}
+	_, err = weaviateSchema.ChangeSchema(UpdateProperty(connutils.RefTypeThing, "City", "population", population), testKeyID, acceptChange(&affected), nil)
+	require.Nil(t, err)
+	require.Empty(t, affected)
+}
@@ -13,14 +13,15 @@ import (
 )
 
 func (f *WeaviateSchema) BuildInMemoryContextionaryFromSchema(context *libcontextionary.Contextionary) (*libcontextionary.Contextionary, error) {
-	in_memory_builder := libcontextionary.InMemoryBuilder((*context).GetVectorLength())
+	// The centroids are computed and compared with the metric of the contextionary they are combined with
+	in_memory_builder := libcontextionary.InMemoryBuilderWithDistanceMetric((*context).GetVectorLength(), (*context).GetDistanceMetric())
 
-	err := add_names_from_schema_properties(context, in_memory_builder, "THING", f.ActionSchema.Schema)
+	err := add_names_from_schema_properties(context, in_memory_builder, "THING", f.ThingSchema.Schema)
 	if err != nil {
 		return nil, err
 	}
 
-	err = add_names_from_schema_properties(context, in_memory_builder, "ACTION", f.ThingSchema.Schema)
+	err = add_names_from_schema_properties(context, in_memory_builder, "ACTION", f.ActionSchema.Schema)
 	if err != nil {
 		return nil, err
 	}
@@ -32,6 +33,8 @@ func (f *WeaviateSchema) BuildInMemoryContextionaryFromSchema(context *libcontex
 
 // This function adds words in the form of $THING[Blurp]
 func add_names_from_schema_properties(context *libcontextionary.Contextionary, in_memory_builder *libcontextionary.MemoryIndexBuilder, kind string, schema *models.SemanticSchema) error {
+	metric := (*context).GetDistanceMetric()
+
 	for _, class := range schema.Classes {
 		class_centroid_name := fmt.Sprintf("$%v[%v]", kind, class.Class)
 
@@ -58,7 +61,7 @@ func add_names_from_schema_properties(context *libcontextionary.Contextionary, i
 				}
 			}
 
-			centroid, err := libcontextionary.ComputeWeightedCentroid(vectors, weights)
+			centroid, err := metric.ComputeWeightedCentroid(vectors, weights)
 			if err != nil {
 				return fmt.Errorf("Could not compute centroid")
 			} else {
@@ -85,7 +88,7 @@ func add_names_from_schema_properties(context *libcontextionary.Contextionary, i
 				}
 			}
 
-			centroid, err := libcontextionary.ComputeCentroid(vectors)
+			centroid, err := metric.ComputeCentroid(vectors)
 			if err != nil {
 				return fmt.Errorf("Could not compute centroid")
 			} else {
@@ -121,7 +124,7 @@ func add_names_from_schema_properties(context *libcontextionary.Contextionary, i
 					}
 				}
 
-				centroid, err := libcontextionary.ComputeWeightedCentroid(vectors, weights)
+				centroid, err := metric.ComputeWeightedCentroid(vectors, weights)
 				if err != nil {
 					return fmt.Errorf("Could not compute centroid")
 				} else {
@@ -148,7 +151,7 @@ func add_names_from_schema_properties(context *libcontextionary.Contextionary, i
 					}
 				}
 
-				centroid, err := libcontextionary.ComputeCentroid(vectors)
+				centroid, err := metric.ComputeCentroid(vectors)
 				if err != nil {
 					return fmt.Errorf("Could not compute centroid")
 				} else {
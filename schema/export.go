@@ -0,0 +1,195 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+package schema
+
+// This file exports the thing and action schema as JSON Schema documents and OpenAPI definitions, which typed models
+// can be generated from.
+
+import (
+	"github.com/creativesoftwarefdn/weaviate/models"
+)
+
+const jsonSchemaVersion = "http://json-schema.org/draft-07/schema#"
+
+// The definitions that the schemas of the classes refer to, like they are defined in the OpenAPI specification.
+var exportDefinitions = map[string]map[string]interface{}{
+	"SingleRef": {
+		"type": "object",
+		"properties": map[string]interface{}{
+			"$cref":       map[string]interface{}{"type": "string", "format": "uuid", "description": "Location of the cross reference."},
+			"locationUrl": map[string]interface{}{"type": "string", "format": "url", "description": "url of location. http://localhost means this database. This option can be used to refer to other databases."},
+			"type":        map[string]interface{}{"type": "string", "enum": []string{"Thing", "Action", "Key"}, "description": "Type should be Thing, Action or Key"},
+		},
+		"required": []string{"$cref", "locationUrl", "type"},
+	},
+	"GeoCoordinates": {
+		"type":        "object",
+		"description": "A location on earth, as the value of a geoCoordinates property.",
+		"properties": map[string]interface{}{
+			"latitude":  map[string]interface{}{"type": "number", "minimum": -90, "maximum": 90, "description": "The latitude of the location, from -90 to 90 degrees."},
+			"longitude": map[string]interface{}{"type": "number", "minimum": -180, "maximum": 180, "description": "The longitude of the location, from -180 to 180 degrees."},
+		},
+		"required": []string{"latitude", "longitude"},
+	},
+}
+
+// ExportJSONSchema returns a JSON Schema document for the schema of the things and the actions of every class, by
+// class name.
+func (f *WeaviateSchema) ExportJSONSchema() map[string]map[string]interface{} {
+	export := map[string]map[string]interface{}{
+		"things":  {},
+		"actions": {},
+	}
+
+	for kind, semanticSchema := range map[string]*models.SemanticSchema{"things": f.ThingSchema.Schema, "actions": f.ActionSchema.Schema} {
+		for _, class := range semanticSchema.Classes {
+			document, used := classSchema(class)
+			document["$schema"] = jsonSchemaVersion
+
+			definitions := map[string]interface{}{}
+			for name := range used {
+				definitions[name] = exportDefinitions[name]
+			}
+			if len(definitions) > 0 {
+				document["definitions"] = definitions
+			}
+
+			export[kind][class.Class] = document
+		}
+	}
+
+	return export
+}
+
+// ExportOpenAPIDefinitions returns the OpenAPI definitions of the schema of the things and actions of every class,
+// named "<Class>Schema", and the definitions they refer to.
+func (f *WeaviateSchema) ExportOpenAPIDefinitions() map[string]interface{} {
+	definitions := map[string]interface{}{}
+
+	for _, semanticSchema := range []*models.SemanticSchema{f.ThingSchema.Schema, f.ActionSchema.Schema} {
+		for _, class := range semanticSchema.Classes {
+			definition, used := classSchema(class)
+			definitions[class.Class+"Schema"] = definition
+			for name := range used {
+				definitions[name] = exportDefinitions[name]
+			}
+		}
+	}
+
+	return definitions
+}
+
+// The schema of the values of the properties of a class, and the names of the definitions that it refers to.
+func classSchema(class *models.SemanticSchemaClass) (map[string]interface{}, map[string]bool) {
+	properties := map[string]interface{}{}
+	required := []string{}
+	used := map[string]bool{}
+
+	for _, property := range class.Properties {
+		dataType, err := GetPropertyDataType(class, property.Name)
+		if err != nil {
+			continue
+		}
+
+		properties[property.Name] = propertySchema(property, *dataType, used)
+		if property.Constraints != nil && property.Constraints.Required {
+			required = append(required, property.Name)
+		}
+	}
+
+	document := map[string]interface{}{
+		"title":                class.Class,
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if class.Description != "" {
+		document["description"] = class.Description
+	}
+	if len(required) > 0 {
+		document["required"] = required
+	}
+
+	return document, used
+}
+
+// The schema of the value of a property. The constraints of an array property apply to each of its values.
+func propertySchema(property *models.SemanticSchemaClassProperty, dataType DataType, used map[string]bool) map[string]interface{} {
+	if dataType == DataTypeCRef {
+		used["SingleRef"] = true
+		return map[string]interface{}{"$ref": "#/definitions/SingleRef"}
+	}
+
+	var schema map[string]interface{}
+	if IsArrayDataType(dataType) {
+		items := valueSchema(ElementDataType(dataType), property.Constraints)
+		schema = map[string]interface{}{"type": "array", "items": items}
+		if property.Constraints != nil && property.Constraints.Required {
+			schema["minItems"] = 1
+		}
+	} else if dataType == DataTypeGeoCoordinates {
+		used["GeoCoordinates"] = true
+		return map[string]interface{}{"$ref": "#/definitions/GeoCoordinates"}
+	} else {
+		schema = valueSchema(dataType, property.Constraints)
+	}
+
+	if property.Description != "" {
+		schema["description"] = property.Description
+	}
+	return schema
+}
+
+// The schema of a single value of a data type, with the constraints on it.
+func valueSchema(dataType DataType, constraints *models.SemanticSchemaClassPropertyConstraints) map[string]interface{} {
+	schema := map[string]interface{}{}
+	switch dataType {
+	case DataTypeString:
+		schema["type"] = "string"
+	case DataTypeInt:
+		schema["type"] = "integer"
+		schema["format"] = "int64"
+	case DataTypeNumber:
+		schema["type"] = "number"
+	case DataTypeBoolean:
+		schema["type"] = "boolean"
+	case DataTypeDate:
+		schema["type"] = "string"
+		schema["format"] = "date-time"
+	}
+
+	if constraints == nil {
+		return schema
+	}
+	if constraints.Minimum != nil {
+		schema["minimum"] = *constraints.Minimum
+	}
+	if constraints.Maximum != nil {
+		schema["maximum"] = *constraints.Maximum
+	}
+	if constraints.MinLength != nil {
+		schema["minLength"] = *constraints.MinLength
+	}
+	if constraints.MaxLength != nil {
+		schema["maxLength"] = *constraints.MaxLength
+	}
+	if constraints.Pattern != "" {
+		schema["pattern"] = constraints.Pattern
+	}
+	if len(constraints.Enum) > 0 {
+		schema["enum"] = constraints.Enum
+	}
+
+	return schema
+}
@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/stretchr/testify/require"
+)
+
+func exportSchema() *WeaviateSchema {
+	minimum, maxLength := float64(0), int64(64)
+	weaviateSchema := testSchema()
+	city := weaviateSchema.ThingSchema.Schema.Classes[0]
+	city.Description = "A city"
+	city.Properties = append(city.Properties,
+		&models.SemanticSchemaClassProperty{Name: "founded", AtDataType: []string{"date"}, Description: "When the city was founded"},
+		&models.SemanticSchemaClassProperty{Name: "location", AtDataType: []string{"geoCoordinates"}},
+		&models.SemanticSchemaClassProperty{Name: "postcodes", AtDataType: []string{"string[]"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Required: true, MaxLength: &maxLength}},
+	)
+	city.Properties[0].Constraints = &models.SemanticSchemaClassPropertyConstraints{Required: true}
+	city.Properties[1].Constraints = &models.SemanticSchemaClassPropertyConstraints{Minimum: &minimum}
+	return weaviateSchema
+}
+
+func requireJSON(t *testing.T, expected string, actual interface{}) {
+	encoded, err := json.Marshal(actual)
+	require.Nil(t, err)
+	require.JSONEq(t, expected, string(encoded))
+}
+
+func TestExportJSONSchema(t *testing.T) {
+	export := exportSchema().ExportJSONSchema()
+
+	require.Len(t, export["things"], 2)
+	require.Len(t, export["actions"], 1)
+	requireJSON(t, `{
+		"$schema": "http://json-schema.org/draft-07/schema#",
+		"title": "City",
+		"description": "A city",
+		"type": "object",
+		"properties": {
+			"name": {"type": "string"},
+			"population": {"type": "integer", "format": "int64", "minimum": 0},
+			"inCountry": {"$ref": "#/definitions/SingleRef"},
+			"founded": {"type": "string", "format": "date-time", "description": "When the city was founded"},
+			"location": {"$ref": "#/definitions/GeoCoordinates"},
+			"postcodes": {"type": "array", "items": {"type": "string", "maxLength": 64}, "minItems": 1}
+		},
+		"required": ["name", "postcodes"],
+		"additionalProperties": false,
+		"definitions": {
+			"SingleRef": {
+				"type": "object",
+				"properties": {
+					"$cref": {"type": "string", "format": "uuid", "description": "Location of the cross reference."},
+					"locationUrl": {"type": "string", "format": "url", "description": "url of location. http://localhost means this database. This option can be used to refer to other databases."},
+					"type": {"type": "string", "enum": ["Thing", "Action", "Key"], "description": "Type should be Thing, Action or Key"}
+				},
+				"required": ["$cref", "locationUrl", "type"]
+			},
+			"GeoCoordinates": {
+				"type": "object",
+				"description": "A location on earth, as the value of a geoCoordinates property.",
+				"properties": {
+					"latitude": {"type": "number", "minimum": -90, "maximum": 90, "description": "The latitude of the location, from -90 to 90 degrees."},
+					"longitude": {"type": "number", "minimum": -180, "maximum": 180, "description": "The longitude of the location, from -180 to 180 degrees."}
+				},
+				"required": ["latitude", "longitude"]
+			}
+		}
+	}`, export["things"]["City"])
+
+	// Only the definitions that a class refers to are in its document
+	country := export["things"]["Country"].(map[string]interface{})
+	require.Nil(t, country["definitions"])
+	require.Nil(t, country["required"])
+}
+
+func TestExportOpenAPIDefinitions(t *testing.T) {
+	definitions := exportSchema().ExportOpenAPIDefinitions()
+
+	names := []string{}
+	for name := range definitions {
+		names = append(names, name)
+	}
+	require.ElementsMatch(t, []string{"CitySchema", "CountrySchema", "VisitSchema", "SingleRef", "GeoCoordinates"}, names)
+
+	requireJSON(t, `{
+		"title": "Visit",
+		"type": "object",
+		"properties": {
+			"toCity": {"$ref": "#/definitions/SingleRef"}
+		},
+		"additionalProperties": false
+	}`, definitions["VisitSchema"])
+}
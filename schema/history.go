@@ -0,0 +1,175 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/models"
+)
+
+// defaultHistoryFile is the file with the history of the schema, next to the thing schema, unless the config gives
+// another file
+const defaultHistoryFile string = "schema_history.json"
+
+// The changes of the schema that are made at runtime, and the file they are saved to.
+type schemaHistory struct {
+	models.SchemaHistory
+	file string
+}
+
+// Load the history from a file. A file that does not exist holds an empty history.
+func loadHistory(file string) (*schemaHistory, error) {
+	history := &schemaHistory{file: file}
+
+	data, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return history, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &history.SchemaHistory); err != nil {
+		return nil, fmt.Errorf("could not parse the schema history '%s'; %v", file, err)
+	}
+
+	return history, nil
+}
+
+// Record a change as the next version of the schema, and save the history. The history itself is not changed; the
+// history with the change is returned, to replace it along with the schema.
+func (h *schemaHistory) record(keyID strfmt.UUID, diff []*models.SchemaDiff) (*schemaHistory, *models.SchemaChange, error) {
+	change := &models.SchemaChange{
+		Version:          h.Version + 1,
+		KeyID:            keyID,
+		CreationTimeUnix: connutils.NowUnix(),
+		Diff:             diff,
+	}
+
+	changes := append(append([]*models.SchemaChange{}, h.Changes...), change)
+	if h.file != "" {
+		if err := writeJSONFile(h.file, &models.SchemaHistory{Version: change.Version, Changes: changes}); err != nil {
+			return nil, nil, fmt.Errorf("could not save the schema history to '%s'; %v", h.file, err)
+		}
+	}
+
+	recorded := &schemaHistory{file: h.file}
+	recorded.Version = change.Version
+	recorded.Changes = changes
+	return recorded, change, nil
+}
+
+// History returns the changes of the schema that were made at runtime, oldest first.
+func (f *WeaviateSchema) History() *models.SchemaHistory {
+	history := &models.SchemaHistory{Changes: []*models.SchemaChange{}}
+	if f.history != nil {
+		history.Version = f.history.Version
+		history.Changes = append(history.Changes, f.history.Changes...)
+	}
+
+	return history
+}
+
+// Version returns the current version of the schema. The schema files that weaviate started with are version 0.
+func (f *WeaviateSchema) Version() int64 {
+	if f.history == nil {
+		return 0
+	}
+	return f.history.Version
+}
+
+// The classes and properties that differ between two versions of the schema.
+func diffSchemas(old *WeaviateSchema, new *WeaviateSchema) []*models.SchemaDiff {
+	diff := diffSemanticSchemas("/things", old.ThingSchema.Schema, new.ThingSchema.Schema)
+	return append(diff, diffSemanticSchemas("/actions", old.ActionSchema.Schema, new.ActionSchema.Schema)...)
+}
+
+func diffSemanticSchemas(path string, old *models.SemanticSchema, new *models.SemanticSchema) []*models.SchemaDiff {
+	diff := []*models.SchemaDiff{}
+	oldClasses, newClasses := classesOf(old), classesOf(new)
+
+	for _, oldClass := range oldClasses {
+		classPath := path + "/" + oldClass.Class
+
+		newClass := classByName(newClasses, oldClass.Class)
+		if newClass == nil {
+			diff = append(diff, &models.SchemaDiff{Op: models.SchemaDiffOpRemove, Path: classPath, Old: oldClass})
+			continue
+		}
+
+		// The class itself, without its properties, which are compared one by one
+		oldBare, newBare := *oldClass, *newClass
+		oldBare.Properties, newBare.Properties = nil, nil
+		if !equalJSON(oldBare, newBare) {
+			diff = append(diff, &models.SchemaDiff{Op: models.SchemaDiffOpReplace, Path: classPath, Old: &oldBare, New: &newBare})
+		}
+
+		for _, oldProperty := range oldClass.Properties {
+			propertyPath := classPath + "/" + oldProperty.Name
+
+			newProperty, err := GetPropertyByName(newClass, oldProperty.Name)
+			switch {
+			case err != nil:
+				diff = append(diff, &models.SchemaDiff{Op: models.SchemaDiffOpRemove, Path: propertyPath, Old: oldProperty})
+			case !equalJSON(oldProperty, newProperty):
+				diff = append(diff, &models.SchemaDiff{Op: models.SchemaDiffOpReplace, Path: propertyPath, Old: oldProperty, New: newProperty})
+			}
+		}
+
+		for _, newProperty := range newClass.Properties {
+			if _, err := GetPropertyByName(oldClass, newProperty.Name); err != nil {
+				diff = append(diff, &models.SchemaDiff{Op: models.SchemaDiffOpAdd, Path: classPath + "/" + newProperty.Name, New: newProperty})
+			}
+		}
+	}
+
+	for _, newClass := range newClasses {
+		if classByName(oldClasses, newClass.Class) == nil {
+			diff = append(diff, &models.SchemaDiff{Op: models.SchemaDiffOpAdd, Path: path + "/" + newClass.Class, New: newClass})
+		}
+	}
+
+	return diff
+}
+
+// The classes of a schema, which may be nil.
+func classesOf(semanticSchema *models.SemanticSchema) []*models.SemanticSchemaClass {
+	if semanticSchema == nil {
+		return nil
+	}
+	return semanticSchema.Classes
+}
+
+func classByName(classes []*models.SemanticSchemaClass, className string) *models.SemanticSchemaClass {
+	for _, class := range classes {
+		if class.Class == className {
+			return class
+		}
+	}
+	return nil
+}
+
+// Whether two values have the same JSON representation.
+func equalJSON(a interface{}, b interface{}) bool {
+	aJSON, aErr := json.Marshal(a)
+	bJSON, bErr := json.Marshal(b)
+	return aErr == nil && bErr == nil && string(aJSON) == string(bJSON)
+}
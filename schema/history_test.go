@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "schema")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	weaviateSchema := testSchema()
+	weaviateSchema.history, err = loadHistory(filepath.Join(dir, defaultHistoryFile))
+	require.Nil(t, err)
+	require.Equal(t, int64(0), weaviateSchema.Version())
+
+	accept := func(*WeaviateSchema, []AffectedData) error { return nil }
+
+	area := &models.SemanticSchemaClassProperty{Name: "area", AtDataType: []string{"number"}}
+	change, err := weaviateSchema.ChangeSchema(AddProperty(connutils.RefTypeThing, "City", area), testKeyID, accept, nil)
+	require.Nil(t, err)
+	require.Equal(t, int64(1), change.Version)
+	require.Equal(t, testKeyID, change.KeyID)
+	require.Equal(t, []*models.SchemaDiff{{Op: models.SchemaDiffOpAdd, Path: "/things/City/area", New: area}}, change.Diff)
+
+	change, err = weaviateSchema.ChangeSchema(DeleteClass(connutils.RefTypeAction, "Visit"), testKeyID, accept, nil)
+	require.Nil(t, err)
+	require.Equal(t, int64(2), change.Version)
+	require.Len(t, change.Diff, 1)
+	require.Equal(t, models.SchemaDiffOpRemove, change.Diff[0].Op)
+	require.Equal(t, "/actions/Visit", change.Diff[0].Path)
+
+	// A change that changes nothing is not recorded
+	change, err = weaviateSchema.ChangeSchema(Changes(), testKeyID, accept, nil)
+	require.Nil(t, err)
+	require.Equal(t, int64(2), change.Version)
+
+	history := weaviateSchema.History()
+	require.Equal(t, int64(2), history.Version)
+	require.Len(t, history.Changes, 2)
+
+	// The history is saved, so the versions continue after a restart
+	loaded, err := loadHistory(filepath.Join(dir, defaultHistoryFile))
+	require.Nil(t, err)
+	require.Equal(t, int64(2), loaded.Version)
+	require.Len(t, loaded.Changes, 2)
+	require.Equal(t, "/things/City/area", loaded.Changes[0].Diff[0].Path)
+}
+
+func TestPreviewChange(t *testing.T) {
+	weaviateSchema := testSchema()
+
+	diff, err := weaviateSchema.PreviewChange(RenameProperty(connutils.RefTypeThing, "City", "population", "inhabitants"))
+	require.Nil(t, err)
+	require.Len(t, diff, 2)
+	require.Equal(t, models.SchemaDiffOpRemove, diff[0].Op)
+	require.Equal(t, "/things/City/population", diff[0].Path)
+	require.Equal(t, models.SchemaDiffOpAdd, diff[1].Op)
+	require.Equal(t, "/things/City/inhabitants", diff[1].Path)
+
+	city, err := GetClassByName(weaviateSchema.ThingSchema.Schema, "City")
+	require.Nil(t, err)
+	_, err = GetPropertyByName(city, "population")
+	require.Nil(t, err)
+	require.Equal(t, int64(0), weaviateSchema.Version())
+}
@@ -0,0 +1,143 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/creativesoftwarefdn/weaviate/models"
+)
+
+// IsSubclassOf returns whether the class with the name is the parent class, or extends it directly or through other
+// classes.
+func IsSubclassOf(s *models.SemanticSchema, className string, parentName string) bool {
+	if s == nil {
+		return className == parentName
+	}
+
+	seen := map[string]bool{}
+	for className != "" && !seen[className] {
+		if className == parentName {
+			return true
+		}
+		seen[className] = true
+
+		class, err := GetClassByName(s, className)
+		if err != nil {
+			return false
+		}
+		className = class.Extends
+	}
+
+	return false
+}
+
+// GetSubclasses returns the classes that extend the class with the name, directly or through other classes.
+func GetSubclasses(s *models.SemanticSchema, className string) []*models.SemanticSchemaClass {
+	subclasses := []*models.SemanticSchemaClass{}
+	if s == nil {
+		return subclasses
+	}
+
+	for _, class := range s.Classes {
+		if class.Class != className && IsSubclassOf(s, class.Class, className) {
+			subclasses = append(subclasses, class)
+		}
+	}
+
+	return subclasses
+}
+
+// Add the properties that the classes inherit from their parent classes to them. A class can override an inherited
+// property, e.g. to describe it differently, as long as its data type stays the same.
+func mergeInheritedProperties(s *models.SemanticSchema) error {
+	merged := map[string]bool{}
+	visiting := map[string]bool{}
+
+	var merge func(class *models.SemanticSchemaClass) error
+	merge = func(class *models.SemanticSchemaClass) error {
+		if merged[class.Class] || class.Extends == "" {
+			return nil
+		}
+		if visiting[class.Class] {
+			return fmt.Errorf("the class '%s' extends itself through the class '%s'", class.Class, class.Extends)
+		}
+		visiting[class.Class] = true
+
+		parent, err := GetClassByName(s, class.Extends)
+		if err != nil {
+			return fmt.Errorf("the class '%s' extends the class '%s', which does not exist", class.Class, class.Extends)
+		}
+		if err := merge(parent); err != nil {
+			return err
+		}
+
+		inherited := []*models.SemanticSchemaClassProperty{}
+		for _, parentProperty := range parent.Properties {
+			property, err := GetPropertyByName(class, parentProperty.Name)
+			if err != nil {
+				copied := *parentProperty
+				inherited = append(inherited, &copied)
+				continue
+			}
+
+			if !equalJSON(property.AtDataType, parentProperty.AtDataType) {
+				return fmt.Errorf("the property '%s' in class '%s' overrides the property of class '%s' with another data type", property.Name, class.Class, parent.Class)
+			}
+		}
+		class.Properties = append(inherited, class.Properties...)
+
+		merged[class.Class] = true
+		return nil
+	}
+
+	for _, class := range s.Classes {
+		if err := merge(class); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// The schema as it is declared, without the properties that classes inherit from their parent classes. A property that
+// is equal to the property of the parent class is inherited.
+func declaredSchema(s *models.SemanticSchema) *models.SemanticSchema {
+	if s == nil {
+		return nil
+	}
+
+	declared := *s
+	declared.Classes = make([]*models.SemanticSchemaClass, 0, len(s.Classes))
+	for _, class := range s.Classes {
+		parent, err := GetClassByName(s, class.Extends)
+		if class.Extends == "" || err != nil {
+			declared.Classes = append(declared.Classes, class)
+			continue
+		}
+
+		declaredClass := *class
+		declaredClass.Properties = []*models.SemanticSchemaClassProperty{}
+		for _, property := range class.Properties {
+			parentProperty, err := GetPropertyByName(parent, property.Name)
+			if err == nil && equalJSON(property, parentProperty) {
+				continue
+			}
+			declaredClass.Properties = append(declaredClass.Properties, property)
+		}
+		declared.Classes = append(declared.Classes, &declaredClass)
+	}
+
+	return &declared
+}
@@ -0,0 +1,153 @@
+package schema
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/stretchr/testify/require"
+)
+
+// A schema with a person, and an employee and a manager that are persons.
+func inheritanceSchema() *WeaviateSchema {
+	weaviateSchema := testSchema()
+	weaviateSchema.ThingSchema.Schema.Classes = append(weaviateSchema.ThingSchema.Schema.Classes,
+		&models.SemanticSchemaClass{
+			Class:   "Manager",
+			Extends: "Employee",
+			Properties: []*models.SemanticSchemaClassProperty{
+				{Name: "reports", AtDataType: []string{"int"}},
+			},
+		},
+		&models.SemanticSchemaClass{
+			Class:   "Employee",
+			Extends: "Person",
+			Properties: []*models.SemanticSchemaClassProperty{
+				{Name: "name", AtDataType: []string{"string"}, Description: "The full name of the employee"},
+				{Name: "salary", AtDataType: []string{"number"}},
+			},
+		},
+		&models.SemanticSchemaClass{
+			Class: "Person",
+			Properties: []*models.SemanticSchemaClassProperty{
+				{Name: "name", AtDataType: []string{"string"}},
+				{Name: "livesIn", AtDataType: []string{"City"}},
+			},
+		},
+	)
+	return weaviateSchema
+}
+
+func propertyNames(class *models.SemanticSchemaClass) []string {
+	names := []string{}
+	for _, property := range class.Properties {
+		names = append(names, property.Name)
+	}
+	return names
+}
+
+func TestMergeInheritedProperties(t *testing.T) {
+	weaviateSchema := inheritanceSchema()
+	semanticSchema := weaviateSchema.ThingSchema.Schema
+	require.Nil(t, mergeInheritedProperties(semanticSchema))
+
+	manager, _ := GetClassByName(semanticSchema, "Manager")
+	require.Equal(t, []string{"livesIn", "name", "salary", "reports"}, propertyNames(manager))
+
+	employee, _ := GetClassByName(semanticSchema, "Employee")
+	require.Equal(t, []string{"livesIn", "name", "salary"}, propertyNames(employee))
+	name, _ := GetPropertyByName(employee, "name")
+	require.Equal(t, "The full name of the employee", name.Description)
+
+	// Merging again changes nothing, and the declared schema leaves the inherited properties out
+	require.Nil(t, mergeInheritedProperties(semanticSchema))
+	require.Equal(t, []string{"livesIn", "name", "salary", "reports"}, propertyNames(manager))
+
+	declared := declaredSchema(semanticSchema)
+	declaredManager, _ := GetClassByName(declared, "Manager")
+	require.Equal(t, []string{"reports"}, propertyNames(declaredManager))
+	declaredEmployee, _ := GetClassByName(declared, "Employee")
+	require.Equal(t, []string{"name", "salary"}, propertyNames(declaredEmployee))
+}
+
+func TestInvalidInheritance(t *testing.T) {
+	invalid := []func(*models.SemanticSchema){
+		func(s *models.SemanticSchema) {
+			s.Classes[0].Extends = "Planet"
+		},
+		func(s *models.SemanticSchema) {
+			person, _ := GetClassByName(s, "Person")
+			person.Extends = "Manager"
+		},
+		func(s *models.SemanticSchema) {
+			employee, _ := GetClassByName(s, "Employee")
+			employee.Properties[0].AtDataType = []string{"int"}
+		},
+	}
+
+	for i, change := range invalid {
+		weaviateSchema := inheritanceSchema()
+		change(weaviateSchema.ThingSchema.Schema)
+		require.NotNil(t, mergeInheritedProperties(weaviateSchema.ThingSchema.Schema), i)
+	}
+}
+
+func TestSubclasses(t *testing.T) {
+	semanticSchema := inheritanceSchema().ThingSchema.Schema
+
+	require.True(t, IsSubclassOf(semanticSchema, "Manager", "Person"))
+	require.True(t, IsSubclassOf(semanticSchema, "Person", "Person"))
+	require.False(t, IsSubclassOf(semanticSchema, "Person", "Employee"))
+	require.False(t, IsSubclassOf(semanticSchema, "City", "Person"))
+
+	var names []string
+	for _, class := range GetSubclasses(semanticSchema, "Person") {
+		names = append(names, class.Class)
+	}
+	require.Equal(t, []string{"Manager", "Employee"}, names)
+}
+
+func TestChangeParentClass(t *testing.T) {
+	dir, err := ioutil.TempDir("", "schema")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	weaviateSchema := inheritanceSchema()
+	weaviateSchema.ThingSchema.localFile = filepath.Join(dir, "things.json")
+	require.Nil(t, mergeInheritedProperties(weaviateSchema.ThingSchema.Schema))
+
+	accept := func(*WeaviateSchema, []AffectedData) error { return nil }
+
+	// A property that is added to the parent is inherited, and one that is deleted is no longer
+	_, err = weaviateSchema.ChangeSchema(AddProperty(connutils.RefTypeThing, "Person", &models.SemanticSchemaClassProperty{Name: "born", AtDataType: []string{"date"}}), testKeyID, accept, nil)
+	require.Nil(t, err)
+	_, err = weaviateSchema.ChangeSchema(DeleteProperty(connutils.RefTypeThing, "Person", "livesIn"), testKeyID, accept, nil)
+	require.Nil(t, err)
+
+	manager, _ := GetClassByName(weaviateSchema.ThingSchema.Schema, "Manager")
+	require.Equal(t, []string{"born", "name", "salary", "reports"}, propertyNames(manager))
+
+	// The saved schema only has the declared properties
+	data, err := ioutil.ReadFile(weaviateSchema.ThingSchema.localFile)
+	require.Nil(t, err)
+	saved := &models.SemanticSchema{}
+	require.Nil(t, json.Unmarshal(data, saved))
+	savedManager, _ := GetClassByName(saved, "Manager")
+	require.Equal(t, []string{"reports"}, propertyNames(savedManager))
+
+	// A class that is extended can't be deleted, and renaming it renames the parent of its subclasses
+	_, err = weaviateSchema.ChangeSchema(DeleteClass(connutils.RefTypeThing, "Person"), testKeyID, accept, nil)
+	require.IsType(t, &ConflictError{}, err)
+
+	person, _ := GetClassByName(weaviateSchema.ThingSchema.Schema, "Person")
+	renamed := *person
+	renamed.Class = "Human"
+	_, err = weaviateSchema.ChangeSchema(UpdateClass(connutils.RefTypeThing, "Person", &renamed), testKeyID, accept, nil)
+	require.Nil(t, err)
+	employee, _ := GetClassByName(weaviateSchema.ThingSchema.Schema, "Employee")
+	require.Equal(t, "Human", employee.Extends)
+}
@@ -0,0 +1,351 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+package schema
+
+// This file checks the names and keywords of the classes and properties against the contextionary, like
+// BuildInMemoryContextionaryFromSchema needs them, but reports all problems at once.
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/fatih/camelcase"
+
+	libcontextionary "github.com/creativesoftwarefdn/weaviate/contextionary"
+	"github.com/creativesoftwarefdn/weaviate/models"
+)
+
+const (
+	// LintError is a problem that keeps the schema from being used
+	LintError = "error"
+	// LintWarning is a problem that doesn't keep the schema from being used
+	LintWarning = "warning"
+
+	// The number of known words that are suggested for a word that is not in the contextionary
+	lintSuggestions = 5
+	// The classes whose centroids have a cosine similarity above this are near duplicates. The similarity is by cosine
+	// for every distance metric of the contextionary, because the distances of the other metrics have no fixed scale.
+	nearDuplicateSimilarity = 0.9
+)
+
+// LintProblem is a problem with the names or keywords of a class or property.
+type LintProblem struct {
+	Severity string
+	Kind     string
+	Class    string
+	// The property, or empty for a problem of the class
+	Property string
+	// The word that is not in the contextionary, or the class that is a near duplicate
+	Word    string
+	Message string
+	// Known words to use instead of the word, or as keywords
+	Suggestions []string
+}
+
+// Lint checks the names and keywords of all classes and properties against the contextionary. Every word that is not
+// in the contextionary is an error, with suggestions of known words that are spelled alike or that are near the other
+// words of the name. Classes of the same kind that are nearly the same in the contextionary are warned about.
+func (f *WeaviateSchema) Lint(context libcontextionary.Contextionary) []LintProblem {
+	linter := &schemaLinter{context: context, problems: []LintProblem{}}
+
+	for _, kind := range []struct {
+		name   string
+		schema *models.SemanticSchema
+	}{{"thing", f.ThingSchema.Schema}, {"action", f.ActionSchema.Schema}} {
+		if kind.schema == nil {
+			continue
+		}
+
+		centroids := map[string]*libcontextionary.Vector{}
+		for _, class := range kind.schema.Classes {
+			words, weights := keywordsOrName(class.Class, class.Keywords, nil)
+			if centroid := linter.checkWords(LintProblem{Kind: kind.name, Class: class.Class}, len(class.Keywords) > 0, words, weights); centroid != nil {
+				centroids[class.Class] = centroid
+			}
+
+			for _, property := range class.Properties {
+				words, weights := keywordsOrName(property.Name, nil, property.Keywords)
+				linter.checkWords(LintProblem{Kind: kind.name, Class: class.Class, Property: property.Name}, len(property.Keywords) > 0, words, weights)
+			}
+		}
+
+		linter.checkNearDuplicates(kind.name, kind.schema, centroids)
+	}
+
+	linter.suggestSpelling()
+	return linter.problems
+}
+
+// ProposeSchema returns the schema with other thing or action schemas, which are validated like the schema files are
+// when they are loaded. The current thing or action schema is kept when the other one is nil.
+func (f *WeaviateSchema) ProposeSchema(things *models.SemanticSchema, actions *models.SemanticSchema) (*WeaviateSchema, error) {
+	candidate, _, err := f.prepareChange(func(candidate *WeaviateSchema) ([]AffectedData, error) {
+		if things != nil {
+			candidate.ThingSchema.Schema = things
+		}
+		if actions != nil {
+			candidate.ActionSchema.Schema = actions
+		}
+		return nil, nil
+	})
+	return candidate, err
+}
+
+// LintErrors returns whether there are errors among the problems.
+func LintErrors(problems []LintProblem) bool {
+	for _, problem := range problems {
+		if problem.Severity == LintError {
+			return true
+		}
+	}
+	return false
+}
+
+// The words that represent a class or property in the contextionary: its keywords, or the camel cased parts of its
+// name when it has none.
+func keywordsOrName(name string, classKeywords []*models.SemanticSchemaClassKeywordsItems0, propertyKeywords []*models.SemanticSchemaClassPropertyKeywordsItems0) ([]string, []float32) {
+	words, weights := []string{}, []float32{}
+	for _, keyword := range classKeywords {
+		words = append(words, strings.ToLower(keyword.Kind))
+		weights = append(weights, keyword.Weight)
+	}
+	for _, keyword := range propertyKeywords {
+		words = append(words, strings.ToLower(keyword.Kind))
+		weights = append(weights, keyword.Weight)
+	}
+
+	if len(words) == 0 {
+		for _, part := range camelcase.Split(name) {
+			words = append(words, strings.ToLower(part))
+			weights = append(weights, 1)
+		}
+	}
+
+	return words, weights
+}
+
+type schemaLinter struct {
+	context  libcontextionary.Contextionary
+	problems []LintProblem
+}
+
+// Check that the words of a class or property are in the contextionary, and return their centroid when they are.
+func (l *schemaLinter) checkWords(subject LintProblem, keywords bool, words []string, weights []float32) *libcontextionary.Vector {
+	known, knownWeights := []libcontextionary.Vector{}, []float32{}
+	missing := []string{}
+	for i, word := range words {
+		index := l.context.WordToItemIndex(word)
+		if !index.IsPresent() {
+			missing = append(missing, word)
+			continue
+		}
+		vector, err := l.context.GetVectorForItemIndex(index)
+		if err != nil {
+			missing = append(missing, word)
+			continue
+		}
+		known = append(known, *vector)
+		knownWeights = append(knownWeights, weights[i])
+	}
+
+	var centroid *libcontextionary.Vector
+	if len(known) > 0 {
+		centroid, _ = l.context.GetDistanceMetric().ComputeWeightedCentroid(known, knownWeights)
+	}
+
+	for _, word := range missing {
+		problem := subject
+		problem.Severity = LintError
+		problem.Word = word
+		problem.Message = fmt.Sprintf("the %s '%s' of %s is not in the contextionary", wordKind(keywords), word, subjectName(subject))
+		if !keywords {
+			problem.Message += ", add keywords instead"
+		}
+		// Words near the known words of the name or keywords can replace the missing word
+		problem.Suggestions = l.nearestWords(centroid, words)
+		l.problems = append(l.problems, problem)
+	}
+
+	if len(missing) > 0 {
+		return nil
+	}
+	return centroid
+}
+
+// Warn about the pairs of classes whose centroids are nearly the same, unless one extends the other.
+func (l *schemaLinter) checkNearDuplicates(kind string, semanticSchema *models.SemanticSchema, centroids map[string]*libcontextionary.Vector) {
+	for i, class := range semanticSchema.Classes {
+		for _, other := range semanticSchema.Classes[i+1:] {
+			a, b := centroids[class.Class], centroids[other.Class]
+			if a == nil || b == nil || IsSubclassOf(semanticSchema, class.Class, other.Class) || IsSubclassOf(semanticSchema, other.Class, class.Class) {
+				continue
+			}
+
+			distance, err := libcontextionary.Cosine.Distance(a, b)
+			if err != nil {
+				continue
+			}
+
+			similarity := 1 - distance
+			if similarity < nearDuplicateSimilarity {
+				continue
+			}
+
+			l.problems = append(l.problems, LintProblem{
+				Severity: LintWarning,
+				Kind:     kind,
+				Class:    class.Class,
+				Word:     other.Class,
+				Message:  fmt.Sprintf("the %s classes '%s' and '%s' are nearly the same in the contextionary (similarity %.2f), consider merging them or giving them distinct keywords", kind, class.Class, other.Class, similarity),
+			})
+		}
+	}
+}
+
+// The known words nearest to the centroid, without the words that are used already.
+func (l *schemaLinter) nearestWords(centroid *libcontextionary.Vector, used []string) []string {
+	suggestions := []string{}
+	if centroid == nil {
+		return suggestions
+	}
+
+	items, _, err := l.context.GetNnsByVector(*centroid, lintSuggestions+len(used), -1)
+	if err != nil {
+		return suggestions
+	}
+
+	for _, item := range items {
+		word, err := l.context.ItemIndexToWord(item)
+		if err != nil || contains(used, word) || len(suggestions) == lintSuggestions {
+			continue
+		}
+		suggestions = append(suggestions, word)
+	}
+	return suggestions
+}
+
+// Suggest the known words that are spelled most alike the missing words first, in one pass over the contextionary.
+func (l *schemaLinter) suggestSpelling() {
+	type candidate struct {
+		word     string
+		distance int
+	}
+	candidates := map[string][]candidate{}
+	for _, problem := range l.problems {
+		if problem.Severity == LintError {
+			candidates[problem.Word] = []candidate{}
+		}
+	}
+	if len(candidates) == 0 {
+		return
+	}
+
+	for i := 0; i < l.context.GetNumberOfItems(); i++ {
+		word, err := l.context.ItemIndexToWord(libcontextionary.ItemIndex(i))
+		if err != nil {
+			continue
+		}
+		for missing := range candidates {
+			// Only words that differ in a few letters are typos
+			maxDistance := 1 + len(missing)/4
+			if int(math.Abs(float64(len(word)-len(missing)))) > maxDistance {
+				continue
+			}
+			if distance := editDistance(missing, word); distance <= maxDistance {
+				candidates[missing] = append(candidates[missing], candidate{word, distance})
+			}
+		}
+	}
+
+	for i, problem := range l.problems {
+		alike, ok := candidates[problem.Word]
+		if !ok || problem.Severity != LintError {
+			continue
+		}
+		sort.SliceStable(alike, func(a, b int) bool {
+			return alike[a].distance < alike[b].distance || (alike[a].distance == alike[b].distance && alike[a].word < alike[b].word)
+		})
+
+		suggestions := []string{}
+		for _, c := range alike {
+			if len(suggestions) < lintSuggestions {
+				suggestions = append(suggestions, c.word)
+			}
+		}
+		for _, word := range problem.Suggestions {
+			if len(suggestions) < lintSuggestions && !contains(suggestions, word) {
+				suggestions = append(suggestions, word)
+			}
+		}
+		l.problems[i].Suggestions = suggestions
+	}
+}
+
+func wordKind(keywords bool) string {
+	if keywords {
+		return "keyword"
+	}
+	return "word"
+}
+
+func subjectName(problem LintProblem) string {
+	if problem.Property != "" {
+		return fmt.Sprintf("property '%s' in %s class '%s'", problem.Property, problem.Kind, problem.Class)
+	}
+	return fmt.Sprintf("%s class '%s'", problem.Kind, problem.Class)
+}
+
+func contains(words []string, word string) bool {
+	for _, w := range words {
+		if w == word {
+			return true
+		}
+	}
+	return false
+}
+
+// The Levenshtein distance between two words.
+func editDistance(a string, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
+
+func minInt(values ...int) int {
+	min := values[0]
+	for _, value := range values[1:] {
+		if value < min {
+			min = value
+		}
+	}
+	return min
+}
@@ -0,0 +1,115 @@
+package schema
+
+import (
+	"testing"
+
+	libcontextionary "github.com/creativesoftwarefdn/weaviate/contextionary"
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/stretchr/testify/require"
+)
+
+// A contextionary in which the cities and towns are alike, and the countries are far from them.
+func lintContextionary() libcontextionary.Contextionary {
+	return lintContextionaryWithMetric(libcontextionary.Euclidean)
+}
+
+func lintContextionaryWithMetric(metric libcontextionary.DistanceMetric) libcontextionary.Contextionary {
+	builder := libcontextionary.InMemoryBuilderWithDistanceMetric(3, metric)
+	words := map[string][]float32{
+		"city":       {1, 0, 0},
+		"town":       {0.95, 0.1, 0},
+		"village":    {0.9, 0.2, 0},
+		"country":    {0, 1, 0},
+		"nation":     {0.1, 0.95, 0},
+		"name":       {0, 0, 1},
+		"population": {0.2, 0, 1},
+		"in":         {0.3, 0.3, 0.3},
+		"to":         {0.3, 0.3, 0.4},
+		"visit":      {0.5, 0.5, 0},
+	}
+	for word, vector := range words {
+		builder.AddWord(word, libcontextionary.NewVector(vector))
+	}
+	return libcontextionary.Contextionary(builder.Build(10))
+}
+
+func TestLintValidSchema(t *testing.T) {
+	problems := testSchema().Lint(lintContextionary())
+	require.Len(t, problems, 0)
+	require.False(t, LintErrors(problems))
+}
+
+func TestLintReportsAllProblems(t *testing.T) {
+	weaviateSchema := testSchema()
+	city := weaviateSchema.ThingSchema.Schema.Classes[0]
+	city.Properties = append(city.Properties, &models.SemanticSchemaClassProperty{Name: "popullation", AtDataType: []string{"int"}})
+	country := weaviateSchema.ThingSchema.Schema.Classes[1]
+	country.Keywords = []*models.SemanticSchemaClassKeywordsItems0{{Kind: "Nation", Weight: 1}, {Kind: "kingdom", Weight: 0.5}}
+	weaviateSchema.ThingSchema.Schema.Classes = append(weaviateSchema.ThingSchema.Schema.Classes,
+		&models.SemanticSchemaClass{Class: "Town"},
+		&models.SemanticSchemaClass{Class: "Village", Extends: "City"},
+	)
+
+	problems := weaviateSchema.Lint(lintContextionary())
+	require.True(t, LintErrors(problems))
+	require.Len(t, problems, 4)
+
+	require.Equal(t, LintProblem{
+		Severity:    LintError,
+		Kind:        "thing",
+		Class:       "City",
+		Property:    "popullation",
+		Word:        "popullation",
+		Message:     "the word 'popullation' of property 'popullation' in thing class 'City' is not in the contextionary, add keywords instead",
+		Suggestions: []string{"population"},
+	}, problems[0])
+
+	// The other keywords suggest words near them
+	require.Equal(t, "kingdom", problems[1].Word)
+	require.Equal(t, "the keyword 'kingdom' of thing class 'Country' is not in the contextionary", problems[1].Message)
+	require.Equal(t, "country", problems[1].Suggestions[0])
+
+	// A class that extends another one is not a near duplicate of it
+	require.Equal(t, LintWarning, problems[2].Severity)
+	require.Equal(t, "City", problems[2].Class)
+	require.Equal(t, "Town", problems[2].Word)
+	require.Contains(t, problems[2].Message, "the thing classes 'City' and 'Town' are nearly the same in the contextionary")
+	require.Equal(t, LintWarning, problems[3].Severity)
+	require.Equal(t, "Town", problems[3].Class)
+	require.Equal(t, "Village", problems[3].Word)
+}
+
+func TestLintNearDuplicatesForEveryMetric(t *testing.T) {
+	for _, metric := range []libcontextionary.DistanceMetric{libcontextionary.Euclidean, libcontextionary.Cosine, libcontextionary.DotProduct, libcontextionary.Manhattan} {
+		weaviateSchema := testSchema()
+		weaviateSchema.ThingSchema.Schema.Classes = append(weaviateSchema.ThingSchema.Schema.Classes, &models.SemanticSchemaClass{Class: "Town"})
+
+		problems := weaviateSchema.Lint(lintContextionaryWithMetric(metric))
+		require.Len(t, problems, 1, metric)
+		require.Equal(t, "City", problems[0].Class, metric)
+		require.Equal(t, "Town", problems[0].Word, metric)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	require.Equal(t, 0, editDistance("city", "city"))
+	require.Equal(t, 1, editDistance("populaton", "population"))
+	require.Equal(t, 2, editDistance("cuontry", "country"))
+	require.Equal(t, 4, editDistance("", "town"))
+}
+
+func TestProposeSchema(t *testing.T) {
+	weaviateSchema := testSchema()
+	weaviateSchema.predicateDict = map[string]DataType{}
+
+	proposed, err := weaviateSchema.ProposeSchema(&models.SemanticSchema{Classes: []*models.SemanticSchemaClass{{Class: "Town"}}}, nil)
+	require.Nil(t, err)
+	require.Equal(t, "Town", proposed.ThingSchema.Schema.Classes[0].Class)
+	require.Equal(t, "Visit", proposed.ActionSchema.Schema.Classes[0].Class)
+
+	// The current schema is not changed
+	require.Equal(t, "City", weaviateSchema.ThingSchema.Schema.Classes[0].Class)
+
+	_, err = weaviateSchema.ProposeSchema(&models.SemanticSchema{Classes: []*models.SemanticSchemaClass{{Class: "Town", Extends: "City"}}}, nil)
+	require.EqualError(t, err, "the class 'Town' extends the class 'City', which does not exist")
+}
@@ -0,0 +1,145 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/models"
+)
+
+// The changes below are made by migrations, which change the existing data along with the schema. So unlike the
+// changes in change.go, they don't return the data that they affect.
+
+// Changes combines changes, which are made in order.
+func Changes(changes ...Change) Change {
+	return func(candidate *WeaviateSchema) ([]AffectedData, error) {
+		var affected []AffectedData
+		for _, change := range changes {
+			data, err := change(candidate)
+			if err != nil {
+				return nil, err
+			}
+			affected = append(affected, data...)
+		}
+		return affected, nil
+	}
+}
+
+// RenameClass renames a thing or action class, and the references to it.
+func RenameClass(kind connutils.RefType, className string, newName string) Change {
+	return func(candidate *WeaviateSchema) ([]AffectedData, error) {
+		semanticSchema, i, err := candidate.findClass(kind, className)
+		if err != nil {
+			return nil, err
+		}
+
+		if candidate.classExists(newName) {
+			return nil, &ConflictError{Message: fmt.Sprintf("the class '%s' exists already", newName)}
+		}
+
+		renamed := *semanticSchema.Classes[i]
+		renamed.Class = newName
+		if err := candidate.checkClass(&renamed); err != nil {
+			return nil, err
+		}
+
+		semanticSchema.Classes[i] = &renamed
+		candidate.renameReferences(className, newName)
+		return nil, nil
+	}
+}
+
+// RenameProperty renames a property of a thing or action class.
+func RenameProperty(kind connutils.RefType, className string, propertyName string, newName string) Change {
+	return func(candidate *WeaviateSchema) ([]AffectedData, error) {
+		semanticSchema, i, err := candidate.findClass(kind, className)
+		if err != nil {
+			return nil, err
+		}
+		class := semanticSchema.Classes[i]
+
+		j, err := propertyIndex(class, propertyName)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := GetPropertyByName(class, newName); err == nil {
+			return nil, &ConflictError{Message: fmt.Sprintf("the class '%s' has a property '%s' already", className, newName)}
+		}
+
+		renamed := *class.Properties[j]
+		renamed.Name = newName
+		if err := candidate.checkProperty(class, &renamed); err != nil {
+			return nil, err
+		}
+
+		class.Properties[j] = &renamed
+		return nil, nil
+	}
+}
+
+// SplitProperty replaces a string property of a thing or action class by the given string properties. Properties
+// without a data type become string properties.
+func SplitProperty(kind connutils.RefType, className string, propertyName string, into []*models.SemanticSchemaClassProperty) Change {
+	return func(candidate *WeaviateSchema) ([]AffectedData, error) {
+		semanticSchema, i, err := candidate.findClass(kind, className)
+		if err != nil {
+			return nil, err
+		}
+		class := semanticSchema.Classes[i]
+
+		j, err := propertyIndex(class, propertyName)
+		if err != nil {
+			return nil, err
+		}
+
+		if dataType, err := GetPropertyDataType(class, propertyName); err != nil || *dataType != DataTypeString {
+			return nil, fmt.Errorf("the property '%s' of class '%s' can't be split, because it is not a string property", propertyName, className)
+		}
+
+		if len(into) == 0 {
+			return nil, fmt.Errorf("the property '%s' of class '%s' is not split into any properties", propertyName, className)
+		}
+
+		properties := []*models.SemanticSchemaClassProperty{}
+		for _, property := range into {
+			if property == nil {
+				return nil, fmt.Errorf("the class '%s' has an empty property", className)
+			}
+
+			split := *property
+			if len(split.AtDataType) == 0 {
+				split.AtDataType = []string{string(DataTypeString)}
+			}
+			if len(split.AtDataType) != 1 || split.AtDataType[0] != string(DataTypeString) {
+				return nil, fmt.Errorf("the property '%s' of class '%s' can only be split into string properties", propertyName, className)
+			}
+
+			// The split property itself may be reused
+			if existing, err := GetPropertyByName(class, split.Name); err == nil && existing.Name != propertyName {
+				return nil, &ConflictError{Message: fmt.Sprintf("the class '%s' has a property '%s' already", className, split.Name)}
+			}
+
+			properties = append(properties, &split)
+		}
+
+		split := append([]*models.SemanticSchemaClassProperty{}, class.Properties[:j]...)
+		split = append(split, properties...)
+		class.Properties = append(split, class.Properties[j+1:]...)
+
+		return nil, candidate.checkClass(class)
+	}
+}
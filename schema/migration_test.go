@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenameClass(t *testing.T) {
+	weaviateSchema := testSchema()
+	var affected []AffectedData
+
+	_, err := weaviateSchema.ChangeSchema(RenameClass(connutils.RefTypeThing, "City", "Town"), testKeyID, acceptChange(&affected), nil)
+	require.Nil(t, err)
+	require.Empty(t, affected)
+
+	_, err = GetClassByName(weaviateSchema.ThingSchema.Schema, "Town")
+	require.Nil(t, err)
+
+	visit, err := GetClassByName(weaviateSchema.ActionSchema.Schema, "Visit")
+	require.Nil(t, err)
+	require.Equal(t, []string{"Town"}, visit.Properties[0].AtDataType)
+
+	_, err = weaviateSchema.ChangeSchema(RenameClass(connutils.RefTypeThing, "Town", "Country"), testKeyID, acceptChange(&affected), nil)
+	require.IsType(t, &ConflictError{}, err)
+}
+
+func TestRenameProperty(t *testing.T) {
+	weaviateSchema := testSchema()
+	var affected []AffectedData
+
+	_, err := weaviateSchema.ChangeSchema(RenameProperty(connutils.RefTypeThing, "City", "population", "inhabitants"), testKeyID, acceptChange(&affected), nil)
+	require.Nil(t, err)
+
+	city, err := GetClassByName(weaviateSchema.ThingSchema.Schema, "City")
+	require.Nil(t, err)
+	dataType, err := GetPropertyDataType(city, "inhabitants")
+	require.Nil(t, err)
+	require.Equal(t, DataTypeInt, *dataType)
+
+	_, err = weaviateSchema.ChangeSchema(RenameProperty(connutils.RefTypeThing, "City", "inhabitants", "name"), testKeyID, acceptChange(&affected), nil)
+	require.IsType(t, &ConflictError{}, err)
+}
+
+func TestSplitProperty(t *testing.T) {
+	weaviateSchema := testSchema()
+	var affected []AffectedData
+
+	into := []*models.SemanticSchemaClassProperty{{Name: "name"}, {Name: "nickname"}}
+	_, err := weaviateSchema.ChangeSchema(SplitProperty(connutils.RefTypeThing, "City", "name", into), testKeyID, acceptChange(&affected), nil)
+	require.Nil(t, err)
+
+	city, err := GetClassByName(weaviateSchema.ThingSchema.Schema, "City")
+	require.Nil(t, err)
+	require.Equal(t, []string{"name", "nickname", "population", "inCountry"}, []string{
+		city.Properties[0].Name, city.Properties[1].Name, city.Properties[2].Name, city.Properties[3].Name,
+	})
+	require.Equal(t, []string{"string"}, city.Properties[1].AtDataType)
+	require.Empty(t, into[1].AtDataType, "the given properties should not be changed")
+
+	// Only string properties can be split, into string properties
+	_, err = weaviateSchema.ChangeSchema(SplitProperty(connutils.RefTypeThing, "City", "population", into), testKeyID, acceptChange(&affected), nil)
+	require.NotNil(t, err)
+
+	into = []*models.SemanticSchemaClassProperty{{Name: "shortName", AtDataType: []string{"int"}}}
+	_, err = weaviateSchema.ChangeSchema(SplitProperty(connutils.RefTypeThing, "Country", "name", into), testKeyID, acceptChange(&affected), nil)
+	require.NotNil(t, err)
+
+	into = []*models.SemanticSchemaClassProperty{{Name: "shortName"}, {Name: "shortName"}}
+	_, err = weaviateSchema.ChangeSchema(SplitProperty(connutils.RefTypeThing, "Country", "name", into), testKeyID, acceptChange(&affected), nil)
+	require.IsType(t, &ConflictError{}, err)
+}
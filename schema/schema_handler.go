@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/creativesoftwarefdn/weaviate/models"
@@ -48,6 +49,9 @@ type WeaviateSchema struct {
 	// The predicate dict to re-use for every schema (thing/action)
 	predicateDict map[string]DataType
 
+	// The changes of the schema that are made at runtime
+	history *schemaHistory
+
 	messaging *messages.Messaging
 }
 
@@ -67,6 +71,16 @@ const (
 	DataTypeBoolean DataType = "boolean"
 	// DataTypeDate The data type is a value of type date
 	DataTypeDate DataType = "date"
+	// DataTypeGeoCoordinates The data type is a location on earth, with a latitude and a longitude
+	DataTypeGeoCoordinates DataType = "geoCoordinates"
+	// DataTypeStringArray The data type is a list of strings
+	DataTypeStringArray DataType = "string[]"
+	// DataTypeIntArray The data type is a list of ints
+	DataTypeIntArray DataType = "int[]"
+	// DataTypeNumberArray The data type is a list of numbers/floats
+	DataTypeNumberArray DataType = "number[]"
+	// DataTypeDateArray The data type is a list of dates
+	DataTypeDateArray DataType = "date[]"
 	// DataTypeUnknown The data type is unknown
 	DataTypeUnknown DataType = "unknown"
 	// validationErrorMessage is a constant for returning the same message
@@ -155,6 +169,8 @@ func GetValueDataTypeFromString(dt string) (*DataType, error) {
 			returnDataType = DataTypeNumber
 		} else if dt == string(DataTypeString) {
 			returnDataType = DataTypeString
+		} else {
+			returnDataType = DataType(dt)
 		}
 	} else {
 		return nil, errors_.New(ErrorNoSuchDatatype)
@@ -171,12 +187,27 @@ func IsValidValueDataType(dt string) bool {
 		string(DataTypeInt),
 		string(DataTypeNumber),
 		string(DataTypeBoolean),
-		string(DataTypeDate):
+		string(DataTypeDate),
+		string(DataTypeGeoCoordinates),
+		string(DataTypeStringArray),
+		string(DataTypeIntArray),
+		string(DataTypeNumberArray),
+		string(DataTypeDateArray):
 		return true
 	}
 	return false
 }
 
+// IsArrayDataType checks whether the given data type is a list of values
+func IsArrayDataType(dt DataType) bool {
+	return strings.HasSuffix(string(dt), "[]")
+}
+
+// ElementDataType returns the data type of the values in a list, or the data type itself when it is not a list
+func ElementDataType(dt DataType) DataType {
+	return DataType(strings.TrimSuffix(string(dt), "[]"))
+}
+
 // LoadSchema from config locations
 func (f *WeaviateSchema) LoadSchema(usedConfig *config.Environment, m *messages.Messaging) error {
 	f.ThingSchema.schemaLocationFromConfig = usedConfig.Schemas.Thing
@@ -258,12 +289,29 @@ func (f *WeaviateSchema) LoadSchema(usedConfig *config.Environment, m *messages.
 		f.messaging.InfoMessage(cfk + ": Schema is validated and correct")
 	}
 
+	// Load the changes that were made at runtime, to continue their versions
+	historyFile := usedConfig.Schemas.History
+	if historyFile == "" {
+		historyFile = filepath.Join(filepath.Dir(f.ThingSchema.localFile), defaultHistoryFile)
+	}
+
+	history, err := loadHistory(historyFile)
+	if err != nil {
+		f.messaging.ErrorMessage("Can not load the schema history from '" + historyFile + "'")
+		return err
+	}
+	f.history = history
+	f.messaging.InfoMessage(fmt.Sprintf("The schema is at version %d", history.Version))
+
 	return nil
 }
 
 // validateSchema validates the given schema
 func (f *WeaviateSchema) validateSchema(schema *models.SemanticSchema) error {
-	// Initialize the dict to compare predicate data types
+	// Add the inherited properties to the classes, so they are validated as properties of the class
+	if err := mergeInheritedProperties(schema); err != nil {
+		return err
+	}
 
 	// Loop through all classes
 	for _, class := range schema.Classes {
@@ -338,6 +386,11 @@ func (f *WeaviateSchema) validateSchema(schema *models.SemanticSchema) error {
 				))
 			}
 
+			// Check whether the constraints fit the data type
+			if err := checkConstraints(class, prop, pred); err != nil {
+				return err
+			}
+
 			// Add to predicate dict if it is not empty
 			f.predicateDict[prop.Name] = pred
 		}
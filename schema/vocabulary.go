@@ -0,0 +1,760 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+package schema
+
+// This file converts RDFS and OWL vocabularies, like schema.org, from JSON-LD into thing and action schemas.
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/fatih/camelcase"
+	"github.com/go-openapi/strfmt"
+
+	libcontextionary "github.com/creativesoftwarefdn/weaviate/contextionary"
+	"github.com/creativesoftwarefdn/weaviate/models"
+)
+
+const (
+	rdfNamespace       = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+	rdfsNamespace      = "http://www.w3.org/2000/01/rdf-schema#"
+	owlNamespace       = "http://www.w3.org/2002/07/owl#"
+	xsdNamespace       = "http://www.w3.org/2001/XMLSchema#"
+	schemaOrgNamespace = "http://schema.org/"
+)
+
+// The data types of the ranges of the vocabularies. The GeoCoordinates of schema.org are a data type in Weaviate.
+var vocabularyDataTypes = map[string]DataType{
+	rdfsNamespace + "Literal":              DataTypeString,
+	xsdNamespace + "string":                DataTypeString,
+	xsdNamespace + "normalizedString":      DataTypeString,
+	xsdNamespace + "token":                 DataTypeString,
+	xsdNamespace + "language":              DataTypeString,
+	xsdNamespace + "anyURI":                DataTypeString,
+	xsdNamespace + "integer":               DataTypeInt,
+	xsdNamespace + "int":                   DataTypeInt,
+	xsdNamespace + "long":                  DataTypeInt,
+	xsdNamespace + "short":                 DataTypeInt,
+	xsdNamespace + "nonNegativeInteger":    DataTypeInt,
+	xsdNamespace + "positiveInteger":       DataTypeInt,
+	xsdNamespace + "nonPositiveInteger":    DataTypeInt,
+	xsdNamespace + "negativeInteger":       DataTypeInt,
+	xsdNamespace + "decimal":               DataTypeNumber,
+	xsdNamespace + "double":                DataTypeNumber,
+	xsdNamespace + "float":                 DataTypeNumber,
+	xsdNamespace + "boolean":               DataTypeBoolean,
+	xsdNamespace + "date":                  DataTypeDate,
+	xsdNamespace + "dateTime":              DataTypeDate,
+	schemaOrgNamespace + "Text":            DataTypeString,
+	schemaOrgNamespace + "URL":             DataTypeString,
+	schemaOrgNamespace + "Time":            DataTypeString,
+	schemaOrgNamespace + "CssSelectorType": DataTypeString,
+	schemaOrgNamespace + "XPathType":       DataTypeString,
+	schemaOrgNamespace + "Integer":         DataTypeInt,
+	schemaOrgNamespace + "Number":          DataTypeNumber,
+	schemaOrgNamespace + "Float":           DataTypeNumber,
+	schemaOrgNamespace + "Boolean":         DataTypeBoolean,
+	schemaOrgNamespace + "Date":            DataTypeDate,
+	schemaOrgNamespace + "DateTime":        DataTypeDate,
+	schemaOrgNamespace + "GeoCoordinates":  DataTypeGeoCoordinates,
+}
+
+var nonNameCharacters = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// VocabularyOptions select which classes of a vocabulary are converted, and how.
+type VocabularyOptions struct {
+	// The names of the classes to convert, which are converted with the classes they extend. All classes are
+	// converted when there are none.
+	Classes []string
+	// The names of the classes that are actions, with the classes that extend them. The other classes are things.
+	// Defaults to the Action of schema.org.
+	ActionClasses []string
+	// The contextionary in which the names of the classes and properties, or their keywords, must be. They are not
+	// checked without a contextionary.
+	Contextionary libcontextionary.Contextionary
+}
+
+// ConvertedVocabulary is the thing and action schema that is converted from a vocabulary.
+type ConvertedVocabulary struct {
+	Things  *models.SemanticSchema
+	Actions *models.SemanticSchema
+	// What is converted differently than the vocabulary describes it, or is not converted at all
+	Warnings []string
+}
+
+// A class or property of a vocabulary.
+type vocabularyTerm struct {
+	iri     string
+	label   string
+	comment string
+	// The classes that a class extends
+	parents []string
+	// The classes that have a property, and the classes or data types of its values
+	domains []string
+	ranges  []string
+}
+
+// A JSON-LD document of a vocabulary.
+type vocabularyDocument struct {
+	// The IRIs of the prefixes and terms that the context defines
+	terms map[string]string
+	vocab string
+
+	classes    []*vocabularyTerm
+	properties []*vocabularyTerm
+}
+
+// ConvertVocabulary converts the RDFS or OWL classes and properties of a vocabulary in JSON-LD, e.g. schema.org, into
+// a thing and action schema. Classes extend their parent classes, properties get the classes of their domain, and
+// the ranges of properties become their data types. The classes and properties get the words of their labels as
+// keywords.
+func ConvertVocabulary(document interface{}, options VocabularyOptions) (*ConvertedVocabulary, error) {
+	doc, err := parseVocabulary(document)
+	if err != nil {
+		return nil, err
+	}
+
+	converter := &vocabularyConverter{
+		doc:       doc,
+		options:   options,
+		classes:   map[string]*vocabularyTerm{},
+		converted: map[string]*models.SemanticSchemaClass{},
+		actions:   map[string]bool{},
+		result: &ConvertedVocabulary{
+			Things:   &models.SemanticSchema{Type: "thing", Classes: []*models.SemanticSchemaClass{}},
+			Actions:  &models.SemanticSchema{Type: "action", Classes: []*models.SemanticSchemaClass{}},
+			Warnings: []string{},
+		},
+	}
+	if len(converter.options.ActionClasses) == 0 {
+		converter.options.ActionClasses = []string{"Action"}
+	}
+
+	if err := converter.selectClasses(); err != nil {
+		return nil, err
+	}
+	converter.convertClasses()
+	converter.convertProperties()
+	converter.inheritFromOtherKind()
+	converter.checkContextionary()
+
+	return converter.result, nil
+}
+
+// Parse the classes and properties of a JSON-LD document, which is a node, a list of nodes or a node with a graph.
+func parseVocabulary(document interface{}) (*vocabularyDocument, error) {
+	doc := &vocabularyDocument{
+		terms: map[string]string{
+			"rdf":    rdfNamespace,
+			"rdfs":   rdfsNamespace,
+			"owl":    owlNamespace,
+			"xsd":    xsdNamespace,
+			"schema": schemaOrgNamespace,
+		},
+	}
+
+	var nodes []interface{}
+	switch document := document.(type) {
+	case []interface{}:
+		nodes = document
+	case map[string]interface{}:
+		doc.parseContext(document["@context"])
+		if graph, ok := document["@graph"].([]interface{}); ok {
+			nodes = graph
+		} else {
+			nodes = []interface{}{document}
+		}
+	default:
+		return nil, fmt.Errorf("the vocabulary should be a JSON-LD object or list")
+	}
+
+	for _, node := range nodes {
+		node, ok := node.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		doc.parseContext(node["@context"])
+		doc.parseNode(node)
+	}
+
+	if len(doc.classes) == 0 {
+		return nil, fmt.Errorf("the vocabulary has no classes")
+	}
+
+	return doc, nil
+}
+
+// Add the prefixes and terms of a JSON-LD context.
+func (doc *vocabularyDocument) parseContext(context interface{}) {
+	switch context := context.(type) {
+	case string:
+		if doc.expand(context+"/") == schemaOrgNamespace {
+			doc.vocab = schemaOrgNamespace
+		}
+	case []interface{}:
+		for _, item := range context {
+			doc.parseContext(item)
+		}
+	case map[string]interface{}:
+		for term, definition := range context {
+			switch definition := definition.(type) {
+			case string:
+				if term == "@vocab" {
+					doc.vocab = doc.expand(definition)
+				} else {
+					doc.terms[term] = definition
+				}
+			case map[string]interface{}:
+				if iri, ok := definition["@id"].(string); ok {
+					doc.terms[term] = iri
+				}
+			}
+		}
+	}
+}
+
+// Expand a compact IRI or term of the document into an IRI.
+func (doc *vocabularyDocument) expand(term string) string {
+	if strings.HasPrefix(term, "@") {
+		return term
+	}
+
+	if iri, ok := doc.terms[term]; ok && iri != term {
+		return doc.expand(iri)
+	}
+
+	if i := strings.Index(term, ":"); i > 0 && !strings.HasPrefix(term[i+1:], "//") {
+		if namespace, ok := doc.terms[term[:i]]; ok {
+			return doc.expand(namespace + term[i+1:])
+		}
+		return term
+	}
+
+	if strings.Contains(term, "://") {
+		// schema.org is known by both its http and https IRIs
+		return strings.Replace(term, "https://schema.org/", schemaOrgNamespace, 1)
+	}
+
+	return doc.vocab + term
+}
+
+// Add the node to the classes or properties of the document, when it is one.
+func (doc *vocabularyDocument) parseNode(node map[string]interface{}) {
+	id, ok := node["@id"].(string)
+	if !ok || len(doc.iris(node, schemaOrgNamespace+"supersededBy")) > 0 {
+		return
+	}
+
+	term := &vocabularyTerm{
+		iri:     doc.expand(id),
+		label:   doc.literal(node, rdfsNamespace+"label"),
+		comment: doc.literal(node, rdfsNamespace+"comment"),
+	}
+
+	isClass, isProperty, isDataType := false, false, false
+	for _, nodeType := range doc.iris(node, "@type") {
+		switch nodeType {
+		case rdfsNamespace + "Class", owlNamespace + "Class":
+			isClass = true
+		case rdfNamespace + "Property", owlNamespace + "ObjectProperty", owlNamespace + "DatatypeProperty":
+			isProperty = true
+		case schemaOrgNamespace + "DataType", rdfsNamespace + "Datatype":
+			isDataType = true
+		}
+	}
+	if _, ok := vocabularyDataTypes[term.iri]; ok {
+		isDataType = true
+	}
+
+	switch {
+	case isClass && !isDataType:
+		term.parents = doc.iris(node, rdfsNamespace+"subClassOf")
+		doc.classes = append(doc.classes, term)
+	case isProperty:
+		term.domains = append(doc.iris(node, rdfsNamespace+"domain"), doc.iris(node, schemaOrgNamespace+"domainIncludes")...)
+		term.ranges = append(doc.iris(node, rdfsNamespace+"range"), doc.iris(node, schemaOrgNamespace+"rangeIncludes")...)
+		doc.properties = append(doc.properties, term)
+	}
+}
+
+// The values of the node for the key, as IRIs.
+func (doc *vocabularyDocument) iris(node map[string]interface{}, key string) []string {
+	iris := []string{}
+	for _, value := range doc.values(node, key) {
+		switch value := value.(type) {
+		case string:
+			iris = append(iris, doc.expand(value))
+		case map[string]interface{}:
+			if id, ok := value["@id"].(string); ok {
+				iris = append(iris, doc.expand(id))
+			}
+		}
+	}
+	return iris
+}
+
+// The value of the node for the key as text, in English when the vocabulary has more languages.
+func (doc *vocabularyDocument) literal(node map[string]interface{}, key string) string {
+	text := ""
+	for _, value := range doc.values(node, key) {
+		switch value := value.(type) {
+		case string:
+			return value
+		case map[string]interface{}:
+			literal, ok := value["@value"].(string)
+			if !ok {
+				continue
+			}
+			if language, _ := value["@language"].(string); language == "" || strings.HasPrefix(language, "en") {
+				return literal
+			}
+			if text == "" {
+				text = literal
+			}
+		}
+	}
+	return text
+}
+
+// The values of the node for the key, which can be written as a compact IRI or term.
+func (doc *vocabularyDocument) values(node map[string]interface{}, key string) []interface{} {
+	values := []interface{}{}
+	for nodeKey, value := range node {
+		if nodeKey != key && doc.expand(nodeKey) != key {
+			continue
+		}
+		if list, ok := value.([]interface{}); ok {
+			values = append(values, list...)
+		} else {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// The state of a conversion of a vocabulary.
+type vocabularyConverter struct {
+	doc     *vocabularyDocument
+	options VocabularyOptions
+	result  *ConvertedVocabulary
+
+	// The selected classes, by IRI
+	classes map[string]*vocabularyTerm
+	// The converted classes, by IRI
+	converted map[string]*models.SemanticSchemaClass
+	// Whether the classes are actions, by IRI
+	actions map[string]bool
+}
+
+func (c *vocabularyConverter) warn(format string, args ...interface{}) {
+	c.result.Warnings = append(c.result.Warnings, fmt.Sprintf(format, args...))
+}
+
+// Select the classes to convert, and whether they are things or actions.
+func (c *vocabularyConverter) selectClasses() error {
+	byIRI := map[string]*vocabularyTerm{}
+	for _, class := range c.doc.classes {
+		byIRI[class.iri] = class
+	}
+
+	find := func(name string) *vocabularyTerm {
+		if class, ok := byIRI[c.doc.expand(name)]; ok {
+			return class
+		}
+		for _, class := range c.doc.classes {
+			if localName(class.iri) == name {
+				return class
+			}
+		}
+		return nil
+	}
+
+	var selectClass func(class *vocabularyTerm)
+	selectClass = func(class *vocabularyTerm) {
+		if c.classes[class.iri] != nil {
+			return
+		}
+		c.classes[class.iri] = class
+		for _, parent := range class.parents {
+			if parentClass, ok := byIRI[parent]; ok {
+				selectClass(parentClass)
+			}
+		}
+	}
+
+	if len(c.options.Classes) == 0 {
+		for _, class := range c.doc.classes {
+			selectClass(class)
+		}
+	}
+	for _, name := range c.options.Classes {
+		class := find(name)
+		if class == nil {
+			return fmt.Errorf("the class '%s' is not in the vocabulary", name)
+		}
+		selectClass(class)
+	}
+
+	actionClasses := map[string]bool{}
+	for _, name := range c.options.ActionClasses {
+		if class := find(name); class != nil {
+			actionClasses[class.iri] = true
+		}
+	}
+
+	visiting := map[string]bool{}
+	var isAction func(class *vocabularyTerm) bool
+	isAction = func(class *vocabularyTerm) bool {
+		if action, ok := c.actions[class.iri]; ok {
+			return action
+		}
+		if visiting[class.iri] {
+			return false
+		}
+		visiting[class.iri] = true
+
+		action := actionClasses[class.iri]
+		for _, parent := range class.parents {
+			if parentClass, ok := c.classes[parent]; ok && isAction(parentClass) {
+				action = true
+			}
+		}
+		c.actions[class.iri] = action
+		return action
+	}
+	for _, class := range c.classes {
+		isAction(class)
+	}
+
+	return nil
+}
+
+// Convert the selected classes, in the order of the vocabulary.
+func (c *vocabularyConverter) convertClasses() {
+	names := map[string]string{}
+	for _, term := range c.doc.classes {
+		if c.classes[term.iri] == nil {
+			continue
+		}
+
+		name := strings.Title(nonNameCharacters.ReplaceAllString(localName(term.iri), ""))
+		if !validClassName.MatchString(name) {
+			c.warn("the class '%s' is left out, because '%s' is not a valid class name", term.iri, name)
+			continue
+		}
+		if other, ok := names[name]; ok {
+			c.warn("the class '%s' is left out, because the class '%s' has the name '%s' already", term.iri, other, name)
+			continue
+		}
+		names[name] = term.iri
+
+		class := &models.SemanticSchemaClass{
+			Class:       name,
+			Description: term.comment,
+			Properties:  []*models.SemanticSchemaClassProperty{},
+		}
+		for _, word := range c.keywords(term.label, name) {
+			class.Keywords = append(class.Keywords, &models.SemanticSchemaClassKeywordsItems0{Kind: word, Weight: 1})
+		}
+
+		c.converted[term.iri] = class
+		semanticSchema := c.result.Things
+		if c.actions[term.iri] {
+			semanticSchema = c.result.Actions
+		}
+		semanticSchema.Classes = append(semanticSchema.Classes, class)
+		if semanticSchema.AtContext == "" {
+			semanticSchema.AtContext = strfmt.URI(namespace(term.iri))
+		}
+	}
+
+	// A class extends one of its parent classes of the same kind
+	for _, term := range c.doc.classes {
+		class, ok := c.converted[term.iri]
+		if !ok {
+			continue
+		}
+
+		parents := []string{}
+		for _, parent := range term.parents {
+			if parentClass, ok := c.converted[parent]; ok && c.actions[parent] == c.actions[term.iri] {
+				parents = append(parents, parentClass.Class)
+			}
+		}
+		if len(parents) > 0 {
+			class.Extends = parents[0]
+		}
+		if len(parents) > 1 {
+			c.warn("the class '%s' only extends '%s' of its parent classes %s", class.Class, parents[0], strings.Join(parents, ", "))
+		}
+	}
+}
+
+// Convert the properties of the vocabulary into properties of the classes of their domain, unless a class inherits
+// them already.
+func (c *vocabularyConverter) convertProperties() {
+	for _, term := range c.doc.properties {
+		name := nonNameCharacters.ReplaceAllString(localName(term.iri), "")
+		if name != "" {
+			name = strings.ToLower(name[:1]) + name[1:]
+		}
+
+		domains := map[string]bool{}
+		for _, domain := range term.domains {
+			if class, ok := c.converted[domain]; ok {
+				domains[class.Class] = true
+			}
+		}
+		if len(domains) == 0 {
+			continue
+		}
+		if !validPropertyName.MatchString(name) {
+			c.warn("the property '%s' is left out, because '%s' is not a valid property name", term.iri, name)
+			continue
+		}
+
+		dataTypes := c.dataTypes(term, name)
+		if len(dataTypes) == 0 {
+			continue
+		}
+		keywords := c.keywords(term.label, name)
+
+		for _, domain := range term.domains {
+			class, ok := c.converted[domain]
+			if !ok || c.inheritsFrom(class, domains) {
+				continue
+			}
+			if _, err := GetPropertyByName(class, name); err == nil {
+				c.warn("the property '%s' is left out of the class '%s', because it has a property '%s' already", term.iri, class.Class, name)
+				continue
+			}
+
+			property := &models.SemanticSchemaClassProperty{
+				Name:        name,
+				AtDataType:  dataTypes,
+				Description: term.comment,
+			}
+			for _, word := range keywords {
+				property.Keywords = append(property.Keywords, &models.SemanticSchemaClassPropertyKeywordsItems0{Kind: word, Weight: 1})
+			}
+			class.Properties = append(class.Properties, property)
+		}
+	}
+}
+
+// Whether one of the classes that the class extends is one of the named classes.
+func (c *vocabularyConverter) inheritsFrom(class *models.SemanticSchemaClass, classNames map[string]bool) bool {
+	seen := map[string]bool{}
+	for class.Extends != "" && !seen[class.Extends] {
+		if classNames[class.Extends] {
+			return true
+		}
+		seen[class.Extends] = true
+
+		parent := c.class(class.Extends)
+		if parent == nil {
+			return false
+		}
+		class = parent
+	}
+	return false
+}
+
+// The converted class with the name.
+func (c *vocabularyConverter) class(className string) *models.SemanticSchemaClass {
+	for _, class := range c.converted {
+		if class.Class == className {
+			return class
+		}
+	}
+	return nil
+}
+
+// The data types of a property, which are the converted classes of its range, or otherwise one data type for the
+// values of its range.
+func (c *vocabularyConverter) dataTypes(term *vocabularyTerm, name string) []string {
+	classNames := []string{}
+	valueDataTypes := map[DataType]bool{}
+	for _, rangeIRI := range term.ranges {
+		if dataType, ok := vocabularyDataTypes[rangeIRI]; ok {
+			valueDataTypes[dataType] = true
+		} else if class, ok := c.converted[rangeIRI]; ok {
+			classNames = append(classNames, class.Class)
+		}
+	}
+
+	if len(classNames) > 0 {
+		if len(valueDataTypes) > 0 {
+			c.warn("the property '%s' only refers to the classes %s, the other values of its range are left out", name, strings.Join(classNames, ", "))
+		}
+		return classNames
+	}
+
+	switch {
+	case len(term.ranges) == 0:
+		c.warn("the property '%s' has no range, so it is a string", name)
+		return []string{string(DataTypeString)}
+	case len(valueDataTypes) == 0:
+		c.warn("the property '%s' is left out, because none of the classes of its range are converted", name)
+		return nil
+	case len(valueDataTypes) == 1:
+		for dataType := range valueDataTypes {
+			return []string{string(dataType)}
+		}
+	}
+
+	// Values of more data types are kept in the data type that can hold them all, or in strings
+	dataType := DataTypeString
+	if len(valueDataTypes) == 2 && valueDataTypes[DataTypeInt] && valueDataTypes[DataTypeNumber] {
+		dataType = DataTypeNumber
+	}
+	others := []string{}
+	for other := range valueDataTypes {
+		if other != dataType {
+			others = append(others, string(other))
+		}
+	}
+	sort.Strings(others)
+	c.warn("the property '%s' is a %s, its values of type %s are converted", name, dataType, strings.Join(others, ", "))
+	return []string{string(dataType)}
+}
+
+// The words of the label as keywords, when they differ from the words of the name. With a contextionary, only the
+// words in the contextionary are kept.
+func (c *vocabularyConverter) keywords(label string, name string) []string {
+	words := []string{}
+	seen := map[string]bool{}
+	for _, word := range strings.Fields(nonNameCharacters.ReplaceAllString(label, " ")) {
+		word = strings.ToLower(word)
+		if seen[word] || (c.options.Contextionary != nil && !c.inContextionary(word)) {
+			continue
+		}
+		seen[word] = true
+		words = append(words, word)
+	}
+
+	if equalJSON(words, nameWords(name)) {
+		return nil
+	}
+	return words
+}
+
+// Warn about the classes and properties that have neither keywords nor a name in the contextionary.
+func (c *vocabularyConverter) checkContextionary() {
+	if c.options.Contextionary == nil {
+		return
+	}
+
+	for _, semanticSchema := range []*models.SemanticSchema{c.result.Things, c.result.Actions} {
+		for _, class := range semanticSchema.Classes {
+			if len(class.Keywords) == 0 && !c.inContextionary(nameWords(class.Class)...) {
+				c.warn("the name of the class '%s' is not in the contextionary, it needs keywords", class.Class)
+			}
+			for _, property := range class.Properties {
+				if len(property.Keywords) == 0 && !c.inContextionary(nameWords(property.Name)...) {
+					c.warn("the name of the property '%s' in class '%s' is not in the contextionary, it needs keywords", property.Name, class.Class)
+				}
+			}
+		}
+	}
+}
+
+func (c *vocabularyConverter) inContextionary(words ...string) bool {
+	for _, word := range words {
+		index := c.options.Contextionary.WordToItemIndex(word)
+		if !index.IsPresent() {
+			return false
+		}
+	}
+	return true
+}
+
+// Give the classes that extend a class of the other kind, like the Action of schema.org extends its Thing, the
+// properties of that class, which they can't inherit.
+func (c *vocabularyConverter) inheritFromOtherKind() {
+	for _, term := range c.doc.classes {
+		class, ok := c.converted[term.iri]
+		if !ok {
+			continue
+		}
+
+		for _, parent := range term.parents {
+			parentClass, ok := c.converted[parent]
+			if !ok || c.actions[parent] == c.actions[term.iri] {
+				continue
+			}
+
+			for _, property := range c.allProperties(parentClass) {
+				if _, err := GetPropertyByName(class, property.Name); err == nil || c.inheritedProperty(class, property.Name) {
+					continue
+				}
+				copied := *property
+				class.Properties = append(class.Properties, &copied)
+			}
+		}
+	}
+}
+
+// The properties of the class, with the properties that it inherits.
+func (c *vocabularyConverter) allProperties(class *models.SemanticSchemaClass) []*models.SemanticSchemaClassProperty {
+	properties := append([]*models.SemanticSchemaClassProperty{}, class.Properties...)
+	seen := map[string]bool{class.Class: true}
+	for class.Extends != "" && !seen[class.Extends] {
+		seen[class.Extends] = true
+		class = c.class(class.Extends)
+		if class == nil {
+			break
+		}
+		properties = append(properties, class.Properties...)
+	}
+	return properties
+}
+
+// Whether the class inherits a property with the name from the classes it extends.
+func (c *vocabularyConverter) inheritedProperty(class *models.SemanticSchemaClass, propertyName string) bool {
+	if class.Extends == "" {
+		return false
+	}
+	parent := c.class(class.Extends)
+	if parent == nil {
+		return false
+	}
+	for _, property := range c.allProperties(parent) {
+		if property.Name == propertyName {
+			return true
+		}
+	}
+	return false
+}
+
+// The lowercase words of a camel cased name.
+func nameWords(name string) []string {
+	words := []string{}
+	for _, part := range camelcase.Split(name) {
+		words = append(words, strings.ToLower(part))
+	}
+	return words
+}
+
+// The last part of an IRI, after its namespace.
+func localName(iri string) string {
+	return iri[len(namespace(iri)):]
+}
+
+// The namespace of an IRI, up to the last '#', '/' or ':'.
+func namespace(iri string) string {
+	return iri[:strings.LastIndexAny(iri, "#/:")+1]
+}
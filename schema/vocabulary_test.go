@@ -0,0 +1,187 @@
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	libcontextionary "github.com/creativesoftwarefdn/weaviate/contextionary"
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/stretchr/testify/require"
+)
+
+// A subset of schema.org, as it is published in JSON-LD.
+const schemaOrgVocabulary = `{
+  "@context": {
+    "rdf": "http://www.w3.org/1999/02/22-rdf-syntax-ns#",
+    "rdfs": "http://www.w3.org/2000/01/rdf-schema#",
+    "schema": "https://schema.org/"
+  },
+  "@graph": [
+    {"@id": "schema:Thing", "@type": "rdfs:Class", "rdfs:label": "Thing", "rdfs:comment": "The most generic type of item."},
+    {"@id": "schema:Person", "@type": "rdfs:Class", "rdfs:label": "Person", "rdfs:subClassOf": {"@id": "schema:Thing"}},
+    {"@id": "schema:Organization", "@type": "rdfs:Class", "rdfs:label": "Organization", "rdfs:subClassOf": {"@id": "schema:Thing"}},
+    {"@id": "schema:Action", "@type": "rdfs:Class", "rdfs:label": "Action", "rdfs:subClassOf": {"@id": "schema:Thing"}},
+    {"@id": "schema:BuyAction", "@type": "rdfs:Class", "rdfs:label": "Buy Action", "rdfs:subClassOf": {"@id": "schema:Action"}},
+    {"@id": "schema:Text", "@type": ["schema:DataType", "rdfs:Class"], "rdfs:label": "Text"},
+    {"@id": "schema:name", "@type": "rdf:Property", "rdfs:label": "name",
+     "schema:domainIncludes": {"@id": "schema:Thing"}, "schema:rangeIncludes": {"@id": "schema:Text"}},
+    {"@id": "schema:birthDate", "@type": "rdf:Property", "rdfs:label": "birthDate",
+     "schema:domainIncludes": {"@id": "schema:Person"}, "schema:rangeIncludes": {"@id": "schema:Date"}},
+    {"@id": "schema:numberOfEmployees", "@type": "rdf:Property", "rdfs:label": "numberOfEmployees",
+     "schema:domainIncludes": {"@id": "schema:Organization"}, "schema:rangeIncludes": [{"@id": "schema:Integer"}, {"@id": "schema:Number"}]},
+    {"@id": "schema:location", "@type": "rdf:Property", "rdfs:label": "location",
+     "schema:domainIncludes": [{"@id": "schema:Organization"}, {"@id": "schema:Person"}], "schema:rangeIncludes": {"@id": "schema:GeoCoordinates"}},
+    {"@id": "schema:agent", "@type": "rdf:Property", "rdfs:label": "agent",
+     "schema:domainIncludes": {"@id": "schema:Action"}, "schema:rangeIncludes": [{"@id": "schema:Organization"}, {"@id": "schema:Person"}, {"@id": "schema:Text"}]},
+    {"@id": "schema:seller", "@type": "rdf:Property", "rdfs:label": "seller",
+     "schema:domainIncludes": {"@id": "schema:BuyAction"}, "schema:rangeIncludes": {"@id": "schema:Organization"}},
+    {"@id": "schema:vendor", "@type": "rdf:Property", "rdfs:label": "vendor", "schema:supersededBy": {"@id": "schema:seller"},
+     "schema:domainIncludes": {"@id": "schema:BuyAction"}, "schema:rangeIncludes": {"@id": "schema:Organization"}}
+  ]
+}`
+
+// A small OWL ontology, as it is written in expanded JSON-LD.
+const owlVocabulary = `[
+  {"@id": "http://example.org/zoo#Animal", "@type": ["http://www.w3.org/2002/07/owl#Class"],
+   "http://www.w3.org/2000/01/rdf-schema#label": [{"@value": "Dier", "@language": "nl"}, {"@value": "Animal", "@language": "en"}]},
+  {"@id": "http://example.org/zoo#Zoo", "@type": ["http://www.w3.org/2002/07/owl#Class"],
+   "http://www.w3.org/2000/01/rdf-schema#label": [{"@value": "Zoological garden"}]},
+  {"@id": "http://example.org/zoo#weight", "@type": ["http://www.w3.org/2002/07/owl#DatatypeProperty"],
+   "http://www.w3.org/2000/01/rdf-schema#domain": [{"@id": "http://example.org/zoo#Animal"}],
+   "http://www.w3.org/2000/01/rdf-schema#range": [{"@id": "http://www.w3.org/2001/XMLSchema#decimal"}]},
+  {"@id": "http://example.org/zoo#livesIn", "@type": ["http://www.w3.org/2002/07/owl#ObjectProperty"],
+   "http://www.w3.org/2000/01/rdf-schema#domain": [{"@id": "http://example.org/zoo#Animal"}],
+   "http://www.w3.org/2000/01/rdf-schema#range": [{"@id": "http://example.org/zoo#Zoo"}]}
+]`
+
+func parseJSON(t *testing.T, document string) interface{} {
+	var parsed interface{}
+	require.Nil(t, json.Unmarshal([]byte(document), &parsed))
+	return parsed
+}
+
+func classNames(s *models.SemanticSchema) []string {
+	names := []string{}
+	for _, class := range s.Classes {
+		names = append(names, class.Class)
+	}
+	return names
+}
+
+func propertyDataTypes(class *models.SemanticSchemaClass) map[string][]string {
+	dataTypes := map[string][]string{}
+	for _, property := range class.Properties {
+		dataTypes[property.Name] = property.AtDataType
+	}
+	return dataTypes
+}
+
+func TestConvertSchemaOrgVocabulary(t *testing.T) {
+	converted, err := ConvertVocabulary(parseJSON(t, schemaOrgVocabulary), VocabularyOptions{})
+	require.Nil(t, err)
+
+	require.Equal(t, []string{"Thing", "Person", "Organization"}, classNames(converted.Things))
+	require.Equal(t, []string{"Action", "BuyAction"}, classNames(converted.Actions))
+	require.Equal(t, "http://schema.org/", converted.Things.AtContext.String())
+	require.Equal(t, "thing", converted.Things.Type)
+	require.Equal(t, "action", converted.Actions.Type)
+
+	thing, _ := GetClassByName(converted.Things, "Thing")
+	person, _ := GetClassByName(converted.Things, "Person")
+	organization, _ := GetClassByName(converted.Things, "Organization")
+	require.Equal(t, "The most generic type of item.", thing.Description)
+	require.Equal(t, "Thing", person.Extends)
+	require.Equal(t, map[string][]string{"name": {"string"}}, propertyDataTypes(thing))
+	require.Equal(t, map[string][]string{"birthDate": {"date"}, "location": {"geoCoordinates"}}, propertyDataTypes(person))
+	require.Equal(t, map[string][]string{"numberOfEmployees": {"number"}, "location": {"geoCoordinates"}}, propertyDataTypes(organization))
+
+	// The action can't extend the thing, so it has its properties
+	action, _ := GetClassByName(converted.Actions, "Action")
+	buyAction, _ := GetClassByName(converted.Actions, "BuyAction")
+	require.Equal(t, "", action.Extends)
+	require.Equal(t, "Action", buyAction.Extends)
+	require.Equal(t, map[string][]string{"agent": {"Organization", "Person"}, "name": {"string"}}, propertyDataTypes(action))
+	require.Equal(t, map[string][]string{"seller": {"Organization"}}, propertyDataTypes(buyAction))
+
+	// Only labels that differ from the name are keywords
+	require.Nil(t, person.Keywords)
+	require.Len(t, buyAction.Keywords, 0)
+
+	require.Contains(t, converted.Warnings, "the property 'agent' only refers to the classes Organization, Person, the other values of its range are left out")
+	require.Contains(t, converted.Warnings, "the property 'numberOfEmployees' is a number, its values of type int are converted")
+
+	// The converted schema is valid, with the inherited properties
+	require.Nil(t, mergeInheritedProperties(converted.Things))
+	require.Nil(t, mergeInheritedProperties(converted.Actions))
+	require.Equal(t, map[string][]string{"name": {"string"}, "birthDate": {"date"}, "location": {"geoCoordinates"}}, propertyDataTypes(person))
+}
+
+func TestConvertVocabularySubset(t *testing.T) {
+	converted, err := ConvertVocabulary(parseJSON(t, schemaOrgVocabulary), VocabularyOptions{
+		Classes:       []string{"Person", "schema:BuyAction"},
+		ActionClasses: []string{"BuyAction"},
+	})
+	require.Nil(t, err)
+
+	// The parents of the selected classes are converted as well; the Action is not an action itself
+	require.Equal(t, []string{"Thing", "Person", "Action"}, classNames(converted.Things))
+	require.Equal(t, []string{"BuyAction"}, classNames(converted.Actions))
+
+	// The seller refers to organizations, which are not converted
+	buyAction, _ := GetClassByName(converted.Actions, "BuyAction")
+	require.Equal(t, "", buyAction.Extends)
+	_, err = GetPropertyByName(buyAction, "seller")
+	require.NotNil(t, err)
+	require.Contains(t, converted.Warnings, "the property 'seller' is left out, because none of the classes of its range are converted")
+
+	_, err = ConvertVocabulary(parseJSON(t, schemaOrgVocabulary), VocabularyOptions{Classes: []string{"Place"}})
+	require.EqualError(t, err, "the class 'Place' is not in the vocabulary")
+}
+
+func TestConvertOWLVocabulary(t *testing.T) {
+	builder := libcontextionary.InMemoryBuilder(2)
+	for i, word := range []string{"animal", "weight", "garden", "lives", "in"} {
+		builder.AddWord(word, libcontextionary.NewVector([]float32{float32(i), 1}))
+	}
+	contextionary := libcontextionary.Contextionary(builder.Build(10))
+
+	converted, err := ConvertVocabulary(parseJSON(t, owlVocabulary), VocabularyOptions{Contextionary: contextionary})
+	require.Nil(t, err)
+
+	require.Equal(t, []string{"Animal", "Zoo"}, classNames(converted.Things))
+	require.Len(t, converted.Actions.Classes, 0)
+	require.Equal(t, "http://example.org/zoo#", converted.Things.AtContext.String())
+
+	animal, _ := GetClassByName(converted.Things, "Animal")
+	require.Equal(t, map[string][]string{"weight": {"number"}, "livesIn": {"Zoo"}}, propertyDataTypes(animal))
+	require.Nil(t, animal.Keywords)
+
+	// Only the words of the label that are in the contextionary are keywords
+	zoo, _ := GetClassByName(converted.Things, "Zoo")
+	require.Len(t, zoo.Keywords, 1)
+	require.Equal(t, "garden", zoo.Keywords[0].Kind)
+
+	require.Len(t, converted.Warnings, 0)
+}
+
+func TestConvertVocabularyNotInContextionary(t *testing.T) {
+	builder := libcontextionary.InMemoryBuilder(2)
+	builder.AddWord("animal", libcontextionary.NewVector([]float32{1, 1}))
+	contextionary := libcontextionary.Contextionary(builder.Build(10))
+
+	converted, err := ConvertVocabulary(parseJSON(t, owlVocabulary), VocabularyOptions{Contextionary: contextionary})
+	require.Nil(t, err)
+	require.Equal(t, []string{
+		"the name of the property 'weight' in class 'Animal' is not in the contextionary, it needs keywords",
+		"the name of the property 'livesIn' in class 'Animal' is not in the contextionary, it needs keywords",
+		"the name of the class 'Zoo' is not in the contextionary, it needs keywords",
+	}, converted.Warnings)
+}
+
+func TestConvertInvalidVocabulary(t *testing.T) {
+	_, err := ConvertVocabulary("schema.org", VocabularyOptions{})
+	require.EqualError(t, err, "the vocabulary should be a JSON-LD object or list")
+
+	_, err = ConvertVocabulary(parseJSON(t, `{"@graph": []}`), VocabularyOptions{})
+	require.EqualError(t, err, "the vocabulary has no classes")
+}
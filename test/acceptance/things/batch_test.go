@@ -0,0 +1,146 @@
+package test
+
+// Acceptance tests for batches of things.
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/creativesoftwarefdn/weaviate/client/things"
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/creativesoftwarefdn/weaviate/test/acceptance/helper"
+	"github.com/creativesoftwarefdn/weaviate/validation"
+)
+
+// Check that a batch stores every valid thing, and reports the invalid ones.
+func TestBatchCreateThings(t *testing.T) {
+	t.Parallel()
+
+	params := things.NewWeaviateThingsBatchCreateParams().WithBody(&models.ThingsBatchRequest{
+		Things: []*models.ThingBatchItem{
+			{
+				Thing: &models.ThingCreate{
+					AtContext: "http://example.org",
+					AtClass:   "TestThing",
+					Schema: map[string]interface{}{
+						"testString": "first",
+					},
+				},
+			},
+			{
+				Thing: &models.ThingCreate{
+					AtContext: "http://example.org",
+					Schema: map[string]interface{}{
+						"testString": "missing class",
+					},
+				},
+			},
+		},
+	})
+
+	resp, err := helper.Client(t).Things.WeaviateThingsBatchCreate(params, helper.RootAuth)
+
+	helper.AssertRequestOk(t, resp, err, func() {
+		results := resp.Payload.Things
+		assert.Len(t, results, 2)
+
+		assert.Equal(t, models.ThingBatchResultStatusCreated, results[0].Status)
+		assert.Regexp(t, strfmt.UUIDPattern, results[0].ThingID)
+
+		assert.Equal(t, models.ThingBatchResultStatusFailed, results[1].Status)
+		assert.Equal(t, validation.ErrorMissingClass, results[1].Errors.Error.Message)
+	})
+}
+
+// Check that a thing can refer to another thing in the same batch.
+func TestBatchCreateThingsWithRefToBatch(t *testing.T) {
+	t.Parallel()
+
+	params := things.NewWeaviateThingsBatchCreateParams().WithBody(&models.ThingsBatchRequest{
+		Things: []*models.ThingBatchItem{
+			{
+				Thing: &models.ThingCreate{
+					AtContext: "http://example.org",
+					AtClass:   "TestThing",
+					Schema: map[string]interface{}{
+						"testCref": map[string]interface{}{
+							"$cref":       "#/things/1",
+							"locationUrl": helper.GetWeaviateURL(),
+							"type":        "Thing",
+						},
+					},
+				},
+			},
+			{
+				Thing: &models.ThingCreate{
+					AtContext: "http://example.org",
+					AtClass:   "TestThing",
+					Schema: map[string]interface{}{
+						"testString": "referred to",
+					},
+				},
+			},
+		},
+	})
+
+	resp, err := helper.Client(t).Things.WeaviateThingsBatchCreate(params, helper.RootAuth)
+
+	helper.AssertRequestOk(t, resp, err, func() {
+		results := resp.Payload.Things
+		assert.Len(t, results, 2)
+		assert.Equal(t, models.ThingBatchResultStatusCreated, results[0].Status)
+		assert.Equal(t, models.ThingBatchResultStatusCreated, results[1].Status)
+
+		getParams := things.NewWeaviateThingsGetParams().WithThingID(results[0].ThingID)
+		getResp, err := helper.Client(t).Things.WeaviateThingsGet(getParams, helper.RootAuth)
+
+		helper.AssertRequestOk(t, getResp, err, func() {
+			schema := getResp.Payload.Schema.(map[string]interface{})
+			cref := schema["testCref"].(map[string]interface{})
+			assert.Equal(t, string(results[1].ThingID), cref["$cref"])
+		})
+	})
+}
+
+// Check that a thing referring to a failed thing in the same batch fails as well.
+func TestBatchCreateThingsWithRefToFailedThing(t *testing.T) {
+	t.Parallel()
+
+	params := things.NewWeaviateThingsBatchCreateParams().WithBody(&models.ThingsBatchRequest{
+		Things: []*models.ThingBatchItem{
+			{
+				Thing: &models.ThingCreate{
+					AtContext: "http://example.org",
+					AtClass:   "TestThing",
+					Schema: map[string]interface{}{
+						"testCref": map[string]interface{}{
+							"$cref":       "#/things/1",
+							"locationUrl": helper.GetWeaviateURL(),
+							"type":        "Thing",
+						},
+					},
+				},
+			},
+			{
+				Thing: &models.ThingCreate{
+					AtContext: "http://example.org",
+					AtClass:   "NonExistingClass",
+				},
+			},
+		},
+	})
+
+	resp, err := helper.Client(t).Things.WeaviateThingsBatchCreate(params, helper.RootAuth)
+
+	helper.AssertRequestOk(t, resp, err, func() {
+		results := resp.Payload.Things
+		assert.Len(t, results, 2)
+		assert.Equal(t, models.ThingBatchResultStatusFailed, results[0].Status)
+		assert.Equal(t, fmt.Sprintf("refers to item %d of the batch, which failed", 1), results[0].Errors.Error.Message)
+		assert.Equal(t, models.ThingBatchResultStatusFailed, results[1].Status)
+	})
+}
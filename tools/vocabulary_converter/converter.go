@@ -0,0 +1,95 @@
+package main
+
+// Convert a vocabulary in JSON-LD, like schema.org or an RDFS or OWL ontology, into a thing and an action schema
+// file, which a Weaviate can be started with.
+//
+// go run ./tools/vocabulary_converter -vocabulary schemaorg-current-https.jsonld -classes Person,Organization,BuyAction \
+//   -knn-file contextionary.knn -idx-file contextionary.idx
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	libcontextionary "github.com/creativesoftwarefdn/weaviate/contextionary"
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/creativesoftwarefdn/weaviate/schema"
+)
+
+func main() {
+	var vocabularyFile, classes, actionClasses, knnFile, idxFile, thingsFile, actionsFile string
+	flag.StringVar(&vocabularyFile, "vocabulary", "", "The JSON-LD file of the vocabulary")
+	flag.StringVar(&classes, "classes", "", "Comma separated names of the classes to convert, with the classes they extend; all classes when empty")
+	flag.StringVar(&actionClasses, "action-classes", "Action", "Comma separated names of the classes that are actions, with the classes that extend them")
+	flag.StringVar(&knnFile, "knn-file", "", "The KNN file of the contextionary to check the names against; they are not checked when empty")
+	flag.StringVar(&idxFile, "idx-file", "", "The IDX file of the contextionary")
+	flag.StringVar(&thingsFile, "things", "things_schema.json", "The file to write the thing schema to")
+	flag.StringVar(&actionsFile, "actions", "actions_schema.json", "The file to write the action schema to")
+	flag.Parse()
+
+	if vocabularyFile == "" {
+		exit("no vocabulary file given")
+	}
+
+	dat, err := ioutil.ReadFile(vocabularyFile)
+	if err != nil {
+		exit("could not read the vocabulary: %v", err)
+	}
+
+	var vocabulary interface{}
+	if err := json.Unmarshal(dat, &vocabulary); err != nil {
+		exit("could not parse the vocabulary: %v", err)
+	}
+
+	options := schema.VocabularyOptions{
+		Classes:       split(classes),
+		ActionClasses: split(actionClasses),
+	}
+	if knnFile != "" {
+		contextionary, err := libcontextionary.LoadVectorFromDisk(knnFile, idxFile)
+		if err != nil {
+			exit("could not load the contextionary: %v", err)
+		}
+		options.Contextionary = *contextionary
+	}
+
+	converted, err := schema.ConvertVocabulary(vocabulary, options)
+	if err != nil {
+		exit("could not convert the vocabulary: %v", err)
+	}
+
+	for _, warning := range converted.Warnings {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", warning)
+	}
+
+	write(thingsFile, converted.Things)
+	write(actionsFile, converted.Actions)
+	fmt.Printf("Converted %d thing classes into %s and %d action classes into %s\n",
+		len(converted.Things.Classes), thingsFile, len(converted.Actions.Classes), actionsFile)
+}
+
+func split(names string) []string {
+	if names == "" {
+		return nil
+	}
+	return strings.Split(names, ",")
+}
+
+func write(file string, semanticSchema *models.SemanticSchema) {
+	dat, err := json.MarshalIndent(semanticSchema, "", "    ")
+	if err != nil {
+		exit("could not encode the schema: %v", err)
+	}
+
+	if err := ioutil.WriteFile(file, dat, 0644); err != nil {
+		exit("could not write the schema to '%s': %v", file, err)
+	}
+}
+
+func exit(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
@@ -0,0 +1,82 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+package validation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/creativesoftwarefdn/weaviate/schema"
+)
+
+// BatchClaims keeps the unique values and one-to-one references that the items of a batch use. The items of a batch
+// are validated one by one before any of them is stored, so the database can't tell that an earlier item of the
+// batch uses a value already. A connector that validates a batch adds the result of Find to the instances it finds.
+type BatchClaims struct {
+	claimed map[string][]strfmt.UUID
+	pending map[string]bool
+	current strfmt.UUID
+}
+
+// NewBatchClaims creates the claims of an empty batch
+func NewBatchClaims() *BatchClaims {
+	return &BatchClaims{claimed: map[string][]strfmt.UUID{}, pending: map[string]bool{}}
+}
+
+// Start starts the validation of the item with the UUID; the values it claims are kept when Commit is called.
+func (b *BatchClaims) Start(UUID strfmt.UUID) {
+	b.current = UUID
+	b.pending = map[string]bool{}
+}
+
+// Commit keeps the values that the item that is validated claims, because it is valid.
+func (b *BatchClaims) Commit() {
+	for key := range b.pending {
+		b.claimed[key] = append(b.claimed[key], b.current)
+	}
+	b.pending = map[string]bool{}
+}
+
+// Find returns the UUIDs of the valid items of the batch that have the value for the property, and claims the value
+// for the item that is validated.
+func (b *BatchClaims) Find(refType connutils.RefType, className string, propertyName string, value interface{}) []strfmt.UUID {
+	key := claimKey(refType, className, propertyName, value)
+	b.pending[key] = true
+	return b.claimed[key]
+}
+
+// The key of a value of a property, which is the same for equal values. Numbers are equal when their values are, and
+// references when they refer to the same thing or action.
+func claimKey(refType connutils.RefType, className string, propertyName string, value interface{}) string {
+	switch t := value.(type) {
+	case *models.SingleRef:
+		location := ""
+		if t.LocationURL != nil {
+			location = *t.LocationURL
+		}
+		value = fmt.Sprintf("%s %s %s", t.Type, t.NrDollarCref, location)
+	case time.Time:
+		value = t.UTC().Format(time.RFC3339Nano)
+	default:
+		if number, ok := schema.NumberValue(value); ok {
+			value = number
+		}
+	}
+
+	return fmt.Sprintf("%s/%s/%s/%T/%v", refType, className, propertyName, value, value)
+}
@@ -0,0 +1,171 @@
+/*                          _       _
+ *__      _____  __ ___   ___  __ _| |_ ___
+ *\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+ * \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+ *  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+ *
+ * Copyright © 2016 - 2018 Weaviate. All rights reserved.
+ * LICENSE: https://github.com/creativesoftwarefdn/weaviate/blob/develop/LICENSE.md
+ * AUTHOR: Bob van Luijt (bob@kub.design)
+ * See www.creativesoftwarefdn.org for details
+ * Contact: @CreativeSofwFdn / bob@kub.design
+ */
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/creativesoftwarefdn/weaviate/connectors"
+	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/creativesoftwarefdn/weaviate/schema"
+)
+
+// PropertyError is returned when the value of a property violates one of the constraints of the property.
+type PropertyError struct {
+	Class    string
+	Property string
+	// The violated constraint, e.g. "maximum"
+	Constraint string
+	Message    string
+}
+
+func (e *PropertyError) Error() string {
+	return fmt.Sprintf("class '%s' with property '%s' %s", e.Class, e.Property, e.Message)
+}
+
+// validateConstraints checks the validated values of a thing or action against the constraints of the properties of
+// its class. The UUID is the UUID of the thing or action that is updated, or empty when it is new.
+func validateConstraints(ctx context.Context, class *models.SemanticSchemaClass, refType connutils.RefType, UUID strfmt.UUID, values map[string]interface{}, dbConnector dbconnector.DatabaseConnector) error {
+	for _, property := range class.Properties {
+		if property.Constraints == nil {
+			continue
+		}
+		constraints := property.Constraints
+
+		violation := func(constraint string, format string, args ...interface{}) error {
+			return &PropertyError{
+				Class:      class.Class,
+				Property:   property.Name,
+				Constraint: constraint,
+				Message:    fmt.Sprintf(format, args...),
+			}
+		}
+
+		value, ok := values[property.Name]
+		if !ok || value == nil {
+			if constraints.Required {
+				return violation("required", "requires a value")
+			}
+			continue
+		}
+
+		// The constraints apply to each of the values of a list, which can not be unique or refer to others
+		if list, ok := value.([]interface{}); ok {
+			if len(list) == 0 && constraints.Required {
+				return violation("required", "requires at least one value")
+			}
+			for _, element := range list {
+				if err := checkValueConstraints(constraints, element, violation); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if err := checkValueConstraints(constraints, value, violation); err != nil {
+			return err
+		}
+
+		oneToOne := constraints.Cardinality == models.SemanticSchemaClassPropertyConstraintsCardinalityOneToOne
+		if !constraints.Unique && !oneToOne {
+			continue
+		}
+
+		finder, ok := dbConnector.(dbconnector.InstanceFinder)
+		if !ok {
+			continue
+		}
+
+		UUIDs, err := finder.FindInstances(ctx, refType, class.Class, property.Name, value)
+		if err != nil {
+			return fmt.Errorf("could not check whether the value of property '%s' in class '%s' is used already; %v", property.Name, class.Class, err)
+		}
+
+		for _, other := range UUIDs {
+			if other == UUID {
+				continue
+			}
+
+			if ref, ok := value.(*models.SingleRef); ok {
+				return violation("cardinality", "allows one reference to every %s, but the %s %s refers to '%s' already", ref.Type, refType, other, ref.NrDollarCref)
+			}
+			if t, ok := value.(time.Time); ok {
+				value = t.Format(time.RFC3339)
+			}
+			return violation("unique", "requires a unique value, but the %s %s has the value '%v' already", refType, other, value)
+		}
+	}
+
+	return nil
+}
+
+// checkValueConstraints checks a single value against the value constraints of a property.
+func checkValueConstraints(constraints *models.SemanticSchemaClassPropertyConstraints, value interface{}, violation func(constraint string, format string, args ...interface{}) error) error {
+	if number, ok := schema.NumberValue(value); ok {
+		if constraints.Minimum != nil && number < *constraints.Minimum {
+			return violation("minimum", "requires a value of at least %v. The given value is '%v'", *constraints.Minimum, value)
+		}
+		if constraints.Maximum != nil && number > *constraints.Maximum {
+			return violation("maximum", "requires a value of at most %v. The given value is '%v'", *constraints.Maximum, value)
+		}
+	}
+
+	if s, ok := value.(string); ok {
+		length := int64(utf8.RuneCountInString(s))
+		if constraints.MinLength != nil && length < *constraints.MinLength {
+			return violation("minLength", "requires at least %d characters. The given value is '%v'", *constraints.MinLength, value)
+		}
+		if constraints.MaxLength != nil && length > *constraints.MaxLength {
+			return violation("maxLength", "requires at most %d characters. The given value is '%v'", *constraints.MaxLength, value)
+		}
+		// The pattern is checked when the schema is loaded or changed, so it compiles
+		if constraints.Pattern != "" && !regexp.MustCompile(constraints.Pattern).MatchString(s) {
+			return violation("pattern", "requires a value that matches '%s'. The given value is '%v'", constraints.Pattern, value)
+		}
+	}
+
+	if constraints.Enum != nil && !enumContains(constraints.Enum, value) {
+		allowed := make([]string, 0, len(constraints.Enum))
+		for _, e := range constraints.Enum {
+			allowed = append(allowed, fmt.Sprintf("'%v'", e))
+		}
+		return violation("enum", "requires one of the values %s. The given value is '%v'", strings.Join(allowed, ", "), value)
+	}
+
+	return nil
+}
+
+// Whether the value is one of the values of the enum. Numbers are compared by their value, as the enum is decoded
+// from JSON.
+func enumContains(enum []interface{}, value interface{}) bool {
+	number, isNumber := schema.NumberValue(value)
+	for _, e := range enum {
+		if isNumber {
+			if n, ok := schema.NumberValue(e); ok && n == number {
+				return true
+			}
+		} else if e == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/creativesoftwarefdn/weaviate/connectors"
+	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/stretchr/testify/require"
+)
+
+// A connector that finds the instances in a map from property values to UUIDs
+type fakeFinder struct {
+	dbconnector.DatabaseConnector
+	instances map[interface{}][]strfmt.UUID
+}
+
+func (f *fakeFinder) FindInstances(ctx context.Context, refType connutils.RefType, className string, propertyName string, value interface{}) ([]strfmt.UUID, error) {
+	if ref, ok := value.(*models.SingleRef); ok {
+		return f.instances[ref.NrDollarCref], nil
+	}
+	return f.instances[value], nil
+}
+
+func float64Pointer(f float64) *float64 {
+	return &f
+}
+
+func int64Pointer(i int64) *int64 {
+	return &i
+}
+
+var (
+	amsterdam = strfmt.UUID("0c3b2c2f-2f42-4a4f-9f0a-6d5b2b1c3a01")
+	rotterdam = strfmt.UUID("0c3b2c2f-2f42-4a4f-9f0a-6d5b2b1c3a02")
+	holland   = strfmt.UUID("0c3b2c2f-2f42-4a4f-9f0a-6d5b2b1c3a03")
+)
+
+var city = &models.SemanticSchemaClass{
+	Class: "City",
+	Properties: []*models.SemanticSchemaClassProperty{
+		{Name: "name", AtDataType: []string{"string"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Required: true, MinLength: int64Pointer(2), MaxLength: int64Pointer(20), Pattern: "^[A-Z]", Unique: true}},
+		{Name: "population", AtDataType: []string{"int"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Minimum: float64Pointer(0), Maximum: float64Pointer(1e8)}},
+		{Name: "size", AtDataType: []string{"string"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Enum: []interface{}{"small", "large"}}},
+		{Name: "rank", AtDataType: []string{"number"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Enum: []interface{}{float64(1), float64(2.5)}}},
+		{Name: "capitalOf", AtDataType: []string{"Country"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Cardinality: "oneToOne"}},
+		{Name: "postcodes", AtDataType: []string{"string[]"}, Constraints: &models.SemanticSchemaClassPropertyConstraints{Pattern: "^[0-9]{4}$"}},
+	},
+}
+
+func TestValidateConstraints(t *testing.T) {
+	location := "http://localhost/"
+	finder := &fakeFinder{instances: map[interface{}][]strfmt.UUID{
+		"Amsterdam": {amsterdam},
+		holland:     {amsterdam},
+	}}
+
+	valid := []map[string]interface{}{
+		{"name": "Rotterdam", "population": int64(600000), "size": "large", "rank": float64(2.5)},
+		{"name": "Utrecht", "rank": int64(1), "postcodes": []interface{}{"3511", "3512"}},
+		// Amsterdam is allowed to keep its name and to stay the capital when it is updated
+		{"name": "Amsterdam", "capitalOf": &models.SingleRef{NrDollarCref: holland, LocationURL: &location, Type: "Thing"}},
+	}
+	for _, values := range valid {
+		require.Nil(t, validateConstraints(context.Background(), city, connutils.RefTypeThing, amsterdam, values, finder), values)
+	}
+
+	invalid := []struct {
+		constraint string
+		values     map[string]interface{}
+	}{
+		{"required", map[string]interface{}{"population": int64(1)}},
+		{"minLength", map[string]interface{}{"name": "R"}},
+		{"maxLength", map[string]interface{}{"name": "Llanfairpwllgwyngyll-gogerychwyrndrobwll"}},
+		{"pattern", map[string]interface{}{"name": "rotterdam"}},
+		{"minimum", map[string]interface{}{"name": "Rotterdam", "population": int64(-1)}},
+		{"maximum", map[string]interface{}{"name": "Rotterdam", "population": float64(2e8)}},
+		{"enum", map[string]interface{}{"name": "Rotterdam", "size": "medium"}},
+		{"enum", map[string]interface{}{"name": "Rotterdam", "rank": float64(2)}},
+		{"pattern", map[string]interface{}{"name": "Rotterdam", "postcodes": []interface{}{"3011", "30112"}}},
+		{"unique", map[string]interface{}{"name": "Amsterdam"}},
+		{"cardinality", map[string]interface{}{"name": "Rotterdam", "capitalOf": &models.SingleRef{NrDollarCref: holland, LocationURL: &location, Type: "Thing"}}},
+	}
+	for _, tc := range invalid {
+		err := validateConstraints(context.Background(), city, connutils.RefTypeThing, rotterdam, tc.values, finder)
+		require.IsType(t, &PropertyError{}, err, tc.constraint)
+		require.Equal(t, tc.constraint, err.(*PropertyError).Constraint)
+		require.Equal(t, "City", err.(*PropertyError).Class)
+	}
+}
+
+func TestUniqueNotCheckedWithoutFinder(t *testing.T) {
+	values := map[string]interface{}{"name": "Amsterdam"}
+	require.Nil(t, validateConstraints(context.Background(), city, connutils.RefTypeThing, "", values, nil))
+}
+
+// A connector that validates a batch; it finds the instances in the database and the earlier items of the batch
+type batchFinder struct {
+	*fakeFinder
+	claims *BatchClaims
+}
+
+func (b *batchFinder) FindInstances(ctx context.Context, refType connutils.RefType, className string, propertyName string, value interface{}) ([]strfmt.UUID, error) {
+	UUIDs, _ := b.fakeFinder.FindInstances(ctx, refType, className, propertyName, value)
+	return append(UUIDs, b.claims.Find(refType, className, propertyName, value)...), nil
+}
+
+func TestUniqueWithinBatch(t *testing.T) {
+	location := "http://localhost/"
+	finder := &batchFinder{fakeFinder: &fakeFinder{}, claims: NewBatchClaims()}
+	validate := func(UUID strfmt.UUID, values map[string]interface{}) error {
+		finder.claims.Start(UUID)
+		err := validateConstraints(context.Background(), city, connutils.RefTypeThing, UUID, values, finder)
+		if err == nil {
+			finder.claims.Commit()
+		}
+		return err
+	}
+	capitalOf := func() *models.SingleRef {
+		return &models.SingleRef{NrDollarCref: holland, LocationURL: &location, Type: "Thing"}
+	}
+
+	// An invalid item doesn't claim its values
+	require.NotNil(t, validate("0c3b2c2f-2f42-4a4f-9f0a-6d5b2b1c3a04", map[string]interface{}{"name": "Rotterdam", "population": int64(-1)}))
+	require.Nil(t, validate(rotterdam, map[string]interface{}{"name": "Rotterdam"}))
+	require.Nil(t, validate(amsterdam, map[string]interface{}{"name": "Amsterdam", "capitalOf": capitalOf()}))
+
+	invalid := []struct {
+		constraint string
+		values     map[string]interface{}
+	}{
+		{"unique", map[string]interface{}{"name": "Rotterdam"}},
+		{"cardinality", map[string]interface{}{"name": "Utrecht", "capitalOf": capitalOf()}},
+	}
+	for _, tc := range invalid {
+		err := validate("0c3b2c2f-2f42-4a4f-9f0a-6d5b2b1c3a05", tc.values)
+		require.IsType(t, &PropertyError{}, err, tc.constraint)
+		require.Equal(t, tc.constraint, err.(*PropertyError).Constraint)
+	}
+}
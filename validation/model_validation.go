@@ -17,6 +17,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/go-openapi/strfmt"
+
 	"github.com/creativesoftwarefdn/weaviate/config"
 	"github.com/creativesoftwarefdn/weaviate/connectors"
 	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
@@ -57,8 +59,9 @@ const (
 	ErrorNotFoundInDatabase string = "error finding the '%s' in the database: '%s' at %s"
 )
 
-// ValidateThingBody Validates a thing body using the 'ThingCreate' object.
-func ValidateThingBody(ctx context.Context, thing *models.ThingCreate, databaseSchema schema.WeaviateSchema, dbConnector dbconnector.DatabaseConnector, serverConfig *config.WeaviateConfig, keyToken *models.KeyTokenGetResponse) error {
+// ValidateThingBody Validates a thing body using the 'ThingCreate' object. The UUID is
+// the UUID of the thing that is updated, or empty for a new thing.
+func ValidateThingBody(ctx context.Context, thing *models.ThingCreate, UUID strfmt.UUID, databaseSchema schema.WeaviateSchema, dbConnector dbconnector.DatabaseConnector, serverConfig *config.WeaviateConfig, keyToken *models.KeyTokenGetResponse) error {
 	// Validate the body
 	bve := validateBody(thing.AtClass, thing.AtContext)
 
@@ -68,13 +71,14 @@ func ValidateThingBody(ctx context.Context, thing *models.ThingCreate, databaseS
 	}
 
 	// Return the schema validation error
-	sve := ValidateSchemaInBody(ctx, databaseSchema.ThingSchema.Schema, thing, connutils.RefTypeThing, dbConnector, serverConfig, keyToken)
+	sve := ValidateSchemaInBody(ctx, databaseSchema, thing, connutils.RefTypeThing, UUID, dbConnector, serverConfig, keyToken)
 
 	return sve
 }
 
-// ValidateActionBody Validates a action body using the 'ActionCreate' object.
-func ValidateActionBody(ctx context.Context, action *models.ActionCreate, databaseSchema schema.WeaviateSchema, dbConnector dbconnector.DatabaseConnector, serverConfig *config.WeaviateConfig, keyToken *models.KeyTokenGetResponse) error {
+// ValidateActionBody Validates a action body using the 'ActionCreate' object. The UUID is
+// the UUID of the action that is updated, or empty for a new action.
+func ValidateActionBody(ctx context.Context, action *models.ActionCreate, UUID strfmt.UUID, databaseSchema schema.WeaviateSchema, dbConnector dbconnector.DatabaseConnector, serverConfig *config.WeaviateConfig, keyToken *models.KeyTokenGetResponse) error {
 	// Validate the body
 	bve := validateBody(action.AtClass, action.AtContext)
 
@@ -84,7 +88,7 @@ func ValidateActionBody(ctx context.Context, action *models.ActionCreate, databa
 	}
 
 	// Return the schema validation error
-	sve := ValidateSchemaInBody(ctx, databaseSchema.ActionSchema.Schema, action, connutils.RefTypeAction, dbConnector, serverConfig, keyToken)
+	sve := ValidateSchemaInBody(ctx, databaseSchema, action, connutils.RefTypeAction, UUID, dbConnector, serverConfig, keyToken)
 
 	return sve
 }
@@ -112,6 +116,13 @@ func validateRefType(s connutils.RefType) bool {
 
 // ValidateSingleRef validates a single ref based on location URL and existence of the object in the database
 func ValidateSingleRef(ctx context.Context, serverConfig *config.WeaviateConfig, cref *models.SingleRef, dbConnector dbconnector.DatabaseConnector, errorVal string, keyToken *models.KeyTokenGetResponse) error {
+	_, err := findSingleRef(ctx, serverConfig, cref, dbConnector, errorVal, keyToken)
+	return err
+}
+
+// findSingleRef checks that the object a reference refers to exists, and returns the class of the thing or action
+// when it is in this Weaviate. The class is empty for keys and external objects.
+func findSingleRef(ctx context.Context, serverConfig *config.WeaviateConfig, cref *models.SingleRef, dbConnector dbconnector.DatabaseConnector, errorVal string, keyToken *models.KeyTokenGetResponse) (string, error) {
 	// Init reftype
 	refType := connutils.RefType(cref.Type)
 
@@ -120,7 +131,7 @@ func ValidateSingleRef(ctx context.Context, serverConfig *config.WeaviateConfig,
 		// Search for key-information for resolving this part. Dont validate if not exists
 		instance, err := serverConfig.GetInstance(*cref.LocationURL, keyToken)
 		if err != nil {
-			return fmt.Errorf(ErrorNoExternalCredentials, *cref.LocationURL, errorVal)
+			return "", fmt.Errorf(ErrorNoExternalCredentials, *cref.LocationURL, errorVal)
 		}
 
 		// Set endpoint
@@ -137,28 +148,33 @@ func ValidateSingleRef(ctx context.Context, serverConfig *config.WeaviateConfig,
 		// Check wheter the Object's location URL is pointing to a existing Weaviate instance
 		response, err := connutils.DoExternalRequest(instance, endpoint, cref.NrDollarCref)
 		if err != nil {
-			return fmt.Errorf(ErrorExternalNotFound, *cref.LocationURL, response.StatusCode, errorVal)
+			return "", fmt.Errorf(ErrorExternalNotFound, *cref.LocationURL, response.StatusCode, errorVal)
 		}
 	} else {
 		// Check whether the given Object exists in the DB
 		var err error
+		var className string
 		if refType == connutils.RefTypeThing {
 			obj := &models.ThingGetResponse{}
 			err = dbConnector.GetThing(ctx, cref.NrDollarCref, obj)
+			className = obj.AtClass
 		} else if refType == connutils.RefTypeAction {
 			obj := &models.ActionGetResponse{}
 			err = dbConnector.GetAction(ctx, cref.NrDollarCref, obj)
+			className = obj.AtClass
 		} else if refType == connutils.RefTypeKey {
 			obj := &models.KeyGetResponse{}
 			err = dbConnector.GetKey(ctx, cref.NrDollarCref, obj)
 		} else {
-			return fmt.Errorf(ErrorInvalidCRefType, cref.Type)
+			return "", fmt.Errorf(ErrorInvalidCRefType, cref.Type)
 		}
 
 		if err != nil {
-			return fmt.Errorf(ErrorNotFoundInDatabase, cref.Type, err, errorVal)
+			return "", fmt.Errorf(ErrorNotFoundInDatabase, cref.Type, err, errorVal)
 		}
+
+		return className, nil
 	}
 
-	return nil
+	return "", nil
 }
@@ -52,19 +52,29 @@ const (
 	ErrorInvalidBool string = "class '%s' with property '%s' requires a bool. The given value is '%v'"
 	// ErrorInvalidDate message
 	ErrorInvalidDate string = "class '%s' with property '%s' requires a string with a RFC3339 formatted date. The given value is '%v'"
+	// ErrorInvalidGeoCoordinates message
+	ErrorInvalidGeoCoordinates string = "class '%s' with property '%s' requires an object with a 'latitude' from -90 to 90 and a 'longitude' from -180 to 180. The given value is '%v'"
+	// ErrorInvalidArray message
+	ErrorInvalidArray string = "class '%s' with property '%s' requires a list of values of the data type '%s'. The given value is '%v'"
+	// ErrorInvalidSingleRefClass message
+	ErrorInvalidSingleRefClass string = "class '%s' with property '%s' requires a reference to one of the classes %v or their subclasses. The %s '%s' is of class '%s'"
 )
 
-// ValidateSchemaInBody Validate the schema in the given body
-func ValidateSchemaInBody(ctx context.Context, weaviateSchema *models.SemanticSchema, object interface{}, refType connutils.RefType, dbConnector dbconnector.DatabaseConnector, serverConfig *config.WeaviateConfig, keyToken *models.KeyTokenGetResponse) error {
+// ValidateSchemaInBody Validate the schema in the given body, and check the constraints of the properties. The UUID is
+// the UUID of the thing or action that is updated, or empty when it is new.
+func ValidateSchemaInBody(ctx context.Context, databaseSchema schema.WeaviateSchema, object interface{}, refType connutils.RefType, UUID strfmt.UUID, dbConnector dbconnector.DatabaseConnector, serverConfig *config.WeaviateConfig, keyToken *models.KeyTokenGetResponse) error {
 	// Initialize class object
 	var isp interface{}
 	var className string
+	var weaviateSchema *models.SemanticSchema
 	if refType == connutils.RefTypeAction {
 		className = object.(*models.ActionCreate).AtClass
 		isp = object.(*models.ActionCreate).Schema
+		weaviateSchema = databaseSchema.ActionSchema.Schema
 	} else if refType == connutils.RefTypeThing {
 		className = object.(*models.ThingCreate).AtClass
 		isp = object.(*models.ThingCreate).Schema
+		weaviateSchema = databaseSchema.ThingSchema.Schema
 	} else {
 		return fmt.Errorf(schema.ErrorInvalidRefType)
 	}
@@ -81,7 +91,7 @@ func ValidateSchemaInBody(ctx context.Context, weaviateSchema *models.SemanticSc
 	// Validate whether the properties exist in the given schema
 	// Get the input properties from the bodySchema in readable format
 	if isp == nil {
-		return nil
+		return validateConstraints(ctx, class, refType, UUID, map[string]interface{}{}, dbConnector)
 	}
 
 	inputSchema := isp.(map[string]interface{})
@@ -155,122 +165,54 @@ func ValidateSchemaInBody(ctx context.Context, weaviateSchema *models.SemanticSc
 			locationURL := pvcr["locationUrl"].(string)
 			cref.LocationURL = &locationURL
 			cref.NrDollarCref = strfmt.UUID(pvcr["$cref"].(string))
-			err = ValidateSingleRef(ctx, serverConfig, cref, dbConnector, fmt.Sprintf("'cref' %s %s:%s", cref.Type, class.Class, pk), keyToken)
+			refClass, err := findSingleRef(ctx, serverConfig, cref, dbConnector, fmt.Sprintf("'cref' %s %s:%s", cref.Type, class.Class, pk), keyToken)
 			if err != nil {
 				return err
 			}
 
+			// The referred thing or action is of one of the classes of the property, or of a subclass of one of them
+			if err := validateSingleRefClass(databaseSchema, class, pk, cref, refClass); err != nil {
+				return err
+			}
+
 			data = cref
-		} else if *dt == schema.DataTypeString {
-			// Return error when the input can not be casted to a string
-			var ok bool
-			data, ok = pv.(string)
+		} else if schema.IsArrayDataType(*dt) {
+			// Validate every value of the list against the data type of its elements
+			list, ok := pv.([]interface{})
 			if !ok {
 				return fmt.Errorf(
-					ErrorInvalidString,
-					class.Class,
-					pk,
-					pv,
-				)
-			}
-		} else if *dt == schema.DataTypeInt {
-			var ok bool
-			// Return error when the input can not be casted to json.Number
-			if data, ok = pv.(json.Number); !ok {
-				// If value is not a json.Number, it could be an int, which is fine
-				if data, ok = pv.(int64); !ok {
-					// If value is not a json.Number, it could be an int, which is fine when the float does not contain a decimal
-					if data, ok = pv.(float64); ok {
-						// Check whether the float is containing a decimal
-						if data != float64(int64(data.(float64))) {
-							return fmt.Errorf(
-								ErrorInvalidInteger,
-								class.Class,
-								pk,
-								pv,
-							)
-						}
-					} else {
-						// If it is not a float, it is cerntainly not a integer, return the error
-						return fmt.Errorf(
-							ErrorInvalidInteger,
-							class.Class,
-							pk,
-							pv,
-						)
-					}
-				}
-			} else if data, err = pv.(json.Number).Int64(); err != nil {
-				// Return error when the input can not be converted to an int
-				return fmt.Errorf(
-					ErrorInvalidIntegerConvertion,
+					ErrorInvalidArray,
 					class.Class,
 					pk,
+					schema.ElementDataType(*dt),
 					pv,
 				)
 			}
 
-		} else if *dt == schema.DataTypeNumber {
-			var ok bool
-			// Return error when the input can not be casted to json.Number
-			if data, ok = pv.(json.Number); !ok {
-				if data, ok = pv.(float64); !ok {
-					return fmt.Errorf(
-						ErrorInvalidFloat,
-						class.Class,
-						pk,
-						pv,
-					)
+			values := make([]interface{}, 0, len(list))
+			for _, element := range list {
+				value, err := validatePrimitiveValue(class.Class, pk, schema.ElementDataType(*dt), element)
+				if err != nil {
+					return err
 				}
-			} else if data, err = pv.(json.Number).Float64(); err != nil {
-				// Return error when the input can not be converted to a float
-				return fmt.Errorf(
-					ErrorInvalidFloatConvertion,
-					class.Class,
-					pk,
-					pv,
-				)
-			}
-		} else if *dt == schema.DataTypeBoolean {
-			var ok bool
-			// Return error when the input can not be casted to a boolean
-			if data, ok = pv.(bool); !ok {
-				return fmt.Errorf(
-					ErrorInvalidBool,
-					class.Class,
-					pk,
-					pv,
-				)
-			}
-		} else if *dt == schema.DataTypeDate {
-			var ok bool
-			// Return error when the input can not be casted to a string
-			if data, ok = pv.(string); !ok {
-				return fmt.Errorf(
-					ErrorInvalidDate,
-					class.Class,
-					pk,
-					pv,
-				)
+				values = append(values, value)
 			}
-
-			// Parse the time as this has to be correct
-			data, err = time.Parse(time.RFC3339, pv.(string))
-
-			// Return if there is an error while parsing
+			data = values
+		} else {
+			data, err = validatePrimitiveValue(class.Class, pk, *dt, pv)
 			if err != nil {
-				return fmt.Errorf(
-					ErrorInvalidDate,
-					class.Class,
-					pk,
-					pv,
-				)
+				return err
 			}
 		}
 		// Put the right and validated types into the schema.
 		returnSchema[pk] = data
 	}
 
+	// Check the validated values against the constraints of the properties
+	if err := validateConstraints(ctx, class, refType, UUID, returnSchema, dbConnector); err != nil {
+		return err
+	}
+
 	// Put the right and validated types into the object-schema.
 	if refType == connutils.RefTypeAction {
 		object.(*models.ActionCreate).Schema = returnSchema
@@ -282,3 +224,181 @@ func ValidateSchemaInBody(ctx context.Context, weaviateSchema *models.SemanticSc
 
 	return nil
 }
+
+// validateSingleRefClass validates that the class of the thing or action a reference refers to is one of the
+// classes in the data type of the property, or extends one of them. The class of external objects is not known.
+func validateSingleRefClass(databaseSchema schema.WeaviateSchema, class *models.SemanticSchemaClass, propertyName string, cref *models.SingleRef, refClass string) error {
+	if refClass == "" {
+		return nil
+	}
+
+	refSchema := databaseSchema.ThingSchema.Schema
+	if connutils.RefType(cref.Type) == connutils.RefTypeAction {
+		refSchema = databaseSchema.ActionSchema.Schema
+	}
+
+	property, err := schema.GetPropertyByName(class, propertyName)
+	if err != nil {
+		return err
+	}
+
+	for _, parentName := range property.AtDataType {
+		if schema.IsSubclassOf(refSchema, refClass, parentName) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf(ErrorInvalidSingleRefClass, class.Class, propertyName, property.AtDataType, cref.Type, cref.NrDollarCref, refClass)
+}
+
+// validatePrimitiveValue validates a single value of a primitive data type, and returns it in the type it is stored in.
+func validatePrimitiveValue(className string, propertyName string, dataType schema.DataType, value interface{}) (interface{}, error) {
+	var data interface{}
+	var err error
+
+	if dataType == schema.DataTypeString {
+		// Return error when the input can not be casted to a string
+		var ok bool
+		data, ok = value.(string)
+		if !ok {
+			return nil, fmt.Errorf(
+				ErrorInvalidString,
+				className,
+				propertyName,
+				value,
+			)
+		}
+	} else if dataType == schema.DataTypeInt {
+		var ok bool
+		// Return error when the input can not be casted to json.Number
+		if data, ok = value.(json.Number); !ok {
+			// If value is not a json.Number, it could be an int, which is fine
+			if data, ok = value.(int64); !ok {
+				// If value is not a json.Number, it could be an int, which is fine when the float does not contain a decimal
+				if data, ok = value.(float64); ok {
+					// Check whether the float is containing a decimal
+					if data != float64(int64(data.(float64))) {
+						return nil, fmt.Errorf(
+							ErrorInvalidInteger,
+							className,
+							propertyName,
+							value,
+						)
+					}
+				} else {
+					// If it is not a float, it is cerntainly not a integer, return the error
+					return nil, fmt.Errorf(
+						ErrorInvalidInteger,
+						className,
+						propertyName,
+						value,
+					)
+				}
+			}
+		} else if data, err = value.(json.Number).Int64(); err != nil {
+			// Return error when the input can not be converted to an int
+			return nil, fmt.Errorf(
+				ErrorInvalidIntegerConvertion,
+				className,
+				propertyName,
+				value,
+			)
+		}
+
+	} else if dataType == schema.DataTypeNumber {
+		var ok bool
+		// Return error when the input can not be casted to json.Number
+		if data, ok = value.(json.Number); !ok {
+			if data, ok = value.(float64); !ok {
+				return nil, fmt.Errorf(
+					ErrorInvalidFloat,
+					className,
+					propertyName,
+					value,
+				)
+			}
+		} else if data, err = value.(json.Number).Float64(); err != nil {
+			// Return error when the input can not be converted to a float
+			return nil, fmt.Errorf(
+				ErrorInvalidFloatConvertion,
+				className,
+				propertyName,
+				value,
+			)
+		}
+	} else if dataType == schema.DataTypeBoolean {
+		var ok bool
+		// Return error when the input can not be casted to a boolean
+		if data, ok = value.(bool); !ok {
+			return nil, fmt.Errorf(
+				ErrorInvalidBool,
+				className,
+				propertyName,
+				value,
+			)
+		}
+	} else if dataType == schema.DataTypeDate {
+		var ok bool
+		// Return error when the input can not be casted to a string
+		if data, ok = value.(string); !ok {
+			return nil, fmt.Errorf(
+				ErrorInvalidDate,
+				className,
+				propertyName,
+				value,
+			)
+		}
+
+		// Parse the time as this has to be correct
+		data, err = time.Parse(time.RFC3339, value.(string))
+
+		// Return if there is an error while parsing
+		if err != nil {
+			return nil, fmt.Errorf(
+				ErrorInvalidDate,
+				className,
+				propertyName,
+				value,
+			)
+		}
+	} else if dataType == schema.DataTypeGeoCoordinates {
+		// Return error when the input is not an object with a latitude and a longitude on earth
+		var ok bool
+		if data, ok = geoCoordinates(value); !ok {
+			return nil, fmt.Errorf(
+				ErrorInvalidGeoCoordinates,
+				className,
+				propertyName,
+				value,
+			)
+		}
+	}
+
+	return data, nil
+}
+
+// geoCoordinates converts an object with a latitude and a longitude to coordinates, if they are on earth.
+func geoCoordinates(value interface{}) (*models.GeoCoordinates, bool) {
+	if coordinates, ok := value.(*models.GeoCoordinates); ok {
+		return coordinates, validGeoCoordinates(coordinates)
+	}
+
+	object, ok := value.(map[string]interface{})
+	if !ok || len(object) != 2 {
+		return nil, false
+	}
+
+	latitude, latitudeOk := schema.NumberValue(object["latitude"])
+	longitude, longitudeOk := schema.NumberValue(object["longitude"])
+	if !latitudeOk || !longitudeOk {
+		return nil, false
+	}
+
+	coordinates := &models.GeoCoordinates{Latitude: latitude, Longitude: longitude}
+	return coordinates, validGeoCoordinates(coordinates)
+}
+
+func validGeoCoordinates(coordinates *models.GeoCoordinates) bool {
+	return coordinates.Latitude >= -90 && coordinates.Latitude <= 90 &&
+		coordinates.Longitude >= -180 && coordinates.Longitude <= 180
+}
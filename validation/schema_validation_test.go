@@ -0,0 +1,158 @@
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/require"
+
+	"github.com/creativesoftwarefdn/weaviate/config"
+	"github.com/creativesoftwarefdn/weaviate/connectors"
+	"github.com/creativesoftwarefdn/weaviate/connectors/utils"
+	"github.com/creativesoftwarefdn/weaviate/models"
+	"github.com/creativesoftwarefdn/weaviate/schema"
+)
+
+// A database schema with the given classes of things, and no classes of actions
+func thingSchema(things *models.SemanticSchema) schema.WeaviateSchema {
+	var databaseSchema schema.WeaviateSchema
+	databaseSchema.ThingSchema.Schema = things
+	databaseSchema.ActionSchema.Schema = &models.SemanticSchema{}
+	return databaseSchema
+}
+
+var arraySchema = &models.SemanticSchema{
+	Classes: []*models.SemanticSchemaClass{
+		{
+			Class: "City",
+			Properties: []*models.SemanticSchemaClassProperty{
+				{Name: "names", AtDataType: []string{"string[]"}},
+				{Name: "districts", AtDataType: []string{"int[]"}},
+				{Name: "areas", AtDataType: []string{"number[]"}},
+				{Name: "elections", AtDataType: []string{"date[]"}},
+			},
+		},
+	},
+}
+
+func TestValidateArrayValues(t *testing.T) {
+	thing := &models.ThingCreate{
+		AtClass: "City",
+		Schema: map[string]interface{}{
+			"names":     []interface{}{"Amsterdam", "Mokum"},
+			"districts": []interface{}{json.Number("1"), float64(2)},
+			"areas":     []interface{}{json.Number("219.3")},
+			"elections": []interface{}{"2018-03-21T00:00:00Z"},
+		},
+	}
+	require.Nil(t, ValidateSchemaInBody(context.Background(), thingSchema(arraySchema), thing, connutils.RefTypeThing, "", nil, nil, nil))
+
+	values := thing.Schema.(map[string]interface{})
+	require.Equal(t, []interface{}{"Amsterdam", "Mokum"}, values["names"])
+	require.Equal(t, []interface{}{int64(1), float64(2)}, values["districts"])
+	require.Equal(t, []interface{}{219.3}, values["areas"])
+	require.IsType(t, time.Time{}, values["elections"].([]interface{})[0])
+
+	invalid := []map[string]interface{}{
+		{"names": "Amsterdam"},
+		{"names": []interface{}{"Amsterdam", int64(1)}},
+		{"districts": []interface{}{float64(1.5)}},
+		{"elections": []interface{}{"yesterday"}},
+	}
+	for _, values := range invalid {
+		thing := &models.ThingCreate{AtClass: "City", Schema: values}
+		require.NotNil(t, ValidateSchemaInBody(context.Background(), thingSchema(arraySchema), thing, connutils.RefTypeThing, "", nil, nil, nil), values)
+	}
+}
+
+func TestValidateGeoCoordinates(t *testing.T) {
+	geoSchema := &models.SemanticSchema{
+		Classes: []*models.SemanticSchemaClass{
+			{
+				Class: "City",
+				Properties: []*models.SemanticSchemaClassProperty{
+					{Name: "location", AtDataType: []string{"geoCoordinates"}},
+				},
+			},
+		},
+	}
+
+	thing := &models.ThingCreate{
+		AtClass: "City",
+		Schema: map[string]interface{}{
+			"location": map[string]interface{}{"latitude": json.Number("52.37"), "longitude": float64(4.89)},
+		},
+	}
+	require.Nil(t, ValidateSchemaInBody(context.Background(), thingSchema(geoSchema), thing, connutils.RefTypeThing, "", nil, nil, nil))
+	require.Equal(t, &models.GeoCoordinates{Latitude: 52.37, Longitude: 4.89}, thing.Schema.(map[string]interface{})["location"])
+
+	invalid := []interface{}{
+		"52.37, 4.89",
+		map[string]interface{}{"latitude": float64(52.37)},
+		map[string]interface{}{"latitude": float64(52.37), "longitude": float64(4.89), "altitude": float64(0)},
+		map[string]interface{}{"latitude": "52.37", "longitude": float64(4.89)},
+		map[string]interface{}{"latitude": float64(91), "longitude": float64(4.89)},
+		map[string]interface{}{"latitude": float64(52.37), "longitude": float64(-181)},
+	}
+	for _, value := range invalid {
+		thing := &models.ThingCreate{AtClass: "City", Schema: map[string]interface{}{"location": value}}
+		require.NotNil(t, ValidateSchemaInBody(context.Background(), thingSchema(geoSchema), thing, connutils.RefTypeThing, "", nil, nil, nil), value)
+	}
+}
+
+// A connector that has things of the classes in a map from UUIDs to class names
+type fakeThings struct {
+	dbconnector.DatabaseConnector
+	classes map[strfmt.UUID]string
+}
+
+func (f *fakeThings) GetThing(ctx context.Context, UUID strfmt.UUID, thingResponse *models.ThingGetResponse) error {
+	className, ok := f.classes[UUID]
+	if !ok {
+		return fmt.Errorf("thing '%s' not found", UUID)
+	}
+	thingResponse.AtClass = className
+	return nil
+}
+
+func TestValidateSingleRefClass(t *testing.T) {
+	inheritanceSchema := &models.SemanticSchema{
+		Classes: []*models.SemanticSchemaClass{
+			{Class: "Person"},
+			{Class: "Employee", Extends: "Person"},
+			{Class: "Manager", Extends: "Employee"},
+			{Class: "City"},
+			{
+				Class: "Company",
+				Properties: []*models.SemanticSchemaClassProperty{
+					{Name: "ceo", AtDataType: []string{"Person"}},
+				},
+			},
+		},
+	}
+	serverConfig := &config.WeaviateConfig{Scheme: "http", Hostname: "localhost"}
+	connector := &fakeThings{classes: map[strfmt.UUID]string{
+		amsterdam: "City",
+		rotterdam: "Person",
+		holland:   "Manager",
+	}}
+
+	ceo := func(UUID strfmt.UUID) *models.ThingCreate {
+		return &models.ThingCreate{AtClass: "Company", Schema: map[string]interface{}{
+			"ceo": map[string]interface{}{"$cref": string(UUID), "locationUrl": "http://localhost", "type": "Thing"},
+		}}
+	}
+
+	// A reference to the class of the property, or to a class that extends it directly or through other classes
+	for _, UUID := range []strfmt.UUID{rotterdam, holland} {
+		require.Nil(t, ValidateSchemaInBody(context.Background(), thingSchema(inheritanceSchema), ceo(UUID), connutils.RefTypeThing, "", connector, serverConfig, nil), UUID)
+	}
+
+	err := ValidateSchemaInBody(context.Background(), thingSchema(inheritanceSchema), ceo(amsterdam), connutils.RefTypeThing, "", connector, serverConfig, nil)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "'City'")
+}